@@ -269,6 +269,10 @@ func createPublicTables(db *bun.DB) error {
 		return err
 	}
 
+	if err := createTableIfNotExists(ctx, tx, (*model.IbptTaxRate)(nil)); err != nil {
+		return err
+	}
+
 	if err := createTableIfNotExists(ctx, tx, (*model.Contact)(nil)); err != nil {
 		return err
 	}
@@ -342,12 +346,33 @@ func registerModels(db *bun.DB) error {
 	db.RegisterModel((*model.ProcessRuleWithOrderProcess)(nil))
 	db.RegisterModel((*model.ProductVariation)(nil))
 	db.RegisterModel((*model.Product)(nil))
+	db.RegisterModel((*model.Combo)(nil))
+	db.RegisterModel((*model.PriceList)(nil))
 
 	db.RegisterModel((*model.Stock)(nil))
 	db.RegisterModel((*model.StockMovement)(nil))
 	db.RegisterModel((*model.StockBatch)(nil))
 	db.RegisterModel((*model.StockAlert)(nil))
 
+	db.RegisterModel((*model.Supplier)(nil))
+	db.RegisterModel((*model.PurchaseOrder)(nil))
+	db.RegisterModel((*model.PurchaseOrderItem)(nil))
+	db.RegisterModel((*model.ExpenseCategory)(nil))
+	db.RegisterModel((*model.AccountPayable)(nil))
+	db.RegisterModel((*model.AccountReceivable)(nil))
+	db.RegisterModel((*model.PayMethodFee)(nil))
+	db.RegisterModel((*model.SettlementStatement)(nil))
+	db.RegisterModel((*model.SettlementStatementLine)(nil))
+	db.RegisterModel((*model.SupplierProductMapping)(nil))
+	db.RegisterModel((*model.SupplierInvoice)(nil))
+	db.RegisterModel((*model.InventoryCount)(nil))
+	db.RegisterModel((*model.InventoryCountItem)(nil))
+	db.RegisterModel((*model.InventoryCountEntry)(nil))
+	db.RegisterModel((*model.StockLoss)(nil))
+	db.RegisterModel((*model.StockLocation)(nil))
+	db.RegisterModel((*model.StockTransfer)(nil))
+	db.RegisterModel((*model.StockTransferLine)(nil))
+
 	db.RegisterModel((*model.Address)(nil))
 	db.RegisterModel((*model.Contact)(nil))
 	db.RegisterModel((*model.Client)(nil))
@@ -377,6 +402,8 @@ func registerModels(db *bun.DB) error {
 	db.RegisterModel((*model.Place)(nil))
 
 	db.RegisterModel((*model.Shift)(nil))
+	db.RegisterModel((*model.ReportSubscription)(nil))
+	db.RegisterModel((*model.ReportSubscriptionDelivery)(nil))
 	db.RegisterModel((*model.CompanyToUsers)(nil))
 	db.RegisterModel((*model.CompanyToCategory)(nil))
 	db.RegisterModel((*model.CategoryToSponsor)(nil))
@@ -394,6 +421,7 @@ func registerModels(db *bun.DB) error {
 	db.RegisterModel((*model.CompanySubscription)(nil))
 	db.RegisterModel((*model.FiscalInvoice)(nil))
 	db.RegisterModel((*model.FiscalSettings)(nil))
+	db.RegisterModel((*model.IbptTaxRate)(nil))
 
 	return nil
 }
@@ -432,6 +460,14 @@ func createTables(ctx context.Context, tx *bun.Tx) error {
 		return err
 	}
 
+	if err := createTableIfNotExists(ctx, tx, (*model.Combo)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.PriceList)(nil)); err != nil {
+		return err
+	}
+
 	if err := createTableIfNotExists(ctx, tx, (*model.Stock)(nil)); err != nil {
 		return err
 	}
@@ -448,6 +484,78 @@ func createTables(ctx context.Context, tx *bun.Tx) error {
 		return err
 	}
 
+	if err := createTableIfNotExists(ctx, tx, (*model.Supplier)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.PurchaseOrder)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.PurchaseOrderItem)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.ExpenseCategory)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.AccountPayable)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.AccountReceivable)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.PayMethodFee)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.SettlementStatement)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.SettlementStatementLine)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.SupplierProductMapping)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.SupplierInvoice)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.InventoryCount)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.InventoryCountItem)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.InventoryCountEntry)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.StockLoss)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.StockLocation)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.StockTransfer)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.StockTransferLine)(nil)); err != nil {
+		return err
+	}
+
 	if err := createTableIfNotExists(ctx, tx, (*model.Address)(nil)); err != nil {
 		return err
 	}
@@ -554,5 +662,13 @@ func createTables(ctx context.Context, tx *bun.Tx) error {
 		return err
 	}
 
+	if err := createTableIfNotExists(ctx, tx, (*model.ReportSubscription)(nil)); err != nil {
+		return err
+	}
+
+	if err := createTableIfNotExists(ctx, tx, (*model.ReportSubscriptionDelivery)(nil)); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,2 @@
+-- NCM do produto, usado na NFC-e e no cálculo dos tributos aproximados (IBPT)
+ALTER TABLE products ADD COLUMN IF NOT EXISTS ncm TEXT;
@@ -0,0 +1,77 @@
+-- =============================================================================
+-- Compras: fornecedores, pedidos de compra, recebimento e contas a pagar
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Fornecedores
+CREATE TABLE IF NOT EXISTS suppliers (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name TEXT NOT NULL,
+    trade_name TEXT,
+    cnpj TEXT,
+    state_registration TEXT,
+    email TEXT,
+    phone TEXT,
+    contact_name TEXT,
+    lead_time_days INTEGER NOT NULL DEFAULT 0,
+    payment_term_days INTEGER NOT NULL DEFAULT 0,
+    notes TEXT,
+    is_active BOOLEAN DEFAULT TRUE
+);
+CREATE INDEX IF NOT EXISTS idx_suppliers_cnpj ON suppliers (cnpj);
+
+-- 2. Pedidos de compra e itens
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    supplier_id UUID NOT NULL REFERENCES suppliers(id),
+    status TEXT NOT NULL,
+    notes TEXT,
+    expected_delivery_at TIMESTAMPTZ,
+    ordered_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_status ON purchase_orders (status);
+
+CREATE TABLE IF NOT EXISTS purchase_order_items (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    purchase_order_id UUID NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    stock_id UUID NOT NULL REFERENCES stocks(id),
+    product_id UUID NOT NULL,
+    product_variation_id UUID,
+    description TEXT,
+    quantity DECIMAL(10,3) NOT NULL,
+    received_quantity DECIMAL(10,3) NOT NULL DEFAULT 0,
+    unit_cost DECIMAL(10,2) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_purchase_order_items_order ON purchase_order_items (purchase_order_id);
+
+-- 3. Movimentos de entrada apontam para o pedido recebido
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS purchase_order_id UUID;
+
+-- 4. Contas a pagar
+CREATE TABLE IF NOT EXISTS account_payables (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    description TEXT NOT NULL,
+    supplier_id UUID REFERENCES suppliers(id),
+    purchase_order_id UUID REFERENCES purchase_orders(id),
+    document_number TEXT,
+    amount DECIMAL(10,2) NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL,
+    paid_at TIMESTAMPTZ,
+    paid_amount DECIMAL(10,2)
+);
+CREATE INDEX IF NOT EXISTS idx_account_payables_status_due ON account_payables (status, due_date);
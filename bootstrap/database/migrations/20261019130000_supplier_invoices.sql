@@ -0,0 +1,37 @@
+-- =============================================================================
+-- Importação de NF-e de compra (XML do fornecedor) para entrada de estoque
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Código do produto no fornecedor (cProd) → estoque, com fator de conversão de unidade
+CREATE TABLE IF NOT EXISTS supplier_product_mappings (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    supplier_id UUID NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE,
+    supplier_code TEXT NOT NULL,
+    stock_id UUID NOT NULL REFERENCES stocks(id) ON DELETE CASCADE,
+    factor DECIMAL(10,3) NOT NULL DEFAULT 1,
+    CONSTRAINT supplier_product_mappings_supplier_code_key UNIQUE (supplier_id, supplier_code)
+);
+
+-- 2. Notas recebidas; a chave de acesso única impede importar a mesma NF-e duas vezes
+CREATE TABLE IF NOT EXISTS supplier_invoices (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    access_key TEXT NOT NULL UNIQUE,
+    supplier_id UUID NOT NULL REFERENCES suppliers(id),
+    number TEXT,
+    series TEXT,
+    issued_at TIMESTAMPTZ,
+    total_amount DECIMAL(10,2),
+    status TEXT NOT NULL,
+    imported_at TIMESTAMPTZ,
+    items JSONB
+);
+
+-- 3. Movimentos de entrada apontam para a NF-e importada
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS supplier_invoice_id UUID;
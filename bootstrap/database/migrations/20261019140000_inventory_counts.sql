@@ -0,0 +1,62 @@
+-- =============================================================================
+-- Inventário físico: sessões de contagem com quantidades esperadas congeladas
+-- e lançamento das diferenças como adjust_in/adjust_out
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Sessões de contagem (completa ou por categoria)
+CREATE TABLE IF NOT EXISTS inventory_counts (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    scope TEXT NOT NULL,
+    category_id UUID,
+    status TEXT NOT NULL,
+    notes TEXT,
+    started_by UUID NOT NULL,
+    approved_by UUID,
+    approved_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_inventory_counts_status ON inventory_counts (status);
+
+-- 2. Itens: um por lote com saldo (ou por estoque sem lote) com esperado e custo congelados
+CREATE TABLE IF NOT EXISTS inventory_count_items (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    inventory_count_id UUID NOT NULL REFERENCES inventory_counts(id) ON DELETE CASCADE,
+    stock_id UUID NOT NULL REFERENCES stocks(id),
+    batch_id UUID,
+    product_id UUID NOT NULL,
+    product_variation_id UUID,
+    sku TEXT,
+    description TEXT,
+    unit TEXT,
+    expected_quantity DECIMAL(10,3) NOT NULL,
+    cost_price DECIMAL(10,2) NOT NULL DEFAULT 0,
+    counted_quantity DECIMAL(10,3),
+    counted_by UUID,
+    counted_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_inventory_count_items_count ON inventory_count_items (inventory_count_id);
+
+-- 3. Lançamentos de contagem por funcionário (auditoria)
+CREATE TABLE IF NOT EXISTS inventory_count_entries (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    inventory_count_id UUID NOT NULL REFERENCES inventory_counts(id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES inventory_count_items(id) ON DELETE CASCADE,
+    employee_id UUID NOT NULL,
+    mode TEXT NOT NULL,
+    quantity DECIMAL(10,3) NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_inventory_count_entries_count ON inventory_count_entries (inventory_count_id);
+
+-- 4. Ajustes de estoque apontam para a sessão de inventário
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS inventory_count_id UUID;
+CREATE INDEX IF NOT EXISTS idx_stock_movements_inventory_count ON stock_movements (inventory_count_id);
@@ -0,0 +1,30 @@
+-- =============================================================================
+-- Perdas e desperdício com motivo categorizado
+-- (vencido, estragado, queda, refeição de funcionário, cortesia, furto)
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Registros de perda valorizados pelo custo dos lotes baixados
+CREATE TABLE IF NOT EXISTS stock_losses (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    stock_id UUID NOT NULL REFERENCES stocks(id),
+    product_id UUID NOT NULL,
+    product_variation_id UUID,
+    batch_id UUID,
+    reason TEXT NOT NULL,
+    quantity DECIMAL(10,3) NOT NULL,
+    total_cost DECIMAL(10,2) NOT NULL DEFAULT 0,
+    employee_id UUID NOT NULL,
+    order_process_id UUID,
+    group_item_id UUID,
+    source_reason TEXT,
+    notes TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_stock_losses_created_at ON stock_losses (created_at);
+CREATE INDEX IF NOT EXISTS idx_stock_losses_reason ON stock_losses (reason);
+
+-- 2. Saídas de estoque apontam para o registro de perda
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS stock_loss_id UUID;
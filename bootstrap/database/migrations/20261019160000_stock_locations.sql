@@ -0,0 +1,59 @@
+-- =============================================================================
+-- Estoque em múltiplos locais (depósito, cozinha, bar) com transferências
+-- e débito das vendas no local mapeado à categoria/regra de processo
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Locais de estoque; lotes sem local pertencem ao local padrão
+CREATE TABLE IF NOT EXISTS stock_locations (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name TEXT NOT NULL,
+    description TEXT,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    category_ids JSONB,
+    process_rule_ids JSONB
+);
+
+-- 2. Transferências entre locais (in_transit, received, cancelled)
+CREATE TABLE IF NOT EXISTS stock_transfers (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    stock_id UUID NOT NULL REFERENCES stocks(id),
+    from_location_id UUID NOT NULL REFERENCES stock_locations(id),
+    to_location_id UUID NOT NULL REFERENCES stock_locations(id),
+    quantity DECIMAL(10,3) NOT NULL,
+    status TEXT NOT NULL,
+    notes TEXT,
+    sent_by UUID NOT NULL,
+    received_by UUID,
+    sent_at TIMESTAMPTZ NOT NULL,
+    received_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_stock_transfers_stock_status ON stock_transfers (stock_id, status);
+
+-- 3. Uma linha por lote de origem, com custo e validade para recriar o lote no destino
+CREATE TABLE IF NOT EXISTS stock_transfer_lines (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    transfer_id UUID NOT NULL REFERENCES stock_transfers(id),
+    source_batch_id UUID,
+    destination_batch_id UUID,
+    quantity DECIMAL(10,3) NOT NULL,
+    cost_price DECIMAL(10,2) NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS idx_stock_transfer_lines_transfer_id ON stock_transfer_lines (transfer_id);
+
+-- 4. Local dos lotes e dos movimentos
+ALTER TABLE IF EXISTS stock_batches ADD COLUMN IF NOT EXISTS location_id UUID;
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS location_id UUID;
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS transfer_id UUID;
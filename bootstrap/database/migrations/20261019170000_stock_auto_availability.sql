@@ -0,0 +1,8 @@
+-- =============================================================================
+-- Disponibilidade automática da variação pelo saldo do estoque (opt-in)
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Com a opção ligada, saldo zerado marca a variação como indisponível
+--    e a reposição volta a disponibilizá-la
+ALTER TABLE IF EXISTS stocks ADD COLUMN IF NOT EXISTS auto_availability BOOLEAN NOT NULL DEFAULT FALSE;
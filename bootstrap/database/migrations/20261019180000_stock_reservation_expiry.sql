@@ -0,0 +1,12 @@
+-- =============================================================================
+-- Validade das reservas de estoque e liberação automática
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Vencimento da reserva (só movimentos reserve); após ele o agendador
+--    libera o saldo de pedidos em rascunho com um movimento restore
+ALTER TABLE IF EXISTS stock_movements ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_reserve_expires_at
+    ON stock_movements (order_id, expires_at)
+    WHERE type = 'reserve' AND deleted_at IS NULL;
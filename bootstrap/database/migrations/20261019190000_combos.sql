@@ -0,0 +1,26 @@
+-- =============================================================================
+-- Combos: produto composto por slots com escolha de produto ou categoria
+-- e acréscimo opcional; cada componente vira um item do pedido
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Combos; os slots e suas opções ficam no jsonb
+CREATE TABLE IF NOT EXISTS combos (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name TEXT NOT NULL,
+    description TEXT,
+    price DECIMAL(10,2) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    slots JSONB
+);
+
+-- 2. Itens de combo: combo vendido e venda que agrupa os componentes
+ALTER TABLE IF EXISTS order_items ADD COLUMN IF NOT EXISTS combo_id UUID REFERENCES combos(id);
+ALTER TABLE IF EXISTS order_items ADD COLUMN IF NOT EXISTS combo_sale_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_order_items_combo_sale_id
+    ON order_items (combo_sale_id)
+    WHERE combo_sale_id IS NOT NULL;
@@ -0,0 +1,24 @@
+-- =============================================================================
+-- Listas de preço por horário (happy hour), canal e período de promoção
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Listas de preço; canais, janelas de horário e preços por variação no jsonb
+CREATE TABLE IF NOT EXISTS price_lists (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name TEXT NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    channels JSONB,
+    windows JSONB,
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    prices JSONB
+);
+
+-- 2. Lista aplicada no item e preço da variação que ela substituiu
+ALTER TABLE IF EXISTS order_items ADD COLUMN IF NOT EXISTS price_list_id UUID REFERENCES price_lists(id);
+ALTER TABLE IF EXISTS order_items ADD COLUMN IF NOT EXISTS list_price DECIMAL(10,2);
@@ -0,0 +1,47 @@
+-- =============================================================================
+-- Assinaturas de relatórios enviados por email (diário, semanal, mensal)
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Assinaturas por usuário; filtros extras do relatório e destinatários no jsonb
+CREATE TABLE IF NOT EXISTS report_subscriptions (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    user_id UUID NOT NULL,
+    name TEXT,
+    report_type TEXT NOT NULL,
+    params JSONB,
+    frequency TEXT NOT NULL,
+    hour INTEGER NOT NULL,
+    weekday INTEGER NOT NULL DEFAULT 0,
+    day_of_month INTEGER NOT NULL DEFAULT 0,
+    recipients JSONB,
+    format TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_report_subscriptions_next_run_at ON report_subscriptions (next_run_at) WHERE is_active AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_report_subscriptions_user_id ON report_subscriptions (user_id);
+
+-- 2. Log de envios de cada assinatura
+CREATE TABLE IF NOT EXISTS report_subscription_deliveries (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    subscription_id UUID NOT NULL REFERENCES report_subscriptions(id),
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    recipients JSONB,
+    format TEXT NOT NULL,
+    filename TEXT,
+    status TEXT NOT NULL,
+    error TEXT,
+    manual BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS idx_report_subscription_deliveries_subscription_id ON report_subscription_deliveries (subscription_id, created_at DESC);
@@ -0,0 +1,43 @@
+-- =============================================================================
+-- Financeiro: categorias de despesa, contas a pagar recorrentes com anexos e contas a receber
+-- Data: 2026-10-19
+-- =============================================================================
+
+-- 1. Categorias de despesa (aluguel, energia, marketing) usadas nas contas a pagar e na DRE
+CREATE TABLE IF NOT EXISTS expense_categories (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    name TEXT NOT NULL,
+    description TEXT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- 2. Contas a pagar: categoria, recorrência (série pela primeira conta) e anexos no S3
+ALTER TABLE IF EXISTS account_payables ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES expense_categories(id);
+ALTER TABLE IF EXISTS account_payables ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '';
+ALTER TABLE IF EXISTS account_payables ADD COLUMN IF NOT EXISTS recurrence_ends_at TIMESTAMPTZ;
+ALTER TABLE IF EXISTS account_payables ADD COLUMN IF NOT EXISTS recurrence_parent_id UUID REFERENCES account_payables(id);
+ALTER TABLE IF EXISTS account_payables ADD COLUMN IF NOT EXISTS attachments JSONB;
+CREATE INDEX IF NOT EXISTS idx_account_payables_recurrence_parent ON account_payables (recurrence_parent_id);
+
+-- 3. Contas a receber (repasses de cartão, vendas a prazo)
+CREATE TABLE IF NOT EXISTS account_receivables (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    description TEXT NOT NULL,
+    source TEXT NOT NULL,
+    pay_method TEXT,
+    order_id UUID REFERENCES orders(id),
+    document_number TEXT,
+    amount DECIMAL(10,2) NOT NULL,
+    expected_date TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL,
+    received_at TIMESTAMPTZ,
+    received_amount DECIMAL(10,2),
+    attachments JSONB
+);
+CREATE INDEX IF NOT EXISTS idx_account_receivables_status_expected ON account_receivables (status, expected_date);
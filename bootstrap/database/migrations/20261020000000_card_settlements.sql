@@ -0,0 +1,57 @@
+-- =============================================================================
+-- Repasses de cartão: taxa (MDR) e prazo por forma de pagamento, repasse esperado por pagamento
+-- e extratos de adquirente importados para conciliação
+-- Data: 2026-10-20
+-- =============================================================================
+
+-- 1. Condição da adquirente por forma de pagamento (uma ativa por forma)
+CREATE TABLE IF NOT EXISTS pay_method_fees (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    method TEXT NOT NULL,
+    acquirer TEXT,
+    fee_percent DECIMAL(5,2) NOT NULL DEFAULT 0,
+    fixed_fee DECIMAL(10,2) NOT NULL DEFAULT 0,
+    settlement_days INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pay_method_fees_method ON pay_method_fees (method) WHERE deleted_at IS NULL;
+
+-- 2. Pagamentos: líquido e data prevista do crédito
+ALTER TABLE IF EXISTS order_payments ADD COLUMN IF NOT EXISTS fee_amount DECIMAL(10,2);
+ALTER TABLE IF EXISTS order_payments ADD COLUMN IF NOT EXISTS net_amount DECIMAL(10,2);
+ALTER TABLE IF EXISTS order_payments ADD COLUMN IF NOT EXISTS expected_settlement_at TIMESTAMPTZ;
+
+-- 3. Conta a receber aponta o pagamento de origem do repasse
+ALTER TABLE IF EXISTS account_receivables ADD COLUMN IF NOT EXISTS payment_id UUID REFERENCES order_payments(id);
+CREATE INDEX IF NOT EXISTS idx_account_receivables_source_status ON account_receivables (source, status, expected_date);
+
+-- 4. Extratos importados (CSV/OFX) e suas linhas conciliadas
+CREATE TABLE IF NOT EXISTS settlement_statements (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    acquirer TEXT,
+    filename TEXT,
+    format TEXT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS settlement_statement_lines (
+    id UUID PRIMARY KEY,
+    statement_id UUID NOT NULL REFERENCES settlement_statements(id),
+    date TIMESTAMPTZ NOT NULL,
+    description TEXT,
+    reference TEXT,
+    pay_method TEXT,
+    amount DECIMAL(10,2) NOT NULL,
+    status TEXT NOT NULL,
+    expected_amount DECIMAL(10,2),
+    difference DECIMAL(10,2),
+    receivable_ids JSONB
+);
+CREATE INDEX IF NOT EXISTS idx_settlement_statement_lines_statement ON settlement_statement_lines (statement_id);
@@ -0,0 +1,20 @@
+-- =============================================================================
+-- Um único local de estoque padrão: ResolveLocation cai no padrão quando nenhum
+-- local cobre a categoria, então dois padrões deixariam o débito na ordem da consulta
+-- Data: 2026-10-20
+-- =============================================================================
+
+-- 1. Mantém só o padrão mais antigo
+UPDATE stock_locations SET is_default = FALSE
+WHERE is_default
+AND id NOT IN (
+    SELECT id FROM stock_locations
+    WHERE is_default AND deleted_at IS NULL
+    ORDER BY created_at ASC
+    LIMIT 1
+);
+
+-- 2. Índice parcial: no máximo um local padrão
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_locations_single_default
+    ON stock_locations (is_default)
+    WHERE is_default AND deleted_at IS NULL;
@@ -0,0 +1,7 @@
+-- =============================================================================
+-- Disponibilidade automática só religa o que ela mesma desligou
+-- Data: 2026-10-20
+-- =============================================================================
+
+-- 1. Variação desligada pelo saldo do estoque (a reposição só religa estas)
+ALTER TABLE IF EXISTS product_variations ADD COLUMN IF NOT EXISTS auto_unavailable BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,13 @@
+-- =============================================================================
+-- Impressão digital do extrato da adquirente: o mesmo arquivo (adquirente, período
+-- e referências das linhas) não pode ser conciliado duas vezes
+-- Data: 2026-10-20
+-- =============================================================================
+
+-- 1. Coluna (extratos antigos ficam sem impressão digital)
+ALTER TABLE settlement_statements ADD COLUMN IF NOT EXISTS fingerprint TEXT;
+
+-- 2. Índice parcial: barra importações repetidas ou simultâneas do mesmo extrato
+CREATE UNIQUE INDEX IF NOT EXISTS idx_settlement_statements_fingerprint
+    ON settlement_statements (fingerprint)
+    WHERE fingerprint IS NOT NULL AND deleted_at IS NULL;
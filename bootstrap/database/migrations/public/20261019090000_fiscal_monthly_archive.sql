@@ -0,0 +1,9 @@
+-- Arquivo mensal de XMLs para o contador
+ALTER TABLE fiscal_settings ADD COLUMN IF NOT EXISTS accountant_email TEXT;
+
+ALTER TABLE fiscal_invoices
+ADD COLUMN IF NOT EXISTS cancellation_xml_path TEXT,
+ADD COLUMN IF NOT EXISTS total_amount DECIMAL(10,2),
+ADD COLUMN IF NOT EXISTS tax_amount DECIMAL(10,2);
+
+CREATE INDEX IF NOT EXISTS idx_fiscal_invoices_company_created_at ON fiscal_invoices(company_id, created_at);
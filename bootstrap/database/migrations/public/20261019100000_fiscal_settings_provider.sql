@@ -0,0 +1,2 @@
+-- Provedor fiscal por empresa (vazio = provedor padrão, Focus NFe)
+ALTER TABLE fiscal_settings ADD COLUMN IF NOT EXISTS provider TEXT;
@@ -0,0 +1,20 @@
+-- Tabela IBPT (De Olho no Imposto) compartilhada entre empresas: alíquotas aproximadas por NCM e UF
+CREATE TABLE IF NOT EXISTS ibpt_tax_rates (
+    id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    ncm TEXT NOT NULL,
+    exception TEXT NOT NULL DEFAULT '',
+    uf TEXT NOT NULL,
+    description TEXT,
+    federal_national DECIMAL(10,2) NOT NULL,
+    federal_imported DECIMAL(10,2) NOT NULL,
+    state DECIMAL(10,2) NOT NULL,
+    municipal DECIMAL(10,2) NOT NULL,
+    valid_from TIMESTAMPTZ,
+    valid_until TIMESTAMPTZ,
+    version TEXT,
+    key TEXT,
+    CONSTRAINT ibpt_tax_rates_ncm_exception_uf_key UNIQUE (ncm, exception, uf)
+);
@@ -0,0 +1,28 @@
+-- Fuso horário IANA por empresa: agrupamento de relatórios, impressão de turno e agendamentos
+ALTER TABLE public.companies ADD COLUMN IF NOT EXISTS time_zone TEXT NOT NULL DEFAULT 'America/Sao_Paulo';
+
+-- Empresas existentes recebem o fuso da capital da UF do endereço (mesma tabela de companyentity.TimeZoneForUF)
+UPDATE public.companies c
+SET time_zone = CASE UPPER(a.uf)
+    WHEN 'AC' THEN 'America/Rio_Branco'
+    WHEN 'AL' THEN 'America/Maceio'
+    WHEN 'AM' THEN 'America/Manaus'
+    WHEN 'AP' THEN 'America/Belem'
+    WHEN 'BA' THEN 'America/Bahia'
+    WHEN 'CE' THEN 'America/Fortaleza'
+    WHEN 'MA' THEN 'America/Fortaleza'
+    WHEN 'MS' THEN 'America/Campo_Grande'
+    WHEN 'MT' THEN 'America/Cuiaba'
+    WHEN 'PA' THEN 'America/Belem'
+    WHEN 'PB' THEN 'America/Fortaleza'
+    WHEN 'PE' THEN 'America/Recife'
+    WHEN 'PI' THEN 'America/Fortaleza'
+    WHEN 'RN' THEN 'America/Fortaleza'
+    WHEN 'RO' THEN 'America/Porto_Velho'
+    WHEN 'RR' THEN 'America/Boa_Vista'
+    WHEN 'SE' THEN 'America/Maceio'
+    WHEN 'TO' THEN 'America/Araguaina'
+    ELSE 'America/Sao_Paulo'
+END
+FROM public.addresses a
+WHERE a.object_id = c.id;
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/spf13/cobra"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe/focusnfetest"
+)
+
+// FocusNFeFakeCmd runs the local Focus NFe stand-in server
+var FocusNFeFakeCmd = &cobra.Command{
+	Use:   "focusnfe-fake",
+	Short: "Runs a local Focus NFe stand-in server (set FOCUS_NFE_BASE_URL to its address)",
+	Run: func(cmd *cobra.Command, _ []string) {
+		port, _ := cmd.Flags().GetString("port")
+
+		cmd.Printf("Focus NFe fake listening on %s\n", port)
+		if err := http.ListenAndServe(port, focusnfetest.NewFake()); err != nil {
+			log.Fatalf("Focus NFe fake failed: %s", err)
+		}
+	},
+}
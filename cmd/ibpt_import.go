@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	ibptrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/ibpt"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
+)
+
+// IbptImportCmd loads a TabelaIBPTax CSV into public.ibpt_tax_rates.
+// The table is shared by every company, so it is only imported at deploy time, never through the API.
+var IbptImportCmd = &cobra.Command{
+	Use:   "ibpt-import",
+	Short: "Import the IBPT table (TabelaIBPTax CSV) of a UF into the shared tax rates",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		uf, err := cmd.Flags().GetString("uf")
+		if err != nil {
+			return err
+		}
+
+		fileName, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(fileName)
+		if err != nil {
+			return fmt.Errorf("failed to open IBPT table: %w", err)
+		}
+		defer file.Close()
+
+		cmd.Printf("connecting to database...\n")
+		db := database.NewPostgreSQLConnection()
+
+		service := ibptusecases.NewService(ibptrepositorybun.NewIbptTaxRateRepositoryBun(db))
+		result, err := service.ImportTable(cmd.Context(), uf, file)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("IBPT %s version %s: %d imported, %d skipped\n", result.UF, result.Version, result.Imported, result.Skipped)
+		return nil
+	},
+}
@@ -0,0 +1,234 @@
+package accountpayableentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrDescriptionRequired = errors.New("description is required")
+	ErrInvalidAmount       = errors.New("amount must be greater than zero")
+	ErrAlreadyPaid         = errors.New("account payable is already paid")
+	ErrAlreadyCancelled    = errors.New("account payable is cancelled")
+	ErrInvalidRecurrence   = errors.New("recurrence must be weekly, monthly or yearly")
+	ErrRecurrenceEndBefore = errors.New("recurrence end must be after the due date")
+)
+
+type AccountPayableStatus string
+
+const (
+	StatusOpen      AccountPayableStatus = "open"
+	StatusPaid      AccountPayableStatus = "paid"
+	StatusCancelled AccountPayableStatus = "cancelled"
+)
+
+// Recurrence repete a conta a cada semana, mês ou ano; vazia é conta avulsa
+type Recurrence string
+
+const (
+	RecurrenceNone    Recurrence = ""
+	RecurrenceWeekly  Recurrence = "weekly"
+	RecurrenceMonthly Recurrence = "monthly"
+	RecurrenceYearly  Recurrence = "yearly"
+)
+
+func (r Recurrence) IsValid() bool {
+	switch r {
+	case RecurrenceNone, RecurrenceWeekly, RecurrenceMonthly, RecurrenceYearly:
+		return true
+	}
+	return false
+}
+
+// Next devolve o vencimento seguinte a after. No mensal o dia é o de anchor (primeiro vencimento da série),
+// limitado ao último dia do mês: uma série do dia 31 vence 28/02 e volta para 31/03.
+func (r Recurrence) Next(after, anchor time.Time) time.Time {
+	switch r {
+	case RecurrenceWeekly:
+		return after.AddDate(0, 0, 7)
+	case RecurrenceYearly:
+		return after.AddDate(1, 0, 0)
+	case RecurrenceMonthly:
+		firstOfNext := time.Date(after.Year(), after.Month()+1, 1, anchor.Hour(), anchor.Minute(), anchor.Second(), 0, after.Location())
+		lastDay := firstOfNext.AddDate(0, 1, -1).Day()
+		day := anchor.Day()
+		if day > lastDay {
+			day = lastDay
+		}
+		return firstOfNext.AddDate(0, 0, day-1)
+	}
+	return after
+}
+
+// AccountPayable é uma conta a pagar: gerada no recebimento de um pedido de compra ou
+// lançada pelo usuário (aluguel, energia, serviços), avulsa ou recorrente
+type AccountPayable struct {
+	entity.Entity
+	AccountPayableCommonAttributes
+}
+
+type AccountPayableCommonAttributes struct {
+	Description     string
+	SupplierID      *uuid.UUID
+	PurchaseOrderID *uuid.UUID
+	DocumentNumber  string // Número da NF/boleto do fornecedor
+	Amount          decimal.Decimal
+	DueDate         time.Time
+	Status          AccountPayableStatus
+	PaidAt          *time.Time
+	PaidAmount      decimal.Decimal
+	CategoryID      *uuid.UUID
+	Recurrence      Recurrence
+	// RecurrenceEndsAt limita a série; nil repete até a recorrência ser removida
+	RecurrenceEndsAt *time.Time
+	// RecurrenceParentID aponta para a primeira conta da série; nil na própria primeira conta
+	RecurrenceParentID *uuid.UUID
+	Attachments        []Attachment
+}
+
+func NewAccountPayable(attributes AccountPayableCommonAttributes) (*AccountPayable, error) {
+	attributes.Status = StatusOpen
+	attributes.PaidAt = nil
+	attributes.PaidAmount = decimal.Zero
+	if attributes.Attachments == nil {
+		attributes.Attachments = []Attachment{}
+	}
+
+	payable := &AccountPayable{
+		Entity:                         entity.NewEntity(),
+		AccountPayableCommonAttributes: attributes,
+	}
+
+	if err := payable.Validate(); err != nil {
+		return nil, err
+	}
+
+	return payable, nil
+}
+
+func (a *AccountPayable) Validate() error {
+	if a.Description == "" {
+		return ErrDescriptionRequired
+	}
+
+	if a.Amount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidAmount
+	}
+
+	if !a.Recurrence.IsValid() {
+		return ErrInvalidRecurrence
+	}
+
+	if a.RecurrenceEndsAt != nil && a.RecurrenceEndsAt.Before(a.DueDate) {
+		return ErrRecurrenceEndBefore
+	}
+
+	return nil
+}
+
+// EnsureOpen impede editar conta já paga ou cancelada
+func (a *AccountPayable) EnsureOpen() error {
+	if a.Status == StatusPaid {
+		return ErrAlreadyPaid
+	}
+
+	if a.Status == StatusCancelled {
+		return ErrAlreadyCancelled
+	}
+
+	return nil
+}
+
+// SeriesID identifica a série recorrente pela primeira conta
+func (a *AccountPayable) SeriesID() uuid.UUID {
+	if a.RecurrenceParentID != nil {
+		return *a.RecurrenceParentID
+	}
+	return a.ID
+}
+
+// NextOccurrence gera a conta seguinte da série, em aberto e sem anexos. anchor é o vencimento da
+// primeira conta da série. Devolve nil quando a conta não é recorrente ou a série já terminou.
+func (a *AccountPayable) NextOccurrence(anchor time.Time) *AccountPayable {
+	if a.Recurrence == RecurrenceNone {
+		return nil
+	}
+
+	dueDate := a.Recurrence.Next(a.DueDate, anchor)
+	if a.RecurrenceEndsAt != nil && dueDate.After(*a.RecurrenceEndsAt) {
+		return nil
+	}
+
+	seriesID := a.SeriesID()
+	return &AccountPayable{
+		Entity: entity.NewEntity(),
+		AccountPayableCommonAttributes: AccountPayableCommonAttributes{
+			Description:        a.Description,
+			SupplierID:         a.SupplierID,
+			Amount:             a.Amount,
+			DueDate:            dueDate,
+			Status:             StatusOpen,
+			PaidAmount:         decimal.Zero,
+			CategoryID:         a.CategoryID,
+			Recurrence:         a.Recurrence,
+			RecurrenceEndsAt:   a.RecurrenceEndsAt,
+			RecurrenceParentID: &seriesID,
+			Attachments:        []Attachment{},
+		},
+	}
+}
+
+// Pay quita a conta; quando amount é nil o valor pago é o valor da conta
+func (a *AccountPayable) Pay(amount *decimal.Decimal, paidAt time.Time) error {
+	if a.Status == StatusPaid {
+		return ErrAlreadyPaid
+	}
+
+	if a.Status == StatusCancelled {
+		return ErrAlreadyCancelled
+	}
+
+	a.PaidAmount = a.Amount
+	if amount != nil {
+		if amount.LessThanOrEqual(decimal.Zero) {
+			return ErrInvalidAmount
+		}
+		a.PaidAmount = *amount
+	}
+
+	a.Status = StatusPaid
+	a.PaidAt = &paidAt
+	return nil
+}
+
+func (a *AccountPayable) Cancel() error {
+	if a.Status == StatusPaid {
+		return ErrAlreadyPaid
+	}
+
+	a.Status = StatusCancelled
+	return nil
+}
+
+func (a *AccountPayable) AddAttachment(attachment Attachment) {
+	a.Attachments = append(a.Attachments, attachment)
+}
+
+// RemoveAttachment tira o anexo da conta e o devolve para apagar o arquivo no S3
+func (a *AccountPayable) RemoveAttachment(id uuid.UUID) (*Attachment, error) {
+	attachments, removed, err := RemoveAttachment(a.Attachments, id)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Attachments = attachments
+	return removed, nil
+}
+
+func (a *AccountPayable) IsOverdue(now time.Time) bool {
+	return a.Status == StatusOpen && a.DueDate.Before(now)
+}
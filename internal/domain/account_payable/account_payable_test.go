@@ -0,0 +1,76 @@
+package accountpayableentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrenceNext(t *testing.T) {
+	anchor := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	feb := RecurrenceMonthly.Next(anchor, anchor)
+	assert.Equal(t, "2026-02-28", feb.Format(time.DateOnly), "limita ao último dia do mês")
+	assert.Equal(t, "2026-03-31", RecurrenceMonthly.Next(feb, anchor).Format(time.DateOnly), "volta para o dia da série")
+	assert.Equal(t, "2026-02-07", RecurrenceWeekly.Next(anchor, anchor).Format(time.DateOnly))
+	assert.Equal(t, "2027-01-31", RecurrenceYearly.Next(anchor, anchor).Format(time.DateOnly))
+}
+
+func TestAccountPayableNextOccurrence(t *testing.T) {
+	dueDate := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC)
+	payable, err := NewAccountPayable(AccountPayableCommonAttributes{
+		Description:      "Aluguel",
+		Amount:           decimal.NewFromInt(3000),
+		DueDate:          dueDate,
+		Recurrence:       RecurrenceMonthly,
+		RecurrenceEndsAt: &endsAt,
+	})
+	require.NoError(t, err)
+
+	next := payable.NextOccurrence(dueDate)
+	require.NotNil(t, next)
+	assert.Equal(t, "2026-11-10", next.DueDate.Format(time.DateOnly))
+	assert.Equal(t, payable.ID, next.SeriesID())
+	assert.Equal(t, StatusOpen, next.Status)
+
+	assert.Nil(t, next.NextOccurrence(dueDate), "dezembro passa do fim da recorrência")
+
+	payable.Recurrence = RecurrenceNone
+	assert.Nil(t, payable.NextOccurrence(dueDate))
+}
+
+func TestAccountPayableValidate(t *testing.T) {
+	_, err := NewAccountPayable(AccountPayableCommonAttributes{Description: "Energia", Amount: decimal.NewFromInt(10), Recurrence: "daily"})
+	assert.ErrorIs(t, err, ErrInvalidRecurrence)
+
+	dueDate := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	endsAt := dueDate.AddDate(0, 0, -1)
+	_, err = NewAccountPayable(AccountPayableCommonAttributes{Description: "Energia", Amount: decimal.NewFromInt(10), DueDate: dueDate, Recurrence: RecurrenceMonthly, RecurrenceEndsAt: &endsAt})
+	assert.ErrorIs(t, err, ErrRecurrenceEndBefore)
+}
+
+func TestAccountPayableAttachments(t *testing.T) {
+	payable, err := NewAccountPayable(AccountPayableCommonAttributes{Description: "Energia", Amount: decimal.NewFromInt(10)})
+	require.NoError(t, err)
+
+	attachment, err := NewAttachment("company_abc", payable.ID, `C:\boletos\conta.pdf`, "application/pdf", 42)
+	require.NoError(t, err)
+	assert.Equal(t, "conta.pdf", attachment.Filename)
+	assert.Equal(t, "financial/company_abc/"+payable.ID.String()+"/"+attachment.ID.String()+"-conta.pdf", attachment.Key)
+
+	payable.AddAttachment(*attachment)
+	removed, err := payable.RemoveAttachment(attachment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, attachment.Key, removed.Key)
+	assert.Empty(t, payable.Attachments)
+
+	_, err = payable.RemoveAttachment(attachment.ID)
+	assert.ErrorIs(t, err, ErrAttachmentNotFound)
+
+	_, err = NewAttachment("company_abc", payable.ID, " ", "", 0)
+	assert.ErrorIs(t, err, ErrAttachmentNameMissing)
+}
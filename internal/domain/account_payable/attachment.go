@@ -0,0 +1,54 @@
+package accountpayableentity
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAttachmentNotFound    = errors.New("attachment not found")
+	ErrAttachmentNameMissing = errors.New("attachment filename is required")
+)
+
+// Attachment é um arquivo da conta (boleto, nota, comprovante) guardado no S3 em Key
+type Attachment struct {
+	ID          uuid.UUID
+	Filename    string
+	ContentType string
+	Key         string
+	Size        int64
+	CreatedAt   time.Time
+}
+
+// NewAttachment monta o anexo com a chave financial/<schema>/<conta>/<anexo>-<arquivo>
+func NewAttachment(schemaName string, ownerID uuid.UUID, filename, contentType string, size int64) (*Attachment, error) {
+	filename = path.Base(strings.ReplaceAll(strings.TrimSpace(filename), "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil, ErrAttachmentNameMissing
+	}
+
+	id := uuid.New()
+	return &Attachment{
+		ID:          id,
+		Filename:    filename,
+		ContentType: contentType,
+		Key:         "financial/" + schemaName + "/" + ownerID.String() + "/" + id.String() + "-" + filename,
+		Size:        size,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// RemoveAttachment tira o anexo da lista e o devolve; também usado pelas contas a receber
+func RemoveAttachment(attachments []Attachment, id uuid.UUID) ([]Attachment, *Attachment, error) {
+	for i := range attachments {
+		if attachments[i].ID == id {
+			removed := attachments[i]
+			return append(attachments[:i:i], attachments[i+1:]...), &removed, nil
+		}
+	}
+	return attachments, nil, ErrAttachmentNotFound
+}
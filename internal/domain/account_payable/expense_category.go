@@ -0,0 +1,41 @@
+package accountpayableentity
+
+import (
+	"errors"
+
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var ErrCategoryNameRequired = errors.New("expense category name is required")
+
+// ExpenseCategory agrupa as despesas (aluguel, energia, marketing) nas contas a pagar e na DRE
+type ExpenseCategory struct {
+	entity.Entity
+	ExpenseCategoryCommonAttributes
+}
+
+type ExpenseCategoryCommonAttributes struct {
+	Name        string
+	Description string
+	IsActive    bool
+}
+
+func NewExpenseCategory(attributes ExpenseCategoryCommonAttributes) (*ExpenseCategory, error) {
+	category := &ExpenseCategory{
+		Entity:                          entity.NewEntity(),
+		ExpenseCategoryCommonAttributes: attributes,
+	}
+
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *ExpenseCategory) Validate() error {
+	if c.Name == "" {
+		return ErrCategoryNameRequired
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package accountreceivableentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrDescriptionRequired = errors.New("description is required")
+	ErrInvalidAmount       = errors.New("amount must be greater than zero")
+	ErrInvalidSource       = errors.New("source must be manual or card_settlement")
+	ErrAlreadyReceived     = errors.New("account receivable is already received")
+	ErrAlreadyCancelled    = errors.New("account receivable is cancelled")
+)
+
+type AccountReceivableStatus string
+
+const (
+	StatusOpen      AccountReceivableStatus = "open"
+	StatusReceived  AccountReceivableStatus = "received"
+	StatusCancelled AccountReceivableStatus = "cancelled"
+)
+
+// Source indica a origem do valor a receber
+type Source string
+
+const (
+	SourceManual         Source = "manual"
+	SourceCardSettlement Source = "card_settlement"
+)
+
+func (s Source) IsValid() bool {
+	return s == SourceManual || s == SourceCardSettlement
+}
+
+// AccountReceivable é um valor a receber: repasse da adquirente de cartão, convênio, venda a prazo
+type AccountReceivable struct {
+	entity.Entity
+	AccountReceivableCommonAttributes
+}
+
+type AccountReceivableCommonAttributes struct {
+	Description    string
+	Source         Source
+	PayMethod      string // Forma de pagamento de origem (ex.: Crédito) nos repasses de cartão
+	OrderID        *uuid.UUID
+	PaymentID      *uuid.UUID // Pagamento do pedido que originou o repasse de cartão
+	DocumentNumber string
+	Amount         decimal.Decimal
+	ExpectedDate   time.Time
+	Status         AccountReceivableStatus
+	ReceivedAt     *time.Time
+	ReceivedAmount decimal.Decimal
+	Attachments    []accountpayableentity.Attachment
+}
+
+func NewAccountReceivable(attributes AccountReceivableCommonAttributes) (*AccountReceivable, error) {
+	if attributes.Source == "" {
+		attributes.Source = SourceManual
+	}
+
+	attributes.Status = StatusOpen
+	attributes.ReceivedAt = nil
+	attributes.ReceivedAmount = decimal.Zero
+	if attributes.Attachments == nil {
+		attributes.Attachments = []accountpayableentity.Attachment{}
+	}
+
+	receivable := &AccountReceivable{
+		Entity:                            entity.NewEntity(),
+		AccountReceivableCommonAttributes: attributes,
+	}
+
+	if err := receivable.Validate(); err != nil {
+		return nil, err
+	}
+
+	return receivable, nil
+}
+
+func (a *AccountReceivable) Validate() error {
+	if a.Description == "" {
+		return ErrDescriptionRequired
+	}
+
+	if a.Amount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidAmount
+	}
+
+	if !a.Source.IsValid() {
+		return ErrInvalidSource
+	}
+
+	return nil
+}
+
+// EnsureOpen impede editar valor já recebido ou cancelado
+func (a *AccountReceivable) EnsureOpen() error {
+	if a.Status == StatusReceived {
+		return ErrAlreadyReceived
+	}
+
+	if a.Status == StatusCancelled {
+		return ErrAlreadyCancelled
+	}
+
+	return nil
+}
+
+// Receive baixa o valor; quando amount é nil o valor recebido é o esperado
+func (a *AccountReceivable) Receive(amount *decimal.Decimal, receivedAt time.Time) error {
+	if err := a.EnsureOpen(); err != nil {
+		return err
+	}
+
+	a.ReceivedAmount = a.Amount
+	if amount != nil {
+		if amount.LessThanOrEqual(decimal.Zero) {
+			return ErrInvalidAmount
+		}
+		a.ReceivedAmount = *amount
+	}
+
+	a.Status = StatusReceived
+	a.ReceivedAt = &receivedAt
+	return nil
+}
+
+func (a *AccountReceivable) Cancel() error {
+	if a.Status == StatusReceived {
+		return ErrAlreadyReceived
+	}
+
+	a.Status = StatusCancelled
+	return nil
+}
+
+func (a *AccountReceivable) IsOverdue(now time.Time) bool {
+	return a.Status == StatusOpen && a.ExpectedDate.Before(now)
+}
+
+func (a *AccountReceivable) AddAttachment(attachment accountpayableentity.Attachment) {
+	a.Attachments = append(a.Attachments, attachment)
+}
+
+// RemoveAttachment tira o anexo e o devolve para apagar o arquivo no S3
+func (a *AccountReceivable) RemoveAttachment(id uuid.UUID) (*accountpayableentity.Attachment, error) {
+	attachments, removed, err := accountpayableentity.RemoveAttachment(a.Attachments, id)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Attachments = attachments
+	return removed, nil
+}
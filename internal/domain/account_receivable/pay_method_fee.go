@@ -0,0 +1,114 @@
+package accountreceivableentity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrPayMethodInvalid       = errors.New("pay method is invalid")
+	ErrCashHasNoFee           = errors.New("cash payments have no acquirer fee")
+	ErrInvalidFee             = errors.New("fee percent must be between 0 and 100 and fixed fee must not be negative")
+	ErrInvalidSettlementDays  = errors.New("settlement days must be between 0 and 365")
+	ErrPayMethodFeeNotPayment = errors.New("payment method does not match the fee configuration")
+)
+
+// PayMethodFee é a condição da adquirente para uma forma de pagamento: MDR e prazo de crédito (D+N)
+type PayMethodFee struct {
+	entity.Entity
+	PayMethodFeeCommonAttributes
+}
+
+type PayMethodFeeCommonAttributes struct {
+	Method         orderentity.PayMethod
+	Acquirer       string          // Stone, Cielo, Rede, PagSeguro...
+	FeePercent     decimal.Decimal // MDR em % sobre o valor bruto
+	FixedFee       decimal.Decimal // Tarifa fixa por transação
+	SettlementDays int             // Dias corridos entre o pagamento e o crédito
+	IsActive       bool
+}
+
+func NewPayMethodFee(attributes PayMethodFeeCommonAttributes) (*PayMethodFee, error) {
+	fee := &PayMethodFee{
+		Entity:                       entity.NewEntity(),
+		PayMethodFeeCommonAttributes: attributes,
+	}
+
+	if err := fee.Validate(); err != nil {
+		return nil, err
+	}
+
+	return fee, nil
+}
+
+func (f *PayMethodFee) Validate() error {
+	valid := false
+	for _, method := range orderentity.GetAllPayMethod() {
+		if method == f.Method {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return ErrPayMethodInvalid
+	}
+
+	if f.Method == orderentity.Dinheiro {
+		return ErrCashHasNoFee
+	}
+
+	if f.FeePercent.IsNegative() || f.FeePercent.GreaterThanOrEqual(decimal.NewFromInt(100)) || f.FixedFee.IsNegative() {
+		return ErrInvalidFee
+	}
+
+	if f.SettlementDays < 0 || f.SettlementDays > 365 {
+		return ErrInvalidSettlementDays
+	}
+
+	return nil
+}
+
+// Fee calcula a taxa sobre o valor bruto, arredondada em centavos e limitada ao próprio valor
+func (f *PayMethodFee) Fee(gross decimal.Decimal) decimal.Decimal {
+	fee := gross.Mul(f.FeePercent).Div(decimal.NewFromInt(100)).Add(f.FixedFee).Round(2)
+	if fee.GreaterThan(gross) {
+		return gross
+	}
+	return fee
+}
+
+// SettlementDate é a data prevista do crédito, em dias corridos a partir do pagamento
+func (f *PayMethodFee) SettlementDate(paidAt time.Time) time.Time {
+	return paidAt.AddDate(0, 0, f.SettlementDays)
+}
+
+// ApplyTo grava no pagamento o líquido e a data do repasse e devolve a conta a receber correspondente
+func (f *PayMethodFee) ApplyTo(payment *orderentity.PaymentOrder) (*AccountReceivable, error) {
+	if payment.Method != f.Method {
+		return nil, ErrPayMethodFeeNotPayment
+	}
+
+	payment.SetSettlement(f.Fee(payment.TotalPaid), f.SettlementDate(payment.PaidAt))
+
+	description := fmt.Sprintf("Repasse %s", payment.Method)
+	if f.Acquirer != "" {
+		description += " - " + f.Acquirer
+	}
+
+	orderID, paymentID := payment.OrderID, payment.ID
+	return NewAccountReceivable(AccountReceivableCommonAttributes{
+		Description:  description,
+		Source:       SourceCardSettlement,
+		PayMethod:    string(payment.Method),
+		OrderID:      &orderID,
+		PaymentID:    &paymentID,
+		Amount:       payment.NetAmount,
+		ExpectedDate: *payment.ExpectedSettlementAt,
+	})
+}
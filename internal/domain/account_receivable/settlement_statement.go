@@ -0,0 +1,244 @@
+package accountreceivableentity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrStatementEmpty         = errors.New("statement has no lines")
+	ErrStatementFormatInvalid = errors.New("statement format must be csv or ofx")
+	ErrStatementDuplicated    = errors.New("statement with the same acquirer, period and lines was already imported")
+)
+
+type StatementFormat string
+
+const (
+	StatementFormatCSV StatementFormat = "csv"
+	StatementFormatOFX StatementFormat = "ofx"
+)
+
+// StatementLineStatus é o resultado da conciliação de uma linha do extrato
+type StatementLineStatus string
+
+const (
+	LineStatusMatched     StatementLineStatus = "matched"     // Valor e data batem com os repasses esperados
+	LineStatusDiscrepancy StatementLineStatus = "discrepancy" // Há repasses esperados no dia, mas o valor difere
+	LineStatusUnmatched   StatementLineStatus = "unmatched"   // Nenhum repasse esperado corresponde
+)
+
+// reconcileTolerance é a diferença em reais aceita como arredondamento da adquirente
+var reconcileTolerance = decimal.NewFromFloat(0.01)
+
+// reconcileDays é a folga em dias para casar um crédito isolado (feriados, fim de semana)
+const reconcileDays = 3
+
+// SettlementStatement é um extrato de adquirente importado para conciliação dos repasses de cartão
+type SettlementStatement struct {
+	entity.Entity
+	SettlementStatementCommonAttributes
+}
+
+type SettlementStatementCommonAttributes struct {
+	Acquirer    string
+	Filename    string
+	Format      StatementFormat
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// Fingerprint identifica o extrato (adquirente, período e referências das linhas) para barrar reimportação
+	Fingerprint string
+	Lines       []StatementLine
+}
+
+// StatementLine é um crédito (ou débito) do extrato; Amount é o valor líquido creditado
+type StatementLine struct {
+	ID             uuid.UUID
+	Date           time.Time
+	Description    string
+	Reference      string // NSU, autorização ou FITID do OFX
+	PayMethod      string // Bandeira informada pela adquirente, quando houver
+	Amount         decimal.Decimal
+	Status         StatementLineStatus
+	ExpectedAmount decimal.Decimal
+	Difference     decimal.Decimal
+	ReceivableIDs  []uuid.UUID
+}
+
+func NewSettlementStatement(acquirer, filename string, format StatementFormat, lines []StatementLine) (*SettlementStatement, error) {
+	if format != StatementFormatCSV && format != StatementFormatOFX {
+		return nil, ErrStatementFormatInvalid
+	}
+
+	if len(lines) == 0 {
+		return nil, ErrStatementEmpty
+	}
+
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].Date.Before(lines[j].Date) })
+	for i := range lines {
+		lines[i].ID = uuid.New()
+		lines[i].Status = LineStatusUnmatched
+		lines[i].ReceivableIDs = []uuid.UUID{}
+	}
+
+	statement := &SettlementStatement{
+		Entity: entity.NewEntity(),
+		SettlementStatementCommonAttributes: SettlementStatementCommonAttributes{
+			Acquirer:    acquirer,
+			Filename:    filename,
+			Format:      format,
+			PeriodStart: lines[0].Date,
+			PeriodEnd:   lines[len(lines)-1].Date,
+			Lines:       lines,
+		},
+	}
+	statement.Fingerprint = statement.fingerprint()
+	return statement, nil
+}
+
+// fingerprint é o hash de adquirente, período e linhas; o nome do arquivo fica de fora para que
+// o mesmo extrato baixado de novo com outro nome também seja recusado. Linhas sem referência
+// entram por data e valor.
+func (s *SettlementStatement) fingerprint() string {
+	keys := make([]string, len(s.Lines))
+	for i, line := range s.Lines {
+		keys[i] = line.Reference
+		if keys[i] == "" {
+			keys[i] = line.Date.UTC().Format(time.RFC3339) + "|" + line.Amount.StringFixed(2)
+		}
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	hash.Write([]byte(strings.ToLower(strings.TrimSpace(s.Acquirer))))
+	hash.Write([]byte("|" + s.PeriodStart.UTC().Format(time.RFC3339) + "|" + s.PeriodEnd.UTC().Format(time.RFC3339)))
+	for _, key := range keys {
+		hash.Write([]byte("|" + key))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Reconcile casa as linhas com os repasses em aberto, baixa os confirmados na data do crédito e os devolve.
+// Cada crédito casa primeiro com um repasse de mesmo valor até reconcileDays de distância e, se não houver,
+// com a soma dos repasses previstos para o mesmo dia (adquirentes costumam creditar o lote do dia por bandeira).
+// Quando a soma do dia difere, a linha fica como divergência apontando os repasses, que continuam em aberto.
+func (s *SettlementStatement) Reconcile(receivables []AccountReceivable, loc *time.Location) []*AccountReceivable {
+	pool := map[uuid.UUID]*AccountReceivable{}
+	for i := range receivables {
+		if receivables[i].Status == StatusOpen && receivables[i].Source == SourceCardSettlement {
+			pool[receivables[i].ID] = &receivables[i]
+		}
+	}
+
+	matched := []*AccountReceivable{}
+	for i := range s.Lines {
+		line := &s.Lines[i]
+		if !line.Amount.IsPositive() {
+			continue
+		}
+
+		if receivable := closestSameAmount(pool, line, loc); receivable != nil {
+			line.link(LineStatusMatched, receivable)
+			delete(pool, receivable.ID)
+			_ = receivable.Receive(&line.Amount, line.Date)
+			matched = append(matched, receivable)
+			continue
+		}
+
+		group := sameDay(pool, line, loc)
+		if len(group) == 0 {
+			continue
+		}
+
+		line.link(LineStatusMatched, group...)
+		if line.Difference.Abs().GreaterThan(reconcileTolerance) {
+			line.Status = LineStatusDiscrepancy
+		}
+
+		for _, receivable := range group {
+			delete(pool, receivable.ID)
+			if line.Status == LineStatusMatched {
+				_ = receivable.Receive(nil, line.Date)
+				matched = append(matched, receivable)
+			}
+		}
+	}
+
+	return matched
+}
+
+// Summary conta as linhas por status
+func (s *SettlementStatement) Summary() map[StatementLineStatus]int {
+	summary := map[StatementLineStatus]int{LineStatusMatched: 0, LineStatusDiscrepancy: 0, LineStatusUnmatched: 0}
+	for _, line := range s.Lines {
+		summary[line.Status]++
+	}
+	return summary
+}
+
+func (l *StatementLine) link(status StatementLineStatus, receivables ...*AccountReceivable) {
+	l.Status = status
+	l.ExpectedAmount = decimal.Zero
+	l.ReceivableIDs = []uuid.UUID{}
+	for _, receivable := range receivables {
+		l.ExpectedAmount = l.ExpectedAmount.Add(receivable.Amount)
+		l.ReceivableIDs = append(l.ReceivableIDs, receivable.ID)
+	}
+	l.Difference = l.Amount.Sub(l.ExpectedAmount)
+}
+
+// matchesMethod aceita qualquer bandeira quando o extrato não informa
+func (l *StatementLine) matchesMethod(receivable *AccountReceivable) bool {
+	return l.PayMethod == "" || strings.EqualFold(l.PayMethod, receivable.PayMethod)
+}
+
+func closestSameAmount(pool map[uuid.UUID]*AccountReceivable, line *StatementLine, loc *time.Location) *AccountReceivable {
+	var best *AccountReceivable
+	bestDays := reconcileDays + 1
+	for _, receivable := range pool {
+		if !line.matchesMethod(receivable) || receivable.Amount.Sub(line.Amount).Abs().GreaterThan(reconcileTolerance) {
+			continue
+		}
+
+		days := daysBetween(localDate(receivable.ExpectedDate, loc), localDate(line.Date, time.UTC))
+		if days < bestDays || (days == bestDays && best != nil && receivable.ExpectedDate.Before(best.ExpectedDate)) {
+			best, bestDays = receivable, days
+		}
+	}
+	return best
+}
+
+func sameDay(pool map[uuid.UUID]*AccountReceivable, line *StatementLine, loc *time.Location) []*AccountReceivable {
+	day := localDate(line.Date, time.UTC)
+	group := []*AccountReceivable{}
+	for _, receivable := range pool {
+		if line.matchesMethod(receivable) && localDate(receivable.ExpectedDate, loc).Equal(day) {
+			group = append(group, receivable)
+		}
+	}
+
+	sort.Slice(group, func(i, j int) bool { return group[i].ExpectedDate.Before(group[j].ExpectedDate) })
+	return group
+}
+
+// localDate é o dia do calendário de t no fuso; as datas do extrato chegam sem horário (meia-noite UTC)
+func localDate(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(a, b time.Time) int {
+	days := int(a.Sub(b).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
@@ -0,0 +1,119 @@
+package accountreceivableentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+func TestPayMethodFeeApplyTo(t *testing.T) {
+	fee, err := NewPayMethodFee(PayMethodFeeCommonAttributes{
+		Method:         orderentity.Visa,
+		Acquirer:       "Stone",
+		FeePercent:     decimal.RequireFromString("3.19"),
+		FixedFee:       decimal.RequireFromString("0.10"),
+		SettlementDays: 30,
+	})
+	require.NoError(t, err)
+
+	payment := orderentity.NewPayment(decimal.NewFromInt(100), orderentity.Visa, uuid.New())
+	receivable, err := fee.ApplyTo(payment)
+	require.NoError(t, err)
+
+	assert.True(t, decimal.RequireFromString("3.29").Equal(payment.FeeAmount))
+	assert.True(t, decimal.RequireFromString("96.71").Equal(payment.NetAmount))
+	assert.Equal(t, payment.PaidAt.AddDate(0, 0, 30), *payment.ExpectedSettlementAt)
+	assert.Equal(t, SourceCardSettlement, receivable.Source)
+	assert.True(t, payment.NetAmount.Equal(receivable.Amount))
+	assert.Equal(t, payment.ID, *receivable.PaymentID)
+
+	_, err = NewPayMethodFee(PayMethodFeeCommonAttributes{Method: orderentity.Dinheiro})
+	assert.ErrorIs(t, err, ErrCashHasNoFee)
+}
+
+func TestSettlementStatementReconcile(t *testing.T) {
+	d := decimal.RequireFromString
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	receivable := func(method, amount string, expected time.Time) AccountReceivable {
+		r, err := NewAccountReceivable(AccountReceivableCommonAttributes{
+			Description: "Repasse", Source: SourceCardSettlement, PayMethod: method, Amount: d(amount), ExpectedDate: expected,
+		})
+		require.NoError(t, err)
+		return *r
+	}
+
+	// 23h em São Paulo ainda é dia 20 no fuso da empresa, embora já seja dia 21 em UTC
+	day20 := time.Date(2026, 10, 20, 23, 0, 0, 0, loc)
+	receivables := []AccountReceivable{
+		receivable("Visa", "96.71", time.Date(2026, 10, 19, 10, 0, 0, 0, loc)),
+		receivable("MasterCard", "50.00", day20),
+		receivable("MasterCard", "30.00", day20),
+		receivable("Elo", "40.00", time.Date(2026, 10, 22, 10, 0, 0, 0, loc)),
+		receivable("Elo", "25.00", time.Date(2026, 10, 25, 10, 0, 0, 0, loc)),
+	}
+
+	date := func(day int) time.Time { return time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC) }
+	statement, err := NewSettlementStatement("Stone", "extrato.csv", StatementFormatCSV, []StatementLine{
+		{Date: date(20), PayMethod: "MasterCard", Amount: d("80.00")},
+		{Date: date(21), Amount: d("96.71")},
+		{Date: date(22), PayMethod: "elo", Amount: d("38.50")},
+		{Date: date(23), Amount: d("10.00")},
+		{Date: date(23), Description: "Tarifa", Amount: d("-5.00")},
+	})
+	require.NoError(t, err)
+
+	matched := statement.Reconcile(receivables, loc)
+	assert.Len(t, matched, 3)
+
+	lines := statement.Lines
+	assert.Equal(t, LineStatusMatched, lines[0].Status, "lote do dia por bandeira")
+	assert.Len(t, lines[0].ReceivableIDs, 2)
+	assert.Equal(t, LineStatusMatched, lines[1].Status, "mesmo valor com atraso de dois dias")
+	assert.Equal(t, LineStatusDiscrepancy, lines[2].Status)
+	assert.True(t, d("-1.50").Equal(lines[2].Difference))
+	assert.Equal(t, LineStatusUnmatched, lines[3].Status)
+	assert.Equal(t, LineStatusUnmatched, lines[4].Status, "débitos não casam")
+
+	assert.Equal(t, StatusReceived, receivables[0].Status)
+	assert.Equal(t, date(21), *receivables[0].ReceivedAt)
+	assert.Equal(t, StatusOpen, receivables[3].Status, "divergência não baixa o repasse")
+	assert.Equal(t, StatusOpen, receivables[4].Status)
+	assert.Equal(t, map[StatementLineStatus]int{LineStatusMatched: 2, LineStatusDiscrepancy: 1, LineStatusUnmatched: 2}, statement.Summary())
+}
+
+func TestSettlementStatementFingerprint(t *testing.T) {
+	d := decimal.RequireFromString
+	date := func(day int) time.Time { return time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC) }
+	lines := func() []StatementLine {
+		return []StatementLine{
+			{Date: date(21), Reference: "NSU-2", Amount: d("96.71")},
+			{Date: date(20), Reference: "NSU-1", Amount: d("80.00")},
+			{Date: date(22), Amount: d("10.00")},
+		}
+	}
+
+	first, err := NewSettlementStatement("Stone", "extrato.csv", StatementFormatCSV, lines())
+	require.NoError(t, err)
+	assert.NotEmpty(t, first.Fingerprint)
+
+	renamed, err := NewSettlementStatement(" stone ", "extrato (1).csv", StatementFormatCSV, lines())
+	require.NoError(t, err)
+	assert.Equal(t, first.Fingerprint, renamed.Fingerprint, "mesmo extrato com outro nome de arquivo")
+
+	otherAcquirer, err := NewSettlementStatement("Cielo", "extrato.csv", StatementFormatCSV, lines())
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Fingerprint, otherAcquirer.Fingerprint)
+
+	changed := lines()
+	changed[2].Amount = d("11.00")
+	otherLines, err := NewSettlementStatement("Stone", "extrato.csv", StatementFormatCSV, changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Fingerprint, otherLines.Fingerprint, "linha sem referência entra por data e valor")
+}
@@ -0,0 +1,137 @@
+package comboentity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrComboNameRequired        = errors.New("combo name is required")
+	ErrComboPriceInvalid        = errors.New("combo price must be greater than zero")
+	ErrComboSlotsRequired       = errors.New("combo must have at least one slot")
+	ErrComboSlotNameRequired    = errors.New("combo slot name is required")
+	ErrComboSlotOptionsRequired = errors.New("combo slot must have at least one option")
+	ErrComboSlotOptionInvalid   = errors.New("combo slot option must reference a product or a category")
+	ErrComboUpchargeInvalid     = errors.New("combo upcharge cannot be negative")
+	ErrComboInactive            = errors.New("combo is not active")
+	ErrComboSlotNotFound        = errors.New("combo slot not found")
+	ErrComboChoiceRequired      = errors.New("exactly one choice is required for every combo slot")
+	ErrComboChoiceNotAllowed    = errors.New("product not allowed in combo slot")
+)
+
+// Combo é um produto composto vendido por preço fechado (ex.: lanche + batata + bebida).
+// Cada slot permite escolher entre produtos ou categorias, com acréscimo opcional.
+type Combo struct {
+	entity.Entity
+	ComboCommonAttributes
+}
+
+type ComboCommonAttributes struct {
+	Name        string
+	Description string
+	Price       decimal.Decimal
+	IsActive    bool
+	Slots       []ComboSlot
+}
+
+// ComboSlot é uma posição do combo; o cliente escolhe um produto entre as opções
+type ComboSlot struct {
+	ID      uuid.UUID
+	Name    string
+	Options []ComboSlotOption
+}
+
+// ComboSlotOption libera um produto específico ou qualquer produto de uma categoria
+type ComboSlotOption struct {
+	ProductID  *uuid.UUID
+	CategoryID *uuid.UUID
+	Upcharge   decimal.Decimal
+}
+
+func NewCombo(attributes ComboCommonAttributes) (*Combo, error) {
+	combo := &Combo{
+		Entity:                entity.NewEntity(),
+		ComboCommonAttributes: attributes,
+	}
+	combo.IsActive = true
+
+	if err := combo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return combo, nil
+}
+
+// Validate confere nome, preço e slots; slots novos recebem ID
+func (c *Combo) Validate() error {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return ErrComboNameRequired
+	}
+
+	if !c.Price.IsPositive() {
+		return ErrComboPriceInvalid
+	}
+
+	if len(c.Slots) == 0 {
+		return ErrComboSlotsRequired
+	}
+
+	for i := range c.Slots {
+		slot := &c.Slots[i]
+		if slot.ID == uuid.Nil {
+			slot.ID = uuid.New()
+		}
+
+		slot.Name = strings.TrimSpace(slot.Name)
+		if slot.Name == "" {
+			return ErrComboSlotNameRequired
+		}
+
+		if len(slot.Options) == 0 {
+			return ErrComboSlotOptionsRequired
+		}
+
+		for _, option := range slot.Options {
+			if option.ProductID == nil && option.CategoryID == nil {
+				return ErrComboSlotOptionInvalid
+			}
+
+			if option.Upcharge.IsNegative() {
+				return ErrComboUpchargeInvalid
+			}
+		}
+	}
+
+	return nil
+}
+
+// option encontra a opção do slot que libera o produto; a opção do próprio produto vence a da categoria
+func (s *ComboSlot) option(productID uuid.UUID, categoryID uuid.UUID) (*ComboSlotOption, bool) {
+	var byCategory *ComboSlotOption
+	for i := range s.Options {
+		option := &s.Options[i]
+		if option.ProductID != nil && *option.ProductID == productID {
+			return option, true
+		}
+
+		if byCategory == nil && option.ProductID == nil && option.CategoryID != nil && *option.CategoryID == categoryID {
+			byCategory = option
+		}
+	}
+
+	return byCategory, byCategory != nil
+}
+
+func (c *Combo) slot(slotID uuid.UUID) *ComboSlot {
+	for i := range c.Slots {
+		if c.Slots[i].ID == slotID {
+			return &c.Slots[i]
+		}
+	}
+	return nil
+}
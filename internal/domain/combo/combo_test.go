@@ -0,0 +1,100 @@
+package comboentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newBurgerCombo(t *testing.T, burgerID, drinksCategoryID uuid.UUID) *Combo {
+	t.Helper()
+
+	combo, err := NewCombo(ComboCommonAttributes{
+		Name:  "Combo X-Burger",
+		Price: decimal.NewFromInt(30),
+		Slots: []ComboSlot{
+			{Name: "Lanche", Options: []ComboSlotOption{{ProductID: &burgerID}}},
+			{Name: "Bebida", Options: []ComboSlotOption{{CategoryID: &drinksCategoryID}}},
+		},
+	})
+	require.NoError(t, err)
+	return combo
+}
+
+func TestNewCombo_Validation(t *testing.T) {
+	_, err := NewCombo(ComboCommonAttributes{Name: " ", Price: decimal.NewFromInt(10)})
+	assert.ErrorIs(t, err, ErrComboNameRequired)
+
+	_, err = NewCombo(ComboCommonAttributes{Name: "Combo", Price: decimal.Zero})
+	assert.ErrorIs(t, err, ErrComboPriceInvalid)
+
+	_, err = NewCombo(ComboCommonAttributes{Name: "Combo", Price: decimal.NewFromInt(10)})
+	assert.ErrorIs(t, err, ErrComboSlotsRequired)
+
+	_, err = NewCombo(ComboCommonAttributes{Name: "Combo", Price: decimal.NewFromInt(10), Slots: []ComboSlot{{Name: "Lanche", Options: []ComboSlotOption{{}}}}})
+	assert.ErrorIs(t, err, ErrComboSlotOptionInvalid)
+
+	combo := newBurgerCombo(t, uuid.New(), uuid.New())
+	assert.True(t, combo.IsActive)
+	assert.NotEqual(t, uuid.Nil, combo.Slots[0].ID, "slot novo recebe ID")
+}
+
+func TestResolve_AllocatesPriceByListPrice(t *testing.T) {
+	burgerID, drinksID := uuid.New(), uuid.New()
+	combo := newBurgerCombo(t, burgerID, drinksID)
+
+	components, err := combo.Resolve([]ComboChoice{
+		{SlotID: combo.Slots[0].ID, ProductID: burgerID, CategoryID: uuid.New(), ListPrice: decimal.NewFromInt(25)},
+		{SlotID: combo.Slots[1].ID, ProductID: uuid.New(), CategoryID: drinksID, ListPrice: decimal.NewFromInt(8)},
+	})
+	require.NoError(t, err)
+	require.Len(t, components, 2)
+
+	assert.Equal(t, "22.73", components[0].Price.StringFixed(2))
+	assert.Equal(t, "7.27", components[1].Price.StringFixed(2), "o último componente fecha o arredondamento")
+	assert.Equal(t, "30", UnitTotal(components).String())
+}
+
+func TestResolve_ProductOptionUpchargeWinsOverCategory(t *testing.T) {
+	burgerID, drinksID, juiceID := uuid.New(), uuid.New(), uuid.New()
+	combo := newBurgerCombo(t, burgerID, drinksID)
+	combo.Slots[1].Options = append(combo.Slots[1].Options, ComboSlotOption{ProductID: &juiceID, Upcharge: decimal.NewFromInt(4)})
+
+	components, err := combo.Resolve([]ComboChoice{
+		{SlotID: combo.Slots[0].ID, ProductID: burgerID},
+		{SlotID: combo.Slots[1].ID, ProductID: juiceID, CategoryID: drinksID},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "4", components[1].Upcharge.String())
+	assert.Equal(t, "15", components[0].Price.String(), "sem preço de tabela divide em partes iguais")
+	assert.Equal(t, "19", components[1].Price.String())
+	assert.Equal(t, "34", UnitTotal(components).String())
+}
+
+func TestResolve_RejectsInvalidChoices(t *testing.T) {
+	burgerID, drinksID := uuid.New(), uuid.New()
+	combo := newBurgerCombo(t, burgerID, drinksID)
+
+	_, err := combo.Resolve([]ComboChoice{{SlotID: combo.Slots[0].ID, ProductID: burgerID}})
+	assert.ErrorIs(t, err, ErrComboChoiceRequired, "todo slot precisa de escolha")
+
+	_, err = combo.Resolve([]ComboChoice{
+		{SlotID: combo.Slots[0].ID, ProductID: burgerID},
+		{SlotID: combo.Slots[0].ID, ProductID: burgerID},
+	})
+	assert.ErrorIs(t, err, ErrComboChoiceRequired, "uma escolha por slot")
+
+	_, err = combo.Resolve([]ComboChoice{
+		{SlotID: combo.Slots[0].ID, ProductID: burgerID},
+		{SlotID: combo.Slots[1].ID, ProductID: uuid.New(), CategoryID: uuid.New()},
+	})
+	assert.ErrorIs(t, err, ErrComboChoiceNotAllowed)
+
+	combo.IsActive = false
+	_, err = combo.Resolve(nil)
+	assert.ErrorIs(t, err, ErrComboInactive)
+}
@@ -0,0 +1,91 @@
+package comboentity
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ComboChoice é o produto escolhido para um slot, com o preço de tabela da variação
+type ComboChoice struct {
+	SlotID     uuid.UUID
+	ProductID  uuid.UUID
+	CategoryID uuid.UUID
+	ListPrice  decimal.Decimal
+}
+
+// ComboComponent é a escolha já validada, com a parte do preço do combo que cabe a ela
+type ComboComponent struct {
+	ComboChoice
+	SlotName string
+	Upcharge decimal.Decimal
+	// Price é o preço unitário do componente: rateio do preço do combo + acréscimo
+	Price decimal.Decimal
+}
+
+// Resolve valida uma escolha por slot e rateia o preço do combo entre os componentes
+// proporcionalmente ao preço de tabela (em partes iguais se nenhum tiver preço).
+// O arredondamento vai para o último componente, então a soma fecha no preço do combo.
+func (c *Combo) Resolve(choices []ComboChoice) ([]ComboComponent, error) {
+	if !c.IsActive {
+		return nil, ErrComboInactive
+	}
+
+	if len(choices) != len(c.Slots) {
+		return nil, ErrComboChoiceRequired
+	}
+
+	seen := map[uuid.UUID]bool{}
+	components := make([]ComboComponent, 0, len(choices))
+	listTotal := decimal.Zero
+
+	for _, choice := range choices {
+		slot := c.slot(choice.SlotID)
+		if slot == nil {
+			return nil, ErrComboSlotNotFound
+		}
+
+		if seen[slot.ID] {
+			return nil, ErrComboChoiceRequired
+		}
+		seen[slot.ID] = true
+
+		option, ok := slot.option(choice.ProductID, choice.CategoryID)
+		if !ok {
+			return nil, ErrComboChoiceNotAllowed
+		}
+
+		components = append(components, ComboComponent{
+			ComboChoice: choice,
+			SlotName:    slot.Name,
+			Upcharge:    option.Upcharge,
+		})
+		listTotal = listTotal.Add(choice.ListPrice)
+	}
+
+	allocated := decimal.Zero
+	count := decimal.NewFromInt(int64(len(components)))
+	for i := range components {
+		share := c.Price.Sub(allocated)
+		if i < len(components)-1 {
+			if listTotal.IsPositive() {
+				share = c.Price.Mul(components[i].ListPrice).Div(listTotal).Round(2)
+			} else {
+				share = c.Price.Div(count).Round(2)
+			}
+		}
+
+		allocated = allocated.Add(share)
+		components[i].Price = share.Add(components[i].Upcharge)
+	}
+
+	return components, nil
+}
+
+// UnitTotal é o preço de uma unidade do combo com os acréscimos das escolhas
+func UnitTotal(components []ComboComponent) decimal.Decimal {
+	total := decimal.Zero
+	for _, component := range components {
+		total = total.Add(component.Price)
+	}
+	return total
+}
@@ -36,6 +36,7 @@ type CompanyCommonAttributes struct {
 	Preferences  Preferences
 	IsBlocked    bool
 	ImagePath    string
+	TimeZone     string // IANA, ex.: America/Sao_Paulo
 
 	// Opening Hours
 	Schedules []Schedule
@@ -59,7 +60,9 @@ type BusinessHour struct {
 	ClosingTime string // HH:MM
 }
 
+// IsOpen compara os horários de funcionamento com t no fuso da empresa
 func (c *Company) IsOpen(t time.Time) bool {
+	t = t.In(c.Location())
 	day := int(t.Weekday())
 	hourMinute := t.Format("15:04")
 
@@ -103,6 +106,7 @@ func NewCompany(cnpjData *cnpj.Cnpj) *Company {
 			Cnpj:         cnpjData.Cnpj,
 			SchemaName:   schema,
 			Preferences:  NewDefaultPreferences(),
+			TimeZone:     TimeZoneForUF(cnpjData.UF),
 		},
 	}
 
@@ -2,6 +2,7 @@ package companyentity
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -23,3 +24,33 @@ func TestNewAndUpdateCompany(t *testing.T) {
 	assert.Equal(t, "TT", c.TradeName)
 	assert.Equal(t, "TT", c.TradeName)
 }
+
+func TestCompanyIsOpenInTimeZone(t *testing.T) {
+	c := &Company{CompanyCommonAttributes: CompanyCommonAttributes{
+		TimeZone: "America/Manaus",
+		Schedules: []Schedule{
+			{DayOfWeek: int(time.Monday), IsOpen: true, Hours: []BusinessHour{{OpeningTime: "18:00", ClosingTime: "23:00"}}},
+		},
+	}}
+
+	// 2026-10-19 (segunda) 22:30 em Manaus = 2026-10-20 02:30 UTC
+	assert.True(t, c.IsOpen(time.Date(2026, 10, 20, 2, 30, 0, 0, time.UTC)))
+	// 2026-10-19 21:30 UTC = 17:30 em Manaus, antes de abrir
+	assert.False(t, c.IsOpen(time.Date(2026, 10, 19, 21, 30, 0, 0, time.UTC)))
+}
+
+func TestCompanyTimeZone(t *testing.T) {
+	assert.Equal(t, "America/Manaus", TimeZoneForUF("am"))
+	assert.Equal(t, DefaultTimeZone, TimeZoneForUF("SP"))
+
+	assert.NoError(t, ValidateTimeZone("America/Cuiaba"))
+	assert.ErrorIs(t, ValidateTimeZone("Brasil/Centro"), ErrInvalidTimeZone)
+	assert.ErrorIs(t, ValidateTimeZone(""), ErrInvalidTimeZone)
+
+	c := &Company{}
+	assert.Equal(t, DefaultTimeZone, c.Location().String())
+
+	c.TimeZone = "America/Manaus"
+	_, offset := c.Now().Zone()
+	assert.Equal(t, -4*60*60, offset)
+}
@@ -38,6 +38,13 @@ const (
 	EnablePrintItemsOnFinishProcess Key = "enable_print_items_on_finish_process"
 	// PrinterShiftReport is the printer used for shift reports.
 	PrinterShiftReport Key = "printer_shift_report"
+
+	// EnableAutoStockLimits toggles the daily recalculation of stock min/max from sales velocity.
+	EnableAutoStockLimits Key = "enable_auto_stock_limits"
+	// ReplenishmentCoverageDays is how many days of consumption a purchase should cover.
+	ReplenishmentCoverageDays Key = "replenishment_coverage_days"
+	// StockReservationMinutes is how long a staging order keeps its stock reserved before release.
+	StockReservationMinutes Key = "stock_reservation_minutes"
 )
 
 // Preference holds a single key-value pair.
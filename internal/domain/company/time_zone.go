@@ -0,0 +1,79 @@
+package companyentity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	// Embute a base IANA: o container de produção não traz /usr/share/zoneinfo
+	_ "time/tzdata"
+)
+
+var ErrInvalidTimeZone = errors.New("invalid time zone: must be an IANA name like America/Sao_Paulo")
+
+// DefaultTimeZone vale para empresas sem fuso gravado
+const DefaultTimeZone = "America/Sao_Paulo"
+
+// timeZonesByUF é o fuso da capital de cada UF, usado como padrão no cadastro da empresa
+var timeZonesByUF = map[string]string{
+	"AC": "America/Rio_Branco",
+	"AL": "America/Maceio",
+	"AM": "America/Manaus",
+	"AP": "America/Belem",
+	"BA": "America/Bahia",
+	"CE": "America/Fortaleza",
+	"MA": "America/Fortaleza",
+	"MS": "America/Campo_Grande",
+	"MT": "America/Cuiaba",
+	"PA": "America/Belem",
+	"PB": "America/Fortaleza",
+	"PE": "America/Recife",
+	"PI": "America/Fortaleza",
+	"RN": "America/Fortaleza",
+	"RO": "America/Porto_Velho",
+	"RR": "America/Boa_Vista",
+	"SE": "America/Maceio",
+	"TO": "America/Araguaina",
+}
+
+// TimeZoneForUF devolve o fuso da capital da UF; demais estados seguem o horário de Brasília
+func TimeZoneForUF(uf string) string {
+	if timeZone, ok := timeZonesByUF[strings.ToUpper(strings.TrimSpace(uf))]; ok {
+		return timeZone
+	}
+	return DefaultTimeZone
+}
+
+func ValidateTimeZone(timeZone string) error {
+	if strings.TrimSpace(timeZone) == "" {
+		return ErrInvalidTimeZone
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return ErrInvalidTimeZone
+	}
+
+	return nil
+}
+
+// LoadLocation carrega o fuso IANA, caindo no DefaultTimeZone quando vazio ou inválido
+func LoadLocation(timeZone string) *time.Location {
+	if timeZone != "" {
+		if loc, err := time.LoadLocation(timeZone); err == nil {
+			return loc
+		}
+	}
+
+	loc, _ := time.LoadLocation(DefaultTimeZone)
+	return loc
+}
+
+// Location é o fuso da empresa: datas ficam em UTC no banco e são convertidas para agrupar e exibir
+func (c *Company) Location() *time.Location {
+	return LoadLocation(c.TimeZone)
+}
+
+// Now é o horário local da empresa
+func (c *Company) Now() time.Time {
+	return time.Now().In(c.Location())
+}
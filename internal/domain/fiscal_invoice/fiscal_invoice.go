@@ -2,6 +2,7 @@ package fiscalinvoice
 
 import (
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
@@ -18,17 +19,20 @@ const (
 // FiscalInvoice represents a fiscal invoice (NFC-e or NF-e)
 type FiscalInvoice struct {
 	entity.Entity
-	CompanyID          uuid.UUID
-	OrderID            uuid.UUID
-	AccessKey          string // ChaveAcesso (44-character access key)
-	Number             int    // Numero
-	Series             int    // Serie
-	Status             InvoiceStatus
-	XMLPath            string
-	PDFPath            string
-	Protocol           string // Protocolo
-	ErrorMessage       string
-	CancellationReason string
+	CompanyID           uuid.UUID
+	OrderID             uuid.UUID
+	AccessKey           string // ChaveAcesso (44-character access key)
+	Number              int    // Numero
+	Series              int    // Serie
+	Status              InvoiceStatus
+	XMLPath             string
+	PDFPath             string
+	Protocol            string // Protocolo
+	ErrorMessage        string
+	CancellationReason  string
+	CancellationXMLPath string
+	TotalAmount         decimal.Decimal // Valor total da nota
+	TaxAmount           decimal.Decimal // Tributos aproximados
 }
 
 // NewFiscalInvoice creates a new fiscal invoice
@@ -65,6 +69,17 @@ func (f *FiscalInvoice) Cancel(reason string) {
 	f.CancellationReason = reason
 }
 
+// SetAmounts stores the invoice total and the approximate taxes
+func (f *FiscalInvoice) SetAmounts(total, taxes decimal.Decimal) {
+	f.TotalAmount = total
+	f.TaxAmount = taxes
+}
+
+// AttachCancellationXML stores the path of the cancellation event XML
+func (f *FiscalInvoice) AttachCancellationXML(xmlPath string) {
+	f.CancellationXMLPath = xmlPath
+}
+
 // IsAuthorized checks if invoice is authorized
 func (f *FiscalInvoice) IsAuthorized() bool {
 	return f.Status == StatusAuthorized
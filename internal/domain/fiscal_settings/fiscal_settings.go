@@ -7,10 +7,14 @@ import (
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 )
 
+// ProviderFocusNFe is the default fiscal provider
+const ProviderFocusNFe = "focusnfe"
+
 type FiscalSettings struct {
 	entity.Entity
 	CompanyID             uuid.UUID
-	CompanyRegistryID     int64 // ID returned by Focus NFe
+	Provider              string // Fiscal API used to emit (empty = default provider)
+	CompanyRegistryID     int64  // ID returned by Focus NFe
 	TokenProduction       string
 	TokenHomologation     string
 	CSCProductionID       string
@@ -29,6 +33,9 @@ type FiscalSettings struct {
 	ShowTaxBreakdown     bool // DiscriminaImpostos
 	SendEmailToRecipient bool // EnviarEmailDestinatario
 
+	// Accountant receiving the monthly XML archive (optional)
+	AccountantEmail string
+
 	// Company Identity (Specific for Fiscal Emission)
 	BusinessName string
 	TradeName    string
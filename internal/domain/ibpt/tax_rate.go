@@ -0,0 +1,80 @@
+package ibptentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrNCMRequired = errors.New("ncm is required")
+	ErrInvalidUF   = errors.New("uf must have 2 letters")
+)
+
+// Source is printed next to the values, as required by the IBPT license
+const Source = "IBPT"
+
+var hundred = decimal.NewFromInt(100)
+
+// TaxRate is one line of the IBPT table (De Olho no Imposto) for a NCM in a UF.
+// Rates are percentages applied over the sale price.
+type TaxRate struct {
+	entity.Entity
+	TaxRateCommonAttributes
+}
+
+type TaxRateCommonAttributes struct {
+	NCM             string
+	Exception       string // EX TIPI
+	UF              string
+	Description     string
+	FederalNational decimal.Decimal
+	FederalImported decimal.Decimal
+	State           decimal.Decimal
+	Municipal       decimal.Decimal
+	ValidFrom       *time.Time
+	ValidUntil      *time.Time
+	Version         string
+	Key             string
+}
+
+func NewTaxRate(attributes TaxRateCommonAttributes) (*TaxRate, error) {
+	if attributes.NCM == "" {
+		return nil, ErrNCMRequired
+	}
+
+	if len(attributes.UF) != 2 {
+		return nil, ErrInvalidUF
+	}
+
+	return &TaxRate{
+		Entity:                  entity.NewEntity(),
+		TaxRateCommonAttributes: attributes,
+	}, nil
+}
+
+// IsValidAt reports whether the table line is in force at the given date
+func (r *TaxRate) IsValidAt(t time.Time) bool {
+	if r.ValidFrom != nil && t.Before(*r.ValidFrom) {
+		return false
+	}
+
+	if r.ValidUntil != nil && t.After(r.ValidUntil.AddDate(0, 0, 1)) {
+		return false
+	}
+
+	return true
+}
+
+// Calculate returns the approximate taxes over the amount, considering a national product
+func (r *TaxRate) Calculate(amount decimal.Decimal) *orderentity.ApproximateTaxes {
+	return &orderentity.ApproximateTaxes{
+		Federal:   amount.Mul(r.FederalNational).Div(hundred).Round(2),
+		State:     amount.Mul(r.State).Div(hundred).Round(2),
+		Municipal: amount.Mul(r.Municipal).Div(hundred).Round(2),
+		Source:    Source,
+	}
+}
@@ -0,0 +1,295 @@
+package inventorycountentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrCategoryRequired       = errors.New("category is required for category scope")
+	ErrInvalidScope           = errors.New("invalid inventory count scope")
+	ErrInventoryCountNotOpen  = errors.New("inventory count is not open")
+	ErrInventoryCountEmpty    = errors.New("inventory count has no items")
+	ErrItemNotFound           = errors.New("inventory count item not found")
+	ErrInvalidCountedQuantity = errors.New("counted quantity must not be negative")
+	ErrInvalidCountMode       = errors.New("invalid count mode")
+	ErrItemsNotCounted        = errors.New("there are items not counted yet")
+)
+
+type InventoryCountStatus string
+
+const (
+	StatusOpen      InventoryCountStatus = "open"
+	StatusApproved  InventoryCountStatus = "approved"
+	StatusCancelled InventoryCountStatus = "cancelled"
+)
+
+type InventoryCountScope string
+
+const (
+	ScopeFull     InventoryCountScope = "full"
+	ScopeCategory InventoryCountScope = "category"
+)
+
+// CountMode define como a quantidade informada é aplicada ao item:
+// "add" soma à contagem (leitor de código de barras, vários funcionários no mesmo item)
+// e "set" substitui a contagem (recontagem)
+type CountMode string
+
+const (
+	CountModeAdd CountMode = "add"
+	CountModeSet CountMode = "set"
+)
+
+// InventoryCount é uma sessão de inventário físico. As quantidades esperadas são
+// congeladas na abertura; as diferenças são lançadas no estoque apenas na aprovação.
+type InventoryCount struct {
+	entity.Entity
+	InventoryCountCommonAttributes
+	InventoryCountTimeLogs
+}
+
+type InventoryCountCommonAttributes struct {
+	Scope      InventoryCountScope
+	CategoryID *uuid.UUID
+	Status     InventoryCountStatus
+	Notes      string
+	StartedBy  uuid.UUID
+	ApprovedBy *uuid.UUID
+	Items      []InventoryCountItem
+}
+
+type InventoryCountTimeLogs struct {
+	ApprovedAt  *time.Time
+	CancelledAt *time.Time
+}
+
+// InventoryCountItem é uma linha da contagem: um lote com saldo ou, para estoques sem lote, o próprio estoque
+type InventoryCountItem struct {
+	entity.Entity
+	InventoryCountID   uuid.UUID
+	StockID            uuid.UUID
+	BatchID            *uuid.UUID
+	ProductID          uuid.UUID
+	ProductVariationID *uuid.UUID
+	SKU                string
+	Description        string
+	Unit               string
+	ExpectedQuantity   decimal.Decimal
+	CostPrice          decimal.Decimal
+	CountedQuantity    *decimal.Decimal
+	CountedBy          *uuid.UUID
+	CountedAt          *time.Time
+}
+
+// CountEntry é um lançamento de contagem feito por um funcionário
+type CountEntry struct {
+	entity.Entity
+	InventoryCountID uuid.UUID
+	ItemID           uuid.UUID
+	EmployeeID       uuid.UUID
+	Mode             CountMode
+	Quantity         decimal.Decimal
+}
+
+func NewInventoryCount(scope InventoryCountScope, categoryID *uuid.UUID, startedBy uuid.UUID, notes string) (*InventoryCount, error) {
+	switch scope {
+	case ScopeFull:
+		categoryID = nil
+	case ScopeCategory:
+		if categoryID == nil || *categoryID == uuid.Nil {
+			return nil, ErrCategoryRequired
+		}
+	default:
+		return nil, ErrInvalidScope
+	}
+
+	return &InventoryCount{
+		Entity: entity.NewEntity(),
+		InventoryCountCommonAttributes: InventoryCountCommonAttributes{
+			Scope:      scope,
+			CategoryID: categoryID,
+			Status:     StatusOpen,
+			Notes:      notes,
+			StartedBy:  startedBy,
+		},
+	}, nil
+}
+
+// AddItem congela a quantidade esperada e o custo do lote/estoque no momento da abertura
+func (c *InventoryCount) AddItem(item InventoryCountItem) {
+	item.Entity = entity.NewEntity()
+	item.InventoryCountID = c.ID
+	item.CountedQuantity = nil
+	item.CountedBy = nil
+	item.CountedAt = nil
+	c.Items = append(c.Items, item)
+}
+
+// RecordCount aplica um lançamento ao item e devolve o registro para auditoria
+func (c *InventoryCount) RecordCount(itemID, employeeID uuid.UUID, quantity decimal.Decimal, mode CountMode) (*CountEntry, error) {
+	if c.Status != StatusOpen {
+		return nil, ErrInventoryCountNotOpen
+	}
+
+	item := c.FindItem(itemID)
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+
+	if mode == "" {
+		mode = CountModeSet
+	}
+
+	counted := decimal.Zero
+	switch mode {
+	case CountModeSet:
+		counted = quantity
+	case CountModeAdd:
+		if item.CountedQuantity != nil {
+			counted = *item.CountedQuantity
+		}
+		counted = counted.Add(quantity)
+	default:
+		return nil, ErrInvalidCountMode
+	}
+
+	if counted.IsNegative() {
+		return nil, ErrInvalidCountedQuantity
+	}
+
+	now := time.Now().UTC()
+	item.CountedQuantity = &counted
+	item.CountedBy = &employeeID
+	item.CountedAt = &now
+
+	return &CountEntry{
+		Entity:           entity.NewEntity(),
+		InventoryCountID: c.ID,
+		ItemID:           item.ID,
+		EmployeeID:       employeeID,
+		Mode:             mode,
+		Quantity:         quantity,
+	}, nil
+}
+
+// Approve encerra a contagem. Itens não contados só são aceitos com treatUncountedAsZero,
+// caso contrário a aprovação é recusada para não zerar estoque por esquecimento.
+func (c *InventoryCount) Approve(approvedBy uuid.UUID, treatUncountedAsZero bool) error {
+	if c.Status != StatusOpen {
+		return ErrInventoryCountNotOpen
+	}
+
+	if len(c.Items) == 0 {
+		return ErrInventoryCountEmpty
+	}
+
+	for i := range c.Items {
+		if c.Items[i].IsCounted() {
+			continue
+		}
+
+		if !treatUncountedAsZero {
+			return ErrItemsNotCounted
+		}
+
+		zero := decimal.Zero
+		c.Items[i].CountedQuantity = &zero
+		c.Items[i].CountedBy = &approvedBy
+	}
+
+	now := time.Now().UTC()
+	c.Status = StatusApproved
+	c.ApprovedBy = &approvedBy
+	c.ApprovedAt = &now
+	return nil
+}
+
+func (c *InventoryCount) Cancel() error {
+	if c.Status != StatusOpen {
+		return ErrInventoryCountNotOpen
+	}
+
+	now := time.Now().UTC()
+	c.Status = StatusCancelled
+	c.CancelledAt = &now
+	return nil
+}
+
+func (c *InventoryCount) FindItem(id uuid.UUID) *InventoryCountItem {
+	for i := range c.Items {
+		if c.Items[i].ID == id {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// FindItemBySKU localiza o item lido pelo leitor; com mais de um lote, devolve o primeiro
+func (c *InventoryCount) FindItemBySKU(sku string) *InventoryCountItem {
+	if sku == "" {
+		return nil
+	}
+
+	for i := range c.Items {
+		if c.Items[i].SKU == sku {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// FindItemByStock localiza o item pelo estoque e, quando informado, pelo lote
+func (c *InventoryCount) FindItemByStock(stockID uuid.UUID, batchID *uuid.UUID) *InventoryCountItem {
+	for i := range c.Items {
+		item := &c.Items[i]
+		if item.StockID != stockID {
+			continue
+		}
+
+		if batchID == nil || (item.BatchID != nil && *item.BatchID == *batchID) {
+			return item
+		}
+	}
+	return nil
+}
+
+// CountedItems devolve quantos itens já receberam contagem
+func (c *InventoryCount) CountedItems() int {
+	counted := 0
+	for _, item := range c.Items {
+		if item.IsCounted() {
+			counted++
+		}
+	}
+	return counted
+}
+
+// TotalVarianceValue soma as diferenças valorizadas a custo (positivo = sobra, negativo = falta)
+func (c *InventoryCount) TotalVarianceValue() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range c.Items {
+		total = total.Add(item.VarianceValue())
+	}
+	return total
+}
+
+func (i *InventoryCountItem) IsCounted() bool {
+	return i.CountedQuantity != nil
+}
+
+// Variance é a diferença contada - esperada; itens ainda não contados não têm diferença
+func (i *InventoryCountItem) Variance() decimal.Decimal {
+	if i.CountedQuantity == nil {
+		return decimal.Zero
+	}
+	return i.CountedQuantity.Sub(i.ExpectedQuantity)
+}
+
+func (i *InventoryCountItem) VarianceValue() decimal.Decimal {
+	return i.Variance().Mul(i.CostPrice).Round(2)
+}
@@ -0,0 +1,78 @@
+package inventorycountentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOpenInventoryCount(t *testing.T) *InventoryCount {
+	c, err := NewInventoryCount(ScopeFull, nil, uuid.New(), "")
+	require.NoError(t, err)
+
+	c.AddItem(InventoryCountItem{StockID: uuid.New(), SKU: "111", ExpectedQuantity: decimal.NewFromInt(10), CostPrice: decimal.NewFromFloat(2.5)})
+	c.AddItem(InventoryCountItem{StockID: uuid.New(), SKU: "222", ExpectedQuantity: decimal.NewFromInt(4), CostPrice: decimal.NewFromInt(10)})
+	return c
+}
+
+func TestNewInventoryCount_CategoryScopeRequiresCategory(t *testing.T) {
+	_, err := NewInventoryCount(ScopeCategory, nil, uuid.New(), "")
+	assert.ErrorIs(t, err, ErrCategoryRequired)
+
+	_, err = NewInventoryCount("partial", nil, uuid.New(), "")
+	assert.ErrorIs(t, err, ErrInvalidScope)
+}
+
+func TestInventoryCount_RecordCountAddAndSet(t *testing.T) {
+	c := newOpenInventoryCount(t)
+	item := c.FindItemBySKU("111")
+	require.NotNil(t, item)
+
+	_, err := c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(5), CountModeAdd)
+	require.NoError(t, err)
+	_, err = c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(3), CountModeAdd)
+	require.NoError(t, err)
+	assert.Equal(t, "8", item.CountedQuantity.String(), "leituras de funcionários diferentes devem somar")
+
+	_, err = c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(9), CountModeSet)
+	require.NoError(t, err)
+	assert.Equal(t, "9", item.CountedQuantity.String())
+
+	_, err = c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(-10), CountModeAdd)
+	assert.ErrorIs(t, err, ErrInvalidCountedQuantity)
+}
+
+func TestInventoryCount_VarianceInQuantityAndValue(t *testing.T) {
+	c := newOpenInventoryCount(t)
+	short := c.FindItemBySKU("111")
+	over := c.FindItemBySKU("222")
+
+	_, err := c.RecordCount(short.ID, uuid.New(), decimal.NewFromInt(7), CountModeSet)
+	require.NoError(t, err)
+	_, err = c.RecordCount(over.ID, uuid.New(), decimal.NewFromInt(5), CountModeSet)
+	require.NoError(t, err)
+
+	assert.Equal(t, "-3", short.Variance().String())
+	assert.Equal(t, "-7.5", short.VarianceValue().String())
+	assert.Equal(t, "10", over.VarianceValue().String())
+	assert.Equal(t, "2.5", c.TotalVarianceValue().String())
+}
+
+func TestInventoryCount_ApproveRequiresAllCounted(t *testing.T) {
+	c := newOpenInventoryCount(t)
+	item := c.FindItemBySKU("111")
+	_, err := c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(10), CountModeSet)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, c.Approve(uuid.New(), false), ErrItemsNotCounted)
+
+	require.NoError(t, c.Approve(uuid.New(), true))
+	assert.Equal(t, StatusApproved, c.Status)
+	assert.Equal(t, "-4", c.FindItemBySKU("222").Variance().String(), "item não contado deve ser zerado")
+
+	_, err = c.RecordCount(item.ID, uuid.New(), decimal.NewFromInt(1), CountModeAdd)
+	assert.ErrorIs(t, err, ErrInventoryCountNotOpen)
+}
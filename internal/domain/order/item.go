@@ -38,6 +38,13 @@ type ItemCommonAttributes struct {
 	Product            *productentity.Product
 	ProductVariationID uuid.UUID
 	Flavor             *string
+	// ComboID e ComboSaleID ligam o componente ao combo vendido; itens do mesmo combo compartilham ComboSaleID
+	ComboID     *uuid.UUID
+	ComboSaleID *uuid.UUID
+	// PriceListID é a lista de preço aplicada na venda; ListPrice guarda o preço da variação que ela substituiu
+	PriceListID *uuid.UUID
+	ListPrice   *decimal.Decimal
+	Taxes       *ApproximateTaxes // calculated on demand from the IBPT table, not persisted
 }
 
 // NewItem creates a new order item with initial price and total
@@ -68,6 +75,15 @@ func (i *Item) AddSizeToName() {
 	i.Name += " (" + i.Size + ")"
 }
 
+// ApplyPriceList troca o preço unitário pelo da lista, mantendo o preço original da variação
+func (i *Item) ApplyPriceList(priceListID uuid.UUID, price decimal.Decimal) {
+	listPrice := i.SubTotal
+	i.PriceListID = &priceListID
+	i.ListPrice = &listPrice
+	i.SubTotal = price
+	i.Total = price.Mul(decimal.NewFromFloat(i.Quantity))
+}
+
 func (i *Item) AddRemovedItem(name string) {
 	i.RemovedItems = append(i.RemovedItems, name)
 }
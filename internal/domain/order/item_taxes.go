@@ -0,0 +1,78 @@
+package orderentity
+
+import "github.com/shopspring/decimal"
+
+// ApproximateTaxes is the approximate tax burden shown to the consumer (Lei 12.741/2012)
+type ApproximateTaxes struct {
+	Federal   decimal.Decimal
+	State     decimal.Decimal
+	Municipal decimal.Decimal
+	Source    string
+}
+
+func (t ApproximateTaxes) Total() decimal.Decimal {
+	return t.Federal.Add(t.State).Add(t.Municipal)
+}
+
+func (t *ApproximateTaxes) Add(other *ApproximateTaxes) {
+	if other == nil {
+		return
+	}
+
+	t.Federal = t.Federal.Add(other.Federal)
+	t.State = t.State.Add(other.State)
+	t.Municipal = t.Municipal.Add(other.Municipal)
+
+	if t.Source == "" {
+		t.Source = other.Source
+	}
+}
+
+// TotalApproximateTaxes sums the item taxes and its additional items, returns nil when nothing was calculated
+func (i *Item) TotalApproximateTaxes() *ApproximateTaxes {
+	var total *ApproximateTaxes
+
+	add := func(taxes *ApproximateTaxes) {
+		if taxes == nil {
+			return
+		}
+		if total == nil {
+			total = &ApproximateTaxes{}
+		}
+		total.Add(taxes)
+	}
+
+	add(i.Taxes)
+	for j := range i.AdditionalItems {
+		add(i.AdditionalItems[j].TotalApproximateTaxes())
+	}
+
+	return total
+}
+
+// ApproximateTaxes sums the taxes of every item of the order, returns nil when nothing was calculated
+func (o *Order) ApproximateTaxes() *ApproximateTaxes {
+	var total *ApproximateTaxes
+
+	add := func(taxes *ApproximateTaxes) {
+		if taxes == nil {
+			return
+		}
+		if total == nil {
+			total = &ApproximateTaxes{}
+		}
+		total.Add(taxes)
+	}
+
+	for _, group := range o.GroupItems {
+		for j := range group.Items {
+			add(group.Items[j].TotalApproximateTaxes())
+		}
+
+		if group.ComplementItem != nil {
+			add(group.ComplementItem.Taxes)
+		}
+	}
+
+	return total
+}
@@ -18,12 +18,28 @@ type PaymentCommonAttributes struct {
 	TotalPaid decimal.Decimal
 	Method    PayMethod
 	OrderID   uuid.UUID
+	PaymentSettlement
+}
+
+// PaymentSettlement é o repasse esperado da adquirente: valor líquido da taxa (MDR) e data de crédito.
+// Fica vazio para formas sem taxa configurada, como dinheiro.
+type PaymentSettlement struct {
+	FeeAmount            decimal.Decimal
+	NetAmount            decimal.Decimal
+	ExpectedSettlementAt *time.Time
 }
 
 type PaymentTimeLogs struct {
 	PaidAt time.Time
 }
 
+// SetSettlement grava a taxa descontada e a data prevista do crédito
+func (p *PaymentOrder) SetSettlement(fee decimal.Decimal, settlementAt time.Time) {
+	p.FeeAmount = fee
+	p.NetAmount = p.TotalPaid.Sub(fee)
+	p.ExpectedSettlementAt = &settlementAt
+}
+
 // NewPayment creates a new payment record for an order
 func NewPayment(totalPaid decimal.Decimal, method PayMethod, orderID uuid.UUID) *PaymentOrder {
 	return &PaymentOrder{
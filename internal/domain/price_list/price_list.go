@@ -0,0 +1,259 @@
+package pricelistentity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrPriceListNameRequired       = errors.New("price list name is required")
+	ErrPriceListChannelInvalid     = errors.New("price list channel is invalid")
+	ErrPriceListWindowInvalid      = errors.New("price list time window must use HH:MM and valid days of week")
+	ErrPriceListDateRangeInvalid   = errors.New("price list end date must be after start date")
+	ErrPriceListPricesRequired     = errors.New("price list must have at least one price")
+	ErrPriceListPriceInvalid       = errors.New("price list price cannot be negative")
+	ErrPriceListVariationDuplicate = errors.New("price list has more than one price for the same variation")
+)
+
+// Channel é o canal de venda em que a lista vale
+type Channel string
+
+const (
+	ChannelDelivery    Channel = "delivery"
+	ChannelTable       Channel = "table"
+	ChannelPickup      Channel = "pickup"
+	ChannelDigitalMenu Channel = "digital_menu"
+)
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelDelivery, ChannelTable, ChannelPickup, ChannelDigitalMenu:
+		return true
+	}
+	return false
+}
+
+// PriceList substitui o preço das variações listadas enquanto estiver vigente
+// (happy hour, preço de delivery, promoção). Restrições vazias não limitam:
+// sem canais vale em todos, sem janelas vale o dia todo, sem datas vale sempre.
+type PriceList struct {
+	entity.Entity
+	PriceListCommonAttributes
+}
+
+type PriceListCommonAttributes struct {
+	Name     string
+	Priority int // maior prioridade vence quando mais de uma lista está vigente
+	IsActive bool
+	Channels []Channel
+	Windows  []TimeWindow
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	Prices   []PriceListEntry
+}
+
+// TimeWindow é um horário (HH:MM) nos dias da semana indicados (0 = domingo).
+// Se EndTime for menor que StartTime a janela passa da meia-noite.
+type TimeWindow struct {
+	DaysOfWeek []int
+	StartTime  string
+	EndTime    string
+}
+
+type PriceListEntry struct {
+	ProductVariationID uuid.UUID
+	Price              decimal.Decimal
+}
+
+func NewPriceList(attributes PriceListCommonAttributes) (*PriceList, error) {
+	priceList := &PriceList{
+		Entity:                    entity.NewEntity(),
+		PriceListCommonAttributes: attributes,
+	}
+	priceList.IsActive = true
+
+	if err := priceList.Validate(); err != nil {
+		return nil, err
+	}
+
+	return priceList, nil
+}
+
+func (p *PriceList) Validate() error {
+	p.Name = strings.TrimSpace(p.Name)
+	if p.Name == "" {
+		return ErrPriceListNameRequired
+	}
+
+	for _, channel := range p.Channels {
+		if !channel.IsValid() {
+			return ErrPriceListChannelInvalid
+		}
+	}
+
+	for _, window := range p.Windows {
+		if !window.isValid() {
+			return ErrPriceListWindowInvalid
+		}
+	}
+
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return ErrPriceListDateRangeInvalid
+	}
+
+	if len(p.Prices) == 0 {
+		return ErrPriceListPricesRequired
+	}
+
+	seen := map[uuid.UUID]bool{}
+	for _, entry := range p.Prices {
+		if entry.Price.IsNegative() {
+			return ErrPriceListPriceInvalid
+		}
+
+		if seen[entry.ProductVariationID] {
+			return ErrPriceListVariationDuplicate
+		}
+		seen[entry.ProductVariationID] = true
+	}
+
+	return nil
+}
+
+// AppliesAt informa se a lista está vigente no canal e no horário
+func (p *PriceList) AppliesAt(channel Channel, at time.Time) bool {
+	if !p.IsActive {
+		return false
+	}
+
+	if p.StartsAt != nil && at.Before(*p.StartsAt) {
+		return false
+	}
+
+	if p.EndsAt != nil && at.After(*p.EndsAt) {
+		return false
+	}
+
+	if len(p.Channels) > 0 && !p.hasChannel(channel) {
+		return false
+	}
+
+	if len(p.Windows) == 0 {
+		return true
+	}
+
+	for _, window := range p.Windows {
+		if window.contains(at) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PriceFor devolve o preço da variação na lista
+func (p *PriceList) PriceFor(variationID uuid.UUID) (decimal.Decimal, bool) {
+	for _, entry := range p.Prices {
+		if entry.ProductVariationID == variationID {
+			return entry.Price, true
+		}
+	}
+	return decimal.Zero, false
+}
+
+func (p *PriceList) hasChannel(channel Channel) bool {
+	for _, c := range p.Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve escolhe, entre as listas vigentes que têm preço para a variação, a de maior
+// prioridade; no empate fica o menor preço. Retorna nil quando vale o preço da variação.
+func Resolve(priceLists []PriceList, variationID uuid.UUID, channel Channel, at time.Time) (*PriceList, decimal.Decimal) {
+	var applied *PriceList
+	price := decimal.Zero
+
+	for i := range priceLists {
+		priceList := &priceLists[i]
+		if !priceList.AppliesAt(channel, at) {
+			continue
+		}
+
+		listPrice, ok := priceList.PriceFor(variationID)
+		if !ok {
+			continue
+		}
+
+		if applied == nil || priceList.Priority > applied.Priority ||
+			(priceList.Priority == applied.Priority && listPrice.LessThan(price)) {
+			applied = priceList
+			price = listPrice
+		}
+	}
+
+	return applied, price
+}
+
+func (w TimeWindow) isValid() bool {
+	if _, err := time.Parse("15:04", w.StartTime); err != nil {
+		return false
+	}
+
+	if _, err := time.Parse("15:04", w.EndTime); err != nil {
+		return false
+	}
+
+	if w.StartTime == w.EndTime {
+		return false
+	}
+
+	for _, day := range w.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// contains compara HH:MM como texto, igual a Company.IsOpen. Na janela que passa da
+// meia-noite, a parte da madrugada pertence ao dia em que a janela começou.
+func (w TimeWindow) contains(at time.Time) bool {
+	hourMinute := at.Format("15:04")
+	day := int(at.Weekday())
+
+	if w.StartTime < w.EndTime {
+		return hourMinute >= w.StartTime && hourMinute < w.EndTime && w.onDay(day)
+	}
+
+	if hourMinute >= w.StartTime {
+		return w.onDay(day)
+	}
+
+	if hourMinute < w.EndTime {
+		return w.onDay((day + 6) % 7)
+	}
+
+	return false
+}
+
+func (w TimeWindow) onDay(day int) bool {
+	if len(w.DaysOfWeek) == 0 {
+		return true
+	}
+
+	for _, d := range w.DaysOfWeek {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package pricelistentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 2026-10-16 é uma sexta-feira (5)
+func friday(hour, minute int) time.Time {
+	return time.Date(2026, 10, 16, hour, minute, 0, 0, time.UTC)
+}
+
+func newHappyHour(t *testing.T, variationID uuid.UUID) *PriceList {
+	t.Helper()
+
+	priceList, err := NewPriceList(PriceListCommonAttributes{
+		Name:    "Happy hour",
+		Windows: []TimeWindow{{DaysOfWeek: []int{4, 5}, StartTime: "17:00", EndTime: "20:00"}},
+		Prices:  []PriceListEntry{{ProductVariationID: variationID, Price: decimal.NewFromInt(8)}},
+	})
+	require.NoError(t, err)
+	return priceList
+}
+
+func TestNewPriceList_Validation(t *testing.T) {
+	variationID := uuid.New()
+	prices := []PriceListEntry{{ProductVariationID: variationID, Price: decimal.NewFromInt(10)}}
+
+	_, err := NewPriceList(PriceListCommonAttributes{Name: " ", Prices: prices})
+	assert.ErrorIs(t, err, ErrPriceListNameRequired)
+
+	_, err = NewPriceList(PriceListCommonAttributes{Name: "Delivery", Channels: []Channel{"ifood"}, Prices: prices})
+	assert.ErrorIs(t, err, ErrPriceListChannelInvalid)
+
+	_, err = NewPriceList(PriceListCommonAttributes{Name: "Noite", Windows: []TimeWindow{{StartTime: "25:00", EndTime: "02:00"}}, Prices: prices})
+	assert.ErrorIs(t, err, ErrPriceListWindowInvalid)
+
+	start := friday(0, 0)
+	_, err = NewPriceList(PriceListCommonAttributes{Name: "Promo", StartsAt: &start, EndsAt: &start, Prices: prices})
+	assert.ErrorIs(t, err, ErrPriceListDateRangeInvalid)
+
+	_, err = NewPriceList(PriceListCommonAttributes{Name: "Promo"})
+	assert.ErrorIs(t, err, ErrPriceListPricesRequired)
+
+	_, err = NewPriceList(PriceListCommonAttributes{Name: "Promo", Prices: append(prices, prices[0])})
+	assert.ErrorIs(t, err, ErrPriceListVariationDuplicate)
+}
+
+func TestAppliesAt_WindowsChannelsAndDates(t *testing.T) {
+	priceList := newHappyHour(t, uuid.New())
+
+	assert.True(t, priceList.AppliesAt(ChannelTable, friday(18, 30)))
+	assert.False(t, priceList.AppliesAt(ChannelTable, friday(20, 0)), "fim da janela é exclusivo")
+	assert.False(t, priceList.AppliesAt(ChannelTable, friday(18, 30).AddDate(0, 0, 1)), "sábado fora dos dias")
+
+	priceList.Channels = []Channel{ChannelTable}
+	assert.False(t, priceList.AppliesAt(ChannelDelivery, friday(18, 30)))
+
+	end := friday(0, 0)
+	priceList.EndsAt = &end
+	assert.False(t, priceList.AppliesAt(ChannelTable, friday(18, 30)), "promoção encerrada")
+}
+
+func TestAppliesAt_OvernightWindowBelongsToStartDay(t *testing.T) {
+	priceList := newHappyHour(t, uuid.New())
+	priceList.Windows = []TimeWindow{{DaysOfWeek: []int{5}, StartTime: "22:00", EndTime: "02:00"}}
+
+	assert.True(t, priceList.AppliesAt(ChannelTable, friday(23, 0)))
+	assert.True(t, priceList.AppliesAt(ChannelTable, friday(1, 0).AddDate(0, 0, 1)), "madrugada de sábado é da janela de sexta")
+	assert.False(t, priceList.AppliesAt(ChannelTable, friday(1, 0)), "madrugada de sexta é da janela de quinta")
+}
+
+func TestResolve_PriorityThenLowestPrice(t *testing.T) {
+	variationID := uuid.New()
+	happyHour := newHappyHour(t, variationID)
+
+	promo, err := NewPriceList(PriceListCommonAttributes{
+		Name:   "Promoção",
+		Prices: []PriceListEntry{{ProductVariationID: variationID, Price: decimal.NewFromInt(7)}},
+	})
+	require.NoError(t, err)
+
+	applied, price := Resolve([]PriceList{*happyHour, *promo}, variationID, ChannelTable, friday(18, 0))
+	require.NotNil(t, applied)
+	assert.Equal(t, promo.ID, applied.ID, "mesma prioridade fica com o menor preço")
+	assert.Equal(t, "7", price.String())
+
+	happyHour.Priority = 1
+	applied, price = Resolve([]PriceList{*happyHour, *promo}, variationID, ChannelTable, friday(18, 0))
+	assert.Equal(t, happyHour.ID, applied.ID)
+	assert.Equal(t, "8", price.String())
+
+	applied, _ = Resolve([]PriceList{*happyHour, *promo}, uuid.New(), ChannelTable, friday(18, 0))
+	assert.Nil(t, applied, "variação fora das listas mantém o preço próprio")
+}
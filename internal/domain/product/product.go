@@ -20,6 +20,7 @@ type Product struct {
 
 type ProductCommonAttributes struct {
 	SKU         string
+	NCM         string
 	Name        string
 	Flavors     []string
 	ImagePath   *string
@@ -13,6 +13,9 @@ type ProductVariation struct {
 	Size        *Size
 	Price       decimal.Decimal
 	IsAvailable bool
+	// AutoUnavailable marca a indisponibilidade feita pela disponibilidade automática do estoque;
+	// só essas a reposição volta a liberar
+	AutoUnavailable bool
 }
 
 func NewProductVariation(productID uuid.UUID, sizeID uuid.UUID, price decimal.Decimal) *ProductVariation {
@@ -0,0 +1,274 @@
+package purchaseorderentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrSupplierRequired         = errors.New("supplier is required")
+	ErrPurchaseOrderNotDraft    = errors.New("purchase order can only be changed while in draft")
+	ErrPurchaseOrderEmpty       = errors.New("purchase order must have at least one item")
+	ErrPurchaseOrderNotOrdered  = errors.New("purchase order must be sent to the supplier before receiving")
+	ErrPurchaseOrderClosed      = errors.New("purchase order is already received or cancelled")
+	ErrPurchaseOrderHasReceipts = errors.New("purchase order with received items cannot be cancelled")
+	ErrStockRequired            = errors.New("item stock is required")
+	ErrInvalidItemQuantity      = errors.New("item quantity must be greater than zero")
+	ErrInvalidItemCost          = errors.New("item unit cost must not be negative")
+	ErrItemNotFound             = errors.New("purchase order item not found")
+	ErrReceivedQuantityExceeds  = errors.New("received quantity exceeds pending quantity")
+	ErrNothingToReceive         = errors.New("no quantity informed to receive")
+)
+
+type PurchaseOrderStatus string
+
+const (
+	StatusDraft             PurchaseOrderStatus = "draft"
+	StatusOrdered           PurchaseOrderStatus = "ordered"
+	StatusPartiallyReceived PurchaseOrderStatus = "partially_received"
+	StatusReceived          PurchaseOrderStatus = "received"
+	StatusCancelled         PurchaseOrderStatus = "cancelled"
+)
+
+// PurchaseOrder é o pedido de compra enviado a um fornecedor
+type PurchaseOrder struct {
+	entity.Entity
+	PurchaseOrderCommonAttributes
+	PurchaseOrderTimeLogs
+}
+
+type PurchaseOrderCommonAttributes struct {
+	SupplierID uuid.UUID
+	Status     PurchaseOrderStatus
+	Notes      string
+	Items      []PurchaseOrderItem
+}
+
+type PurchaseOrderTimeLogs struct {
+	ExpectedDeliveryAt *time.Time
+	OrderedAt          *time.Time
+	ReceivedAt         *time.Time
+	CancelledAt        *time.Time
+}
+
+// PurchaseOrderItem é uma linha do pedido, sempre ligada ao estoque que será abastecido
+// (variação de produto ou insumo)
+type PurchaseOrderItem struct {
+	entity.Entity
+	PurchaseOrderID    uuid.UUID
+	StockID            uuid.UUID
+	ProductID          uuid.UUID
+	ProductVariationID *uuid.UUID
+	Description        string
+	Quantity           decimal.Decimal
+	ReceivedQuantity   decimal.Decimal
+	UnitCost           decimal.Decimal
+}
+
+// ReceiveLine informa quanto de um item chegou; UnitCost e ExpiresAt vêm da nota do fornecedor
+type ReceiveLine struct {
+	ItemID    uuid.UUID
+	Quantity  decimal.Decimal
+	UnitCost  *decimal.Decimal
+	ExpiresAt *time.Time
+}
+
+// ReceivedItem é o resultado de um recebimento, usado para gerar lotes e movimentos de entrada
+type ReceivedItem struct {
+	Item      *PurchaseOrderItem
+	Quantity  decimal.Decimal
+	UnitCost  decimal.Decimal
+	ExpiresAt *time.Time
+}
+
+func (r ReceivedItem) Total() decimal.Decimal {
+	return r.Quantity.Mul(r.UnitCost)
+}
+
+func NewPurchaseOrder(supplierID uuid.UUID, expectedDeliveryAt *time.Time, notes string) (*PurchaseOrder, error) {
+	if supplierID == uuid.Nil {
+		return nil, ErrSupplierRequired
+	}
+
+	return &PurchaseOrder{
+		Entity: entity.NewEntity(),
+		PurchaseOrderCommonAttributes: PurchaseOrderCommonAttributes{
+			SupplierID: supplierID,
+			Status:     StatusDraft,
+			Notes:      notes,
+		},
+		PurchaseOrderTimeLogs: PurchaseOrderTimeLogs{
+			ExpectedDeliveryAt: expectedDeliveryAt,
+		},
+	}, nil
+}
+
+func (p *PurchaseOrder) AddItem(stockID, productID uuid.UUID, productVariationID *uuid.UUID, description string, quantity, unitCost decimal.Decimal) (*PurchaseOrderItem, error) {
+	if p.Status != StatusDraft {
+		return nil, ErrPurchaseOrderNotDraft
+	}
+
+	if stockID == uuid.Nil {
+		return nil, ErrStockRequired
+	}
+
+	if quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidItemQuantity
+	}
+
+	if unitCost.IsNegative() {
+		return nil, ErrInvalidItemCost
+	}
+
+	p.Items = append(p.Items, PurchaseOrderItem{
+		Entity:             entity.NewEntity(),
+		PurchaseOrderID:    p.ID,
+		StockID:            stockID,
+		ProductID:          productID,
+		ProductVariationID: productVariationID,
+		Description:        description,
+		Quantity:           quantity,
+		ReceivedQuantity:   decimal.Zero,
+		UnitCost:           unitCost,
+	})
+
+	return &p.Items[len(p.Items)-1], nil
+}
+
+// ClearItems remove todos os itens para que o rascunho seja remontado
+func (p *PurchaseOrder) ClearItems() error {
+	if p.Status != StatusDraft {
+		return ErrPurchaseOrderNotDraft
+	}
+
+	p.Items = nil
+	return nil
+}
+
+// Total é o valor previsto do pedido (quantidade pedida x custo unitário)
+func (p *PurchaseOrder) Total() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range p.Items {
+		total = total.Add(item.Quantity.Mul(item.UnitCost))
+	}
+	return total
+}
+
+// Send marca o pedido como enviado ao fornecedor
+func (p *PurchaseOrder) Send() error {
+	if p.Status != StatusDraft {
+		return ErrPurchaseOrderNotDraft
+	}
+
+	if len(p.Items) == 0 {
+		return ErrPurchaseOrderEmpty
+	}
+
+	now := time.Now().UTC()
+	p.Status = StatusOrdered
+	p.OrderedAt = &now
+	return nil
+}
+
+func (p *PurchaseOrder) Cancel() error {
+	if p.Status == StatusReceived || p.Status == StatusCancelled {
+		return ErrPurchaseOrderClosed
+	}
+
+	for _, item := range p.Items {
+		if item.ReceivedQuantity.GreaterThan(decimal.Zero) {
+			return ErrPurchaseOrderHasReceipts
+		}
+	}
+
+	now := time.Now().UTC()
+	p.Status = StatusCancelled
+	p.CancelledAt = &now
+	return nil
+}
+
+// Receive registra a chegada (total ou parcial) dos itens e atualiza o status do pedido.
+// Linhas sem quantidade são ignoradas; quantidades acima do pendente são rejeitadas.
+func (p *PurchaseOrder) Receive(lines []ReceiveLine) ([]ReceivedItem, error) {
+	if p.Status != StatusOrdered && p.Status != StatusPartiallyReceived {
+		if p.Status == StatusDraft {
+			return nil, ErrPurchaseOrderNotOrdered
+		}
+		return nil, ErrPurchaseOrderClosed
+	}
+
+	received := []ReceivedItem{}
+	for _, line := range lines {
+		if line.Quantity.IsZero() {
+			continue
+		}
+
+		item := p.findItem(line.ItemID)
+		if item == nil {
+			return nil, ErrItemNotFound
+		}
+
+		if line.Quantity.IsNegative() {
+			return nil, ErrInvalidItemQuantity
+		}
+
+		if line.Quantity.GreaterThan(item.PendingQuantity()) {
+			return nil, ErrReceivedQuantityExceeds
+		}
+
+		unitCost := item.UnitCost
+		if line.UnitCost != nil {
+			if line.UnitCost.IsNegative() {
+				return nil, ErrInvalidItemCost
+			}
+			unitCost = *line.UnitCost
+		}
+
+		item.ReceivedQuantity = item.ReceivedQuantity.Add(line.Quantity)
+		received = append(received, ReceivedItem{
+			Item:      item,
+			Quantity:  line.Quantity,
+			UnitCost:  unitCost,
+			ExpiresAt: line.ExpiresAt,
+		})
+	}
+
+	if len(received) == 0 {
+		return nil, ErrNothingToReceive
+	}
+
+	p.Status = StatusPartiallyReceived
+	if p.IsFullyReceived() {
+		now := time.Now().UTC()
+		p.Status = StatusReceived
+		p.ReceivedAt = &now
+	}
+
+	return received, nil
+}
+
+func (p *PurchaseOrder) IsFullyReceived() bool {
+	for _, item := range p.Items {
+		if item.PendingQuantity().GreaterThan(decimal.Zero) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *PurchaseOrder) findItem(id uuid.UUID) *PurchaseOrderItem {
+	for i := range p.Items {
+		if p.Items[i].ID == id {
+			return &p.Items[i]
+		}
+	}
+	return nil
+}
+
+func (i *PurchaseOrderItem) PendingQuantity() decimal.Decimal {
+	return i.Quantity.Sub(i.ReceivedQuantity)
+}
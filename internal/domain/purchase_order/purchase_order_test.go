@@ -0,0 +1,112 @@
+package purchaseorderentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrderedPurchaseOrder(t *testing.T) *PurchaseOrder {
+	p, err := NewPurchaseOrder(uuid.New(), nil, "")
+	require.NoError(t, err)
+
+	_, err = p.AddItem(uuid.New(), uuid.New(), nil, "Farinha", decimal.NewFromInt(10), decimal.NewFromFloat(4.5))
+	require.NoError(t, err)
+	_, err = p.AddItem(uuid.New(), uuid.New(), nil, "Queijo", decimal.NewFromInt(2), decimal.NewFromFloat(30))
+	require.NoError(t, err)
+
+	require.NoError(t, p.Send())
+	return p
+}
+
+func TestNewPurchaseOrder_RequiresSupplier(t *testing.T) {
+	_, err := NewPurchaseOrder(uuid.Nil, nil, "")
+	assert.ErrorIs(t, err, ErrSupplierRequired)
+}
+
+func TestPurchaseOrder_SendEmpty(t *testing.T) {
+	p, err := NewPurchaseOrder(uuid.New(), nil, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.Send(), ErrPurchaseOrderEmpty)
+}
+
+func TestPurchaseOrder_TotalAndAddItemAfterSend(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+	assert.Equal(t, "105", p.Total().String())
+	assert.Equal(t, StatusOrdered, p.Status)
+	assert.NotNil(t, p.OrderedAt)
+
+	_, err := p.AddItem(uuid.New(), uuid.New(), nil, "", decimal.NewFromInt(1), decimal.Zero)
+	assert.ErrorIs(t, err, ErrPurchaseOrderNotDraft)
+}
+
+func TestPurchaseOrder_ReceiveDraft(t *testing.T) {
+	p, err := NewPurchaseOrder(uuid.New(), nil, "")
+	require.NoError(t, err)
+	_, err = p.AddItem(uuid.New(), uuid.New(), nil, "", decimal.NewFromInt(1), decimal.NewFromInt(1))
+	require.NoError(t, err)
+
+	_, err = p.Receive([]ReceiveLine{{ItemID: p.Items[0].ID, Quantity: decimal.NewFromInt(1)}})
+	assert.ErrorIs(t, err, ErrPurchaseOrderNotOrdered)
+}
+
+func TestPurchaseOrder_PartialThenFullReceive(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+	flour, cheese := p.Items[0].ID, p.Items[1].ID
+	newCost := decimal.NewFromFloat(4.2)
+
+	received, err := p.Receive([]ReceiveLine{{ItemID: flour, Quantity: decimal.NewFromInt(6), UnitCost: &newCost}})
+	require.NoError(t, err)
+	require.Len(t, received, 1)
+	assert.Equal(t, "25.2", received[0].Total().String(), "custo informado na nota substitui o custo do pedido")
+	assert.Equal(t, StatusPartiallyReceived, p.Status)
+	assert.Equal(t, "4", p.Items[0].PendingQuantity().String())
+
+	received, err = p.Receive([]ReceiveLine{
+		{ItemID: flour, Quantity: decimal.NewFromInt(4)},
+		{ItemID: cheese, Quantity: decimal.NewFromInt(2)},
+	})
+	require.NoError(t, err)
+	assert.Len(t, received, 2)
+	assert.Equal(t, StatusReceived, p.Status)
+	assert.NotNil(t, p.ReceivedAt)
+
+	_, err = p.Receive([]ReceiveLine{{ItemID: flour, Quantity: decimal.NewFromInt(1)}})
+	assert.ErrorIs(t, err, ErrPurchaseOrderClosed)
+}
+
+func TestPurchaseOrder_ReceiveExceedsPending(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+
+	_, err := p.Receive([]ReceiveLine{{ItemID: p.Items[1].ID, Quantity: decimal.NewFromInt(3)}})
+	assert.ErrorIs(t, err, ErrReceivedQuantityExceeds)
+	assert.True(t, p.Items[1].ReceivedQuantity.IsZero())
+}
+
+func TestPurchaseOrder_ReceiveUnknownItemOrNothing(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+
+	_, err := p.Receive([]ReceiveLine{{ItemID: uuid.New(), Quantity: decimal.NewFromInt(1)}})
+	assert.ErrorIs(t, err, ErrItemNotFound)
+
+	_, err = p.Receive([]ReceiveLine{{ItemID: p.Items[0].ID, Quantity: decimal.Zero}})
+	assert.ErrorIs(t, err, ErrNothingToReceive)
+}
+
+func TestPurchaseOrder_CancelAfterReceiving(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+	_, err := p.Receive([]ReceiveLine{{ItemID: p.Items[0].ID, Quantity: decimal.NewFromInt(1)}})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, p.Cancel(), ErrPurchaseOrderHasReceipts)
+}
+
+func TestPurchaseOrder_Cancel(t *testing.T) {
+	p := newOrderedPurchaseOrder(t)
+	require.NoError(t, p.Cancel())
+	assert.Equal(t, StatusCancelled, p.Status)
+	assert.NotNil(t, p.CancelledAt)
+}
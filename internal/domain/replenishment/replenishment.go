@@ -0,0 +1,204 @@
+package replenishmententity
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrInvalidParameters = errors.New("period, coverage and safety days must not be negative")
+)
+
+const (
+	DefaultPeriodDays   = 30
+	DefaultCoverageDays = 7
+	DefaultSafetyDays   = 2
+)
+
+// Parameters define a janela de consumo analisada e quantos dias o pedido deve cobrir.
+// SafetyDays é a margem de segurança somada ao prazo do fornecedor no ponto de pedido.
+type Parameters struct {
+	PeriodDays   int
+	CoverageDays int
+	SafetyDays   int
+}
+
+// NewParameters aplica os padrões para valores zerados
+func NewParameters(periodDays, coverageDays, safetyDays int) (Parameters, error) {
+	if periodDays < 0 || coverageDays < 0 || safetyDays < 0 {
+		return Parameters{}, ErrInvalidParameters
+	}
+
+	if periodDays == 0 {
+		periodDays = DefaultPeriodDays
+	}
+
+	if coverageDays == 0 {
+		coverageDays = DefaultCoverageDays
+	}
+
+	if safetyDays == 0 {
+		safetyDays = DefaultSafetyDays
+	}
+
+	return Parameters{PeriodDays: periodDays, CoverageDays: coverageDays, SafetyDays: safetyDays}, nil
+}
+
+// StockInput reúne o estoque, o consumo do período e o fornecedor preferencial
+type StockInput struct {
+	StockID            uuid.UUID
+	ProductID          uuid.UUID
+	ProductVariationID *uuid.UUID
+	Description        string
+	Unit               string
+	CurrentStock       decimal.Decimal
+	MinStock           decimal.Decimal
+	MaxStock           decimal.Decimal
+	ConsumedQuantity   decimal.Decimal
+	SupplierID         *uuid.UUID
+	SupplierName       string
+	LeadTimeDays       int
+	UnitCost           decimal.Decimal
+}
+
+// ReorderSuggestion é a recomendação de compra de um estoque
+type ReorderSuggestion struct {
+	StockInput
+	AvgDailyConsumption decimal.Decimal
+	ReorderPoint        decimal.Decimal
+	TargetStock         decimal.Decimal
+	SuggestedQuantity   decimal.Decimal
+	NeedsReorder        bool
+}
+
+// SupplierGroup agrupa as sugestões que viram um pedido de compra em rascunho
+type SupplierGroup struct {
+	SupplierID   *uuid.UUID
+	SupplierName string
+	LeadTimeDays int
+	Items        []ReorderSuggestion
+}
+
+// Suggest calcula consumo médio diário, ponto de pedido e quantidade a comprar:
+// ponto de pedido = média × (prazo + segurança) e alvo = média × (prazo + cobertura).
+// Só há sugestão quando o estoque atual está no ponto de pedido ou abaixo dele.
+func Suggest(input StockInput, params Parameters) ReorderSuggestion {
+	suggestion := ReorderSuggestion{
+		StockInput:          input,
+		AvgDailyConsumption: decimal.Zero,
+		ReorderPoint:        decimal.Zero,
+		TargetStock:         decimal.Zero,
+		SuggestedQuantity:   decimal.Zero,
+	}
+
+	if params.PeriodDays <= 0 || !input.ConsumedQuantity.IsPositive() {
+		return suggestion
+	}
+
+	avg := input.ConsumedQuantity.Div(decimal.NewFromInt(int64(params.PeriodDays)))
+	suggestion.AvgDailyConsumption = avg.Round(3)
+	suggestion.ReorderPoint = roundQuantity(avg.Mul(decimal.NewFromInt(int64(input.LeadTimeDays+params.SafetyDays))), input.Unit)
+	suggestion.TargetStock = roundQuantity(avg.Mul(decimal.NewFromInt(int64(input.LeadTimeDays+params.CoverageDays))), input.Unit)
+
+	if suggestion.TargetStock.LessThan(suggestion.ReorderPoint) {
+		suggestion.TargetStock = suggestion.ReorderPoint
+	}
+
+	if input.CurrentStock.GreaterThan(suggestion.ReorderPoint) {
+		return suggestion
+	}
+
+	quantity := roundQuantity(suggestion.TargetStock.Sub(input.CurrentStock), input.Unit)
+	if quantity.IsPositive() {
+		suggestion.SuggestedQuantity = quantity
+		suggestion.NeedsReorder = true
+	}
+
+	return suggestion
+}
+
+// EstimatedCost valoriza a quantidade sugerida pelo último custo conhecido do fornecedor
+func (s ReorderSuggestion) EstimatedCost() decimal.Decimal {
+	return s.SuggestedQuantity.Mul(s.UnitCost).Round(2)
+}
+
+// StockLimits devolve os novos mínimo/máximo (ponto de pedido e alvo);
+// estoques sem consumo no período mantêm os limites atuais.
+func (s ReorderSuggestion) StockLimits() (minStock, maxStock decimal.Decimal, ok bool) {
+	if !s.AvgDailyConsumption.IsPositive() {
+		return s.MinStock, s.MaxStock, false
+	}
+
+	return s.ReorderPoint, s.TargetStock, true
+}
+
+// GroupBySupplier agrupa as sugestões que precisam de compra por fornecedor,
+// ordenado pelo nome; itens sem fornecedor conhecido ficam em um grupo no final.
+func GroupBySupplier(suggestions []ReorderSuggestion) []SupplierGroup {
+	groups := []SupplierGroup{}
+	indexBySupplier := map[uuid.UUID]int{}
+	unassigned := SupplierGroup{}
+
+	for _, suggestion := range suggestions {
+		if !suggestion.NeedsReorder {
+			continue
+		}
+
+		if suggestion.SupplierID == nil {
+			unassigned.Items = append(unassigned.Items, suggestion)
+			continue
+		}
+
+		index, ok := indexBySupplier[*suggestion.SupplierID]
+		if !ok {
+			index = len(groups)
+			indexBySupplier[*suggestion.SupplierID] = index
+			groups = append(groups, SupplierGroup{
+				SupplierID:   suggestion.SupplierID,
+				SupplierName: suggestion.SupplierName,
+				LeadTimeDays: suggestion.LeadTimeDays,
+			})
+		}
+
+		groups[index].Items = append(groups[index].Items, suggestion)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return strings.ToLower(groups[i].SupplierName) < strings.ToLower(groups[j].SupplierName)
+	})
+
+	if len(unassigned.Items) > 0 {
+		groups = append(groups, unassigned)
+	}
+
+	return groups
+}
+
+// TotalCost soma o custo estimado dos itens do grupo
+func (g SupplierGroup) TotalCost() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range g.Items {
+		total = total.Add(item.EstimatedCost())
+	}
+	return total
+}
+
+// roundQuantity arredonda para cima em unidades inteiras e para 3 casas nas demais unidades
+func roundQuantity(quantity decimal.Decimal, unit string) decimal.Decimal {
+	if isCountUnit(unit) {
+		return quantity.Ceil()
+	}
+	return quantity.RoundCeil(3)
+}
+
+func isCountUnit(unit string) bool {
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "", "un", "und", "unid", "unidade", "pc", "pç", "cx":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package replenishmententity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParameters_Defaults(t *testing.T) {
+	params, err := NewParameters(0, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, Parameters{PeriodDays: 30, CoverageDays: 7, SafetyDays: 2}, params)
+
+	_, err = NewParameters(-1, 0, 0)
+	assert.ErrorIs(t, err, ErrInvalidParameters)
+}
+
+func TestSuggest_BelowReorderPoint(t *testing.T) {
+	params, _ := NewParameters(30, 7, 2)
+
+	// 90 un em 30 dias = 3/dia; prazo 3 dias → ponto de pedido 15, alvo 30
+	suggestion := Suggest(StockInput{
+		StockID:          uuid.New(),
+		Unit:             "UN",
+		CurrentStock:     decimal.NewFromInt(10),
+		ConsumedQuantity: decimal.NewFromInt(90),
+		LeadTimeDays:     3,
+		UnitCost:         decimal.NewFromFloat(2.5),
+	}, params)
+
+	assert.True(t, suggestion.NeedsReorder)
+	assert.Equal(t, "3", suggestion.AvgDailyConsumption.String())
+	assert.Equal(t, "15", suggestion.ReorderPoint.String())
+	assert.Equal(t, "30", suggestion.TargetStock.String())
+	assert.Equal(t, "20", suggestion.SuggestedQuantity.String())
+	assert.Equal(t, "50", suggestion.EstimatedCost().String())
+
+	minStock, maxStock, ok := suggestion.StockLimits()
+	assert.True(t, ok)
+	assert.Equal(t, "15", minStock.String())
+	assert.Equal(t, "30", maxStock.String())
+}
+
+func TestSuggest_AboveReorderPointOrNoConsumption(t *testing.T) {
+	params, _ := NewParameters(30, 7, 2)
+
+	suggestion := Suggest(StockInput{Unit: "un", CurrentStock: decimal.NewFromInt(16), ConsumedQuantity: decimal.NewFromInt(90), LeadTimeDays: 3}, params)
+	assert.False(t, suggestion.NeedsReorder, "acima do ponto de pedido não deve sugerir compra")
+
+	suggestion = Suggest(StockInput{Unit: "un", CurrentStock: decimal.Zero, MinStock: decimal.NewFromInt(5)}, params)
+	assert.False(t, suggestion.NeedsReorder, "sem consumo não há sugestão")
+
+	_, _, ok := suggestion.StockLimits()
+	assert.False(t, ok, "sem consumo os limites são mantidos")
+}
+
+func TestSuggest_FractionalUnitRoundsUpToThreeDecimals(t *testing.T) {
+	params, _ := NewParameters(30, 7, 2)
+
+	suggestion := Suggest(StockInput{Unit: "kg", CurrentStock: decimal.Zero, ConsumedQuantity: decimal.NewFromInt(10)}, params)
+
+	assert.True(t, suggestion.NeedsReorder)
+	assert.Equal(t, "0.667", suggestion.ReorderPoint.String())
+	assert.Equal(t, "2.334", suggestion.TargetStock.String())
+}
+
+func TestGroupBySupplier(t *testing.T) {
+	params, _ := NewParameters(30, 7, 2)
+	supplierA, supplierB := uuid.New(), uuid.New()
+
+	inputs := []StockInput{
+		{Unit: "un", ConsumedQuantity: decimal.NewFromInt(30), SupplierID: &supplierB, SupplierName: "Bebidas Sul"},
+		{Unit: "un", ConsumedQuantity: decimal.NewFromInt(30)},
+		{Unit: "un", ConsumedQuantity: decimal.NewFromInt(30), SupplierID: &supplierA, SupplierName: "Atacado Norte"},
+		{Unit: "un", ConsumedQuantity: decimal.NewFromInt(30), SupplierID: &supplierB, SupplierName: "Bebidas Sul"},
+		{Unit: "un", CurrentStock: decimal.NewFromInt(100), ConsumedQuantity: decimal.NewFromInt(30), SupplierID: &supplierA},
+	}
+
+	suggestions := []ReorderSuggestion{}
+	for _, input := range inputs {
+		suggestions = append(suggestions, Suggest(input, params))
+	}
+
+	groups := GroupBySupplier(suggestions)
+	require.Len(t, groups, 3)
+	assert.Equal(t, "Atacado Norte", groups[0].SupplierName)
+	assert.Len(t, groups[0].Items, 1, "estoque acima do ponto de pedido fica fora do grupo")
+	assert.Equal(t, "Bebidas Sul", groups[1].SupplierName)
+	assert.Len(t, groups[1].Items, 2)
+	assert.Nil(t, groups[2].SupplierID, "itens sem fornecedor ficam no último grupo")
+}
@@ -0,0 +1,59 @@
+package reportsubscriptionentity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusSent   DeliveryStatus = "sent"
+	DeliveryStatusFailed DeliveryStatus = "failed"
+)
+
+// Delivery é o registro de cada envio de uma assinatura, com o período coberto e o erro quando falha.
+// "sent" significa que o email foi publicado na fila; o worker de email faz o envio SMTP.
+type Delivery struct {
+	entity.Entity
+	DeliveryCommonAttributes
+}
+
+type DeliveryCommonAttributes struct {
+	SubscriptionID uuid.UUID
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	Recipients     []string
+	Format         Format
+	Filename       string
+	Status         DeliveryStatus
+	Error          string
+	Manual         bool // disparado pelo usuário fora da cadência
+}
+
+func NewDelivery(subscription *ReportSubscription, periodStart, periodEnd time.Time, manual bool) *Delivery {
+	return &Delivery{
+		Entity: entity.NewEntity(),
+		DeliveryCommonAttributes: DeliveryCommonAttributes{
+			SubscriptionID: subscription.ID,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			Recipients:     subscription.Recipients,
+			Format:         subscription.Format,
+			Manual:         manual,
+		},
+	}
+}
+
+func (d *Delivery) Sent(filename string) {
+	d.Filename = filename
+	d.Status = DeliveryStatusSent
+	d.Error = ""
+}
+
+func (d *Delivery) Failed(err error) {
+	d.Status = DeliveryStatusFailed
+	d.Error = err.Error()
+}
@@ -0,0 +1,206 @@
+package reportsubscriptionentity
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrReportSubscriptionUserRequired       = errors.New("report subscription user is required")
+	ErrReportSubscriptionTypeRequired       = errors.New("report subscription report type is required")
+	ErrReportSubscriptionRecipientsRequired = errors.New("report subscription must have at least one recipient")
+	ErrReportSubscriptionRecipientInvalid   = errors.New("report subscription recipient email is invalid")
+	ErrReportSubscriptionFormatInvalid      = errors.New("report subscription format must be csv, xlsx or pdf")
+	ErrReportSubscriptionFrequencyInvalid   = errors.New("report subscription frequency must be daily, weekly or monthly")
+	ErrReportSubscriptionHourInvalid        = errors.New("report subscription hour must be between 0 and 23")
+	ErrReportSubscriptionWeekdayInvalid     = errors.New("report subscription weekday must be between 0 (sunday) and 6")
+	ErrReportSubscriptionDayOfMonthInvalid  = errors.New("report subscription day of month must be between 1 and 31")
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "daily"
+	FrequencyWeekly  Frequency = "weekly"
+	FrequencyMonthly Frequency = "monthly"
+)
+
+// Format é o formato do anexo enviado por email
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatPDF  Format = "pdf"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatCSV, FormatXLSX, FormatPDF:
+		return true
+	}
+	return false
+}
+
+// Cadence é o agendamento no estilo cron: todo dia, num dia da semana ou num dia do mês, sempre na hora cheia.
+// Cada envio cobre o período fechado anterior: o dia anterior, os últimos 7 dias ou o mês anterior.
+type Cadence struct {
+	Frequency  Frequency
+	Hour       int // 0 a 23
+	Weekday    int // semanal: 0 = domingo
+	DayOfMonth int // mensal: 1 a 31, usa o último dia nos meses mais curtos
+}
+
+func (c Cadence) Validate() error {
+	if c.Hour < 0 || c.Hour > 23 {
+		return ErrReportSubscriptionHourInvalid
+	}
+
+	switch c.Frequency {
+	case FrequencyDaily:
+	case FrequencyWeekly:
+		if c.Weekday < 0 || c.Weekday > 6 {
+			return ErrReportSubscriptionWeekdayInvalid
+		}
+	case FrequencyMonthly:
+		if c.DayOfMonth < 1 || c.DayOfMonth > 31 {
+			return ErrReportSubscriptionDayOfMonthInvalid
+		}
+	default:
+		return ErrReportSubscriptionFrequencyInvalid
+	}
+
+	return nil
+}
+
+// Next devolve o primeiro horário de envio estritamente depois de after, no fuso de after
+func (c Cadence) Next(after time.Time) time.Time {
+	year, month, day := after.Date()
+	loc := after.Location()
+
+	switch c.Frequency {
+	case FrequencyWeekly:
+		for i := 0; i <= 7; i++ {
+			candidate := time.Date(year, month, day+i, c.Hour, 0, 0, 0, loc)
+			if int(candidate.Weekday()) == c.Weekday && candidate.After(after) {
+				return candidate
+			}
+		}
+	case FrequencyMonthly:
+		for i := 0; i <= 1; i++ {
+			firstDay := time.Date(year, month+time.Month(i), 1, 0, 0, 0, 0, loc)
+			lastDay := firstDay.AddDate(0, 1, -1).Day()
+			candidate := time.Date(firstDay.Year(), firstDay.Month(), min(c.DayOfMonth, lastDay), c.Hour, 0, 0, 0, loc)
+			if candidate.After(after) {
+				return candidate
+			}
+		}
+	}
+
+	candidate := time.Date(year, month, day, c.Hour, 0, 0, 0, loc)
+	if !candidate.After(after) {
+		candidate = time.Date(year, month, day+1, c.Hour, 0, 0, 0, loc)
+	}
+	return candidate
+}
+
+// Period devolve o período fechado [start, end) coberto pelo envio agendado em runAt
+func (c Cadence) Period(runAt time.Time) (start, end time.Time) {
+	year, month, day := runAt.Date()
+	loc := runAt.Location()
+
+	switch c.Frequency {
+	case FrequencyWeekly:
+		end = time.Date(year, month, day, 0, 0, 0, 0, loc)
+		return end.AddDate(0, 0, -7), end
+	case FrequencyMonthly:
+		end = time.Date(year, month, 1, 0, 0, 0, 0, loc)
+		return end.AddDate(0, -1, 0), end
+	}
+
+	end = time.Date(year, month, day, 0, 0, 0, 0, loc)
+	return end.AddDate(0, 0, -1), end
+}
+
+// ReportSubscription envia um relatório por email para os destinatários na cadência escolhida pelo usuário
+type ReportSubscription struct {
+	entity.Entity
+	ReportSubscriptionCommonAttributes
+}
+
+type ReportSubscriptionCommonAttributes struct {
+	UserID     uuid.UUID
+	Name       string
+	ReportType string         // rota do relatório, ex.: daily-sales, top-products, payments-by-method
+	Params     map[string]any // filtros extras do relatório; o período vem da cadência
+	Cadence    Cadence
+	Recipients []string
+	Format     Format
+	IsActive   bool
+	NextRunAt  time.Time
+	LastRunAt  *time.Time
+}
+
+func NewReportSubscription(attributes ReportSubscriptionCommonAttributes, now time.Time) (*ReportSubscription, error) {
+	subscription := &ReportSubscription{
+		Entity:                             entity.NewEntity(),
+		ReportSubscriptionCommonAttributes: attributes,
+	}
+	subscription.IsActive = true
+
+	if err := subscription.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription.NextRunAt = subscription.Cadence.Next(now)
+	return subscription, nil
+}
+
+func (s *ReportSubscription) Validate() error {
+	if s.UserID == uuid.Nil {
+		return ErrReportSubscriptionUserRequired
+	}
+
+	if strings.TrimSpace(s.ReportType) == "" {
+		return ErrReportSubscriptionTypeRequired
+	}
+
+	if len(s.Recipients) == 0 {
+		return ErrReportSubscriptionRecipientsRequired
+	}
+
+	for i, recipient := range s.Recipients {
+		address, err := mail.ParseAddress(strings.TrimSpace(recipient))
+		if err != nil {
+			return ErrReportSubscriptionRecipientInvalid
+		}
+		s.Recipients[i] = address.Address
+	}
+
+	if !s.Format.IsValid() {
+		return ErrReportSubscriptionFormatInvalid
+	}
+
+	return s.Cadence.Validate()
+}
+
+// IsDue indica se o envio agendado já passou
+func (s *ReportSubscription) IsDue(now time.Time) bool {
+	return s.IsActive && !s.NextRunAt.After(now)
+}
+
+// Reschedule recalcula o próximo envio a partir de now, sem reenviar os períodos perdidos
+func (s *ReportSubscription) Reschedule(now time.Time) {
+	s.NextRunAt = s.Cadence.Next(now)
+}
+
+// MarkRun registra o envio e agenda o próximo
+func (s *ReportSubscription) MarkRun(now time.Time) {
+	s.LastRunAt = &now
+	s.Reschedule(now)
+}
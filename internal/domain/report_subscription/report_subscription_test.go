@@ -0,0 +1,85 @@
+package reportsubscriptionentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCadenceNext(t *testing.T) {
+	// 2026-10-19 é uma segunda-feira
+	now := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+
+	daily := Cadence{Frequency: FrequencyDaily, Hour: 7}
+	assert.Equal(t, time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC), daily.Next(now))
+
+	daily.Hour = 11
+	assert.Equal(t, time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC), daily.Next(now))
+
+	weekly := Cadence{Frequency: FrequencyWeekly, Hour: 8, Weekday: int(time.Monday)}
+	assert.Equal(t, time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC), weekly.Next(now))
+
+	weekly.Hour = 12
+	assert.Equal(t, time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC), weekly.Next(now))
+
+	monthly := Cadence{Frequency: FrequencyMonthly, Hour: 6, DayOfMonth: 31}
+	assert.Equal(t, time.Date(2026, 10, 31, 6, 0, 0, 0, time.UTC), monthly.Next(now))
+
+	// Novembro tem 30 dias: o envio do dia 31 cai no último dia do mês
+	assert.Equal(t, time.Date(2026, 11, 30, 6, 0, 0, 0, time.UTC), monthly.Next(time.Date(2026, 10, 31, 6, 0, 0, 0, time.UTC)))
+}
+
+func TestCadencePeriod(t *testing.T) {
+	runAt := time.Date(2026, 10, 1, 6, 0, 0, 0, time.UTC)
+
+	start, end := Cadence{Frequency: FrequencyDaily}.Period(runAt)
+	assert.Equal(t, time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = Cadence{Frequency: FrequencyWeekly}.Period(runAt)
+	assert.Equal(t, time.Date(2026, 9, 24, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), end)
+
+	start, end = Cadence{Frequency: FrequencyMonthly, DayOfMonth: 1}.Period(runAt)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), end)
+}
+
+func TestNewReportSubscription(t *testing.T) {
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	attributes := ReportSubscriptionCommonAttributes{
+		UserID:     uuid.New(),
+		ReportType: "daily-sales",
+		Cadence:    Cadence{Frequency: FrequencyDaily, Hour: 7},
+		Recipients: []string{" Dono <dono@loja.com> "},
+		Format:     FormatPDF,
+	}
+
+	subscription, err := NewReportSubscription(attributes, now)
+	require.NoError(t, err)
+	assert.True(t, subscription.IsActive)
+	assert.Equal(t, []string{"dono@loja.com"}, subscription.Recipients)
+	assert.Equal(t, time.Date(2026, 10, 20, 7, 0, 0, 0, time.UTC), subscription.NextRunAt)
+	assert.False(t, subscription.IsDue(now))
+	assert.True(t, subscription.IsDue(subscription.NextRunAt))
+
+	subscription.MarkRun(subscription.NextRunAt)
+	assert.Equal(t, time.Date(2026, 10, 21, 7, 0, 0, 0, time.UTC), subscription.NextRunAt)
+
+	attributes.Recipients = []string{"sem-arroba"}
+	_, err = NewReportSubscription(attributes, now)
+	assert.ErrorIs(t, err, ErrReportSubscriptionRecipientInvalid)
+
+	attributes.Recipients = []string{"dono@loja.com"}
+	attributes.Format = "json"
+	_, err = NewReportSubscription(attributes, now)
+	assert.ErrorIs(t, err, ErrReportSubscriptionFormatInvalid)
+
+	attributes.Format = FormatCSV
+	attributes.Cadence = Cadence{Frequency: FrequencyWeekly, Hour: 7, Weekday: 7}
+	_, err = NewReportSubscription(attributes, now)
+	assert.ErrorIs(t, err, ErrReportSubscriptionWeekdayInvalid)
+}
@@ -21,6 +21,8 @@ type StockBatchCommonAttributes struct {
 	CurrentQuantity    decimal.Decimal
 	CostPrice          decimal.Decimal
 	ExpiresAt          *time.Time
+	// LocationID é o local físico do lote; nil = local padrão
+	LocationID *uuid.UUID
 }
 
 func NewStockBatch(stockID uuid.UUID, variationID uuid.UUID, quantity, costPrice decimal.Decimal, expiresAt *time.Time) *StockBatch {
@@ -1,6 +1,8 @@
 package stockentity
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
@@ -13,12 +15,26 @@ type StockMovement struct {
 }
 
 type StockMovementCommonAttributes struct {
-	StockID    uuid.UUID
-	BatchID    *uuid.UUID // ID do lote associado (opcional)
-	Type       MovementType
-	Quantity   decimal.Decimal
-	Reason     string
-	OrderID    *uuid.UUID
+	StockID  uuid.UUID
+	BatchID  *uuid.UUID // ID do lote associado (opcional)
+	Type     MovementType
+	Quantity decimal.Decimal
+	Reason   string
+	OrderID  *uuid.UUID
+	// PurchaseOrderID liga entradas ao pedido de compra recebido (opcional)
+	PurchaseOrderID *uuid.UUID
+	// SupplierInvoiceID liga entradas à NF-e de compra importada (opcional)
+	SupplierInvoiceID *uuid.UUID
+	// InventoryCountID liga ajustes à sessão de inventário aprovada (opcional)
+	InventoryCountID *uuid.UUID
+	// StockLossID liga saídas ao registro de perda/desperdício (opcional)
+	StockLossID *uuid.UUID
+	// LocationID é o local de onde saiu/para onde entrou a quantidade (opcional)
+	LocationID *uuid.UUID
+	// TransferID liga os movimentos de transferência entre locais (opcional)
+	TransferID *uuid.UUID
+	// ExpiresAt é o vencimento da reserva; após ele a reserva pode ser liberada (apenas reserve)
+	ExpiresAt  *time.Time
 	EmployeeID uuid.UUID
 	Price      decimal.Decimal // Entrada: Custo do lote | Saída: Preço de venda
 }
@@ -27,12 +43,14 @@ type StockMovementCommonAttributes struct {
 type MovementType string
 
 const (
-	MovementTypeIn        MovementType = "in"         // Entrada de estoque
-	MovementTypeOut       MovementType = "out"        // Saída de estoque
-	MovementTypeAdjustIn  MovementType = "adjust_in"  // Ajuste manual
-	MovementTypeAdjustOut MovementType = "adjust_out" // Ajuste manual
-	MovementTypeReserve   MovementType = "reserve"    // Reserva para pedido
-	MovementTypeRestore   MovementType = "restore"    // Restauração de reserva
+	MovementTypeIn          MovementType = "in"           // Entrada de estoque
+	MovementTypeOut         MovementType = "out"          // Saída de estoque
+	MovementTypeAdjustIn    MovementType = "adjust_in"    // Ajuste manual
+	MovementTypeAdjustOut   MovementType = "adjust_out"   // Ajuste manual
+	MovementTypeReserve     MovementType = "reserve"      // Reserva para pedido
+	MovementTypeRestore     MovementType = "restore"      // Restauração de reserva
+	MovementTypeTransferOut MovementType = "transfer_out" // Saída do local de origem
+	MovementTypeTransferIn  MovementType = "transfer_in"  // Entrada no local de destino (ou retorno)
 )
 
 func NewStockMovement(stockID uuid.UUID, quantity decimal.Decimal, reason string, employeeID uuid.UUID, price decimal.Decimal) *StockMovement {
@@ -0,0 +1,76 @@
+package stockentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+// DefaultReservationMinutes é a validade da reserva quando a empresa não configura stock_reservation_minutes
+const DefaultReservationMinutes = 120
+
+var ErrNoReservationToRelease = errors.New("no reserved stock to release")
+
+// ReservationExpiresAt calcula o vencimento de uma reserva feita em from
+func ReservationExpiresAt(from time.Time, minutes int) time.Time {
+	if minutes <= 0 {
+		minutes = DefaultReservationMinutes
+	}
+
+	return from.Add(time.Duration(minutes) * time.Minute)
+}
+
+// OpenReservation soma o que um pedido ainda mantém reservado em um estoque:
+// reservas menos restaurações e saídas do próprio pedido, nunca abaixo de zero.
+func OpenReservation(movements []StockMovement, stockID uuid.UUID) decimal.Decimal {
+	open := decimal.Zero
+	for _, m := range movements {
+		if m.StockID != stockID {
+			continue
+		}
+
+		switch m.Type {
+		case MovementTypeReserve:
+			open = open.Add(m.Quantity)
+		case MovementTypeRestore, MovementTypeOut:
+			open = open.Sub(m.Quantity)
+		}
+	}
+
+	if open.IsNegative() {
+		return decimal.Zero
+	}
+
+	return open
+}
+
+// ReleaseReservation devolve ao disponível uma reserva vencida do pedido.
+// A quantidade é limitada ao que está reservado no estoque.
+func (s *Stock) ReleaseReservation(quantity decimal.Decimal, orderID uuid.UUID, employeeID uuid.UUID) (*StockMovement, error) {
+	if quantity.GreaterThan(s.ReservedStock) {
+		quantity = s.ReservedStock
+	}
+
+	if quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrNoReservationToRelease
+	}
+
+	s.ReservedStock = s.ReservedStock.Sub(quantity)
+	s.CurrentStock = s.CurrentStock.Add(quantity)
+
+	return &StockMovement{
+		Entity: entity.NewEntity(),
+		StockMovementCommonAttributes: StockMovementCommonAttributes{
+			StockID:    s.ID,
+			Type:       MovementTypeRestore,
+			Quantity:   quantity,
+			Reason:     "Reserva expirada",
+			OrderID:    &orderID,
+			EmployeeID: employeeID,
+			Price:      decimal.Zero,
+		},
+	}, nil
+}
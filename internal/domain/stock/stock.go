@@ -2,6 +2,8 @@ package stockentity
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -32,6 +34,8 @@ type StockCommonAttributes struct {
 	MaxStock           decimal.Decimal
 	Unit               string
 	IsActive           bool
+	// AutoAvailability liga a disponibilidade da variação ao saldo (opt-in por estoque)
+	AutoAvailability bool
 }
 
 // NewStock cria um novo estoque
@@ -51,6 +55,66 @@ func NewStock(productID uuid.UUID, productVariationID *uuid.UUID, initialStock,
 	}
 }
 
+// AvailableStock é o saldo livre para venda. As reservas já são descontadas
+// do CurrentStock em ReserveStock, por isso ReservedStock não entra na conta.
+func (s *Stock) AvailableStock() decimal.Decimal {
+	return s.CurrentStock
+}
+
+// PhysicalStock é o que está na prateleira: o saldo livre mais o reservado para pedidos ainda não baixados
+func (s *Stock) PhysicalStock() decimal.Decimal {
+	return s.CurrentStock.Add(s.ReservedStock)
+}
+
+// Description é o nome exibido em listas de compra e contagem: produto e, para variações, o tamanho
+func (s *Stock) Description() string {
+	if s.ProductVariationID == nil {
+		return s.Product.Name
+	}
+
+	for _, variation := range s.Product.Variations {
+		if variation.ID == *s.ProductVariationID && variation.Size != nil {
+			return fmt.Sprintf("%s - %s", s.Product.Name, variation.Size.Name)
+		}
+	}
+
+	return s.Product.Name
+}
+
+// VariationAvailability indica se a variação ligada ao estoque deve estar disponível.
+// managed é false quando o estoque não controla a disponibilidade (opção desligada ou controle inativo).
+func (s *Stock) VariationAvailability() (available bool, managed bool) {
+	if !s.AutoAvailability || !s.IsActive {
+		return false, false
+	}
+
+	return s.AvailableStock().IsPositive(), true
+}
+
+// ApplyVariationAvailability ajusta a variação ao saldo e retorna true quando ela mudou.
+// Saldo zerado desliga só variações disponíveis, marcando AutoUnavailable; reposição ou
+// opção desligada religam apenas as marcadas, sem mexer no que foi desligado à mão.
+func (s *Stock) ApplyVariationAvailability(variation *productentity.ProductVariation) bool {
+	available, managed := s.VariationAvailability()
+	if managed && !available {
+		if !variation.IsAvailable {
+			return false
+		}
+
+		variation.IsAvailable = false
+		variation.AutoUnavailable = true
+		return true
+	}
+
+	if !variation.AutoUnavailable {
+		return false
+	}
+
+	variation.IsAvailable = true
+	variation.AutoUnavailable = false
+	return true
+}
+
 // AddMovementStock adiciona estoque manualmente
 func (s *Stock) AddMovementStock(quantity decimal.Decimal, reason string, employeeID uuid.UUID, price decimal.Decimal) (*StockMovement, error) {
 	if quantity.LessThanOrEqual(decimal.Zero) {
@@ -78,6 +142,169 @@ func (s *Stock) AddMovementStock(quantity decimal.Decimal, reason string, employ
 	return movement, nil
 }
 
+// BatchEntry descreve a entrada de um lote vindo de uma compra (pedido de compra, NF-e do fornecedor)
+type BatchEntry struct {
+	Quantity          decimal.Decimal
+	CostPrice         decimal.Decimal
+	ExpiresAt         *time.Time
+	Reason            string
+	EmployeeID        uuid.UUID
+	PurchaseOrderID   *uuid.UUID
+	SupplierInvoiceID *uuid.UUID
+}
+
+// ReceiveBatch cria o lote e o movimento de entrada ligado a ele, somando ao estoque atual
+func (s *Stock) ReceiveBatch(entry BatchEntry) (*StockBatch, *StockMovement, error) {
+	movement, err := s.AddMovementStock(entry.Quantity, entry.Reason, entry.EmployeeID, entry.CostPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var variationID uuid.UUID
+	if s.ProductVariationID != nil {
+		variationID = *s.ProductVariationID
+	}
+
+	batch := NewStockBatch(s.ID, variationID, entry.Quantity, entry.CostPrice, entry.ExpiresAt)
+	movement.BatchID = &batch.ID
+	movement.PurchaseOrderID = entry.PurchaseOrderID
+	movement.SupplierInvoiceID = entry.SupplierInvoiceID
+
+	return batch, movement, nil
+}
+
+// CountAdjustment descreve a diferença apurada em um inventário para um estoque/lote
+type CountAdjustment struct {
+	Variance         decimal.Decimal // contado - esperado
+	CostPrice        decimal.Decimal
+	Reason           string
+	EmployeeID       uuid.UUID
+	InventoryCountID uuid.UUID
+}
+
+// PostCountAdjustment lança a diferença de inventário como adjust_in/adjust_out.
+// A diferença é aplicada sobre o saldo atual (e não substitui o saldo), preservando
+// as vendas feitas entre a abertura e a aprovação da contagem. Sem diferença, não há movimento.
+func (s *Stock) PostCountAdjustment(batch *StockBatch, adjustment CountAdjustment) (*StockMovement, error) {
+	if adjustment.Variance.IsZero() {
+		return nil, nil
+	}
+
+	if !s.IsActive {
+		return nil, errors.New("stock control is not active")
+	}
+
+	quantity := adjustment.Variance.Abs()
+	movementType := MovementTypeAdjustIn
+	if adjustment.Variance.IsNegative() {
+		movementType = MovementTypeAdjustOut
+		s.CurrentStock = s.CurrentStock.Sub(quantity)
+	} else {
+		s.CurrentStock = s.CurrentStock.Add(quantity)
+	}
+
+	movement := &StockMovement{
+		Entity: entity.NewEntity(),
+		StockMovementCommonAttributes: StockMovementCommonAttributes{
+			StockID:          s.ID,
+			Type:             movementType,
+			Quantity:         quantity,
+			Reason:           adjustment.Reason,
+			EmployeeID:       adjustment.EmployeeID,
+			Price:            adjustment.CostPrice,
+			InventoryCountID: &adjustment.InventoryCountID,
+		},
+	}
+
+	if batch == nil {
+		return movement, nil
+	}
+
+	movement.BatchID = &batch.ID
+	if movementType == MovementTypeAdjustIn {
+		batch.CurrentQuantity = batch.CurrentQuantity.Add(quantity)
+	} else {
+		// O lote pode ter sido consumido por vendas depois da abertura; não deixa saldo negativo
+		batch.CurrentQuantity = decimal.Max(decimal.Zero, batch.CurrentQuantity.Sub(quantity))
+	}
+
+	return movement, nil
+}
+
+// LossEntry descreve a baixa de uma perda registrada (vencido, estragado, furto...)
+type LossEntry struct {
+	Quantity    decimal.Decimal
+	Reason      string
+	EmployeeID  uuid.UUID
+	StockLossID uuid.UUID
+	// BatchID restringe a baixa ao lote informado; sem ele a baixa segue FIFO
+	BatchID *uuid.UUID
+}
+
+// RegisterLoss baixa a perda dos lotes recebidos (na ordem), gerando um movimento de saída
+// por lote valorizado a custo. Sem lote definido, o que faltar vira saída sem lote com custo zero,
+// como no débito FIFO; com lote definido, a quantidade precisa caber no lote.
+func (s *Stock) RegisterLoss(batches []*StockBatch, entry LossEntry) ([]*StockMovement, error) {
+	if entry.Quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidQuantity
+	}
+
+	if !s.IsActive {
+		return nil, errors.New("stock control is not active")
+	}
+
+	if entry.BatchID != nil {
+		available := decimal.Zero
+		for _, batch := range batches {
+			available = available.Add(batch.CurrentQuantity)
+		}
+
+		if available.LessThan(entry.Quantity) {
+			return nil, ErrInsufficientStock
+		}
+	}
+
+	newMovement := func(batchID *uuid.UUID, quantity, price decimal.Decimal) *StockMovement {
+		return &StockMovement{
+			Entity: entity.NewEntity(),
+			StockMovementCommonAttributes: StockMovementCommonAttributes{
+				StockID:     s.ID,
+				BatchID:     batchID,
+				Type:        MovementTypeOut,
+				Quantity:    quantity,
+				Reason:      entry.Reason,
+				EmployeeID:  entry.EmployeeID,
+				Price:       price,
+				StockLossID: &entry.StockLossID,
+			},
+		}
+	}
+
+	movements := []*StockMovement{}
+	remaining := entry.Quantity
+	for _, batch := range batches {
+		if remaining.LessThanOrEqual(decimal.Zero) {
+			break
+		}
+
+		if !batch.HasStock() {
+			continue
+		}
+
+		consume := decimal.Min(remaining, batch.CurrentQuantity)
+		batch.CurrentQuantity = batch.CurrentQuantity.Sub(consume)
+		remaining = remaining.Sub(consume)
+		movements = append(movements, newMovement(&batch.ID, consume, batch.CostPrice))
+	}
+
+	if remaining.GreaterThan(decimal.Zero) {
+		movements = append(movements, newMovement(nil, remaining, decimal.Zero))
+	}
+
+	s.CurrentStock = s.CurrentStock.Sub(entry.Quantity)
+	return movements, nil
+}
+
 // RemoveMovementStock remove estoque manualmente (sem lote específico, o serviço deve lidar com a distribuição)
 func (s *Stock) RemoveMovementStock(quantity decimal.Decimal, reason string, employeeID uuid.UUID, price decimal.Decimal) (*StockMovement, error) {
 	if quantity.LessThanOrEqual(decimal.Zero) {
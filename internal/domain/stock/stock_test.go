@@ -0,0 +1,37 @@
+package stockentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStock_PhysicalStockKeepsReservedUnits(t *testing.T) {
+	stock := NewStock(uuid.New(), nil, decimal.NewFromInt(10), decimal.Zero, decimal.Zero, "UN")
+
+	_, err := stock.ReserveStock(decimal.NewFromInt(3), uuid.New(), uuid.New(), decimal.NewFromInt(5))
+	require.NoError(t, err)
+
+	assert.Equal(t, "7", stock.AvailableStock().String())
+	assert.Equal(t, "10", stock.PhysicalStock().String(), "a reserva ainda está na prateleira")
+
+	// contagem física igual ao esperado não gera ajuste
+	counted := decimal.NewFromInt(10)
+	movement, err := stock.PostCountAdjustment(nil, CountAdjustment{Variance: counted.Sub(stock.PhysicalStock())})
+	require.NoError(t, err)
+	assert.Nil(t, movement)
+	assert.Equal(t, "7", stock.CurrentStock.String())
+
+	// falta de 2 unidades baixa só a diferença, sem mexer na reserva
+	counted = decimal.NewFromInt(8)
+	movement, err = stock.PostCountAdjustment(nil, CountAdjustment{Variance: counted.Sub(stock.PhysicalStock())})
+	require.NoError(t, err)
+	require.NotNil(t, movement)
+	assert.Equal(t, MovementTypeAdjustOut, movement.Type)
+	assert.Equal(t, "2", movement.Quantity.String())
+	assert.Equal(t, "5", stock.CurrentStock.String())
+	assert.Equal(t, "3", stock.ReservedStock.String())
+}
@@ -0,0 +1,124 @@
+package stockentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+// TransferEntry descreve a saída de uma transferência entre locais
+type TransferEntry struct {
+	TransferID     uuid.UUID
+	FromLocationID uuid.UUID
+	Quantity       decimal.Decimal
+	EmployeeID     uuid.UUID
+}
+
+// TransferReceipt descreve a entrada de uma parte da transferência em um local
+type TransferReceipt struct {
+	TransferID uuid.UUID
+	LocationID uuid.UUID
+	Quantity   decimal.Decimal
+	CostPrice  decimal.Decimal
+	ExpiresAt  *time.Time
+	EmployeeID uuid.UUID
+}
+
+// DispatchTransfer retira a quantidade dos lotes do local de origem (na ordem recebida).
+// Enquanto em trânsito a quantidade não está disponível para venda, por isso sai do CurrentStock.
+// Diferente do débito de venda, a transferência não aceita saldo negativo.
+func (s *Stock) DispatchTransfer(batches []*StockBatch, entry TransferEntry) ([]*StockMovement, error) {
+	if entry.Quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidQuantity
+	}
+
+	if !s.IsActive {
+		return nil, errors.New("stock control is not active")
+	}
+
+	available := decimal.Zero
+	for _, batch := range batches {
+		available = available.Add(batch.CurrentQuantity)
+	}
+
+	if available.LessThan(entry.Quantity) {
+		return nil, ErrInsufficientStock
+	}
+
+	movements := []*StockMovement{}
+	remaining := entry.Quantity
+	for _, batch := range batches {
+		if remaining.LessThanOrEqual(decimal.Zero) {
+			break
+		}
+
+		if !batch.HasStock() {
+			continue
+		}
+
+		consume := decimal.Min(remaining, batch.CurrentQuantity)
+		batch.CurrentQuantity = batch.CurrentQuantity.Sub(consume)
+		remaining = remaining.Sub(consume)
+
+		movements = append(movements, &StockMovement{
+			Entity: entity.NewEntity(),
+			StockMovementCommonAttributes: StockMovementCommonAttributes{
+				StockID:    s.ID,
+				BatchID:    &batch.ID,
+				Type:       MovementTypeTransferOut,
+				Quantity:   consume,
+				Reason:     "Transferência entre locais",
+				LocationID: &entry.FromLocationID,
+				TransferID: &entry.TransferID,
+				EmployeeID: entry.EmployeeID,
+				Price:      batch.CostPrice,
+			},
+		})
+	}
+
+	s.CurrentStock = s.CurrentStock.Sub(entry.Quantity)
+	return movements, nil
+}
+
+// ReceiveTransfer dá entrada de uma parte da transferência. Com returnTo (cancelamento)
+// a quantidade volta ao lote de origem; sem ele é criado um lote no local com o mesmo custo e validade.
+func (s *Stock) ReceiveTransfer(receipt TransferReceipt, returnTo *StockBatch) (*StockBatch, *StockMovement, error) {
+	if receipt.Quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, nil, ErrInvalidQuantity
+	}
+
+	batch := returnTo
+	if batch != nil {
+		batch.CurrentQuantity = batch.CurrentQuantity.Add(receipt.Quantity)
+	} else {
+		variationID := uuid.Nil
+		if s.ProductVariationID != nil {
+			variationID = *s.ProductVariationID
+		}
+
+		batch = NewStockBatch(s.ID, variationID, receipt.Quantity, receipt.CostPrice, receipt.ExpiresAt)
+		batch.LocationID = &receipt.LocationID
+	}
+
+	s.CurrentStock = s.CurrentStock.Add(receipt.Quantity)
+
+	movement := &StockMovement{
+		Entity: entity.NewEntity(),
+		StockMovementCommonAttributes: StockMovementCommonAttributes{
+			StockID:    s.ID,
+			BatchID:    &batch.ID,
+			Type:       MovementTypeTransferIn,
+			Quantity:   receipt.Quantity,
+			Reason:     "Transferência entre locais",
+			LocationID: &receipt.LocationID,
+			TransferID: &receipt.TransferID,
+			EmployeeID: receipt.EmployeeID,
+			Price:      receipt.CostPrice,
+		},
+	}
+
+	return batch, movement, nil
+}
@@ -0,0 +1,76 @@
+package stocklocationentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+func newLocation(name string, isDefault bool) StockLocation {
+	return StockLocation{
+		Entity:                        entity.NewEntity(),
+		StockLocationCommonAttributes: StockLocationCommonAttributes{Name: name, IsDefault: isDefault, IsActive: true},
+	}
+}
+
+func TestResolveLocation_Priority(t *testing.T) {
+	categoryID, grillRule, barRule := uuid.New(), uuid.New(), uuid.New()
+
+	warehouse := newLocation("Depósito", true)
+	kitchen := newLocation("Cozinha", false)
+	kitchen.CategoryIDs = []uuid.UUID{categoryID}
+	bar := newLocation("Bar", false)
+	bar.ProcessRuleIDs = []uuid.UUID{barRule}
+	locations := []StockLocation{warehouse, kitchen, bar}
+
+	assert.Equal(t, bar.ID, ResolveLocation(locations, categoryID, []uuid.UUID{grillRule, barRule}).ID, "regra de processo tem prioridade sobre a categoria")
+	assert.Equal(t, kitchen.ID, ResolveLocation(locations, categoryID, []uuid.UUID{grillRule}).ID)
+	assert.Equal(t, warehouse.ID, ResolveLocation(locations, uuid.New(), nil).ID, "sem mapeamento usa o local padrão")
+	assert.Nil(t, ResolveLocation(nil, categoryID, nil), "sem locais o débito não filtra lotes")
+}
+
+func TestResolveLocation_IgnoresInactive(t *testing.T) {
+	categoryID := uuid.New()
+	warehouse := newLocation("Depósito", true)
+	kitchen := newLocation("Cozinha", false)
+	kitchen.CategoryIDs = []uuid.UUID{categoryID}
+	kitchen.IsActive = false
+
+	assert.Equal(t, warehouse.ID, ResolveLocation([]StockLocation{warehouse, kitchen}, categoryID, nil).ID)
+}
+
+func TestHolds_BatchWithoutLocationBelongsToDefault(t *testing.T) {
+	warehouse := newLocation("Depósito", true)
+	bar := newLocation("Bar", false)
+
+	assert.True(t, warehouse.Holds(nil))
+	assert.False(t, bar.Holds(nil))
+	assert.True(t, bar.Holds(&bar.ID))
+	assert.False(t, warehouse.Holds(&bar.ID))
+}
+
+func TestStockTransfer_Lifecycle(t *testing.T) {
+	from, to := uuid.New(), uuid.New()
+
+	_, err := NewStockTransfer(uuid.New(), from, from, decimal.NewFromInt(1), uuid.New(), "")
+	assert.ErrorIs(t, err, ErrSameLocation)
+
+	_, err = NewStockTransfer(uuid.New(), from, to, decimal.Zero, uuid.New(), "")
+	assert.ErrorIs(t, err, ErrInvalidTransferQuantity)
+
+	transfer, err := NewStockTransfer(uuid.New(), from, to, decimal.NewFromInt(5), uuid.New(), "")
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusInTransit, transfer.Status)
+
+	transfer.AddLine(nil, decimal.NewFromInt(2), decimal.NewFromFloat(3.5), nil)
+	transfer.AddLine(nil, decimal.NewFromInt(3), decimal.NewFromInt(4), nil)
+	assert.Equal(t, "19", transfer.TotalCost().String())
+
+	require.NoError(t, transfer.Receive(uuid.New()))
+	assert.Equal(t, TransferStatusReceived, transfer.Status)
+	assert.ErrorIs(t, transfer.Cancel(), ErrTransferNotInTransit, "transferência recebida não pode ser cancelada")
+}
@@ -0,0 +1,134 @@
+package stocklocationentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrSameLocation             = errors.New("source and destination locations must be different")
+	ErrInvalidTransferQuantity  = errors.New("transfer quantity must be greater than zero")
+	ErrTransferNotInTransit     = errors.New("stock transfer is not in transit")
+	ErrTransferLocationRequired = errors.New("source and destination locations are required")
+)
+
+type StockTransferStatus string
+
+const (
+	TransferStatusInTransit StockTransferStatus = "in_transit"
+	TransferStatusReceived  StockTransferStatus = "received"
+	TransferStatusCancelled StockTransferStatus = "cancelled"
+)
+
+// StockTransfer move um estoque entre locais. Ao despachar, a quantidade sai dos lotes
+// de origem e fica em trânsito; no recebimento vira lotes no destino com o mesmo custo
+// e validade; no cancelamento volta para os lotes de origem.
+type StockTransfer struct {
+	entity.Entity
+	StockTransferCommonAttributes
+	StockTransferTimeLogs
+}
+
+type StockTransferCommonAttributes struct {
+	StockID        uuid.UUID
+	FromLocationID uuid.UUID
+	ToLocationID   uuid.UUID
+	Quantity       decimal.Decimal
+	Status         StockTransferStatus
+	Notes          string
+	SentBy         uuid.UUID
+	ReceivedBy     *uuid.UUID
+	Lines          []StockTransferLine
+}
+
+type StockTransferTimeLogs struct {
+	SentAt      time.Time
+	ReceivedAt  *time.Time
+	CancelledAt *time.Time
+}
+
+// StockTransferLine é a parte da transferência que saiu de um lote de origem
+type StockTransferLine struct {
+	entity.Entity
+	TransferID         uuid.UUID
+	SourceBatchID      *uuid.UUID
+	DestinationBatchID *uuid.UUID
+	Quantity           decimal.Decimal
+	CostPrice          decimal.Decimal
+	ExpiresAt          *time.Time
+}
+
+func NewStockTransfer(stockID, fromLocationID, toLocationID uuid.UUID, quantity decimal.Decimal, sentBy uuid.UUID, notes string) (*StockTransfer, error) {
+	if fromLocationID == uuid.Nil || toLocationID == uuid.Nil {
+		return nil, ErrTransferLocationRequired
+	}
+
+	if fromLocationID == toLocationID {
+		return nil, ErrSameLocation
+	}
+
+	if !quantity.IsPositive() {
+		return nil, ErrInvalidTransferQuantity
+	}
+
+	return &StockTransfer{
+		Entity: entity.NewEntity(),
+		StockTransferCommonAttributes: StockTransferCommonAttributes{
+			StockID:        stockID,
+			FromLocationID: fromLocationID,
+			ToLocationID:   toLocationID,
+			Quantity:       quantity,
+			Status:         TransferStatusInTransit,
+			Notes:          notes,
+			SentBy:         sentBy,
+		},
+		StockTransferTimeLogs: StockTransferTimeLogs{SentAt: time.Now().UTC()},
+	}, nil
+}
+
+func (t *StockTransfer) AddLine(sourceBatchID *uuid.UUID, quantity, costPrice decimal.Decimal, expiresAt *time.Time) {
+	t.Lines = append(t.Lines, StockTransferLine{
+		Entity:        entity.NewEntity(),
+		TransferID:    t.ID,
+		SourceBatchID: sourceBatchID,
+		Quantity:      quantity,
+		CostPrice:     costPrice,
+		ExpiresAt:     expiresAt,
+	})
+}
+
+func (t *StockTransfer) Receive(receivedBy uuid.UUID) error {
+	if t.Status != TransferStatusInTransit {
+		return ErrTransferNotInTransit
+	}
+
+	now := time.Now().UTC()
+	t.Status = TransferStatusReceived
+	t.ReceivedBy = &receivedBy
+	t.ReceivedAt = &now
+	return nil
+}
+
+func (t *StockTransfer) Cancel() error {
+	if t.Status != TransferStatusInTransit {
+		return ErrTransferNotInTransit
+	}
+
+	now := time.Now().UTC()
+	t.Status = TransferStatusCancelled
+	t.CancelledAt = &now
+	return nil
+}
+
+// TotalCost valoriza a transferência pelo custo dos lotes de origem
+func (t *StockTransfer) TotalCost() decimal.Decimal {
+	total := decimal.Zero
+	for _, line := range t.Lines {
+		total = total.Add(line.Quantity.Mul(line.CostPrice))
+	}
+	return total.Round(2)
+}
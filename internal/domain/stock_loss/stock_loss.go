@@ -0,0 +1,112 @@
+package stocklossentity
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrInvalidLossReason   = errors.New("invalid loss reason")
+	ErrInvalidLossQuantity = errors.New("loss quantity must be greater than zero")
+	ErrStockRequired       = errors.New("stock is required")
+	ErrMultipleLossSources = errors.New("loss can be linked to an order process or a group item, not both")
+)
+
+// LossReason é a taxonomia fixa de perdas, usada para separar desperdício de furto e consumo interno
+type LossReason string
+
+const (
+	LossReasonExpired   LossReason = "expired"
+	LossReasonSpoiled   LossReason = "spoiled"
+	LossReasonDropped   LossReason = "dropped"
+	LossReasonStaffMeal LossReason = "staff_meal"
+	LossReasonCourtesy  LossReason = "courtesy"
+	LossReasonTheft     LossReason = "theft"
+)
+
+var lossReasonLabels = map[LossReason]string{
+	LossReasonExpired:   "Vencido",
+	LossReasonSpoiled:   "Estragado",
+	LossReasonDropped:   "Queda/Quebra",
+	LossReasonStaffMeal: "Refeição de funcionário",
+	LossReasonCourtesy:  "Cortesia",
+	LossReasonTheft:     "Furto",
+}
+
+func GetAllLossReasons() []LossReason {
+	return []LossReason{
+		LossReasonExpired,
+		LossReasonSpoiled,
+		LossReasonDropped,
+		LossReasonStaffMeal,
+		LossReasonCourtesy,
+		LossReasonTheft,
+	}
+}
+
+func (r LossReason) IsValid() bool {
+	_, ok := lossReasonLabels[r]
+	return ok
+}
+
+func (r LossReason) Label() string {
+	return lossReasonLabels[r]
+}
+
+// StockLoss registra uma perda de estoque. O custo é o dos lotes efetivamente baixados.
+type StockLoss struct {
+	entity.Entity
+	StockLossCommonAttributes
+}
+
+type StockLossCommonAttributes struct {
+	StockID            uuid.UUID
+	ProductID          uuid.UUID
+	ProductVariationID *uuid.UUID
+	BatchID            *uuid.UUID
+	Reason             LossReason
+	Quantity           decimal.Decimal
+	TotalCost          decimal.Decimal
+	EmployeeID         uuid.UUID
+	OrderProcessID     *uuid.UUID
+	GroupItemID        *uuid.UUID
+	// SourceReason copia o motivo de cancelamento do processo/item de origem
+	SourceReason string
+	Notes        string
+}
+
+func NewStockLoss(attributes StockLossCommonAttributes) (*StockLoss, error) {
+	if attributes.StockID == uuid.Nil {
+		return nil, ErrStockRequired
+	}
+
+	if !attributes.Reason.IsValid() {
+		return nil, ErrInvalidLossReason
+	}
+
+	if attributes.Quantity.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidLossQuantity
+	}
+
+	if attributes.OrderProcessID != nil && attributes.GroupItemID != nil {
+		return nil, ErrMultipleLossSources
+	}
+
+	attributes.TotalCost = decimal.Zero
+
+	return &StockLoss{
+		Entity:                    entity.NewEntity(),
+		StockLossCommonAttributes: attributes,
+	}, nil
+}
+
+// UnitCost é o custo médio das unidades perdidas
+func (l *StockLoss) UnitCost() decimal.Decimal {
+	if l.Quantity.IsZero() {
+		return decimal.Zero
+	}
+	return l.TotalCost.Div(l.Quantity).Round(2)
+}
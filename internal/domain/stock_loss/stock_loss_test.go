@@ -0,0 +1,31 @@
+package stocklossentity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStockLoss_Validation(t *testing.T) {
+	_, err := NewStockLoss(StockLossCommonAttributes{StockID: uuid.New(), Reason: "lost", Quantity: decimal.NewFromInt(1)})
+	assert.ErrorIs(t, err, ErrInvalidLossReason)
+
+	_, err = NewStockLoss(StockLossCommonAttributes{StockID: uuid.New(), Reason: LossReasonSpoiled, Quantity: decimal.Zero})
+	assert.ErrorIs(t, err, ErrInvalidLossQuantity)
+
+	processID, groupItemID := uuid.New(), uuid.New()
+	_, err = NewStockLoss(StockLossCommonAttributes{StockID: uuid.New(), Reason: LossReasonDropped, Quantity: decimal.NewFromInt(1), OrderProcessID: &processID, GroupItemID: &groupItemID})
+	assert.ErrorIs(t, err, ErrMultipleLossSources)
+}
+
+func TestStockLoss_UnitCost(t *testing.T) {
+	loss, err := NewStockLoss(StockLossCommonAttributes{StockID: uuid.New(), Reason: LossReasonExpired, Quantity: decimal.NewFromInt(3)})
+	require.NoError(t, err)
+
+	loss.TotalCost = decimal.NewFromInt(10)
+	assert.Equal(t, "3.33", loss.UnitCost().String())
+	assert.Equal(t, "Vencido", loss.Reason.Label())
+}
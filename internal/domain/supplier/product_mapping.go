@@ -0,0 +1,53 @@
+package supplierentity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrSupplierCodeRequired = errors.New("supplier product code is required")
+	ErrMappingStockRequired = errors.New("stock is required for supplier product mapping")
+	ErrInvalidMappingFactor = errors.New("conversion factor must be greater than zero")
+)
+
+// SupplierProductMapping lembra qual estoque recebe o código de produto (cProd) de um fornecedor.
+// Factor converte a unidade do fornecedor para a unidade do estoque (ex.: caixa com 12 = 12).
+type SupplierProductMapping struct {
+	entity.Entity
+	SupplierID   uuid.UUID
+	SupplierCode string
+	StockID      uuid.UUID
+	Factor       decimal.Decimal
+}
+
+func NewSupplierProductMapping(supplierID uuid.UUID, supplierCode string, stockID uuid.UUID, factor decimal.Decimal) (*SupplierProductMapping, error) {
+	supplierCode = strings.TrimSpace(supplierCode)
+	if supplierCode == "" {
+		return nil, ErrSupplierCodeRequired
+	}
+
+	if stockID == uuid.Nil {
+		return nil, ErrMappingStockRequired
+	}
+
+	if factor.IsZero() {
+		factor = decimal.NewFromInt(1)
+	}
+
+	if factor.IsNegative() {
+		return nil, ErrInvalidMappingFactor
+	}
+
+	return &SupplierProductMapping{
+		Entity:       entity.NewEntity(),
+		SupplierID:   supplierID,
+		SupplierCode: supplierCode,
+		StockID:      stockID,
+		Factor:       factor,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package supplierentity
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrSupplierNameRequired = errors.New("supplier name is required")
+	ErrInvalidCnpj          = errors.New("cnpj must have 14 digits")
+	ErrInvalidLeadTime      = errors.New("lead time and payment term must not be negative")
+)
+
+// Supplier é o fornecedor de insumos e produtos de revenda
+type Supplier struct {
+	entity.Entity
+	SupplierCommonAttributes
+}
+
+type SupplierCommonAttributes struct {
+	Name              string // Razão social
+	TradeName         string // Nome fantasia
+	Cnpj              string // Somente dígitos
+	StateRegistration string
+	Email             string
+	Phone             string
+	ContactName       string
+	LeadTimeDays      int // Prazo de entrega em dias
+	PaymentTermDays   int // Prazo de pagamento em dias (gera o vencimento da conta a pagar)
+	Notes             string
+	IsActive          bool
+}
+
+func NewSupplier(attributes SupplierCommonAttributes) (*Supplier, error) {
+	supplier := &Supplier{
+		Entity:                   entity.NewEntity(),
+		SupplierCommonAttributes: attributes,
+	}
+	supplier.IsActive = true
+
+	if err := supplier.Validate(); err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *Supplier) Validate() error {
+	s.Name = strings.TrimSpace(s.Name)
+	if s.Name == "" {
+		return ErrSupplierNameRequired
+	}
+
+	s.Cnpj = onlyDigits(s.Cnpj)
+	if s.Cnpj != "" && len(s.Cnpj) != 14 {
+		return ErrInvalidCnpj
+	}
+
+	if s.LeadTimeDays < 0 || s.PaymentTermDays < 0 {
+		return ErrInvalidLeadTime
+	}
+
+	return nil
+}
+
+func onlyDigits(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
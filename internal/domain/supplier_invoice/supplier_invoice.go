@@ -0,0 +1,243 @@
+package supplierinvoiceentity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+)
+
+var (
+	ErrInvalidAccessKey        = errors.New("nf-e access key must have 44 digits")
+	ErrInvoiceWithoutItems     = errors.New("nf-e has no items")
+	ErrInvoiceAlreadyImported  = errors.New("nf-e already imported")
+	ErrInvoiceItemNotFound     = errors.New("nf-e item not found")
+	ErrInvoiceItemsNotMapped   = errors.New("all nf-e items must be mapped to a stock or ignored before importing")
+	ErrInvalidConversionFactor = errors.New("conversion factor must be greater than zero")
+)
+
+type SupplierInvoiceStatus string
+
+const (
+	StatusPending  SupplierInvoiceStatus = "pending"
+	StatusImported SupplierInvoiceStatus = "imported"
+)
+
+// SupplierInvoice é uma NF-e de compra recebida do fornecedor. Fica pendente até que todos os
+// itens estejam ligados a um estoque (ou ignorados) e então é importada como entrada de estoque.
+type SupplierInvoice struct {
+	entity.Entity
+	SupplierInvoiceCommonAttributes
+}
+
+type SupplierInvoiceCommonAttributes struct {
+	AccessKey   string
+	SupplierID  uuid.UUID
+	Number      string
+	Series      string
+	IssuedAt    *time.Time
+	TotalAmount decimal.Decimal
+	Status      SupplierInvoiceStatus
+	ImportedAt  *time.Time
+	Items       []SupplierInvoiceItem
+}
+
+// SupplierInvoiceItem é uma linha (det) da NF-e com o mapeamento escolhido para o estoque
+type SupplierInvoiceItem struct {
+	ItemNumber   int
+	SupplierCode string // cProd
+	EAN          string
+	Description  string // xProd
+	NCM          string
+	Unit         string // uCom
+	Quantity     decimal.Decimal
+	UnitCost     decimal.Decimal
+	Total        decimal.Decimal
+	Lots         []InvoiceLot
+	StockID      *uuid.UUID
+	Factor       decimal.Decimal
+	Ignored      bool // frete, brindes ou itens sem controle de estoque
+}
+
+// InvoiceLot é o grupo rastro do item (lote e validade informados pelo fornecedor)
+type InvoiceLot struct {
+	Code           string
+	Quantity       decimal.Decimal
+	ManufacturedAt *time.Time
+	ExpiresAt      *time.Time
+}
+
+// ItemEntry é uma entrada de lote já convertida para a unidade do estoque
+type ItemEntry struct {
+	StockID   uuid.UUID
+	LotCode   string
+	Quantity  decimal.Decimal
+	UnitCost  decimal.Decimal
+	ExpiresAt *time.Time
+}
+
+func NewSupplierInvoice(attributes SupplierInvoiceCommonAttributes) (*SupplierInvoice, error) {
+	if !isAccessKey(attributes.AccessKey) {
+		return nil, ErrInvalidAccessKey
+	}
+
+	if len(attributes.Items) == 0 {
+		return nil, ErrInvoiceWithoutItems
+	}
+
+	attributes.Status = StatusPending
+	for i := range attributes.Items {
+		if attributes.Items[i].Factor.IsZero() {
+			attributes.Items[i].Factor = decimal.NewFromInt(1)
+		}
+	}
+
+	return &SupplierInvoice{
+		Entity:                          entity.NewEntity(),
+		SupplierInvoiceCommonAttributes: attributes,
+	}, nil
+}
+
+func (i *SupplierInvoice) IsImported() bool {
+	return i.Status == StatusImported
+}
+
+// MapItem liga a linha da NF-e a um estoque; stockID nil com ignored=false desfaz o mapeamento
+func (i *SupplierInvoice) MapItem(itemNumber int, stockID *uuid.UUID, factor decimal.Decimal, ignored bool) error {
+	if i.IsImported() {
+		return ErrInvoiceAlreadyImported
+	}
+
+	item := i.findItem(itemNumber)
+	if item == nil {
+		return ErrInvoiceItemNotFound
+	}
+
+	if factor.IsZero() {
+		factor = decimal.NewFromInt(1)
+	}
+
+	if factor.IsNegative() {
+		return ErrInvalidConversionFactor
+	}
+
+	item.Ignored = ignored
+	item.StockID = stockID
+	item.Factor = factor
+	if ignored {
+		item.StockID = nil
+	}
+
+	return nil
+}
+
+// UnmappedItems devolve os números das linhas sem estoque e não ignoradas
+func (i *SupplierInvoice) UnmappedItems() []int {
+	unmapped := []int{}
+	for _, item := range i.Items {
+		if !item.Ignored && item.StockID == nil {
+			unmapped = append(unmapped, item.ItemNumber)
+		}
+	}
+	return unmapped
+}
+
+// Entries gera as entradas de estoque de todos os itens mapeados. Cada lote do rastro vira um
+// StockBatch próprio; itens sem rastro geram um único lote sem validade.
+func (i *SupplierInvoice) Entries() ([]ItemEntry, error) {
+	if i.IsImported() {
+		return nil, ErrInvoiceAlreadyImported
+	}
+
+	if len(i.UnmappedItems()) > 0 {
+		return nil, ErrInvoiceItemsNotMapped
+	}
+
+	entries := []ItemEntry{}
+	for _, item := range i.Items {
+		if item.Ignored {
+			continue
+		}
+		entries = append(entries, item.entries()...)
+	}
+
+	return entries, nil
+}
+
+func (i *SupplierInvoice) MarkImported() error {
+	if i.IsImported() {
+		return ErrInvoiceAlreadyImported
+	}
+
+	now := time.Now().UTC()
+	i.Status = StatusImported
+	i.ImportedAt = &now
+	return nil
+}
+
+func (i *SupplierInvoice) findItem(itemNumber int) *SupplierInvoiceItem {
+	for idx := range i.Items {
+		if i.Items[idx].ItemNumber == itemNumber {
+			return &i.Items[idx]
+		}
+	}
+	return nil
+}
+
+// entries converte a quantidade comercial para a unidade do estoque (Factor) e rateia o
+// valor total do item, de forma que o custo do lote reflita o que foi pago
+func (item *SupplierInvoiceItem) entries() []ItemEntry {
+	stockQuantity := item.Quantity.Mul(item.Factor)
+	unitCost := item.UnitCost
+	if stockQuantity.GreaterThan(decimal.Zero) {
+		total := item.Total
+		if total.IsZero() {
+			total = item.Quantity.Mul(item.UnitCost)
+		}
+		unitCost = total.Div(stockQuantity).Round(2)
+	}
+
+	if len(item.Lots) == 0 {
+		return []ItemEntry{{StockID: *item.StockID, Quantity: stockQuantity, UnitCost: unitCost}}
+	}
+
+	entries := make([]ItemEntry, 0, len(item.Lots))
+	remaining := stockQuantity
+	for idx, lot := range item.Lots {
+		quantity := lot.Quantity.Mul(item.Factor)
+		// O último lote absorve a diferença caso a soma do rastro não bata com qCom
+		if idx == len(item.Lots)-1 || quantity.GreaterThan(remaining) {
+			quantity = remaining
+		}
+
+		if quantity.LessThanOrEqual(decimal.Zero) {
+			continue
+		}
+
+		remaining = remaining.Sub(quantity)
+		entries = append(entries, ItemEntry{
+			StockID:   *item.StockID,
+			LotCode:   lot.Code,
+			Quantity:  quantity,
+			UnitCost:  unitCost,
+			ExpiresAt: lot.ExpiresAt,
+		})
+	}
+
+	return entries
+}
+
+func isAccessKey(key string) bool {
+	if len(key) != 44 {
+		return false
+	}
+
+	for _, r := range key {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+package supplierinvoiceentity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const accessKey = "35261012345678000199550010000012341000012345"
+
+func newInvoice(t *testing.T) *SupplierInvoice {
+	expiresAt := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+	invoice, err := NewSupplierInvoice(SupplierInvoiceCommonAttributes{
+		AccessKey:  accessKey,
+		SupplierID: uuid.New(),
+		Items: []SupplierInvoiceItem{
+			{
+				ItemNumber: 1, SupplierCode: "QJ", Quantity: decimal.NewFromInt(2),
+				UnitCost: decimal.NewFromInt(120), Total: decimal.NewFromInt(240),
+				Lots: []InvoiceLot{
+					{Code: "L01", Quantity: decimal.NewFromInt(1), ExpiresAt: &expiresAt},
+					{Code: "L02", Quantity: decimal.NewFromInt(1)},
+				},
+			},
+			{ItemNumber: 2, SupplierCode: "FRT", Quantity: decimal.NewFromInt(1), UnitCost: decimal.NewFromInt(15), Total: decimal.NewFromInt(15)},
+		},
+	})
+	require.NoError(t, err)
+	return invoice
+}
+
+func TestNewSupplierInvoice_Validation(t *testing.T) {
+	_, err := NewSupplierInvoice(SupplierInvoiceCommonAttributes{AccessKey: "123"})
+	assert.ErrorIs(t, err, ErrInvalidAccessKey)
+
+	_, err = NewSupplierInvoice(SupplierInvoiceCommonAttributes{AccessKey: accessKey})
+	assert.ErrorIs(t, err, ErrInvoiceWithoutItems)
+
+	invoice := newInvoice(t)
+	assert.Equal(t, StatusPending, invoice.Status)
+	assert.Equal(t, "1", invoice.Items[0].Factor.String(), "fator padrão deve ser 1")
+}
+
+func TestSupplierInvoice_EntriesRequireMapping(t *testing.T) {
+	invoice := newInvoice(t)
+	assert.Equal(t, []int{1, 2}, invoice.UnmappedItems())
+
+	_, err := invoice.Entries()
+	assert.ErrorIs(t, err, ErrInvoiceItemsNotMapped)
+
+	assert.ErrorIs(t, invoice.MapItem(9, nil, decimal.Zero, true), ErrInvoiceItemNotFound)
+}
+
+func TestSupplierInvoice_EntriesSplitLotsAndConvertUnits(t *testing.T) {
+	invoice := newInvoice(t)
+	stockID := uuid.New()
+
+	// Caixa do fornecedor = 12 unidades no estoque; frete é ignorado
+	require.NoError(t, invoice.MapItem(1, &stockID, decimal.NewFromInt(12), false))
+	require.NoError(t, invoice.MapItem(2, nil, decimal.Zero, true))
+	assert.Empty(t, invoice.UnmappedItems())
+
+	entries, err := invoice.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "um lote por rastro")
+
+	assert.Equal(t, stockID, entries[0].StockID)
+	assert.Equal(t, "L01", entries[0].LotCode)
+	assert.Equal(t, "12", entries[0].Quantity.String())
+	assert.Equal(t, "10", entries[0].UnitCost.String(), "custo unitário = vProd / quantidade convertida")
+	require.NotNil(t, entries[0].ExpiresAt)
+	assert.Equal(t, "12", entries[1].Quantity.String())
+	assert.Nil(t, entries[1].ExpiresAt)
+}
+
+func TestSupplierInvoice_EntriesWithoutLots(t *testing.T) {
+	invoice := newInvoice(t)
+	cheeseStock, freightStock := uuid.New(), uuid.New()
+	require.NoError(t, invoice.MapItem(1, &cheeseStock, decimal.Zero, false))
+	require.NoError(t, invoice.MapItem(2, &freightStock, decimal.Zero, false))
+
+	entries, err := invoice.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, freightStock, entries[2].StockID)
+	assert.Equal(t, "1", entries[2].Quantity.String())
+	assert.Equal(t, "", entries[2].LotCode)
+}
+
+func TestSupplierInvoice_MarkImportedBlocksChanges(t *testing.T) {
+	invoice := newInvoice(t)
+	require.NoError(t, invoice.MarkImported())
+	assert.NotNil(t, invoice.ImportedAt)
+
+	assert.ErrorIs(t, invoice.MarkImported(), ErrInvoiceAlreadyImported)
+	assert.ErrorIs(t, invoice.MapItem(1, nil, decimal.Zero, true), ErrInvoiceAlreadyImported)
+	_, err := invoice.Entries()
+	assert.ErrorIs(t, err, ErrInvoiceAlreadyImported)
+}
@@ -0,0 +1,87 @@
+package accountpayabledto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+)
+
+// AccountPayableCreateDTO lança uma conta manual (aluguel, energia, serviços); com recurrence
+// as próximas contas da série são geradas pelo scheduler
+type AccountPayableCreateDTO struct {
+	Description      string                          `json:"description"`
+	SupplierID       *uuid.UUID                      `json:"supplier_id"`
+	CategoryID       *uuid.UUID                      `json:"category_id"`
+	DocumentNumber   string                          `json:"document_number"`
+	Amount           decimal.Decimal                 `json:"amount"`
+	DueDate          time.Time                       `json:"due_date"`
+	Recurrence       accountpayableentity.Recurrence `json:"recurrence"`
+	RecurrenceEndsAt *time.Time                      `json:"recurrence_ends_at"`
+}
+
+func (d *AccountPayableCreateDTO) ToDomain() (*accountpayableentity.AccountPayable, error) {
+	return accountpayableentity.NewAccountPayable(accountpayableentity.AccountPayableCommonAttributes{
+		Description:      d.Description,
+		SupplierID:       d.SupplierID,
+		CategoryID:       d.CategoryID,
+		DocumentNumber:   d.DocumentNumber,
+		Amount:           d.Amount,
+		DueDate:          d.DueDate,
+		Recurrence:       d.Recurrence,
+		RecurrenceEndsAt: d.RecurrenceEndsAt,
+	})
+}
+
+// AccountPayableUpdateDTO edita uma conta em aberto. Recurrence vazia ("") na última conta da série encerra a série.
+type AccountPayableUpdateDTO struct {
+	Description      *string                          `json:"description"`
+	SupplierID       *uuid.UUID                       `json:"supplier_id"`
+	CategoryID       *uuid.UUID                       `json:"category_id"`
+	DocumentNumber   *string                          `json:"document_number"`
+	Amount           *decimal.Decimal                 `json:"amount"`
+	DueDate          *time.Time                       `json:"due_date"`
+	Recurrence       *accountpayableentity.Recurrence `json:"recurrence"`
+	RecurrenceEndsAt *time.Time                       `json:"recurrence_ends_at"`
+}
+
+func (d *AccountPayableUpdateDTO) UpdateDomain(payable *accountpayableentity.AccountPayable) error {
+	if err := payable.EnsureOpen(); err != nil {
+		return err
+	}
+
+	if d.Description != nil {
+		payable.Description = *d.Description
+	}
+
+	if d.SupplierID != nil {
+		payable.SupplierID = d.SupplierID
+	}
+
+	if d.CategoryID != nil {
+		payable.CategoryID = d.CategoryID
+	}
+
+	if d.DocumentNumber != nil {
+		payable.DocumentNumber = *d.DocumentNumber
+	}
+
+	if d.Amount != nil {
+		payable.Amount = *d.Amount
+	}
+
+	if d.DueDate != nil {
+		payable.DueDate = *d.DueDate
+	}
+
+	if d.Recurrence != nil {
+		payable.Recurrence = *d.Recurrence
+	}
+
+	if d.RecurrenceEndsAt != nil {
+		payable.RecurrenceEndsAt = d.RecurrenceEndsAt
+	}
+
+	return payable.Validate()
+}
@@ -0,0 +1,64 @@
+package accountpayabledto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+)
+
+type AccountPayableDTO struct {
+	ID              uuid.UUID       `json:"id"`
+	Description     string          `json:"description"`
+	SupplierID      *uuid.UUID      `json:"supplier_id,omitempty"`
+	SupplierName    string          `json:"supplier_name,omitempty"`
+	PurchaseOrderID *uuid.UUID      `json:"purchase_order_id,omitempty"`
+	DocumentNumber  string          `json:"document_number"`
+	Amount          decimal.Decimal `json:"amount"`
+	DueDate         time.Time       `json:"due_date"`
+	Status          string          `json:"status"`
+	IsOverdue       bool            `json:"is_overdue"`
+	PaidAt          *time.Time      `json:"paid_at,omitempty"`
+	PaidAmount      decimal.Decimal `json:"paid_amount"`
+	CategoryID      *uuid.UUID      `json:"category_id,omitempty"`
+	CategoryName    string          `json:"category_name,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+
+	Recurrence         accountpayableentity.Recurrence `json:"recurrence"`
+	RecurrenceEndsAt   *time.Time                      `json:"recurrence_ends_at,omitempty"`
+	RecurrenceParentID *uuid.UUID                      `json:"recurrence_parent_id,omitempty"`
+	Attachments        []AttachmentDTO                 `json:"attachments"`
+}
+
+func (a *AccountPayableDTO) FromDomain(payable *accountpayableentity.AccountPayable) {
+	if payable == nil {
+		return
+	}
+	*a = AccountPayableDTO{
+		ID:              payable.ID,
+		Description:     payable.Description,
+		SupplierID:      payable.SupplierID,
+		PurchaseOrderID: payable.PurchaseOrderID,
+		DocumentNumber:  payable.DocumentNumber,
+		Amount:          payable.Amount,
+		DueDate:         payable.DueDate,
+		Status:          string(payable.Status),
+		IsOverdue:       payable.IsOverdue(time.Now().UTC()),
+		PaidAt:          payable.PaidAt,
+		PaidAmount:      payable.PaidAmount,
+		CategoryID:      payable.CategoryID,
+		CreatedAt:       payable.CreatedAt,
+
+		Recurrence:         payable.Recurrence,
+		RecurrenceEndsAt:   payable.RecurrenceEndsAt,
+		RecurrenceParentID: payable.RecurrenceParentID,
+		Attachments:        AttachmentsFromDomain(payable.Attachments),
+	}
+}
+
+// AccountPayablePayDTO quita a conta; sem valor, considera o valor integral
+type AccountPayablePayDTO struct {
+	PaidAmount *decimal.Decimal `json:"paid_amount,omitempty"`
+	PaidAt     *time.Time       `json:"paid_at,omitempty"`
+}
@@ -0,0 +1,34 @@
+package accountpayabledto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+)
+
+// AttachmentDTO expõe o anexo; URL é preenchida pelo usecase com um link assinado do S3 que expira
+type AttachmentDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Key         string    `json:"-"`
+	URL         string    `json:"url"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func AttachmentsFromDomain(attachments []accountpayableentity.Attachment) []AttachmentDTO {
+	dtos := []AttachmentDTO{}
+	for _, attachment := range attachments {
+		dtos = append(dtos, AttachmentDTO{
+			ID:          attachment.ID,
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Size:        attachment.Size,
+			Key:         attachment.Key,
+			CreatedAt:   attachment.CreatedAt,
+		})
+	}
+	return dtos
+}
@@ -0,0 +1,60 @@
+package accountpayabledto
+
+import (
+	"github.com/google/uuid"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+)
+
+type ExpenseCategoryDTO struct {
+	ID          uuid.UUID `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	IsActive    bool      `json:"is_active"`
+}
+
+func (c *ExpenseCategoryDTO) FromDomain(category *accountpayableentity.ExpenseCategory) {
+	if category == nil {
+		return
+	}
+	*c = ExpenseCategoryDTO{
+		ID:          category.ID,
+		Name:        category.Name,
+		Description: category.Description,
+		IsActive:    category.IsActive,
+	}
+}
+
+type ExpenseCategoryCreateDTO struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (d *ExpenseCategoryCreateDTO) ToDomain() (*accountpayableentity.ExpenseCategory, error) {
+	return accountpayableentity.NewExpenseCategory(accountpayableentity.ExpenseCategoryCommonAttributes{
+		Name:        d.Name,
+		Description: d.Description,
+		IsActive:    true,
+	})
+}
+
+type ExpenseCategoryUpdateDTO struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	IsActive    *bool   `json:"is_active"`
+}
+
+func (d *ExpenseCategoryUpdateDTO) UpdateDomain(category *accountpayableentity.ExpenseCategory) error {
+	if d.Name != nil {
+		category.Name = *d.Name
+	}
+
+	if d.Description != nil {
+		category.Description = *d.Description
+	}
+
+	if d.IsActive != nil {
+		category.IsActive = *d.IsActive
+	}
+
+	return category.Validate()
+}
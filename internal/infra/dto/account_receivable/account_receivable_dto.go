@@ -0,0 +1,111 @@
+package accountreceivabledto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+)
+
+type AccountReceivableDTO struct {
+	ID             uuid.UUID                         `json:"id"`
+	Description    string                            `json:"description"`
+	Source         accountreceivableentity.Source    `json:"source"`
+	PayMethod      string                            `json:"pay_method,omitempty"`
+	OrderID        *uuid.UUID                        `json:"order_id,omitempty"`
+	PaymentID      *uuid.UUID                        `json:"payment_id,omitempty"`
+	DocumentNumber string                            `json:"document_number"`
+	Amount         decimal.Decimal                   `json:"amount"`
+	ExpectedDate   time.Time                         `json:"expected_date"`
+	Status         string                            `json:"status"`
+	IsOverdue      bool                              `json:"is_overdue"`
+	ReceivedAt     *time.Time                        `json:"received_at,omitempty"`
+	ReceivedAmount decimal.Decimal                   `json:"received_amount"`
+	Attachments    []accountpayabledto.AttachmentDTO `json:"attachments"`
+	CreatedAt      time.Time                         `json:"created_at"`
+}
+
+func (a *AccountReceivableDTO) FromDomain(receivable *accountreceivableentity.AccountReceivable) {
+	if receivable == nil {
+		return
+	}
+	*a = AccountReceivableDTO{
+		ID:             receivable.ID,
+		Description:    receivable.Description,
+		Source:         receivable.Source,
+		PayMethod:      receivable.PayMethod,
+		OrderID:        receivable.OrderID,
+		PaymentID:      receivable.PaymentID,
+		DocumentNumber: receivable.DocumentNumber,
+		Amount:         receivable.Amount,
+		ExpectedDate:   receivable.ExpectedDate,
+		Status:         string(receivable.Status),
+		IsOverdue:      receivable.IsOverdue(time.Now().UTC()),
+		ReceivedAt:     receivable.ReceivedAt,
+		ReceivedAmount: receivable.ReceivedAmount,
+		Attachments:    accountpayabledto.AttachmentsFromDomain(receivable.Attachments),
+		CreatedAt:      receivable.CreatedAt,
+	}
+}
+
+// AccountReceivableCreateDTO lança um valor a receber manual (venda a prazo, convênio)
+type AccountReceivableCreateDTO struct {
+	Description    string          `json:"description"`
+	PayMethod      string          `json:"pay_method"`
+	OrderID        *uuid.UUID      `json:"order_id"`
+	DocumentNumber string          `json:"document_number"`
+	Amount         decimal.Decimal `json:"amount"`
+	ExpectedDate   time.Time       `json:"expected_date"`
+}
+
+func (d *AccountReceivableCreateDTO) ToDomain() (*accountreceivableentity.AccountReceivable, error) {
+	return accountreceivableentity.NewAccountReceivable(accountreceivableentity.AccountReceivableCommonAttributes{
+		Description:    d.Description,
+		Source:         accountreceivableentity.SourceManual,
+		PayMethod:      d.PayMethod,
+		OrderID:        d.OrderID,
+		DocumentNumber: d.DocumentNumber,
+		Amount:         d.Amount,
+		ExpectedDate:   d.ExpectedDate,
+	})
+}
+
+// AccountReceivableUpdateDTO edita um valor ainda em aberto
+type AccountReceivableUpdateDTO struct {
+	Description    *string          `json:"description"`
+	DocumentNumber *string          `json:"document_number"`
+	Amount         *decimal.Decimal `json:"amount"`
+	ExpectedDate   *time.Time       `json:"expected_date"`
+}
+
+func (d *AccountReceivableUpdateDTO) UpdateDomain(receivable *accountreceivableentity.AccountReceivable) error {
+	if err := receivable.EnsureOpen(); err != nil {
+		return err
+	}
+
+	if d.Description != nil {
+		receivable.Description = *d.Description
+	}
+
+	if d.DocumentNumber != nil {
+		receivable.DocumentNumber = *d.DocumentNumber
+	}
+
+	if d.Amount != nil {
+		receivable.Amount = *d.Amount
+	}
+
+	if d.ExpectedDate != nil {
+		receivable.ExpectedDate = *d.ExpectedDate
+	}
+
+	return receivable.Validate()
+}
+
+// AccountReceivableReceiveDTO baixa o valor; sem valor, considera o valor integral
+type AccountReceivableReceiveDTO struct {
+	ReceivedAmount *decimal.Decimal `json:"received_amount,omitempty"`
+	ReceivedAt     *time.Time       `json:"received_at,omitempty"`
+}
@@ -0,0 +1,86 @@
+package accountreceivabledto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+type PayMethodFeeDTO struct {
+	ID             uuid.UUID             `json:"id"`
+	Method         orderentity.PayMethod `json:"method"`
+	Acquirer       string                `json:"acquirer"`
+	FeePercent     decimal.Decimal       `json:"fee_percent"`
+	FixedFee       decimal.Decimal       `json:"fixed_fee"`
+	SettlementDays int                   `json:"settlement_days"`
+	IsActive       bool                  `json:"is_active"`
+}
+
+func (f *PayMethodFeeDTO) FromDomain(fee *accountreceivableentity.PayMethodFee) {
+	if fee == nil {
+		return
+	}
+	*f = PayMethodFeeDTO{
+		ID:             fee.ID,
+		Method:         fee.Method,
+		Acquirer:       fee.Acquirer,
+		FeePercent:     fee.FeePercent,
+		FixedFee:       fee.FixedFee,
+		SettlementDays: fee.SettlementDays,
+		IsActive:       fee.IsActive,
+	}
+}
+
+// PayMethodFeeCreateDTO configura a taxa (MDR em %) e o prazo D+N de uma forma de pagamento
+type PayMethodFeeCreateDTO struct {
+	Method         orderentity.PayMethod `json:"method"`
+	Acquirer       string                `json:"acquirer"`
+	FeePercent     decimal.Decimal       `json:"fee_percent"`
+	FixedFee       decimal.Decimal       `json:"fixed_fee"`
+	SettlementDays int                   `json:"settlement_days"`
+}
+
+func (d *PayMethodFeeCreateDTO) ToDomain() (*accountreceivableentity.PayMethodFee, error) {
+	return accountreceivableentity.NewPayMethodFee(accountreceivableentity.PayMethodFeeCommonAttributes{
+		Method:         d.Method,
+		Acquirer:       d.Acquirer,
+		FeePercent:     d.FeePercent,
+		FixedFee:       d.FixedFee,
+		SettlementDays: d.SettlementDays,
+		IsActive:       true,
+	})
+}
+
+// PayMethodFeeUpdateDTO altera a condição; vale só para os pagamentos seguintes
+type PayMethodFeeUpdateDTO struct {
+	Acquirer       *string          `json:"acquirer"`
+	FeePercent     *decimal.Decimal `json:"fee_percent"`
+	FixedFee       *decimal.Decimal `json:"fixed_fee"`
+	SettlementDays *int             `json:"settlement_days"`
+	IsActive       *bool            `json:"is_active"`
+}
+
+func (d *PayMethodFeeUpdateDTO) UpdateDomain(fee *accountreceivableentity.PayMethodFee) error {
+	if d.Acquirer != nil {
+		fee.Acquirer = *d.Acquirer
+	}
+
+	if d.FeePercent != nil {
+		fee.FeePercent = *d.FeePercent
+	}
+
+	if d.FixedFee != nil {
+		fee.FixedFee = *d.FixedFee
+	}
+
+	if d.SettlementDays != nil {
+		fee.SettlementDays = *d.SettlementDays
+	}
+
+	if d.IsActive != nil {
+		fee.IsActive = *d.IsActive
+	}
+
+	return fee.Validate()
+}
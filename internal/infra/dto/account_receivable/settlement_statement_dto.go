@@ -0,0 +1,78 @@
+package accountreceivabledto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+)
+
+// SettlementStatementDTO é o extrato importado com a conciliação; Missing são os repasses previstos
+// no período do extrato que nenhuma linha creditou
+type SettlementStatementDTO struct {
+	ID          uuid.UUID                                           `json:"id"`
+	Acquirer    string                                              `json:"acquirer"`
+	Filename    string                                              `json:"filename"`
+	Format      accountreceivableentity.StatementFormat             `json:"format"`
+	PeriodStart time.Time                                           `json:"period_start"`
+	PeriodEnd   time.Time                                           `json:"period_end"`
+	Summary     map[accountreceivableentity.StatementLineStatus]int `json:"summary"`
+	Credited    decimal.Decimal                                     `json:"credited"`
+	Expected    decimal.Decimal                                     `json:"expected"`
+	Lines       []StatementLineDTO                                  `json:"lines,omitempty"`
+	Missing     []AccountReceivableDTO                              `json:"missing,omitempty"`
+	CreatedAt   time.Time                                           `json:"created_at"`
+}
+
+type StatementLineDTO struct {
+	ID             uuid.UUID                                   `json:"id"`
+	Date           time.Time                                   `json:"date"`
+	Description    string                                      `json:"description"`
+	Reference      string                                      `json:"reference"`
+	PayMethod      string                                      `json:"pay_method,omitempty"`
+	Amount         decimal.Decimal                             `json:"amount"`
+	Status         accountreceivableentity.StatementLineStatus `json:"status"`
+	ExpectedAmount decimal.Decimal                             `json:"expected_amount"`
+	Difference     decimal.Decimal                             `json:"difference"`
+	ReceivableIDs  []uuid.UUID                                 `json:"receivable_ids"`
+}
+
+// FromDomain preenche o extrato; sem withLines fica só o cabeçalho com os totais, para a listagem
+func (s *SettlementStatementDTO) FromDomain(statement *accountreceivableentity.SettlementStatement, withLines bool) {
+	if statement == nil {
+		return
+	}
+	*s = SettlementStatementDTO{
+		ID:          statement.ID,
+		Acquirer:    statement.Acquirer,
+		Filename:    statement.Filename,
+		Format:      statement.Format,
+		PeriodStart: statement.PeriodStart,
+		PeriodEnd:   statement.PeriodEnd,
+		Summary:     statement.Summary(),
+		Credited:    decimal.Zero,
+		Expected:    decimal.Zero,
+		CreatedAt:   statement.CreatedAt,
+	}
+
+	for _, line := range statement.Lines {
+		s.Credited = s.Credited.Add(line.Amount)
+		s.Expected = s.Expected.Add(line.ExpectedAmount)
+
+		if withLines {
+			s.Lines = append(s.Lines, StatementLineDTO{
+				ID:             line.ID,
+				Date:           line.Date,
+				Description:    line.Description,
+				Reference:      line.Reference,
+				PayMethod:      line.PayMethod,
+				Amount:         line.Amount,
+				Status:         line.Status,
+				ExpectedAmount: line.ExpectedAmount,
+				Difference:     line.Difference,
+				ReceivableIDs:  line.ReceivableIDs,
+			})
+		}
+	}
+}
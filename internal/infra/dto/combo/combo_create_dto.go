@@ -0,0 +1,56 @@
+package combodto
+
+import (
+	"github.com/shopspring/decimal"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+)
+
+type ComboCreateDTO struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Price       decimal.Decimal `json:"price"`
+	Slots       []ComboSlotDTO  `json:"slots"`
+}
+
+func (d *ComboCreateDTO) ToDomain() (*comboentity.Combo, error) {
+	return comboentity.NewCombo(comboentity.ComboCommonAttributes{
+		Name:        d.Name,
+		Description: d.Description,
+		Price:       d.Price,
+		Slots:       slotsToDomain(d.Slots),
+	})
+}
+
+// ComboUpdateDTO substitui os slots inteiros quando slots é enviado; mantenha o id
+// dos slots existentes para não quebrar as escolhas já feitas pelo cardápio.
+type ComboUpdateDTO struct {
+	Name        *string          `json:"name"`
+	Description *string          `json:"description"`
+	Price       *decimal.Decimal `json:"price"`
+	IsActive    *bool            `json:"is_active"`
+	Slots       []ComboSlotDTO   `json:"slots"`
+}
+
+func (d *ComboUpdateDTO) UpdateDomain(combo *comboentity.Combo) error {
+	if d.Name != nil {
+		combo.Name = *d.Name
+	}
+
+	if d.Description != nil {
+		combo.Description = *d.Description
+	}
+
+	if d.Price != nil {
+		combo.Price = *d.Price
+	}
+
+	if d.IsActive != nil {
+		combo.IsActive = *d.IsActive
+	}
+
+	if d.Slots != nil {
+		combo.Slots = slotsToDomain(d.Slots)
+	}
+
+	return combo.Validate()
+}
@@ -0,0 +1,72 @@
+package combodto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+)
+
+type ComboDTO struct {
+	ID          uuid.UUID       `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Price       decimal.Decimal `json:"price"`
+	IsActive    bool            `json:"is_active"`
+	Slots       []ComboSlotDTO  `json:"slots"`
+}
+
+// ComboSlotDTO é uma posição do combo; id é gerado no cadastro e usado na escolha ao vender
+type ComboSlotDTO struct {
+	ID      uuid.UUID            `json:"id,omitempty"`
+	Name    string               `json:"name"`
+	Options []ComboSlotOptionDTO `json:"options"`
+}
+
+// ComboSlotOptionDTO libera um produto (product_id) ou uma categoria inteira (category_id)
+type ComboSlotOptionDTO struct {
+	ProductID  *uuid.UUID      `json:"product_id,omitempty"`
+	CategoryID *uuid.UUID      `json:"category_id,omitempty"`
+	Upcharge   decimal.Decimal `json:"upcharge"`
+}
+
+func (d *ComboDTO) FromDomain(combo *comboentity.Combo) {
+	if combo == nil {
+		return
+	}
+	*d = ComboDTO{
+		ID:          combo.ID,
+		Name:        combo.Name,
+		Description: combo.Description,
+		Price:       combo.Price,
+		IsActive:    combo.IsActive,
+		Slots:       make([]ComboSlotDTO, 0, len(combo.Slots)),
+	}
+
+	for _, slot := range combo.Slots {
+		slotDTO := ComboSlotDTO{ID: slot.ID, Name: slot.Name, Options: make([]ComboSlotOptionDTO, 0, len(slot.Options))}
+		for _, option := range slot.Options {
+			slotDTO.Options = append(slotDTO.Options, ComboSlotOptionDTO{
+				ProductID:  option.ProductID,
+				CategoryID: option.CategoryID,
+				Upcharge:   option.Upcharge,
+			})
+		}
+		d.Slots = append(d.Slots, slotDTO)
+	}
+}
+
+func slotsToDomain(slots []ComboSlotDTO) []comboentity.ComboSlot {
+	domainSlots := make([]comboentity.ComboSlot, 0, len(slots))
+	for _, slot := range slots {
+		domainSlot := comboentity.ComboSlot{ID: slot.ID, Name: slot.Name, Options: make([]comboentity.ComboSlotOption, 0, len(slot.Options))}
+		for _, option := range slot.Options {
+			domainSlot.Options = append(domainSlot.Options, comboentity.ComboSlotOption{
+				ProductID:  option.ProductID,
+				CategoryID: option.CategoryID,
+				Upcharge:   option.Upcharge,
+			})
+		}
+		domainSlots = append(domainSlots, domainSlot)
+	}
+	return domainSlots
+}
@@ -22,6 +22,7 @@ type CompanyDTO struct {
 	Preferences  companyentity.Preferences `json:"preferences,omitempty"`
 	IsBlocked    bool                      `json:"is_blocked,omitempty"`
 	ImagePath    string                    `json:"image_path"`
+	TimeZone     string                    `json:"time_zone"`
 
 	// Schedules
 	Schedules []ScheduleDTO `json:"schedules,omitempty"`
@@ -82,6 +83,7 @@ func (c *CompanyDTO) FromDomain(company *companyentity.Company) {
 		Preferences:                   company.Preferences,
 		IsBlocked:                     company.IsBlocked,
 		ImagePath:                     company.ImagePath,
+		TimeZone:                      company.TimeZone,
 		Schedules:                     []ScheduleDTO{},
 		Categories:                    []companycategorydto.CompanyCategoryDTO{},
 		MonthlyPaymentDueDay:          company.MonthlyPaymentDueDay,
@@ -20,11 +20,18 @@ type CompanyUpdateDTO struct {
 	Categories   []companycategorydto.CompanyCategoryDTO `json:"categories"`
 	ImagePath    *string                                 `json:"image_path"`
 	Schedules    []ScheduleDTO                           `json:"schedules"`
+	TimeZone     *string                                 `json:"time_zone"`
 
 	MonthlyPaymentDueDay *int `json:"monthly_payment_due_day,omitempty"`
 }
 
 func (c *CompanyUpdateDTO) validate() error {
+	if c.TimeZone != nil {
+		if err := companyentity.ValidateTimeZone(*c.TimeZone); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -69,6 +76,9 @@ func (c *CompanyUpdateDTO) UpdateDomain(company *companyentity.Company) (err err
 	if c.ImagePath != nil {
 		company.ImagePath = *c.ImagePath
 	}
+	if c.TimeZone != nil {
+		company.TimeZone = *c.TimeZone
+	}
 
 	if c.Address != nil {
 		if company.Address == nil {
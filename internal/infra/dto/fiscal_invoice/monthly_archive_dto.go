@@ -0,0 +1,22 @@
+package fiscalinvoicedto
+
+import "time"
+
+type MonthlyArchiveRequestDTO struct {
+	Year             int  `json:"year" validate:"required"`
+	Month            int  `json:"month" validate:"required,min=1,max=12"`
+	SendToAccountant bool `json:"send_to_accountant"`
+}
+
+// MonthlyArchiveDTO describes the zip generated for the accountant
+type MonthlyArchiveDTO struct {
+	Year            int       `json:"year"`
+	Month           int       `json:"month"`
+	FileKey         string    `json:"file_key"`
+	URL             string    `json:"url"` // presigned, expires at URLExpiresAt
+	URLExpiresAt    time.Time `json:"url_expires_at"`
+	AuthorizedCount int       `json:"authorized_count"`
+	CancelledCount  int       `json:"cancelled_count"`
+	MissingFiles    []string  `json:"missing_files,omitempty"` // references that could not be downloaded from the provider
+	SentTo          string    `json:"sent_to,omitempty"`
+}
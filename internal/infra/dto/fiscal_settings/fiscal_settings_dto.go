@@ -6,6 +6,7 @@ import (
 
 type FiscalSettingsDTO struct {
 	CompanyRegistryID     int64  `json:"company_registry_id,omitempty"`
+	Provider              string `json:"provider"`
 	FiscalEnabled         bool   `json:"fiscal_enabled"`
 	StateRegistration     string `json:"state_registration"`
 	TaxRegime             int    `json:"tax_regime"`
@@ -22,6 +23,9 @@ type FiscalSettingsDTO struct {
 	ShowTaxBreakdown     bool `json:"show_tax_breakdown"`
 	SendEmailToRecipient bool `json:"send_email_to_recipient"`
 
+	// Accountant
+	AccountantEmail string `json:"accountant_email,omitempty"`
+
 	// Company Identity
 	BusinessName string `json:"business_name"` // Razão Social
 	TradeName    string `json:"trade_name"`    // Nome Fantasia
@@ -41,6 +45,7 @@ type FiscalSettingsDTO struct {
 
 func (d *FiscalSettingsDTO) FromDomain(entity *fiscalsettingsentity.FiscalSettings) {
 	d.CompanyRegistryID = entity.CompanyRegistryID
+	d.Provider = entity.Provider
 	d.FiscalEnabled = entity.IsActive
 	d.StateRegistration = entity.StateRegistration
 	d.TaxRegime = entity.TaxRegime
@@ -50,6 +55,7 @@ func (d *FiscalSettingsDTO) FromDomain(entity *fiscalsettingsentity.FiscalSettin
 	d.MunicipalRegistration = entity.MunicipalRegistration
 	d.ShowTaxBreakdown = entity.ShowTaxBreakdown
 	d.SendEmailToRecipient = entity.SendEmailToRecipient
+	d.AccountantEmail = entity.AccountantEmail
 
 	d.CSCProductionID = entity.CSCProductionID
 	d.CSCProductionCode = entity.CSCProductionCode
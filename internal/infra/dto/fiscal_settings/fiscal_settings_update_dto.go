@@ -2,6 +2,7 @@ package fiscalsettingsdto
 
 type FiscalSettingsUpdateDTO struct {
 	FiscalEnabled         *bool   `json:"fiscal_enabled,omitempty"`
+	Provider              *string `json:"provider,omitempty"`
 	StateRegistration     *string `json:"state_registration,omitempty"`
 	TaxRegime             *int    `json:"tax_regime,omitempty"`
 	CNAE                  *string `json:"cnae,omitempty"`
@@ -16,6 +17,9 @@ type FiscalSettingsUpdateDTO struct {
 	ShowTaxBreakdown     *bool `json:"show_tax_breakdown,omitempty"`
 	SendEmailToRecipient *bool `json:"send_email_to_recipient,omitempty"`
 
+	// Accountant
+	AccountantEmail *string `json:"accountant_email,omitempty"`
+
 	// Company Identity
 	BusinessName *string `json:"business_name,omitempty"`
 	TradeName    *string `json:"trade_name,omitempty"`
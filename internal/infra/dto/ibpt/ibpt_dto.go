@@ -0,0 +1,49 @@
+package ibptdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	ibptentity "github.com/willjrcom/sales-backend-go/internal/domain/ibpt"
+)
+
+type ImportResultDTO struct {
+	UF       string `json:"uf"`
+	Version  string `json:"version"`
+	Imported int    `json:"imported"`
+	Skipped  int    `json:"skipped"`
+}
+
+type TaxRateDTO struct {
+	NCM             string          `json:"ncm"`
+	Exception       string          `json:"exception,omitempty"`
+	UF              string          `json:"uf"`
+	Description     string          `json:"description"`
+	FederalNational decimal.Decimal `json:"federal_national"`
+	FederalImported decimal.Decimal `json:"federal_imported"`
+	State           decimal.Decimal `json:"state"`
+	Municipal       decimal.Decimal `json:"municipal"`
+	ValidFrom       *time.Time      `json:"valid_from,omitempty"`
+	ValidUntil      *time.Time      `json:"valid_until,omitempty"`
+	Version         string          `json:"version"`
+}
+
+func (d *TaxRateDTO) FromDomain(rate *ibptentity.TaxRate) {
+	if rate == nil {
+		return
+	}
+
+	*d = TaxRateDTO{
+		NCM:             rate.NCM,
+		Exception:       rate.Exception,
+		UF:              rate.UF,
+		Description:     rate.Description,
+		FederalNational: rate.FederalNational,
+		FederalImported: rate.FederalImported,
+		State:           rate.State,
+		Municipal:       rate.Municipal,
+		ValidFrom:       rate.ValidFrom,
+		ValidUntil:      rate.ValidUntil,
+		Version:         rate.Version,
+	}
+}
@@ -0,0 +1,46 @@
+package inventorycountdto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	inventorycountentity "github.com/willjrcom/sales-backend-go/internal/domain/inventory_count"
+)
+
+// InventoryCountCreateDTO abre uma contagem: "full" (todo o estoque ativo) ou "category"
+type InventoryCountCreateDTO struct {
+	Scope      inventorycountentity.InventoryCountScope `json:"scope"`
+	CategoryID *uuid.UUID                               `json:"category_id,omitempty"`
+	Notes      string                                   `json:"notes"`
+}
+
+// InventoryCountEntriesDTO recebe os lançamentos de contagem de um funcionário.
+// Cada lançamento identifica o item por item_id, por stock_id (+ batch_id) ou pelo SKU lido no leitor.
+type InventoryCountEntriesDTO struct {
+	Entries []InventoryCountEntryDTO `json:"entries"`
+}
+
+type InventoryCountEntryDTO struct {
+	ItemID   *uuid.UUID                     `json:"item_id,omitempty"`
+	StockID  *uuid.UUID                     `json:"stock_id,omitempty"`
+	BatchID  *uuid.UUID                     `json:"batch_id,omitempty"`
+	SKU      string                         `json:"sku,omitempty"`
+	Quantity decimal.Decimal                `json:"quantity"`
+	Mode     inventorycountentity.CountMode `json:"mode"` // add | set (padrão)
+}
+
+// InventoryCountApproveDTO confirma a contagem; itens não contados só são zerados se explicitamente pedido
+type InventoryCountApproveDTO struct {
+	TreatUncountedAsZero bool `json:"treat_uncounted_as_zero"`
+}
+
+// ResolveItem localiza o item da contagem referenciado pelo lançamento
+func (e *InventoryCountEntryDTO) ResolveItem(count *inventorycountentity.InventoryCount) *inventorycountentity.InventoryCountItem {
+	switch {
+	case e.ItemID != nil:
+		return count.FindItem(*e.ItemID)
+	case e.StockID != nil:
+		return count.FindItemByStock(*e.StockID, e.BatchID)
+	default:
+		return count.FindItemBySKU(e.SKU)
+	}
+}
@@ -0,0 +1,103 @@
+package inventorycountdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	inventorycountentity "github.com/willjrcom/sales-backend-go/internal/domain/inventory_count"
+)
+
+type InventoryCountDTO struct {
+	ID                 uuid.UUID               `json:"id"`
+	Scope              string                  `json:"scope"`
+	CategoryID         *uuid.UUID              `json:"category_id,omitempty"`
+	Status             string                  `json:"status"`
+	Notes              string                  `json:"notes"`
+	StartedBy          uuid.UUID               `json:"started_by"`
+	ApprovedBy         *uuid.UUID              `json:"approved_by,omitempty"`
+	TotalItems         int                     `json:"total_items"`
+	CountedItems       int                     `json:"counted_items"`
+	ShortageValue      decimal.Decimal         `json:"shortage_value"`
+	SurplusValue       decimal.Decimal         `json:"surplus_value"`
+	TotalVarianceValue decimal.Decimal         `json:"total_variance_value"`
+	Items              []InventoryCountItemDTO `json:"items"`
+	ApprovedAt         *time.Time              `json:"approved_at,omitempty"`
+	CancelledAt        *time.Time              `json:"cancelled_at,omitempty"`
+	CreatedAt          time.Time               `json:"created_at"`
+}
+
+type InventoryCountItemDTO struct {
+	ID                 uuid.UUID        `json:"id"`
+	StockID            uuid.UUID        `json:"stock_id"`
+	BatchID            *uuid.UUID       `json:"batch_id,omitempty"`
+	ProductID          uuid.UUID        `json:"product_id"`
+	ProductVariationID *uuid.UUID       `json:"product_variation_id,omitempty"`
+	SKU                string           `json:"sku"`
+	Description        string           `json:"description"`
+	Unit               string           `json:"unit"`
+	ExpectedQuantity   decimal.Decimal  `json:"expected_quantity"`
+	CountedQuantity    *decimal.Decimal `json:"counted_quantity,omitempty"`
+	CostPrice          decimal.Decimal  `json:"cost_price"`
+	Variance           decimal.Decimal  `json:"variance"`
+	VarianceValue      decimal.Decimal  `json:"variance_value"`
+	CountedBy          *uuid.UUID       `json:"counted_by,omitempty"`
+	CountedAt          *time.Time       `json:"counted_at,omitempty"`
+}
+
+// InventoryCountApprovalDTO é a resposta da aprovação, com os movimentos de ajuste gerados
+type InventoryCountApprovalDTO struct {
+	InventoryCount InventoryCountDTO `json:"inventory_count"`
+	MovementIDs    []uuid.UUID       `json:"movement_ids"`
+}
+
+func (c *InventoryCountDTO) FromDomain(count *inventorycountentity.InventoryCount) {
+	if count == nil {
+		return
+	}
+	*c = InventoryCountDTO{
+		ID:                 count.ID,
+		Scope:              string(count.Scope),
+		CategoryID:         count.CategoryID,
+		Status:             string(count.Status),
+		Notes:              count.Notes,
+		StartedBy:          count.StartedBy,
+		ApprovedBy:         count.ApprovedBy,
+		TotalItems:         len(count.Items),
+		CountedItems:       count.CountedItems(),
+		ShortageValue:      decimal.Zero,
+		SurplusValue:       decimal.Zero,
+		TotalVarianceValue: count.TotalVarianceValue(),
+		Items:              []InventoryCountItemDTO{},
+		ApprovedAt:         count.ApprovedAt,
+		CancelledAt:        count.CancelledAt,
+		CreatedAt:          count.CreatedAt,
+	}
+
+	for _, item := range count.Items {
+		varianceValue := item.VarianceValue()
+		if varianceValue.IsNegative() {
+			c.ShortageValue = c.ShortageValue.Add(varianceValue.Abs())
+		} else {
+			c.SurplusValue = c.SurplusValue.Add(varianceValue)
+		}
+
+		c.Items = append(c.Items, InventoryCountItemDTO{
+			ID:                 item.ID,
+			StockID:            item.StockID,
+			BatchID:            item.BatchID,
+			ProductID:          item.ProductID,
+			ProductVariationID: item.ProductVariationID,
+			SKU:                item.SKU,
+			Description:        item.Description,
+			Unit:               item.Unit,
+			ExpectedQuantity:   item.ExpectedQuantity,
+			CountedQuantity:    item.CountedQuantity,
+			CostPrice:          item.CostPrice,
+			Variance:           item.Variance(),
+			VarianceValue:      varianceValue,
+			CountedBy:          item.CountedBy,
+			CountedAt:          item.CountedAt,
+		})
+	}
+}
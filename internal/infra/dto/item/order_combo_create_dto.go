@@ -0,0 +1,74 @@
+package itemdto
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrComboQuantityNotInteger = errors.New("combo quantity must be a whole number")
+	ErrComboChoicesRequired    = errors.New("combo choices are required")
+)
+
+// OrderComboCreateDTO adiciona um combo ao pedido com uma escolha por slot
+type OrderComboCreateDTO struct {
+	OrderID     uuid.UUID             `json:"order_id"`
+	ComboID     uuid.UUID             `json:"combo_id"`
+	Quantity    float64               `json:"quantity"`
+	Observation string                `json:"observation"`
+	Choices     []OrderComboChoiceDTO `json:"choices"`
+}
+
+// OrderComboChoiceDTO é o produto escolhido para um slot do combo
+type OrderComboChoiceDTO struct {
+	SlotID      uuid.UUID `json:"slot_id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	VariationID uuid.UUID `json:"variation_id"`
+	Flavor      *string   `json:"flavor,omitempty"`
+	Observation string    `json:"observation"`
+}
+
+func (a *OrderComboCreateDTO) Validate() error {
+	if a.OrderID == uuid.Nil {
+		return errors.New("order id is required")
+	}
+
+	if a.ComboID == uuid.Nil {
+		return errors.New("combo id is required")
+	}
+
+	if a.Quantity <= 0 {
+		return errors.New("quantity is required")
+	}
+
+	if a.Quantity != float64(int64(a.Quantity)) {
+		return ErrComboQuantityNotInteger
+	}
+
+	if len(a.Choices) == 0 {
+		return ErrComboChoicesRequired
+	}
+
+	for _, choice := range a.Choices {
+		if choice.SlotID == uuid.Nil {
+			return errors.New("slot id is required")
+		}
+
+		if choice.ProductID == uuid.Nil {
+			return errors.New("product id is required")
+		}
+
+		if choice.VariationID == uuid.Nil {
+			return errors.New("variation id is required")
+		}
+	}
+
+	return nil
+}
+
+// ComboItemIDsDTO devolve a venda do combo e os itens criados para cada componente
+type ComboItemIDsDTO struct {
+	ComboSaleID uuid.UUID   `json:"combo_sale_id"`
+	Items       []ItemIDDTO `json:"items"`
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
 	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
 )
 
@@ -12,6 +13,7 @@ var (
 	ErrGroupItemNotStaging      = errors.New("group item not staging")
 	ErrGroupItemCategoryInvalid = errors.New("group item category invalid")
 	ErrGroupItemSizeInvalid     = errors.New("group item size invalid")
+	ErrItemChannelInvalid       = errors.New("only the digital_menu channel can be informed")
 )
 
 type OrderItemCreateDTO struct {
@@ -24,6 +26,8 @@ type OrderItemCreateDTO struct {
 	Flavor       *string                        `json:"flavor,omitempty"`
 	Additions    []OrderAdditionalItemCreateDTO `json:"additions,omitempty"`
 	RemovedItems []RemovedItemDTO               `json:"removed_items,omitempty"`
+	// Channel é enviado só pelo cardápio digital; sem ele o canal da lista de preço vem do tipo do pedido
+	Channel pricelistentity.Channel `json:"channel,omitempty"`
 }
 
 func (a *OrderItemCreateDTO) Validate() error {
@@ -43,6 +47,10 @@ func (a *OrderItemCreateDTO) Validate() error {
 		return errors.New("quantity is required")
 	}
 
+	if a.Channel != "" && a.Channel != pricelistentity.ChannelDigitalMenu {
+		return ErrItemChannelInvalid
+	}
+
 	for _, addition := range a.Additions {
 		if err := addition.validate(); err != nil {
 			return err
@@ -22,6 +22,10 @@ type ItemDTO struct {
 	ProductID       uuid.UUID                      `json:"product_id"`
 	Product         *productcategorydto.ProductDTO `json:"product"`
 	Flavor          *string                        `json:"flavor,omitempty"`
+	ComboID         *uuid.UUID                     `json:"combo_id,omitempty"`
+	ComboSaleID     *uuid.UUID                     `json:"combo_sale_id,omitempty"`
+	PriceListID     *uuid.UUID                     `json:"price_list_id,omitempty"`
+	ListPrice       *decimal.Decimal               `json:"list_price,omitempty"`
 }
 
 func (i *ItemDTO) FromDomain(item *orderentity.Item) {
@@ -41,6 +45,10 @@ func (i *ItemDTO) FromDomain(item *orderentity.Item) {
 		RemovedItems:    item.RemovedItems,
 		ProductID:       item.ProductID,
 		Flavor:          item.Flavor,
+		ComboID:         item.ComboID,
+		ComboSaleID:     item.ComboSaleID,
+		PriceListID:     item.PriceListID,
+		ListPrice:       item.ListPrice,
 		AdditionalItems: []ItemDTO{},
 	}
 
@@ -18,6 +18,10 @@ type PaymentCommonAttributes struct {
 	TotalPaid decimal.Decimal       `json:"total_paid"`
 	Method    orderentity.PayMethod `json:"method"`
 	OrderID   uuid.UUID             `json:"order_id"`
+	// Repasse esperado da adquirente; vazio nas formas sem taxa configurada
+	FeeAmount            decimal.Decimal `json:"fee_amount"`
+	NetAmount            decimal.Decimal `json:"net_amount"`
+	ExpectedSettlementAt *time.Time      `json:"expected_settlement_at,omitempty"`
 }
 
 type PaymentTimeLogs struct {
@@ -33,6 +37,10 @@ func (p *PaymentOrderDTO) FromDomain(payment *orderentity.PaymentOrder) {
 			TotalPaid: payment.TotalPaid,
 			Method:    payment.Method,
 			OrderID:   payment.OrderID,
+
+			FeeAmount:            payment.FeeAmount,
+			NetAmount:            payment.NetAmount,
+			ExpectedSettlementAt: payment.ExpectedSettlementAt,
 		},
 		PaymentTimeLogs: PaymentTimeLogs{
 			PaidAt: payment.PaidAt,
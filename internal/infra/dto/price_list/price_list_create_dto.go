@@ -0,0 +1,84 @@
+package pricelistdto
+
+import (
+	"time"
+
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
+)
+
+type PriceListCreateDTO struct {
+	Name     string                    `json:"name"`
+	Priority int                       `json:"priority"`
+	Channels []pricelistentity.Channel `json:"channels"`
+	Windows  []TimeWindowDTO           `json:"windows"`
+	StartsAt *time.Time                `json:"starts_at"`
+	EndsAt   *time.Time                `json:"ends_at"`
+	Prices   []PriceListEntryDTO       `json:"prices"`
+}
+
+func (d *PriceListCreateDTO) ToDomain() (*pricelistentity.PriceList, error) {
+	return pricelistentity.NewPriceList(pricelistentity.PriceListCommonAttributes{
+		Name:     d.Name,
+		Priority: d.Priority,
+		Channels: d.Channels,
+		Windows:  windowsToDomain(d.Windows),
+		StartsAt: d.StartsAt,
+		EndsAt:   d.EndsAt,
+		Prices:   pricesToDomain(d.Prices),
+	})
+}
+
+// PriceListUpdateDTO substitui canais, janelas e preços inteiros quando enviados.
+// Para remover a data de início ou fim envie clear_starts_at/clear_ends_at.
+type PriceListUpdateDTO struct {
+	Name          *string                   `json:"name"`
+	Priority      *int                      `json:"priority"`
+	IsActive      *bool                     `json:"is_active"`
+	Channels      []pricelistentity.Channel `json:"channels"`
+	Windows       []TimeWindowDTO           `json:"windows"`
+	StartsAt      *time.Time                `json:"starts_at"`
+	EndsAt        *time.Time                `json:"ends_at"`
+	ClearStartsAt bool                      `json:"clear_starts_at"`
+	ClearEndsAt   bool                      `json:"clear_ends_at"`
+	Prices        []PriceListEntryDTO       `json:"prices"`
+}
+
+func (d *PriceListUpdateDTO) UpdateDomain(priceList *pricelistentity.PriceList) error {
+	if d.Name != nil {
+		priceList.Name = *d.Name
+	}
+
+	if d.Priority != nil {
+		priceList.Priority = *d.Priority
+	}
+
+	if d.IsActive != nil {
+		priceList.IsActive = *d.IsActive
+	}
+
+	if d.Channels != nil {
+		priceList.Channels = d.Channels
+	}
+
+	if d.Windows != nil {
+		priceList.Windows = windowsToDomain(d.Windows)
+	}
+
+	if d.StartsAt != nil {
+		priceList.StartsAt = d.StartsAt
+	} else if d.ClearStartsAt {
+		priceList.StartsAt = nil
+	}
+
+	if d.EndsAt != nil {
+		priceList.EndsAt = d.EndsAt
+	} else if d.ClearEndsAt {
+		priceList.EndsAt = nil
+	}
+
+	if d.Prices != nil {
+		priceList.Prices = pricesToDomain(d.Prices)
+	}
+
+	return priceList.Validate()
+}
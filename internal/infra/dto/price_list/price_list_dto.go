@@ -0,0 +1,88 @@
+package pricelistdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
+)
+
+type PriceListDTO struct {
+	ID       uuid.UUID                 `json:"id"`
+	Name     string                    `json:"name"`
+	Priority int                       `json:"priority"`
+	IsActive bool                      `json:"is_active"`
+	Channels []pricelistentity.Channel `json:"channels"`
+	Windows  []TimeWindowDTO           `json:"windows"`
+	StartsAt *time.Time                `json:"starts_at,omitempty"`
+	EndsAt   *time.Time                `json:"ends_at,omitempty"`
+	Prices   []PriceListEntryDTO       `json:"prices"`
+}
+
+// TimeWindowDTO é um horário HH:MM nos dias da semana (0 = domingo); sem dias vale todos
+type TimeWindowDTO struct {
+	DaysOfWeek []int  `json:"days_of_week"`
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+}
+
+type PriceListEntryDTO struct {
+	ProductVariationID uuid.UUID       `json:"product_variation_id"`
+	Price              decimal.Decimal `json:"price"`
+}
+
+func (d *PriceListDTO) FromDomain(priceList *pricelistentity.PriceList) {
+	if priceList == nil {
+		return
+	}
+	*d = PriceListDTO{
+		ID:       priceList.ID,
+		Name:     priceList.Name,
+		Priority: priceList.Priority,
+		IsActive: priceList.IsActive,
+		Channels: priceList.Channels,
+		Windows:  make([]TimeWindowDTO, 0, len(priceList.Windows)),
+		StartsAt: priceList.StartsAt,
+		EndsAt:   priceList.EndsAt,
+		Prices:   make([]PriceListEntryDTO, 0, len(priceList.Prices)),
+	}
+
+	for _, window := range priceList.Windows {
+		d.Windows = append(d.Windows, TimeWindowDTO{
+			DaysOfWeek: window.DaysOfWeek,
+			StartTime:  window.StartTime,
+			EndTime:    window.EndTime,
+		})
+	}
+
+	for _, entry := range priceList.Prices {
+		d.Prices = append(d.Prices, PriceListEntryDTO{
+			ProductVariationID: entry.ProductVariationID,
+			Price:              entry.Price,
+		})
+	}
+}
+
+func windowsToDomain(windows []TimeWindowDTO) []pricelistentity.TimeWindow {
+	domainWindows := make([]pricelistentity.TimeWindow, 0, len(windows))
+	for _, window := range windows {
+		domainWindows = append(domainWindows, pricelistentity.TimeWindow{
+			DaysOfWeek: window.DaysOfWeek,
+			StartTime:  window.StartTime,
+			EndTime:    window.EndTime,
+		})
+	}
+	return domainWindows
+}
+
+func pricesToDomain(prices []PriceListEntryDTO) []pricelistentity.PriceListEntry {
+	domainPrices := make([]pricelistentity.PriceListEntry, 0, len(prices))
+	for _, entry := range prices {
+		domainPrices = append(domainPrices, pricelistentity.PriceListEntry{
+			ProductVariationID: entry.ProductVariationID,
+			Price:              entry.Price,
+		})
+	}
+	return domainPrices
+}
@@ -16,6 +16,7 @@ var (
 
 type ProductCreateDTO struct {
 	SKU         string                      `json:"sku"`
+	NCM         string                      `json:"ncm"`
 	Name        string                      `json:"name"`
 	Flavors     []string                    `json:"flavors"`
 	Description string                      `json:"description"`
@@ -66,6 +67,7 @@ func (p *ProductCreateDTO) ToDomain() (*productentity.Product, error) {
 
 	productCommonAttributes := productentity.ProductCommonAttributes{
 		SKU:         p.SKU,
+		NCM:         p.NCM,
 		Name:        p.Name,
 		Flavors:     flavors,
 		Description: p.Description,
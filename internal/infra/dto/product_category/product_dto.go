@@ -8,6 +8,7 @@ import (
 type ProductDTO struct {
 	ID          uuid.UUID             `json:"id"`
 	SKU         string                `json:"sku"`
+	NCM         string                `json:"ncm"`
 	Name        string                `json:"name"`
 	Flavors     []string              `json:"flavors"`
 	ImagePath   *string               `json:"image_path"`
@@ -26,6 +27,7 @@ func (p *ProductDTO) FromDomain(product *productentity.Product) {
 	*p = ProductDTO{
 		ID:          product.ID,
 		SKU:         product.SKU,
+		NCM:         product.NCM,
 		Name:        product.Name,
 		Flavors:     append([]string{}, product.Flavors...),
 		ImagePath:   product.ImagePath,
@@ -7,6 +7,7 @@ import (
 
 type ProductUpdateDTO struct {
 	SKU         *string                      `json:"sku"`
+	NCM         *string                      `json:"ncm"`
 	Name        *string                      `json:"name"`
 	Flavors     []string                     `json:"flavors,omitempty"`
 	ImagePath   *string                      `json:"image_path"`
@@ -31,6 +32,9 @@ func (p *ProductUpdateDTO) UpdateDomain(product *productentity.Product) (err err
 	if p.SKU != nil {
 		product.SKU = *p.SKU
 	}
+	if p.NCM != nil {
+		product.NCM = *p.NCM
+	}
 	if p.Name != nil {
 		product.Name = *p.Name
 	}
@@ -0,0 +1,30 @@
+package purchaseorderdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PurchaseOrderCreateDTO cria o pedido em rascunho; ProductID/Variação são derivados do estoque
+type PurchaseOrderCreateDTO struct {
+	SupplierID         uuid.UUID                    `json:"supplier_id"`
+	ExpectedDeliveryAt *time.Time                   `json:"expected_delivery_at,omitempty"`
+	Notes              string                       `json:"notes"`
+	Items              []PurchaseOrderItemCreateDTO `json:"items"`
+}
+
+type PurchaseOrderItemCreateDTO struct {
+	StockID     uuid.UUID       `json:"stock_id"`
+	Description string          `json:"description"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	UnitCost    decimal.Decimal `json:"unit_cost"`
+}
+
+// PurchaseOrderUpdateDTO altera um rascunho; quando Items é enviado, substitui todos os itens
+type PurchaseOrderUpdateDTO struct {
+	ExpectedDeliveryAt *time.Time                    `json:"expected_delivery_at,omitempty"`
+	Notes              *string                       `json:"notes"`
+	Items              *[]PurchaseOrderItemCreateDTO `json:"items"`
+}
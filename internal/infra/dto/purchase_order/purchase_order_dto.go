@@ -0,0 +1,69 @@
+package purchaseorderdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+)
+
+type PurchaseOrderDTO struct {
+	ID                 uuid.UUID              `json:"id"`
+	SupplierID         uuid.UUID              `json:"supplier_id"`
+	SupplierName       string                 `json:"supplier_name,omitempty"`
+	Status             string                 `json:"status"`
+	Notes              string                 `json:"notes"`
+	Total              decimal.Decimal        `json:"total"`
+	Items              []PurchaseOrderItemDTO `json:"items"`
+	ExpectedDeliveryAt *time.Time             `json:"expected_delivery_at,omitempty"`
+	OrderedAt          *time.Time             `json:"ordered_at,omitempty"`
+	ReceivedAt         *time.Time             `json:"received_at,omitempty"`
+	CancelledAt        *time.Time             `json:"cancelled_at,omitempty"`
+	CreatedAt          time.Time              `json:"created_at"`
+}
+
+type PurchaseOrderItemDTO struct {
+	ID                 uuid.UUID       `json:"id"`
+	StockID            uuid.UUID       `json:"stock_id"`
+	ProductID          uuid.UUID       `json:"product_id"`
+	ProductVariationID *uuid.UUID      `json:"product_variation_id,omitempty"`
+	Description        string          `json:"description"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	ReceivedQuantity   decimal.Decimal `json:"received_quantity"`
+	PendingQuantity    decimal.Decimal `json:"pending_quantity"`
+	UnitCost           decimal.Decimal `json:"unit_cost"`
+}
+
+func (p *PurchaseOrderDTO) FromDomain(order *purchaseorderentity.PurchaseOrder) {
+	if order == nil {
+		return
+	}
+	*p = PurchaseOrderDTO{
+		ID:                 order.ID,
+		SupplierID:         order.SupplierID,
+		Status:             string(order.Status),
+		Notes:              order.Notes,
+		Total:              order.Total(),
+		Items:              []PurchaseOrderItemDTO{},
+		ExpectedDeliveryAt: order.ExpectedDeliveryAt,
+		OrderedAt:          order.OrderedAt,
+		ReceivedAt:         order.ReceivedAt,
+		CancelledAt:        order.CancelledAt,
+		CreatedAt:          order.CreatedAt,
+	}
+
+	for _, item := range order.Items {
+		p.Items = append(p.Items, PurchaseOrderItemDTO{
+			ID:                 item.ID,
+			StockID:            item.StockID,
+			ProductID:          item.ProductID,
+			ProductVariationID: item.ProductVariationID,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			ReceivedQuantity:   item.ReceivedQuantity,
+			PendingQuantity:    item.PendingQuantity(),
+			UnitCost:           item.UnitCost,
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package purchaseorderdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+)
+
+// PurchaseOrderReceiveDTO informa o que chegou do fornecedor (recebimento total ou parcial)
+type PurchaseOrderReceiveDTO struct {
+	DocumentNumber string                        `json:"document_number"` // NF do fornecedor
+	DueDate        *time.Time                    `json:"due_date,omitempty"`
+	Items          []PurchaseOrderReceiveItemDTO `json:"items"`
+}
+
+type PurchaseOrderReceiveItemDTO struct {
+	ItemID    uuid.UUID        `json:"item_id"`
+	Quantity  decimal.Decimal  `json:"quantity"`
+	UnitCost  *decimal.Decimal `json:"unit_cost,omitempty"`
+	ExpiresAt *time.Time       `json:"expires_at,omitempty"`
+}
+
+func (r *PurchaseOrderReceiveDTO) ToLines() []purchaseorderentity.ReceiveLine {
+	lines := make([]purchaseorderentity.ReceiveLine, 0, len(r.Items))
+	for _, item := range r.Items {
+		lines = append(lines, purchaseorderentity.ReceiveLine{
+			ItemID:    item.ItemID,
+			Quantity:  item.Quantity,
+			UnitCost:  item.UnitCost,
+			ExpiresAt: item.ExpiresAt,
+		})
+	}
+	return lines
+}
+
+// PurchaseOrderReceiptDTO é a resposta do recebimento
+type PurchaseOrderReceiptDTO struct {
+	PurchaseOrder    PurchaseOrderDTO `json:"purchase_order"`
+	MovementIDs      []uuid.UUID      `json:"movement_ids"`
+	AccountPayableID *uuid.UUID       `json:"account_payable_id,omitempty"`
+	Total            decimal.Decimal  `json:"total"`
+}
@@ -0,0 +1,37 @@
+package replenishmentdto
+
+import (
+	"github.com/google/uuid"
+	replenishmententity "github.com/willjrcom/sales-backend-go/internal/domain/replenishment"
+)
+
+// ReplenishmentParamsDTO ajusta o cálculo; valores zerados usam os padrões (30, 7 e 2 dias)
+type ReplenishmentParamsDTO struct {
+	PeriodDays   int `json:"period_days"`
+	CoverageDays int `json:"coverage_days"`
+	SafetyDays   int `json:"safety_days"`
+}
+
+func (d *ReplenishmentParamsDTO) ToDomain() (replenishmententity.Parameters, error) {
+	return replenishmententity.NewParameters(d.PeriodDays, d.CoverageDays, d.SafetyDays)
+}
+
+// DraftPurchaseOrdersCreateDTO gera um pedido em rascunho por fornecedor;
+// supplier_ids vazio gera para todos os fornecedores com sugestão
+type DraftPurchaseOrdersCreateDTO struct {
+	ReplenishmentParamsDTO
+	SupplierIDs []uuid.UUID `json:"supplier_ids"`
+}
+
+func (d *DraftPurchaseOrdersCreateDTO) IncludesSupplier(supplierID uuid.UUID) bool {
+	if len(d.SupplierIDs) == 0 {
+		return true
+	}
+
+	for _, id := range d.SupplierIDs {
+		if id == supplierID {
+			return true
+		}
+	}
+	return false
+}
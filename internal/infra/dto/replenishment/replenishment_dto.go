@@ -0,0 +1,111 @@
+package replenishmentdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	replenishmententity "github.com/willjrcom/sales-backend-go/internal/domain/replenishment"
+)
+
+// ReplenishmentDTO é a lista de compras sugerida, agrupada por fornecedor
+type ReplenishmentDTO struct {
+	PeriodDays   int                `json:"period_days"`
+	CoverageDays int                `json:"coverage_days"`
+	SafetyDays   int                `json:"safety_days"`
+	GeneratedAt  time.Time          `json:"generated_at"`
+	Groups       []SupplierGroupDTO `json:"groups"`
+	TotalCost    decimal.Decimal    `json:"total_cost"`
+}
+
+type SupplierGroupDTO struct {
+	SupplierID   *uuid.UUID             `json:"supplier_id,omitempty"`
+	SupplierName string                 `json:"supplier_name"`
+	LeadTimeDays int                    `json:"lead_time_days"`
+	TotalCost    decimal.Decimal        `json:"total_cost"`
+	Items        []ReorderSuggestionDTO `json:"items"`
+}
+
+type ReorderSuggestionDTO struct {
+	StockID             uuid.UUID       `json:"stock_id"`
+	ProductID           uuid.UUID       `json:"product_id"`
+	ProductVariationID  *uuid.UUID      `json:"product_variation_id,omitempty"`
+	Description         string          `json:"description"`
+	Unit                string          `json:"unit"`
+	CurrentStock        decimal.Decimal `json:"current_stock"`
+	MinStock            decimal.Decimal `json:"min_stock"`
+	MaxStock            decimal.Decimal `json:"max_stock"`
+	ConsumedQuantity    decimal.Decimal `json:"consumed_quantity"`
+	AvgDailyConsumption decimal.Decimal `json:"avg_daily_consumption"`
+	LeadTimeDays        int             `json:"lead_time_days"`
+	ReorderPoint        decimal.Decimal `json:"reorder_point"`
+	TargetStock         decimal.Decimal `json:"target_stock"`
+	SuggestedQuantity   decimal.Decimal `json:"suggested_quantity"`
+	UnitCost            decimal.Decimal `json:"unit_cost"`
+	EstimatedCost       decimal.Decimal `json:"estimated_cost"`
+}
+
+func (r *ReplenishmentDTO) FromDomain(params replenishmententity.Parameters, groups []replenishmententity.SupplierGroup) {
+	r.PeriodDays = params.PeriodDays
+	r.CoverageDays = params.CoverageDays
+	r.SafetyDays = params.SafetyDays
+	r.GeneratedAt = time.Now().UTC()
+	r.Groups = []SupplierGroupDTO{}
+	r.TotalCost = decimal.Zero
+
+	for _, group := range groups {
+		groupDTO := SupplierGroupDTO{}
+		groupDTO.FromDomain(group)
+		r.Groups = append(r.Groups, groupDTO)
+		r.TotalCost = r.TotalCost.Add(groupDTO.TotalCost)
+	}
+}
+
+func (g *SupplierGroupDTO) FromDomain(group replenishmententity.SupplierGroup) {
+	*g = SupplierGroupDTO{
+		SupplierID:   group.SupplierID,
+		SupplierName: group.SupplierName,
+		LeadTimeDays: group.LeadTimeDays,
+		TotalCost:    group.TotalCost(),
+		Items:        []ReorderSuggestionDTO{},
+	}
+
+	for _, item := range group.Items {
+		itemDTO := ReorderSuggestionDTO{}
+		itemDTO.FromDomain(item)
+		g.Items = append(g.Items, itemDTO)
+	}
+}
+
+func (s *ReorderSuggestionDTO) FromDomain(suggestion replenishmententity.ReorderSuggestion) {
+	*s = ReorderSuggestionDTO{
+		StockID:             suggestion.StockID,
+		ProductID:           suggestion.ProductID,
+		ProductVariationID:  suggestion.ProductVariationID,
+		Description:         suggestion.Description,
+		Unit:                suggestion.Unit,
+		CurrentStock:        suggestion.CurrentStock,
+		MinStock:            suggestion.MinStock,
+		MaxStock:            suggestion.MaxStock,
+		ConsumedQuantity:    suggestion.ConsumedQuantity,
+		AvgDailyConsumption: suggestion.AvgDailyConsumption,
+		LeadTimeDays:        suggestion.LeadTimeDays,
+		ReorderPoint:        suggestion.ReorderPoint,
+		TargetStock:         suggestion.TargetStock,
+		SuggestedQuantity:   suggestion.SuggestedQuantity,
+		UnitCost:            suggestion.UnitCost,
+		EstimatedCost:       suggestion.EstimatedCost(),
+	}
+}
+
+// DraftPurchaseOrdersDTO lista os pedidos de compra criados em rascunho
+type DraftPurchaseOrdersDTO struct {
+	PurchaseOrderIDs []uuid.UUID `json:"purchase_order_ids"`
+	// UnassignedItems são as sugestões sem fornecedor conhecido, que não geram pedido
+	UnassignedItems int `json:"unassigned_items"`
+}
+
+// StockLimitsDTO resume o ajuste automático de mínimo/máximo
+type StockLimitsDTO struct {
+	UpdatedStocks int `json:"updated_stocks"`
+}
@@ -0,0 +1,62 @@
+package reportdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CashFlowProjectionRequest selects the projected days [start, end], both inclusive, in the company time zone.
+// Empty dates project the next 30 days from today; OpeningBalance is the cash available at start.
+type CashFlowProjectionRequest struct {
+	Start          time.Time       `json:"start"`
+	End            time.Time       `json:"end"`
+	OpeningBalance decimal.Decimal `json:"opening_balance"`
+}
+
+// CashFlowProjectionResponse holds the daily projection and its totals.
+// Overdue values were due before start and are still open; they are reported apart and not in the balance.
+type CashFlowProjectionResponse struct {
+	Start              string          `json:"start"`
+	End                string          `json:"end"`
+	OpeningBalance     decimal.Decimal `json:"opening_balance"`
+	TotalInflows       decimal.Decimal `json:"total_inflows"`
+	TotalOutflows      decimal.Decimal `json:"total_outflows"`
+	ClosingBalance     decimal.Decimal `json:"closing_balance"`
+	OverduePayables    decimal.Decimal `json:"overdue_payables"`
+	OverdueReceivables decimal.Decimal `json:"overdue_receivables"`
+	Days               []CashFlowDay   `json:"days"`
+}
+
+// CashFlowDay holds the flows of one day. ExpectedRevenue is the average order revenue of the weekday,
+// only on days after today; Balance is the cumulative balance at the end of the day.
+type CashFlowDay struct {
+	Date            string          `json:"date"`
+	Receivables     decimal.Decimal `json:"receivables"`
+	ExpectedRevenue decimal.Decimal `json:"expected_revenue"`
+	Payables        decimal.Decimal `json:"payables"`
+	Payroll         decimal.Decimal `json:"payroll"`
+	Inflows         decimal.Decimal `json:"inflows"`
+	Outflows        decimal.Decimal `json:"outflows"`
+	Net             decimal.Decimal `json:"net"`
+	Balance         decimal.Decimal `json:"balance"`
+}
+
+// CSVRecords returns the header and one line per day.
+func (r *CashFlowProjectionResponse) CSVRecords() [][]string {
+	records := [][]string{{"data", "a_receber", "receita_prevista", "a_pagar", "folha", "entradas", "saidas", "saldo_do_dia", "saldo_acumulado"}}
+	for _, day := range r.Days {
+		records = append(records, []string{
+			day.Date,
+			day.Receivables.StringFixed(2),
+			day.ExpectedRevenue.StringFixed(2),
+			day.Payables.StringFixed(2),
+			day.Payroll.StringFixed(2),
+			day.Inflows.StringFixed(2),
+			day.Outflows.StringFixed(2),
+			day.Net.StringFixed(2),
+			day.Balance.StringFixed(2),
+		})
+	}
+	return records
+}
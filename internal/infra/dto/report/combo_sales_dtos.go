@@ -0,0 +1,57 @@
+package reportdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ComboSalesRequest filters the combo report by order finish date.
+type ComboSalesRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// ComboSalesResponse holds the combos sold in the period with their components.
+type ComboSalesResponse struct {
+	Quantity decimal.Decimal `json:"quantity"`
+	Revenue  decimal.Decimal `json:"revenue"`
+	Combos   []ComboSales    `json:"combos"`
+}
+
+// ComboSales holds units sold and revenue of one combo.
+type ComboSales struct {
+	ComboID    string                `json:"combo_id"`
+	Name       string                `json:"name"`
+	Quantity   decimal.Decimal       `json:"quantity"`
+	Revenue    decimal.Decimal       `json:"revenue"`
+	Components []ComboComponentSales `json:"components"`
+}
+
+// ComboComponentSales holds quantity and allocated revenue of one product inside a combo.
+type ComboComponentSales struct {
+	ProductID string          `json:"product_id"`
+	Name      string          `json:"name"`
+	Quantity  decimal.Decimal `json:"quantity"`
+	Revenue   decimal.Decimal `json:"revenue"`
+}
+
+// CSVRecords returns the header and one line per combo component.
+func (r *ComboSalesResponse) CSVRecords() [][]string {
+	records := [][]string{{"combo", "nome_combo", "quantidade_combo", "faturamento_combo", "produto", "nome_produto", "quantidade_produto", "faturamento_produto"}}
+	for _, combo := range r.Combos {
+		for _, component := range combo.Components {
+			records = append(records, []string{
+				combo.ComboID,
+				combo.Name,
+				combo.Quantity.String(),
+				combo.Revenue.StringFixed(2),
+				component.ProductID,
+				component.Name,
+				component.Quantity.String(),
+				component.Revenue.StringFixed(2),
+			})
+		}
+	}
+	return records
+}
@@ -0,0 +1,61 @@
+package reportdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	ComparisonPreviousPeriod = "previous_period"
+	ComparisonPreviousYear   = "previous_year"
+	ComparisonCustom         = "custom"
+)
+
+// ReportComparisonRequest is the "compare" field accepted by every report request.
+// Mode is previous_period, previous_year or custom; Start and End are only read by custom.
+type ReportComparisonRequest struct {
+	Mode  string     `json:"mode"`
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+// ReportComparisonResponse holds the report for both periods side by side and the deltas of every numeric value.
+// The periods list the date fields sent to each run (start/end, day, at or month).
+type ReportComparisonResponse struct {
+	Mode             string            `json:"mode"`
+	CurrentPeriod    map[string]string `json:"current_period"`
+	ComparisonPeriod map[string]string `json:"comparison_period"`
+	Current          any               `json:"current"`
+	Comparison       any               `json:"comparison"`
+	Deltas           []ReportDelta     `json:"deltas"`
+}
+
+// ReportDelta compares one numeric value found at the same path in both results.
+// DeltaPercent is relative to the absolute comparison value and is nil when it is zero.
+type ReportDelta struct {
+	Path         string           `json:"path"`
+	Current      decimal.Decimal  `json:"current"`
+	Comparison   decimal.Decimal  `json:"comparison"`
+	Delta        decimal.Decimal  `json:"delta"`
+	DeltaPercent *decimal.Decimal `json:"delta_percent"`
+}
+
+// CSVRecords returns the header and one line per compared value.
+func (r *ReportComparisonResponse) CSVRecords() [][]string {
+	records := [][]string{{"campo", "atual", "comparacao", "variacao", "variacao_percentual"}}
+	for _, delta := range r.Deltas {
+		percent := ""
+		if delta.DeltaPercent != nil {
+			percent = delta.DeltaPercent.StringFixed(2)
+		}
+		records = append(records, []string{
+			delta.Path,
+			delta.Current.String(),
+			delta.Comparison.String(),
+			delta.Delta.String(),
+			percent,
+		})
+	}
+	return records
+}
@@ -0,0 +1,74 @@
+package reportdto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ConsolidatedReportRequest filters the multi-store report by order finish date.
+// CompanyIDs narrows the stores (default: every company where the user has the statistics permission);
+// TopProducts is the ranking size (default 10).
+type ConsolidatedReportRequest struct {
+	Start       time.Time   `json:"start"`
+	End         time.Time   `json:"end"`
+	CompanyIDs  []uuid.UUID `json:"company_ids"`
+	TopProducts int         `json:"top_products"`
+}
+
+// ConsolidatedReportResponse sums sales, ticket, payments and top products of several stores,
+// with the same figures per store.
+type ConsolidatedReportResponse struct {
+	Orders      int                   `json:"orders"`
+	Revenue     decimal.Decimal       `json:"revenue"`
+	AvgTicket   decimal.Decimal       `json:"avg_ticket"`
+	Payments    []ConsolidatedPayment `json:"payments"`
+	TopProducts []ConsolidatedProduct `json:"top_products"`
+	Stores      []ConsolidatedStore   `json:"stores"`
+}
+
+// ConsolidatedStore holds the figures of one store and its share of the consolidated revenue.
+type ConsolidatedStore struct {
+	CompanyID           string                `json:"company_id"`
+	TradeName           string                `json:"trade_name"`
+	Orders              int                   `json:"orders"`
+	Revenue             decimal.Decimal       `json:"revenue"`
+	AvgTicket           decimal.Decimal       `json:"avg_ticket"`
+	RevenueSharePercent decimal.Decimal       `json:"revenue_share_percent"`
+	Payments            []ConsolidatedPayment `json:"payments"`
+	TopProducts         []ConsolidatedProduct `json:"top_products"`
+}
+
+// ConsolidatedPayment holds the total paid with one method and its share of all payments.
+type ConsolidatedPayment struct {
+	Method       string          `json:"method"`
+	Total        decimal.Decimal `json:"total"`
+	SharePercent decimal.Decimal `json:"share_percent"`
+}
+
+// ConsolidatedProduct holds units and revenue of one product name; Stores counts the stores that sold it.
+type ConsolidatedProduct struct {
+	Name     string          `json:"name"`
+	Quantity decimal.Decimal `json:"quantity"`
+	Revenue  decimal.Decimal `json:"revenue"`
+	Stores   int             `json:"stores"`
+}
+
+// CSVRecords returns the header, one line per store and the consolidated total.
+func (r *ConsolidatedReportResponse) CSVRecords() [][]string {
+	records := [][]string{{"empresa", "loja", "pedidos", "faturamento", "ticket_medio", "participacao_percentual"}}
+	for _, store := range r.Stores {
+		records = append(records, []string{
+			store.CompanyID,
+			store.TradeName,
+			strconv.Itoa(store.Orders),
+			store.Revenue.StringFixed(2),
+			store.AvgTicket.StringFixed(2),
+			store.RevenueSharePercent.StringFixed(2),
+		})
+	}
+	records = append(records, []string{"", "Consolidado", strconv.Itoa(r.Orders), r.Revenue.StringFixed(2), r.AvgTicket.StringFixed(2), "100.00"})
+	return records
+}
@@ -0,0 +1,121 @@
+package reportdto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MenuEngineeringRequest filters the menu engineering report by order finish date.
+type MenuEngineeringRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// MenuEngineeringResponse classifies the variations sold in the period by popularity and contribution margin.
+// PopularityThreshold is in units and MarginThreshold is the average unit margin weighted by units sold.
+type MenuEngineeringResponse struct {
+	Quantity            decimal.Decimal       `json:"quantity"`
+	Revenue             decimal.Decimal       `json:"revenue"`
+	Contribution        decimal.Decimal       `json:"contribution"`
+	PopularityThreshold decimal.Decimal       `json:"popularity_threshold"`
+	MarginThreshold     decimal.Decimal       `json:"margin_threshold"`
+	Classes             map[string]int        `json:"classes"`
+	Items               []MenuEngineeringItem `json:"items"`
+}
+
+// MenuEngineeringItem holds the popularity, unit margin and class of one product variation.
+type MenuEngineeringItem struct {
+	ProductID          string          `json:"product_id"`
+	VariationID        string          `json:"variation_id"`
+	Name               string          `json:"name"`
+	Size               string          `json:"size"`
+	Category           string          `json:"category"`
+	Price              decimal.Decimal `json:"price"`
+	UnitCost           decimal.Decimal `json:"unit_cost"`
+	CostSource         string          `json:"cost_source"`
+	ContributionMargin decimal.Decimal `json:"contribution_margin"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	Revenue            decimal.Decimal `json:"revenue"`
+	Contribution       decimal.Decimal `json:"contribution"`
+	MenuMixPercent     decimal.Decimal `json:"menu_mix_percent"`
+	Class              string          `json:"class"`
+}
+
+// CSVRecords returns the header and one line per variation.
+func (r *MenuEngineeringResponse) CSVRecords() [][]string {
+	records := [][]string{{"produto", "variacao", "nome", "tamanho", "categoria", "preco", "custo_unitario", "origem_custo", "margem_unitaria", "quantidade", "receita", "contribuicao", "mix_percentual", "classe"}}
+	for _, item := range r.Items {
+		records = append(records, []string{
+			item.ProductID,
+			item.VariationID,
+			item.Name,
+			item.Size,
+			item.Category,
+			item.Price.StringFixed(2),
+			item.UnitCost.StringFixed(2),
+			item.CostSource,
+			item.ContributionMargin.StringFixed(2),
+			item.Quantity.String(),
+			item.Revenue.StringFixed(2),
+			item.Contribution.StringFixed(2),
+			item.MenuMixPercent.StringFixed(2),
+			item.Class,
+		})
+	}
+	return records
+}
+
+// AbcCurveRequest filters the ABC curve by order finish date.
+type AbcCurveRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// AbcCurveResponse holds the products ranked by revenue with their cumulative share and class.
+type AbcCurveResponse struct {
+	Revenue  decimal.Decimal   `json:"revenue"`
+	Classes  []AbcCurveClass   `json:"classes"`
+	Products []AbcCurveProduct `json:"products"`
+}
+
+// AbcCurveClass sums the products and revenue of one class (A, B or C).
+type AbcCurveClass struct {
+	Class        string          `json:"class"`
+	Products     int             `json:"products"`
+	Revenue      decimal.Decimal `json:"revenue"`
+	SharePercent decimal.Decimal `json:"share_percent"`
+}
+
+// AbcCurveProduct holds the revenue share of one product and the cumulative share up to it.
+type AbcCurveProduct struct {
+	Rank              int             `json:"rank"`
+	ProductID         string          `json:"product_id"`
+	Name              string          `json:"name"`
+	Category          string          `json:"category"`
+	Quantity          decimal.Decimal `json:"quantity"`
+	Revenue           decimal.Decimal `json:"revenue"`
+	SharePercent      decimal.Decimal `json:"share_percent"`
+	CumulativePercent decimal.Decimal `json:"cumulative_percent"`
+	Class             string          `json:"class"`
+}
+
+// CSVRecords returns the header and one line per product, in curve order.
+func (r *AbcCurveResponse) CSVRecords() [][]string {
+	records := [][]string{{"posicao", "produto", "nome", "categoria", "quantidade", "receita", "participacao_percentual", "acumulado_percentual", "classe"}}
+	for _, product := range r.Products {
+		records = append(records, []string{
+			strconv.Itoa(product.Rank),
+			product.ProductID,
+			product.Name,
+			product.Category,
+			product.Quantity.String(),
+			product.Revenue.StringFixed(2),
+			product.SharePercent.StringFixed(2),
+			product.CumulativePercent.StringFixed(2),
+			product.Class,
+		})
+	}
+	return records
+}
@@ -0,0 +1,50 @@
+package reportdto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceListSalesRequest filters the price list report by order finish date.
+type PriceListSalesRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// PriceListSalesResponse holds the sales per applied price list.
+type PriceListSalesResponse struct {
+	Revenue    decimal.Decimal  `json:"revenue"`
+	Discount   decimal.Decimal  `json:"discount"`
+	PriceLists []PriceListSales `json:"price_lists"`
+}
+
+// PriceListSales holds the items sold under one price list; items at the variation price have no id.
+// Discount is the difference to the variation price (negative when the list raised the price).
+type PriceListSales struct {
+	PriceListID string          `json:"price_list_id,omitempty"`
+	Name        string          `json:"name"`
+	Items       int             `json:"items"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	Revenue     decimal.Decimal `json:"revenue"`
+	ListRevenue decimal.Decimal `json:"list_revenue"`
+	Discount    decimal.Decimal `json:"discount"`
+}
+
+// CSVRecords returns the header and one line per price list.
+func (r *PriceListSalesResponse) CSVRecords() [][]string {
+	records := [][]string{{"lista", "nome", "itens", "quantidade", "faturamento", "faturamento_preco_padrao", "desconto"}}
+	for _, priceList := range r.PriceLists {
+		records = append(records, []string{
+			priceList.PriceListID,
+			priceList.Name,
+			strconv.Itoa(priceList.Items),
+			priceList.Quantity.String(),
+			priceList.Revenue.StringFixed(2),
+			priceList.ListRevenue.StringFixed(2),
+			priceList.Discount.StringFixed(2),
+		})
+	}
+	return records
+}
@@ -0,0 +1,58 @@
+package reportdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ProfitAndLossRequest selects the DRE month as YYYY-MM; when empty the month of start is used.
+type ProfitAndLossRequest struct {
+	Month string    `json:"month"`
+	Start time.Time `json:"start"`
+}
+
+// ProfitAndLossResponse holds the DRE lines of the month next to the previous month and the same month last year.
+type ProfitAndLossResponse struct {
+	Month         string              `json:"month"`
+	PreviousMonth string              `json:"previous_month"`
+	LastYear      string              `json:"last_year"`
+	Lines         []ProfitAndLossLine `json:"lines"`
+}
+
+// ProfitAndLossLine holds one DRE line; deductions and expenses are negative.
+// Changes are in percent over the compared month, or in percentage points on margin lines,
+// and are omitted when the compared value is zero.
+type ProfitAndLossLine struct {
+	Key                   string           `json:"key"`
+	Label                 string           `json:"label"`
+	Current               decimal.Decimal  `json:"current"`
+	PreviousMonth         decimal.Decimal  `json:"previous_month"`
+	LastYear              decimal.Decimal  `json:"last_year"`
+	ChangeVsPreviousMonth *decimal.Decimal `json:"change_vs_previous_month,omitempty"`
+	ChangeVsLastYear      *decimal.Decimal `json:"change_vs_last_year,omitempty"`
+}
+
+// CSVRecords returns the header and one line per DRE line.
+func (r *ProfitAndLossResponse) CSVRecords() [][]string {
+	records := [][]string{{"linha", "descricao", r.Month, r.PreviousMonth, r.LastYear, "variacao_mes_anterior", "variacao_ano_anterior"}}
+	for _, line := range r.Lines {
+		records = append(records, []string{
+			line.Key,
+			line.Label,
+			line.Current.StringFixed(2),
+			line.PreviousMonth.StringFixed(2),
+			line.LastYear.StringFixed(2),
+			optionalFixed(line.ChangeVsPreviousMonth),
+			optionalFixed(line.ChangeVsLastYear),
+		})
+	}
+	return records
+}
+
+func optionalFixed(d *decimal.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.StringFixed(2)
+}
@@ -416,3 +416,20 @@ type ProcessedCountByRuleResponse struct {
 	ProcessRuleName string `json:"process_rule_name"`
 	Count           int    `json:"count"`
 }
+
+// StockLossesRequest filters for the loss report; group_by is reason (default), product, employee or day.
+type StockLossesRequest struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	GroupBy string    `json:"group_by"`
+	Reason  string    `json:"reason,omitempty"`
+}
+
+// StockLossesResponse holds loss count, quantity and cost for one group.
+type StockLossesResponse struct {
+	Key       string          `json:"key"`
+	Label     string          `json:"label"`
+	Count     int             `json:"count"`
+	Quantity  decimal.Decimal `json:"quantity"`
+	TotalCost decimal.Decimal `json:"total_cost"`
+}
@@ -0,0 +1,120 @@
+package reportdto
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SalesForecastRequest selects the forecast days from start (default today) in the company time zone.
+// Days defaults to 7; OrdersPerEmployeeHour is how many orders one attendant handles per hour (default 12).
+type SalesForecastRequest struct {
+	Start                 time.Time       `json:"start"`
+	Days                  int             `json:"days"`
+	OrdersPerEmployeeHour decimal.Decimal `json:"orders_per_employee_hour"`
+}
+
+// SalesForecastResponse holds the forecast per day, hour, product and process rule.
+// Days already closed carry the actual figures, and Accuracy compares them with the forecast.
+type SalesForecastResponse struct {
+	Start              string                 `json:"start"`
+	End                string                 `json:"end"`
+	HistoryWeeks       int                    `json:"history_weeks"`
+	WeeklyTrendPercent decimal.Decimal        `json:"weekly_trend_percent"`
+	Orders             decimal.Decimal        `json:"orders"`
+	Revenue            decimal.Decimal        `json:"revenue"`
+	Accuracy           SalesForecastAccuracy  `json:"accuracy"`
+	Days               []SalesForecastDay     `json:"days"`
+	Products           []SalesForecastProduct `json:"products"`
+	PrepList           []SalesForecastPrep    `json:"prep_list"`
+}
+
+// SalesForecastAccuracy compares forecast and actuals over the closed days of the period.
+// OrdersErrorPercent is the mean absolute percentage error per day; UnitsErrorPercent weighs every
+// product-day by its actual units. Both are zero while no day has closed.
+type SalesForecastAccuracy struct {
+	ClosedDays         int             `json:"closed_days"`
+	ForecastOrders     decimal.Decimal `json:"forecast_orders"`
+	ActualOrders       int             `json:"actual_orders"`
+	OrdersErrorPercent decimal.Decimal `json:"orders_error_percent"`
+	UnitsErrorPercent  decimal.Decimal `json:"units_error_percent"`
+}
+
+// SalesForecastDay holds the orders and revenue forecast for one day; actuals are nil until the day closes.
+type SalesForecastDay struct {
+	Date          string              `json:"date"`
+	Orders        decimal.Decimal     `json:"orders"`
+	Revenue       decimal.Decimal     `json:"revenue"`
+	ActualOrders  *int                `json:"actual_orders"`
+	ActualRevenue *decimal.Decimal    `json:"actual_revenue"`
+	Hours         []SalesForecastHour `json:"hours"`
+}
+
+// SalesForecastHour holds the orders forecast for one hour and the suggested staff:
+// production covers the ideal time of the process rules, service the orders per attendant.
+type SalesForecastHour struct {
+	Hour            int             `json:"hour"`
+	Orders          decimal.Decimal `json:"orders"`
+	ActualOrders    *int            `json:"actual_orders"`
+	ProductionStaff int             `json:"production_staff"`
+	ServiceStaff    int             `json:"service_staff"`
+	SuggestedStaff  int             `json:"suggested_staff"`
+}
+
+// SalesForecastProduct holds the units forecast for one product over the period and per day.
+type SalesForecastProduct struct {
+	ProductID   string                    `json:"product_id"`
+	Name        string                    `json:"name"`
+	Units       decimal.Decimal           `json:"units"`
+	ActualUnits *decimal.Decimal          `json:"actual_units"`
+	Days        []SalesForecastProductDay `json:"days"`
+}
+
+// SalesForecastProductDay holds the units forecast for one product on one day.
+type SalesForecastProductDay struct {
+	Date        string           `json:"date"`
+	Units       decimal.Decimal  `json:"units"`
+	ActualUnits *decimal.Decimal `json:"actual_units"`
+}
+
+// SalesForecastPrep is one line of the prep list: the units one process rule should handle on one day.
+type SalesForecastPrep struct {
+	Date            string                  `json:"date"`
+	ProcessRuleID   string                  `json:"process_rule_id"`
+	Name            string                  `json:"name"`
+	Order           int                     `json:"order"`
+	Units           decimal.Decimal         `json:"units"`
+	WorkloadMinutes decimal.Decimal         `json:"workload_minutes"`
+	Products        []SalesForecastPrepItem `json:"products"`
+}
+
+// SalesForecastPrepItem holds the units of one product in a prep list line.
+type SalesForecastPrepItem struct {
+	ProductID string          `json:"product_id"`
+	Name      string          `json:"name"`
+	Units     decimal.Decimal `json:"units"`
+}
+
+// CSVRecords returns the header and one line per forecast hour.
+func (r *SalesForecastResponse) CSVRecords() [][]string {
+	records := [][]string{{"data", "hora", "pedidos_previstos", "pedidos_realizados", "equipe_producao", "equipe_atendimento", "equipe_sugerida"}}
+	for _, day := range r.Days {
+		for _, hour := range day.Hours {
+			actual := ""
+			if hour.ActualOrders != nil {
+				actual = strconv.Itoa(*hour.ActualOrders)
+			}
+			records = append(records, []string{
+				day.Date,
+				strconv.Itoa(hour.Hour),
+				hour.Orders.String(),
+				actual,
+				strconv.Itoa(hour.ProductionStaff),
+				strconv.Itoa(hour.ServiceStaff),
+				strconv.Itoa(hour.SuggestedStaff),
+			})
+		}
+	}
+	return records
+}
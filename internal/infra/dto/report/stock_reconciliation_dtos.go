@@ -0,0 +1,53 @@
+package reportdto
+
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// StockReconciliationRequest filters the reconciliation report.
+type StockReconciliationRequest struct {
+	OnlyDrift bool `json:"only_drift"`
+}
+
+// StockReconciliationResponse holds the stored balances checked against the movement ledger.
+type StockReconciliationResponse struct {
+	DriftCount int                       `json:"drift_count"`
+	Items      []StockReconciliationItem `json:"items"`
+}
+
+// StockReconciliationItem compares stored and ledger balances of one stock.
+// An opening balance typed when the stock was created has no movement and shows as current drift.
+type StockReconciliationItem struct {
+	StockID          string          `json:"stock_id"`
+	Description      string          `json:"description"`
+	Unit             string          `json:"unit"`
+	CurrentStock     decimal.Decimal `json:"current_stock"`
+	ExpectedCurrent  decimal.Decimal `json:"expected_current"`
+	CurrentDrift     decimal.Decimal `json:"current_drift"`
+	ReservedStock    decimal.Decimal `json:"reserved_stock"`
+	ExpectedReserved decimal.Decimal `json:"expected_reserved"`
+	ReservedDrift    decimal.Decimal `json:"reserved_drift"`
+	HasDrift         bool            `json:"has_drift"`
+}
+
+// CSVRecords returns the header and one line per stock.
+func (r *StockReconciliationResponse) CSVRecords() [][]string {
+	records := [][]string{{"estoque", "descricao", "unidade", "atual", "atual_esperado", "diferenca_atual", "reservado", "reservado_esperado", "diferenca_reservado", "divergente"}}
+	for _, item := range r.Items {
+		records = append(records, []string{
+			item.StockID,
+			item.Description,
+			item.Unit,
+			item.CurrentStock.String(),
+			item.ExpectedCurrent.String(),
+			item.CurrentDrift.String(),
+			item.ReservedStock.String(),
+			item.ExpectedReserved.String(),
+			item.ReservedDrift.String(),
+			strconv.FormatBool(item.HasDrift),
+		})
+	}
+	return records
+}
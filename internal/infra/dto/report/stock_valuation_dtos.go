@@ -0,0 +1,127 @@
+package reportdto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// StockValuationRequest asks for the inventory value at a date (zero = now).
+type StockValuationRequest struct {
+	At time.Time `json:"at"`
+}
+
+// StockValuationResponse holds the inventory valued at batch cost.
+type StockValuationResponse struct {
+	At         time.Time            `json:"at"`
+	TotalValue decimal.Decimal      `json:"total_value"`
+	Items      []StockValuationItem `json:"items"`
+}
+
+// StockValuationItem holds quantity, average cost and value of one stock.
+type StockValuationItem struct {
+	StockID     string          `json:"stock_id"`
+	Description string          `json:"description"`
+	Unit        string          `json:"unit"`
+	Quantity    decimal.Decimal `json:"quantity"`
+	AvgCost     decimal.Decimal `json:"avg_cost"`
+	TotalValue  decimal.Decimal `json:"total_value"`
+}
+
+// CSVRecords returns the header and one line per stock.
+func (r *StockValuationResponse) CSVRecords() [][]string {
+	records := [][]string{{"estoque", "descricao", "unidade", "quantidade", "custo_medio", "valor_total"}}
+	for _, item := range r.Items {
+		records = append(records, []string{
+			item.StockID,
+			item.Description,
+			item.Unit,
+			item.Quantity.String(),
+			item.AvgCost.StringFixed(2),
+			item.TotalValue.StringFixed(2),
+		})
+	}
+	return records
+}
+
+// CostOfGoodsSoldRequest filters the COGS report by debit date.
+type CostOfGoodsSoldRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// CostOfGoodsSoldResponse holds the COGS per day and the period total.
+type CostOfGoodsSoldResponse struct {
+	Total decimal.Decimal        `json:"total"`
+	Days  []CostOfGoodsSoldByDay `json:"days"`
+}
+
+// CostOfGoodsSoldByDay holds quantity debited and its batch cost for one day.
+type CostOfGoodsSoldByDay struct {
+	Day      string          `json:"day"`
+	Quantity decimal.Decimal `json:"quantity"`
+	Cost     decimal.Decimal `json:"cost"`
+}
+
+// CSVRecords returns the header and one line per day.
+func (r *CostOfGoodsSoldResponse) CSVRecords() [][]string {
+	records := [][]string{{"dia", "quantidade", "cmv"}}
+	for _, day := range r.Days {
+		records = append(records, []string{day.Day, day.Quantity.String(), day.Cost.StringFixed(2)})
+	}
+	return records
+}
+
+// GrossMarginRequest filters the margin report by order finish date.
+type GrossMarginRequest struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// GrossMarginResponse holds the margin per product and the period totals.
+type GrossMarginResponse struct {
+	Revenue       decimal.Decimal        `json:"revenue"`
+	Cost          decimal.Decimal        `json:"cost"`
+	GrossProfit   decimal.Decimal        `json:"gross_profit"`
+	MarginPercent decimal.Decimal        `json:"margin_percent"`
+	Products      []GrossMarginByProduct `json:"products"`
+}
+
+// GrossMarginByProduct holds revenue, batch cost and margin of one product.
+// UntrackedQuantity was debited without a batch and has no cost, inflating the margin.
+type GrossMarginByProduct struct {
+	ProductID         string          `json:"product_id"`
+	Name              string          `json:"name"`
+	Quantity          decimal.Decimal `json:"quantity"`
+	Revenue           decimal.Decimal `json:"revenue"`
+	Cost              decimal.Decimal `json:"cost"`
+	GrossProfit       decimal.Decimal `json:"gross_profit"`
+	MarginPercent     decimal.Decimal `json:"margin_percent"`
+	UntrackedQuantity decimal.Decimal `json:"untracked_quantity"`
+}
+
+// CSVRecords returns the header and one line per product.
+func (r *GrossMarginResponse) CSVRecords() [][]string {
+	records := [][]string{{"produto", "nome", "quantidade", "receita", "custo", "lucro_bruto", "margem_percentual", "quantidade_sem_custo"}}
+	for _, product := range r.Products {
+		records = append(records, []string{
+			product.ProductID,
+			product.Name,
+			product.Quantity.String(),
+			product.Revenue.StringFixed(2),
+			product.Cost.StringFixed(2),
+			product.GrossProfit.StringFixed(2),
+			product.MarginPercent.StringFixed(2),
+			product.UntrackedQuantity.String(),
+		})
+	}
+	return records
+}
+
+// MarginPercent returns profit over revenue in percent, zero when there is no revenue.
+func MarginPercent(profit, revenue decimal.Decimal) decimal.Decimal {
+	if revenue.IsZero() {
+		return decimal.Zero
+	}
+	return profit.Div(revenue).Mul(decimal.NewFromInt(100)).Round(2)
+}
@@ -0,0 +1,85 @@
+package reportsubscriptiondto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	reportsubscriptionentity "github.com/willjrcom/sales-backend-go/internal/domain/report_subscription"
+)
+
+type ReportSubscriptionCreateDTO struct {
+	Name       string                          `json:"name"`
+	ReportType string                          `json:"report_type"`
+	Params     map[string]any                  `json:"params"`
+	Cadence    CadenceDTO                      `json:"cadence"`
+	Recipients []string                        `json:"recipients"`
+	Format     reportsubscriptionentity.Format `json:"format"`
+}
+
+func (d *ReportSubscriptionCreateDTO) ToDomain(userID uuid.UUID, now time.Time) (*reportsubscriptionentity.ReportSubscription, error) {
+	return reportsubscriptionentity.NewReportSubscription(reportsubscriptionentity.ReportSubscriptionCommonAttributes{
+		UserID:     userID,
+		Name:       d.Name,
+		ReportType: d.ReportType,
+		Params:     d.Params,
+		Cadence:    d.Cadence.ToDomain(),
+		Recipients: d.Recipients,
+		Format:     d.Format,
+	}, now)
+}
+
+// ReportSubscriptionUpdateDTO substitui parâmetros e destinatários inteiros quando enviados.
+// Mudar a cadência ou reativar a assinatura reagenda o próximo envio.
+type ReportSubscriptionUpdateDTO struct {
+	Name       *string                          `json:"name"`
+	ReportType *string                          `json:"report_type"`
+	Params     map[string]any                   `json:"params"`
+	Cadence    *CadenceDTO                      `json:"cadence"`
+	Recipients []string                         `json:"recipients"`
+	Format     *reportsubscriptionentity.Format `json:"format"`
+	IsActive   *bool                            `json:"is_active"`
+}
+
+func (d *ReportSubscriptionUpdateDTO) UpdateDomain(subscription *reportsubscriptionentity.ReportSubscription, now time.Time) error {
+	reschedule := false
+
+	if d.Name != nil {
+		subscription.Name = *d.Name
+	}
+
+	if d.ReportType != nil {
+		subscription.ReportType = *d.ReportType
+	}
+
+	if d.Params != nil {
+		subscription.Params = d.Params
+	}
+
+	if d.Cadence != nil {
+		subscription.Cadence = d.Cadence.ToDomain()
+		reschedule = true
+	}
+
+	if d.Recipients != nil {
+		subscription.Recipients = d.Recipients
+	}
+
+	if d.Format != nil {
+		subscription.Format = *d.Format
+	}
+
+	if d.IsActive != nil {
+		reschedule = reschedule || (*d.IsActive && !subscription.IsActive)
+		subscription.IsActive = *d.IsActive
+	}
+
+	if err := subscription.Validate(); err != nil {
+		return err
+	}
+
+	if reschedule {
+		subscription.Reschedule(now)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package reportsubscriptiondto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	reportsubscriptionentity "github.com/willjrcom/sales-backend-go/internal/domain/report_subscription"
+)
+
+type ReportSubscriptionDTO struct {
+	ID         uuid.UUID                       `json:"id"`
+	UserID     uuid.UUID                       `json:"user_id"`
+	Name       string                          `json:"name"`
+	ReportType string                          `json:"report_type"`
+	Params     map[string]any                  `json:"params"`
+	Cadence    CadenceDTO                      `json:"cadence"`
+	Recipients []string                        `json:"recipients"`
+	Format     reportsubscriptionentity.Format `json:"format"`
+	IsActive   bool                            `json:"is_active"`
+	NextRunAt  time.Time                       `json:"next_run_at"`
+	LastRunAt  *time.Time                      `json:"last_run_at,omitempty"`
+}
+
+// CadenceDTO: weekday (0 = domingo) só no semanal e day_of_month só no mensal
+type CadenceDTO struct {
+	Frequency  reportsubscriptionentity.Frequency `json:"frequency"`
+	Hour       int                                `json:"hour"`
+	Weekday    int                                `json:"weekday,omitempty"`
+	DayOfMonth int                                `json:"day_of_month,omitempty"`
+}
+
+func (d CadenceDTO) ToDomain() reportsubscriptionentity.Cadence {
+	return reportsubscriptionentity.Cadence{
+		Frequency:  d.Frequency,
+		Hour:       d.Hour,
+		Weekday:    d.Weekday,
+		DayOfMonth: d.DayOfMonth,
+	}
+}
+
+func (d *ReportSubscriptionDTO) FromDomain(subscription *reportsubscriptionentity.ReportSubscription) {
+	if subscription == nil {
+		return
+	}
+	*d = ReportSubscriptionDTO{
+		ID:         subscription.ID,
+		UserID:     subscription.UserID,
+		Name:       subscription.Name,
+		ReportType: subscription.ReportType,
+		Params:     subscription.Params,
+		Cadence: CadenceDTO{
+			Frequency:  subscription.Cadence.Frequency,
+			Hour:       subscription.Cadence.Hour,
+			Weekday:    subscription.Cadence.Weekday,
+			DayOfMonth: subscription.Cadence.DayOfMonth,
+		},
+		Recipients: subscription.Recipients,
+		Format:     subscription.Format,
+		IsActive:   subscription.IsActive,
+		NextRunAt:  subscription.NextRunAt,
+		LastRunAt:  subscription.LastRunAt,
+	}
+}
+
+type DeliveryDTO struct {
+	ID             uuid.UUID                               `json:"id"`
+	SubscriptionID uuid.UUID                               `json:"subscription_id"`
+	CreatedAt      time.Time                               `json:"created_at"`
+	PeriodStart    time.Time                               `json:"period_start"`
+	PeriodEnd      time.Time                               `json:"period_end"`
+	Recipients     []string                                `json:"recipients"`
+	Format         reportsubscriptionentity.Format         `json:"format"`
+	Filename       string                                  `json:"filename,omitempty"`
+	Status         reportsubscriptionentity.DeliveryStatus `json:"status"`
+	Error          string                                  `json:"error,omitempty"`
+	Manual         bool                                    `json:"manual"`
+}
+
+func (d *DeliveryDTO) FromDomain(delivery *reportsubscriptionentity.Delivery) {
+	if delivery == nil {
+		return
+	}
+	*d = DeliveryDTO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		CreatedAt:      delivery.CreatedAt,
+		PeriodStart:    delivery.PeriodStart,
+		PeriodEnd:      delivery.PeriodEnd,
+		Recipients:     delivery.Recipients,
+		Format:         delivery.Format,
+		Filename:       delivery.Filename,
+		Status:         delivery.Status,
+		Error:          delivery.Error,
+		Manual:         delivery.Manual,
+	}
+}
@@ -17,6 +17,7 @@ type StockBatchDTO struct {
 	CurrentQuantity    decimal.Decimal `json:"current_quantity"`
 	CostPrice          decimal.Decimal `json:"cost_price"`
 	ExpiresAt          *time.Time      `json:"expires_at,omitempty"`
+	LocationID         *uuid.UUID      `json:"location_id,omitempty"`
 	CreatedAt          time.Time       `json:"created_at"`
 }
 
@@ -31,5 +32,6 @@ func (d *StockBatchDTO) FromDomain(b *stockentity.StockBatch) {
 	d.CurrentQuantity = b.CurrentQuantity
 	d.CostPrice = b.CostPrice
 	d.ExpiresAt = b.ExpiresAt
+	d.LocationID = b.LocationID
 	d.CreatedAt = b.CreatedAt
 }
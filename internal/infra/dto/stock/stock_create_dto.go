@@ -15,11 +15,12 @@ type StockCreateDTO struct {
 	MaxStock           decimal.Decimal `json:"max_stock"`
 	Unit               string          `json:"unit"`
 	IsActive           bool            `json:"is_active"`
+	AutoAvailability   bool            `json:"auto_availability"`
 }
 
 // ToDomain converte DTO para domain
 func (s *StockCreateDTO) ToDomain() *stockentity.Stock {
-	return stockentity.NewStock(
+	stock := stockentity.NewStock(
 		s.ProductID,
 		s.ProductVariationID,
 		s.CurrentStock,
@@ -27,4 +28,6 @@ func (s *StockCreateDTO) ToDomain() *stockentity.Stock {
 		s.MaxStock,
 		s.Unit,
 	)
+	stock.AutoAvailability = s.AutoAvailability
+	return stock
 }
@@ -21,6 +21,7 @@ type StockDTO struct {
 	MaxStock           decimal.Decimal               `json:"max_stock"`
 	Unit               string                        `json:"unit"`
 	IsActive           bool                          `json:"is_active"`
+	AutoAvailability   bool                          `json:"auto_availability"`
 	CreatedAt          time.Time                     `json:"created_at"`
 	UpdatedAt          time.Time                     `json:"updated_at"`
 }
@@ -47,6 +48,7 @@ func (s *StockDTO) FromDomain(stock *stockentity.Stock) {
 		MaxStock:           stock.MaxStock,
 		Unit:               stock.Unit,
 		IsActive:           stock.IsActive,
+		AutoAvailability:   stock.AutoAvailability,
 		CreatedAt:          stock.CreatedAt,
 		UpdatedAt:          stock.UpdatedAt,
 	}
@@ -10,15 +10,22 @@ import (
 
 // StockMovementDTO representa o DTO de movimento de estoque
 type StockMovementDTO struct {
-	ID         uuid.UUID       `json:"id"`
-	StockID    uuid.UUID       `json:"stock_id"`
-	Type       string          `json:"type"`
-	Reason     string          `json:"reason"`
-	OrderID    *uuid.UUID      `json:"order_id,omitempty"`
-	EmployeeID uuid.UUID       `json:"employee_id,omitempty"`
-	Quantity   decimal.Decimal `json:"quantity"`
-	Price      decimal.Decimal `json:"unit_cost"`
-	CreatedAt  time.Time       `json:"created_at"`
+	ID                uuid.UUID       `json:"id"`
+	StockID           uuid.UUID       `json:"stock_id"`
+	Type              string          `json:"type"`
+	Reason            string          `json:"reason"`
+	OrderID           *uuid.UUID      `json:"order_id,omitempty"`
+	PurchaseOrderID   *uuid.UUID      `json:"purchase_order_id,omitempty"`
+	SupplierInvoiceID *uuid.UUID      `json:"supplier_invoice_id,omitempty"`
+	InventoryCountID  *uuid.UUID      `json:"inventory_count_id,omitempty"`
+	StockLossID       *uuid.UUID      `json:"stock_loss_id,omitempty"`
+	LocationID        *uuid.UUID      `json:"location_id,omitempty"`
+	TransferID        *uuid.UUID      `json:"transfer_id,omitempty"`
+	ExpiresAt         *time.Time      `json:"expires_at,omitempty"`
+	EmployeeID        uuid.UUID       `json:"employee_id,omitempty"`
+	Quantity          decimal.Decimal `json:"quantity"`
+	Price             decimal.Decimal `json:"unit_cost"`
+	CreatedAt         time.Time       `json:"created_at"`
 }
 
 // FromDomain converte domain para DTO
@@ -27,14 +34,21 @@ func (sm *StockMovementDTO) FromDomain(movement *stockentity.StockMovement) {
 		return
 	}
 	*sm = StockMovementDTO{
-		ID:         movement.ID,
-		StockID:    movement.StockID,
-		Type:       string(movement.Type),
-		Quantity:   movement.Quantity,
-		Reason:     movement.Reason,
-		OrderID:    movement.OrderID,
-		EmployeeID: movement.EmployeeID,
-		Price:      movement.Price,
-		CreatedAt:  movement.CreatedAt,
+		ID:                movement.ID,
+		StockID:           movement.StockID,
+		Type:              string(movement.Type),
+		Quantity:          movement.Quantity,
+		Reason:            movement.Reason,
+		OrderID:           movement.OrderID,
+		PurchaseOrderID:   movement.PurchaseOrderID,
+		SupplierInvoiceID: movement.SupplierInvoiceID,
+		InventoryCountID:  movement.InventoryCountID,
+		StockLossID:       movement.StockLossID,
+		LocationID:        movement.LocationID,
+		TransferID:        movement.TransferID,
+		ExpiresAt:         movement.ExpiresAt,
+		EmployeeID:        movement.EmployeeID,
+		Price:             movement.Price,
+		CreatedAt:         movement.CreatedAt,
 	}
 }
@@ -6,10 +6,11 @@ import (
 )
 
 type StockUpdateDTO struct {
-	MinStock *decimal.Decimal `json:"min_stock,omitempty"`
-	MaxStock *decimal.Decimal `json:"max_stock,omitempty"`
-	Unit     *string          `json:"unit,omitempty"`
-	IsActive *bool            `json:"is_active,omitempty"`
+	MinStock         *decimal.Decimal `json:"min_stock,omitempty"`
+	MaxStock         *decimal.Decimal `json:"max_stock,omitempty"`
+	Unit             *string          `json:"unit,omitempty"`
+	IsActive         *bool            `json:"is_active,omitempty"`
+	AutoAvailability *bool            `json:"auto_availability,omitempty"`
 }
 
 func (s *StockUpdateDTO) Validate() error {
@@ -33,6 +34,9 @@ func (s *StockUpdateDTO) UpdateDomain(stock *stockentity.Stock) (err error) {
 	if s.IsActive != nil {
 		stock.IsActive = *s.IsActive
 	}
+	if s.AutoAvailability != nil {
+		stock.AutoAvailability = *s.AutoAvailability
+	}
 
 	return nil
 }
@@ -0,0 +1,63 @@
+package stocklocationdto
+
+import (
+	"github.com/google/uuid"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+)
+
+// StockLocationCreateDTO cadastra um local; category_ids e process_rule_ids definem
+// quais vendas debitam deste local.
+type StockLocationCreateDTO struct {
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	IsDefault      bool        `json:"is_default"`
+	CategoryIDs    []uuid.UUID `json:"category_ids"`
+	ProcessRuleIDs []uuid.UUID `json:"process_rule_ids"`
+}
+
+func (d *StockLocationCreateDTO) ToDomain() (*stocklocationentity.StockLocation, error) {
+	return stocklocationentity.NewStockLocation(stocklocationentity.StockLocationCommonAttributes{
+		Name:           d.Name,
+		Description:    d.Description,
+		IsDefault:      d.IsDefault,
+		CategoryIDs:    d.CategoryIDs,
+		ProcessRuleIDs: d.ProcessRuleIDs,
+	})
+}
+
+type StockLocationUpdateDTO struct {
+	Name           *string     `json:"name"`
+	Description    *string     `json:"description"`
+	IsDefault      *bool       `json:"is_default"`
+	IsActive       *bool       `json:"is_active"`
+	CategoryIDs    []uuid.UUID `json:"category_ids"`
+	ProcessRuleIDs []uuid.UUID `json:"process_rule_ids"`
+}
+
+func (d *StockLocationUpdateDTO) UpdateDomain(location *stocklocationentity.StockLocation) error {
+	if d.Name != nil {
+		location.Name = *d.Name
+	}
+
+	if d.Description != nil {
+		location.Description = *d.Description
+	}
+
+	if d.IsDefault != nil {
+		location.IsDefault = *d.IsDefault
+	}
+
+	if d.IsActive != nil {
+		location.IsActive = *d.IsActive
+	}
+
+	if d.CategoryIDs != nil {
+		location.CategoryIDs = d.CategoryIDs
+	}
+
+	if d.ProcessRuleIDs != nil {
+		location.ProcessRuleIDs = d.ProcessRuleIDs
+	}
+
+	return location.Validate()
+}
@@ -0,0 +1,50 @@
+package stocklocationdto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+)
+
+type StockLocationDTO struct {
+	ID             uuid.UUID   `json:"id"`
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	IsDefault      bool        `json:"is_default"`
+	IsActive       bool        `json:"is_active"`
+	CategoryIDs    []uuid.UUID `json:"category_ids"`
+	ProcessRuleIDs []uuid.UUID `json:"process_rule_ids"`
+}
+
+func (d *StockLocationDTO) FromDomain(location *stocklocationentity.StockLocation) {
+	if location == nil {
+		return
+	}
+	*d = StockLocationDTO{
+		ID:             location.ID,
+		Name:           location.Name,
+		Description:    location.Description,
+		IsDefault:      location.IsDefault,
+		IsActive:       location.IsActive,
+		CategoryIDs:    location.CategoryIDs,
+		ProcessRuleIDs: location.ProcessRuleIDs,
+	}
+
+	if d.CategoryIDs == nil {
+		d.CategoryIDs = []uuid.UUID{}
+	}
+
+	if d.ProcessRuleIDs == nil {
+		d.ProcessRuleIDs = []uuid.UUID{}
+	}
+}
+
+// StockLocationBalanceDTO é o saldo de um estoque em um local; lotes sem local
+// aparecem no local padrão ou, sem padrão, com location_id vazio.
+type StockLocationBalanceDTO struct {
+	LocationID   *uuid.UUID      `json:"location_id,omitempty"`
+	LocationName string          `json:"location_name"`
+	Quantity     decimal.Decimal `json:"quantity"`
+	Batches      int             `json:"batches"`
+	Incoming     decimal.Decimal `json:"incoming"`
+}
@@ -0,0 +1,80 @@
+package stocklocationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+)
+
+type StockTransferCreateDTO struct {
+	StockID        uuid.UUID       `json:"stock_id"`
+	FromLocationID uuid.UUID       `json:"from_location_id"`
+	ToLocationID   uuid.UUID       `json:"to_location_id"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	Notes          string          `json:"notes"`
+}
+
+func (d *StockTransferCreateDTO) ToDomain(sentBy uuid.UUID) (*stocklocationentity.StockTransfer, error) {
+	return stocklocationentity.NewStockTransfer(d.StockID, d.FromLocationID, d.ToLocationID, d.Quantity, sentBy, d.Notes)
+}
+
+type StockTransferDTO struct {
+	ID             uuid.UUID              `json:"id"`
+	StockID        uuid.UUID              `json:"stock_id"`
+	FromLocationID uuid.UUID              `json:"from_location_id"`
+	ToLocationID   uuid.UUID              `json:"to_location_id"`
+	Quantity       decimal.Decimal        `json:"quantity"`
+	TotalCost      decimal.Decimal        `json:"total_cost"`
+	Status         string                 `json:"status"`
+	Notes          string                 `json:"notes"`
+	SentBy         uuid.UUID              `json:"sent_by"`
+	ReceivedBy     *uuid.UUID             `json:"received_by,omitempty"`
+	SentAt         time.Time              `json:"sent_at"`
+	ReceivedAt     *time.Time             `json:"received_at,omitempty"`
+	CancelledAt    *time.Time             `json:"cancelled_at,omitempty"`
+	Lines          []StockTransferLineDTO `json:"lines"`
+}
+
+type StockTransferLineDTO struct {
+	ID                 uuid.UUID       `json:"id"`
+	SourceBatchID      *uuid.UUID      `json:"source_batch_id,omitempty"`
+	DestinationBatchID *uuid.UUID      `json:"destination_batch_id,omitempty"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	CostPrice          decimal.Decimal `json:"cost_price"`
+	ExpiresAt          *time.Time      `json:"expires_at,omitempty"`
+}
+
+func (d *StockTransferDTO) FromDomain(transfer *stocklocationentity.StockTransfer) {
+	if transfer == nil {
+		return
+	}
+	*d = StockTransferDTO{
+		ID:             transfer.ID,
+		StockID:        transfer.StockID,
+		FromLocationID: transfer.FromLocationID,
+		ToLocationID:   transfer.ToLocationID,
+		Quantity:       transfer.Quantity,
+		TotalCost:      transfer.TotalCost(),
+		Status:         string(transfer.Status),
+		Notes:          transfer.Notes,
+		SentBy:         transfer.SentBy,
+		ReceivedBy:     transfer.ReceivedBy,
+		SentAt:         transfer.SentAt,
+		ReceivedAt:     transfer.ReceivedAt,
+		CancelledAt:    transfer.CancelledAt,
+		Lines:          []StockTransferLineDTO{},
+	}
+
+	for _, line := range transfer.Lines {
+		d.Lines = append(d.Lines, StockTransferLineDTO{
+			ID:                 line.ID,
+			SourceBatchID:      line.SourceBatchID,
+			DestinationBatchID: line.DestinationBatchID,
+			Quantity:           line.Quantity,
+			CostPrice:          line.CostPrice,
+			ExpiresAt:          line.ExpiresAt,
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package stocklossdto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
+)
+
+// StockLossCreateDTO registra uma perda; batch_id, order_process_id e group_item_id são opcionais
+type StockLossCreateDTO struct {
+	StockID        uuid.UUID                  `json:"stock_id"`
+	BatchID        *uuid.UUID                 `json:"batch_id,omitempty"`
+	Reason         stocklossentity.LossReason `json:"reason"`
+	Quantity       decimal.Decimal            `json:"quantity"`
+	OrderProcessID *uuid.UUID                 `json:"order_process_id,omitempty"`
+	GroupItemID    *uuid.UUID                 `json:"group_item_id,omitempty"`
+	Notes          string                     `json:"notes"`
+}
+
+func (d *StockLossCreateDTO) ToDomain() (*stocklossentity.StockLoss, error) {
+	return stocklossentity.NewStockLoss(stocklossentity.StockLossCommonAttributes{
+		StockID:        d.StockID,
+		BatchID:        d.BatchID,
+		Reason:         d.Reason,
+		Quantity:       d.Quantity,
+		OrderProcessID: d.OrderProcessID,
+		GroupItemID:    d.GroupItemID,
+		Notes:          d.Notes,
+	})
+}
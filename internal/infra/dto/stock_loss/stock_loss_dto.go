@@ -0,0 +1,66 @@
+package stocklossdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
+)
+
+type StockLossDTO struct {
+	ID                 uuid.UUID       `json:"id"`
+	StockID            uuid.UUID       `json:"stock_id"`
+	ProductID          uuid.UUID       `json:"product_id"`
+	ProductName        string          `json:"product_name,omitempty"`
+	ProductVariationID *uuid.UUID      `json:"product_variation_id,omitempty"`
+	BatchID            *uuid.UUID      `json:"batch_id,omitempty"`
+	Reason             string          `json:"reason"`
+	ReasonLabel        string          `json:"reason_label"`
+	Quantity           decimal.Decimal `json:"quantity"`
+	UnitCost           decimal.Decimal `json:"unit_cost"`
+	TotalCost          decimal.Decimal `json:"total_cost"`
+	EmployeeID         uuid.UUID       `json:"employee_id"`
+	OrderProcessID     *uuid.UUID      `json:"order_process_id,omitempty"`
+	GroupItemID        *uuid.UUID      `json:"group_item_id,omitempty"`
+	SourceReason       string          `json:"source_reason,omitempty"`
+	Notes              string          `json:"notes"`
+	CreatedAt          time.Time       `json:"created_at"`
+}
+
+type LossReasonDTO struct {
+	Reason string `json:"reason"`
+	Label  string `json:"label"`
+}
+
+func (d *StockLossDTO) FromDomain(loss *stocklossentity.StockLoss) {
+	if loss == nil {
+		return
+	}
+	*d = StockLossDTO{
+		ID:                 loss.ID,
+		StockID:            loss.StockID,
+		ProductID:          loss.ProductID,
+		ProductVariationID: loss.ProductVariationID,
+		BatchID:            loss.BatchID,
+		Reason:             string(loss.Reason),
+		ReasonLabel:        loss.Reason.Label(),
+		Quantity:           loss.Quantity,
+		UnitCost:           loss.UnitCost(),
+		TotalCost:          loss.TotalCost,
+		EmployeeID:         loss.EmployeeID,
+		OrderProcessID:     loss.OrderProcessID,
+		GroupItemID:        loss.GroupItemID,
+		SourceReason:       loss.SourceReason,
+		Notes:              loss.Notes,
+		CreatedAt:          loss.CreatedAt,
+	}
+}
+
+func GetAllLossReasons() []LossReasonDTO {
+	reasons := []LossReasonDTO{}
+	for _, reason := range stocklossentity.GetAllLossReasons() {
+		reasons = append(reasons, LossReasonDTO{Reason: string(reason), Label: reason.Label()})
+	}
+	return reasons
+}
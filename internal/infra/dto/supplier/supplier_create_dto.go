@@ -0,0 +1,33 @@
+package supplierdto
+
+import (
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+)
+
+type SupplierCreateDTO struct {
+	Name              string `json:"name"`
+	TradeName         string `json:"trade_name"`
+	Cnpj              string `json:"cnpj"`
+	StateRegistration string `json:"state_registration"`
+	Email             string `json:"email"`
+	Phone             string `json:"phone"`
+	ContactName       string `json:"contact_name"`
+	LeadTimeDays      int    `json:"lead_time_days"`
+	PaymentTermDays   int    `json:"payment_term_days"`
+	Notes             string `json:"notes"`
+}
+
+func (s *SupplierCreateDTO) ToDomain() (*supplierentity.Supplier, error) {
+	return supplierentity.NewSupplier(supplierentity.SupplierCommonAttributes{
+		Name:              s.Name,
+		TradeName:         s.TradeName,
+		Cnpj:              s.Cnpj,
+		StateRegistration: s.StateRegistration,
+		Email:             s.Email,
+		Phone:             s.Phone,
+		ContactName:       s.ContactName,
+		LeadTimeDays:      s.LeadTimeDays,
+		PaymentTermDays:   s.PaymentTermDays,
+		Notes:             s.Notes,
+	})
+}
@@ -0,0 +1,41 @@
+package supplierdto
+
+import (
+	"github.com/google/uuid"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+)
+
+type SupplierDTO struct {
+	ID                uuid.UUID `json:"id"`
+	Name              string    `json:"name"`
+	TradeName         string    `json:"trade_name"`
+	Cnpj              string    `json:"cnpj"`
+	StateRegistration string    `json:"state_registration"`
+	Email             string    `json:"email"`
+	Phone             string    `json:"phone"`
+	ContactName       string    `json:"contact_name"`
+	LeadTimeDays      int       `json:"lead_time_days"`
+	PaymentTermDays   int       `json:"payment_term_days"`
+	Notes             string    `json:"notes"`
+	IsActive          bool      `json:"is_active"`
+}
+
+func (s *SupplierDTO) FromDomain(supplier *supplierentity.Supplier) {
+	if supplier == nil {
+		return
+	}
+	*s = SupplierDTO{
+		ID:                supplier.ID,
+		Name:              supplier.Name,
+		TradeName:         supplier.TradeName,
+		Cnpj:              supplier.Cnpj,
+		StateRegistration: supplier.StateRegistration,
+		Email:             supplier.Email,
+		Phone:             supplier.Phone,
+		ContactName:       supplier.ContactName,
+		LeadTimeDays:      supplier.LeadTimeDays,
+		PaymentTermDays:   supplier.PaymentTermDays,
+		Notes:             supplier.Notes,
+		IsActive:          supplier.IsActive,
+	}
+}
@@ -0,0 +1,57 @@
+package supplierdto
+
+import (
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+)
+
+type SupplierUpdateDTO struct {
+	Name              *string `json:"name"`
+	TradeName         *string `json:"trade_name"`
+	Cnpj              *string `json:"cnpj"`
+	StateRegistration *string `json:"state_registration"`
+	Email             *string `json:"email"`
+	Phone             *string `json:"phone"`
+	ContactName       *string `json:"contact_name"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	PaymentTermDays   *int    `json:"payment_term_days"`
+	Notes             *string `json:"notes"`
+	IsActive          *bool   `json:"is_active"`
+}
+
+func (s *SupplierUpdateDTO) UpdateDomain(supplier *supplierentity.Supplier) error {
+	if s.Name != nil {
+		supplier.Name = *s.Name
+	}
+	if s.TradeName != nil {
+		supplier.TradeName = *s.TradeName
+	}
+	if s.Cnpj != nil {
+		supplier.Cnpj = *s.Cnpj
+	}
+	if s.StateRegistration != nil {
+		supplier.StateRegistration = *s.StateRegistration
+	}
+	if s.Email != nil {
+		supplier.Email = *s.Email
+	}
+	if s.Phone != nil {
+		supplier.Phone = *s.Phone
+	}
+	if s.ContactName != nil {
+		supplier.ContactName = *s.ContactName
+	}
+	if s.LeadTimeDays != nil {
+		supplier.LeadTimeDays = *s.LeadTimeDays
+	}
+	if s.PaymentTermDays != nil {
+		supplier.PaymentTermDays = *s.PaymentTermDays
+	}
+	if s.Notes != nil {
+		supplier.Notes = *s.Notes
+	}
+	if s.IsActive != nil {
+		supplier.IsActive = *s.IsActive
+	}
+
+	return supplier.Validate()
+}
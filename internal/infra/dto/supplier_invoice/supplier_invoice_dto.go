@@ -0,0 +1,89 @@
+package supplierinvoicedto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	supplierinvoiceentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier_invoice"
+)
+
+type SupplierInvoiceDTO struct {
+	ID            uuid.UUID                `json:"id"`
+	AccessKey     string                   `json:"access_key"`
+	SupplierID    uuid.UUID                `json:"supplier_id"`
+	SupplierName  string                   `json:"supplier_name,omitempty"`
+	Number        string                   `json:"number"`
+	Series        string                   `json:"series"`
+	IssuedAt      *time.Time               `json:"issued_at,omitempty"`
+	TotalAmount   decimal.Decimal          `json:"total_amount"`
+	Status        string                   `json:"status"`
+	ImportedAt    *time.Time               `json:"imported_at,omitempty"`
+	Items         []SupplierInvoiceItemDTO `json:"items"`
+	UnmappedItems []int                    `json:"unmapped_items"`
+}
+
+type SupplierInvoiceItemDTO struct {
+	ItemNumber   int                     `json:"item_number"`
+	SupplierCode string                  `json:"supplier_code"`
+	EAN          string                  `json:"ean,omitempty"`
+	Description  string                  `json:"description"`
+	NCM          string                  `json:"ncm,omitempty"`
+	Unit         string                  `json:"unit,omitempty"`
+	Quantity     decimal.Decimal         `json:"quantity"`
+	UnitCost     decimal.Decimal         `json:"unit_cost"`
+	Total        decimal.Decimal         `json:"total"`
+	Lots         []SupplierInvoiceLotDTO `json:"lots,omitempty"`
+	StockID      *uuid.UUID              `json:"stock_id,omitempty"`
+	Factor       decimal.Decimal         `json:"factor"`
+	Ignored      bool                    `json:"ignored"`
+}
+
+type SupplierInvoiceLotDTO struct {
+	Code           string          `json:"code"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	ManufacturedAt *time.Time      `json:"manufactured_at,omitempty"`
+	ExpiresAt      *time.Time      `json:"expires_at,omitempty"`
+}
+
+func (s *SupplierInvoiceDTO) FromDomain(invoice *supplierinvoiceentity.SupplierInvoice) {
+	if invoice == nil {
+		return
+	}
+	*s = SupplierInvoiceDTO{
+		ID:            invoice.ID,
+		AccessKey:     invoice.AccessKey,
+		SupplierID:    invoice.SupplierID,
+		Number:        invoice.Number,
+		Series:        invoice.Series,
+		IssuedAt:      invoice.IssuedAt,
+		TotalAmount:   invoice.TotalAmount,
+		Status:        string(invoice.Status),
+		ImportedAt:    invoice.ImportedAt,
+		Items:         []SupplierInvoiceItemDTO{},
+		UnmappedItems: invoice.UnmappedItems(),
+	}
+
+	for _, item := range invoice.Items {
+		itemDTO := SupplierInvoiceItemDTO{
+			ItemNumber:   item.ItemNumber,
+			SupplierCode: item.SupplierCode,
+			EAN:          item.EAN,
+			Description:  item.Description,
+			NCM:          item.NCM,
+			Unit:         item.Unit,
+			Quantity:     item.Quantity,
+			UnitCost:     item.UnitCost,
+			Total:        item.Total,
+			StockID:      item.StockID,
+			Factor:       item.Factor,
+			Ignored:      item.Ignored,
+		}
+
+		for _, lot := range item.Lots {
+			itemDTO.Lots = append(itemDTO.Lots, SupplierInvoiceLotDTO(lot))
+		}
+
+		s.Items = append(s.Items, itemDTO)
+	}
+}
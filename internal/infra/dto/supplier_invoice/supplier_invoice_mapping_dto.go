@@ -0,0 +1,24 @@
+package supplierinvoicedto
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SupplierInvoiceMappingDTO confirma ou corrige o estoque de cada linha da NF-e
+type SupplierInvoiceMappingDTO struct {
+	Items []SupplierInvoiceItemMappingDTO `json:"items"`
+}
+
+type SupplierInvoiceItemMappingDTO struct {
+	ItemNumber int             `json:"item_number"`
+	StockID    *uuid.UUID      `json:"stock_id,omitempty"`
+	Factor     decimal.Decimal `json:"factor"` // unidades de estoque por unidade do fornecedor (padrão 1)
+	Ignored    bool            `json:"ignored"`
+}
+
+// SupplierInvoiceImportDTO é a resposta da importação
+type SupplierInvoiceImportDTO struct {
+	Invoice     SupplierInvoiceDTO `json:"invoice"`
+	MovementIDs []uuid.UUID        `json:"movement_ids"`
+}
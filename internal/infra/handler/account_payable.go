@@ -0,0 +1,275 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerAccountPayableImpl struct {
+	s *accountpayableusecases.Service
+}
+
+func NewHandlerAccountPayable(accountPayableService *accountpayableusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerAccountPayableImpl{
+		s: accountPayableService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateAccountPayable)
+		c.Patch("/update/{id}", h.handlerUpdateAccountPayable)
+		c.Get("/all", h.handlerGetAllAccountPayables)
+		c.Get("/{id}", h.handlerGetAccountPayableById)
+		c.Post("/{id}/pay", h.handlerPayAccountPayable)
+		c.Post("/{id}/cancel", h.handlerCancelAccountPayable)
+		c.Post("/{id}/attachment", h.handlerAddAccountPayableAttachment)
+		c.Delete("/{id}/attachment/{attachmentId}", h.handlerRemoveAccountPayableAttachment)
+	})
+
+	return handler.NewHandler("/account-payable", c)
+}
+
+func (h *handlerAccountPayableImpl) handlerCreateAccountPayable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &accountpayabledto.AccountPayableCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateAccountPayable(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerAccountPayableImpl) handlerUpdateAccountPayable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountpayabledto.AccountPayableUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateAccountPayable(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerAccountPayableImpl) handlerGetAccountPayableById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	payable, err := h.s.GetAccountPayableById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, payable)
+}
+
+// handlerGetAllAccountPayables filtra por status, categoria (category_id) e intervalo de vencimento (due_from/due_to em YYYY-MM-DD)
+func (h *handlerAccountPayableImpl) handlerGetAllAccountPayables(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+	categoryID := r.URL.Query().Get("category_id")
+
+	var dueFrom, dueTo *time.Time
+	for param, target := range map[string]**time.Time{"due_from": &dueFrom, "due_to": &dueTo} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid "+param+" parameter"))
+			return
+		}
+		*target = &date
+	}
+
+	payables, count, err := h.s.GetAllAccountPayables(ctx, page, perPage, status, dueFrom, dueTo, categoryID)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, payables)
+}
+
+func (h *handlerAccountPayableImpl) handlerPayAccountPayable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountpayabledto.AccountPayablePayDTO{}
+	if r.ContentLength > 0 {
+		if err := jsonpkg.ParseBody(r, dto); err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if err := h.s.PayAccountPayable(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerAccountPayableImpl) handlerCancelAccountPayable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelAccountPayable(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+// handlerAddAccountPayableAttachment recebe boleto, nota ou comprovante no campo multipart "file"
+func (h *handlerAccountPayableImpl) handlerAddAccountPayableAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	upload, closeFile, err := parseAttachmentUpload(w, r)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	defer closeFile()
+
+	payable, err := h.s.AddAccountPayableAttachment(ctx, dtoId, upload)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, payable)
+}
+
+func (h *handlerAccountPayableImpl) handlerRemoveAccountPayableAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	attachmentID := chi.URLParam(r, "attachmentId")
+
+	if id == "" || attachmentID == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id and attachmentId are required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.RemoveAccountPayableAttachment(ctx, dtoId, uuid.MustParse(attachmentID)); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountPayableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+// parseAttachmentUpload lê o arquivo multipart "file"; compartilhado com as contas a receber
+func parseAttachmentUpload(w http.ResponseWriter, r *http.Request) (*accountpayableusecases.AttachmentUpload, func(), error) {
+	// folga para os cabeçalhos do multipart; o limite do arquivo é conferido no usecase
+	r.Body = http.MaxBytesReader(w, r.Body, accountpayableusecases.MaxAttachmentSize+(1<<20))
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upload := &accountpayableusecases.AttachmentUpload{
+		Filename: header.Filename,
+		Content:  file,
+	}
+
+	return upload, func() { file.Close() }, nil
+}
+
+func accountPayableErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountpayableentity.ErrAlreadyPaid),
+		errors.Is(err, accountpayableentity.ErrAlreadyCancelled),
+		errors.Is(err, accountpayableentity.ErrInvalidAmount),
+		errors.Is(err, accountpayableentity.ErrDescriptionRequired),
+		errors.Is(err, accountpayableentity.ErrInvalidRecurrence),
+		errors.Is(err, accountpayableentity.ErrRecurrenceEndBefore),
+		errors.Is(err, accountpayableentity.ErrAttachmentNotFound),
+		errors.Is(err, accountpayableentity.ErrAttachmentNameMissing),
+		errors.Is(err, accountpayableusecases.ErrExpenseCategoryNotFound),
+		errors.Is(err, accountpayableusecases.ErrAttachmentTooLarge),
+		errors.Is(err, accountpayableusecases.ErrAttachmentType):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
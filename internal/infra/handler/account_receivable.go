@@ -0,0 +1,255 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	accountreceivabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_receivable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
+	accountreceivableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_receivable"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerAccountReceivableImpl struct {
+	s *accountreceivableusecases.Service
+}
+
+func NewHandlerAccountReceivable(accountReceivableService *accountreceivableusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerAccountReceivableImpl{
+		s: accountReceivableService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateAccountReceivable)
+		c.Patch("/update/{id}", h.handlerUpdateAccountReceivable)
+		c.Get("/all", h.handlerGetAllAccountReceivables)
+		c.Get("/{id}", h.handlerGetAccountReceivableById)
+		c.Post("/{id}/receive", h.handlerReceiveAccountReceivable)
+		c.Post("/{id}/cancel", h.handlerCancelAccountReceivable)
+		c.Post("/{id}/attachment", h.handlerAddAccountReceivableAttachment)
+		c.Delete("/{id}/attachment/{attachmentId}", h.handlerRemoveAccountReceivableAttachment)
+	})
+
+	return handler.NewHandler("/account-receivable", c)
+}
+
+func (h *handlerAccountReceivableImpl) handlerCreateAccountReceivable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &accountreceivabledto.AccountReceivableCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateAccountReceivable(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerAccountReceivableImpl) handlerUpdateAccountReceivable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountreceivabledto.AccountReceivableUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateAccountReceivable(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerAccountReceivableImpl) handlerGetAccountReceivableById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	receivable, err := h.s.GetAccountReceivableById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, receivable)
+}
+
+// handlerGetAllAccountReceivables filtra por status e intervalo de previsão (expected_from/expected_to em YYYY-MM-DD)
+func (h *handlerAccountReceivableImpl) handlerGetAllAccountReceivables(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+
+	var expectedFrom, expectedTo *time.Time
+	for param, target := range map[string]**time.Time{"expected_from": &expectedFrom, "expected_to": &expectedTo} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid "+param+" parameter"))
+			return
+		}
+		*target = &date
+	}
+
+	receivables, count, err := h.s.GetAllAccountReceivables(ctx, page, perPage, status, expectedFrom, expectedTo)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, receivables)
+}
+
+func (h *handlerAccountReceivableImpl) handlerReceiveAccountReceivable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountreceivabledto.AccountReceivableReceiveDTO{}
+	if r.ContentLength > 0 {
+		if err := jsonpkg.ParseBody(r, dto); err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	if err := h.s.ReceiveAccountReceivable(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerAccountReceivableImpl) handlerCancelAccountReceivable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelAccountReceivable(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerAccountReceivableImpl) handlerAddAccountReceivableAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	upload, closeFile, err := parseAttachmentUpload(w, r)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	defer closeFile()
+
+	receivable, err := h.s.AddAccountReceivableAttachment(ctx, dtoId, upload)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, receivable)
+}
+
+func (h *handlerAccountReceivableImpl) handlerRemoveAccountReceivableAttachment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	attachmentID := chi.URLParam(r, "attachmentId")
+
+	if id == "" || attachmentID == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id and attachmentId are required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.RemoveAccountReceivableAttachment(ctx, dtoId, uuid.MustParse(attachmentID)); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, accountReceivableErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func accountReceivableErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountreceivableentity.ErrAlreadyReceived),
+		errors.Is(err, accountreceivableentity.ErrAlreadyCancelled),
+		errors.Is(err, accountreceivableentity.ErrInvalidAmount),
+		errors.Is(err, accountreceivableentity.ErrDescriptionRequired),
+		errors.Is(err, accountreceivableentity.ErrInvalidSource),
+		errors.Is(err, accountpayableentity.ErrAttachmentNotFound),
+		errors.Is(err, accountpayableentity.ErrAttachmentNameMissing),
+		errors.Is(err, accountpayableusecases.ErrAttachmentTooLarge),
+		errors.Is(err, accountpayableusecases.ErrAttachmentType):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
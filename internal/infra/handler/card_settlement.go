@@ -0,0 +1,197 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	accountreceivabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_receivable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	accountreceivableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_receivable"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerCardSettlementImpl struct {
+	s *accountreceivableusecases.Service
+}
+
+func NewHandlerCardSettlement(accountReceivableService *accountreceivableusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerCardSettlementImpl{
+		s: accountReceivableService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/fee/new", h.handlerCreatePayMethodFee)
+		c.Patch("/fee/update/{id}", h.handlerUpdatePayMethodFee)
+		c.Delete("/fee/{id}", h.handlerDeletePayMethodFee)
+		c.Get("/fee/all", h.handlerGetAllPayMethodFees)
+		c.Post("/statement/import", h.handlerImportSettlementStatement)
+		c.Get("/statement/all", h.handlerGetAllSettlementStatements)
+		c.Get("/statement/{id}", h.handlerGetSettlementStatementById)
+	})
+
+	return handler.NewHandler("/card-settlement", c)
+}
+
+func (h *handlerCardSettlementImpl) handlerCreatePayMethodFee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &accountreceivabledto.PayMethodFeeCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreatePayMethodFee(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, cardSettlementErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerCardSettlementImpl) handlerUpdatePayMethodFee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountreceivabledto.PayMethodFeeUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdatePayMethodFee(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, cardSettlementErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerCardSettlementImpl) handlerDeletePayMethodFee(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeletePayMethodFee(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, cardSettlementErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerCardSettlementImpl) handlerGetAllPayMethodFees(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	fees, err := h.s.GetAllPayMethodFees(ctx)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, fees)
+}
+
+// handlerImportSettlementStatement recebe o extrato em multipart: "file" (CSV ou OFX) e "acquirer"
+func (h *handlerCardSettlementImpl) handlerImportSettlementStatement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	r.Body = http.MaxBytesReader(w, r.Body, accountreceivableusecases.MaxStatementSize+(1<<20))
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	defer file.Close()
+
+	statement, err := h.s.ImportSettlementStatement(ctx, r.FormValue("acquirer"), header.Filename, file)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, cardSettlementErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, statement)
+}
+
+func (h *handlerCardSettlementImpl) handlerGetAllSettlementStatements(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 50)
+
+	statements, count, err := h.s.GetAllSettlementStatements(ctx, page, perPage)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, statements)
+}
+
+func (h *handlerCardSettlementImpl) handlerGetSettlementStatementById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	statement, err := h.s.GetSettlementStatementById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, statement)
+}
+
+func cardSettlementErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, accountreceivableentity.ErrPayMethodInvalid),
+		errors.Is(err, accountreceivableentity.ErrCashHasNoFee),
+		errors.Is(err, accountreceivableentity.ErrInvalidFee),
+		errors.Is(err, accountreceivableentity.ErrInvalidSettlementDays),
+		errors.Is(err, accountreceivableentity.ErrStatementEmpty),
+		errors.Is(err, accountreceivableentity.ErrStatementFormatInvalid),
+		errors.Is(err, accountreceivableusecases.ErrStatementColumns),
+		errors.Is(err, accountreceivableusecases.ErrStatementInvalid),
+		errors.Is(err, accountreceivableusecases.ErrStatementTooLarge),
+		errors.Is(err, accountreceivableusecases.ErrPayMethodFeeExists):
+		return http.StatusBadRequest
+	case errors.Is(err, accountreceivableentity.ErrStatementDuplicated):
+		return http.StatusConflict
+	case errors.Is(err, accountreceivableusecases.ErrPayMethodFeeNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,166 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+	combodto "github.com/willjrcom/sales-backend-go/internal/infra/dto/combo"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	combousecases "github.com/willjrcom/sales-backend-go/internal/usecases/combo"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerComboImpl struct {
+	s *combousecases.Service
+}
+
+func NewHandlerCombo(comboService *combousecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerComboImpl{
+		s: comboService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateCombo)
+		c.Patch("/update/{id}", h.handlerUpdateCombo)
+		c.Delete("/{id}", h.handlerDeleteCombo)
+		c.Get("/all", h.handlerGetAllCombos)
+		c.Get("/{id}", h.handlerGetComboById)
+	})
+
+	return handler.NewHandler("/combo", c)
+}
+
+func (h *handlerComboImpl) handlerCreateCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &combodto.ComboCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateCombo(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, comboErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerComboImpl) handlerUpdateCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &combodto.ComboUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateCombo(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, comboErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerComboImpl) handlerDeleteCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteCombo(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerComboImpl) handlerGetComboById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	combo, err := h.s.GetComboById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, combo)
+}
+
+func (h *handlerComboImpl) handlerGetAllCombos(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// only_active=true lista apenas os combos à venda
+	onlyActive := false
+	if onlyActiveParam := r.URL.Query().Get("only_active"); onlyActiveParam != "" {
+		var err error
+		onlyActive, err = strconv.ParseBool(onlyActiveParam)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid only_active parameter"))
+			return
+		}
+	}
+
+	combos, err := h.s.GetAllCombos(ctx, onlyActive)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, combos)
+}
+
+func comboErrorStatus(err error) int {
+	businessErrors := []error{
+		comboentity.ErrComboNameRequired,
+		comboentity.ErrComboPriceInvalid,
+		comboentity.ErrComboSlotsRequired,
+		comboentity.ErrComboSlotNameRequired,
+		comboentity.ErrComboSlotOptionsRequired,
+		comboentity.ErrComboSlotOptionInvalid,
+		comboentity.ErrComboUpchargeInvalid,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
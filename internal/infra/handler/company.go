@@ -2,6 +2,7 @@ package handlerimpl
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -98,7 +99,11 @@ func (h *handlerCompanyImpl) handlerUpdateCompany(w http.ResponseWriter, r *http
 	}
 
 	if err := h.s.UpdateCompany(ctx, dtoCompany); err != nil {
-		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		status := http.StatusInternalServerError
+		if errors.Is(err, companyentity.ErrInvalidTimeZone) {
+			status = http.StatusBadRequest
+		}
+		jsonpkg.ResponseErrorJson(w, r, status, err)
 		return
 	}
 
@@ -0,0 +1,125 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerExpenseCategoryImpl struct {
+	s *accountpayableusecases.Service
+}
+
+func NewHandlerExpenseCategory(accountPayableService *accountpayableusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerExpenseCategoryImpl{
+		s: accountPayableService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateExpenseCategory)
+		c.Patch("/update/{id}", h.handlerUpdateExpenseCategory)
+		c.Delete("/{id}", h.handlerDeleteExpenseCategory)
+		c.Get("/all", h.handlerGetAllExpenseCategories)
+	})
+
+	return handler.NewHandler("/expense-category", c)
+}
+
+func (h *handlerExpenseCategoryImpl) handlerCreateExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &accountpayabledto.ExpenseCategoryCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateExpenseCategory(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, expenseCategoryErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerExpenseCategoryImpl) handlerUpdateExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &accountpayabledto.ExpenseCategoryUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateExpenseCategory(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, expenseCategoryErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerExpenseCategoryImpl) handlerDeleteExpenseCategory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteExpenseCategory(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, expenseCategoryErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+// handlerGetAllExpenseCategories lista todas as categorias; only_active=true esconde as desativadas
+func (h *handlerExpenseCategoryImpl) handlerGetAllExpenseCategories(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	onlyActive := r.URL.Query().Get("only_active") == "true"
+
+	categories, err := h.s.GetAllExpenseCategories(ctx, onlyActive)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, categories)
+}
+
+func expenseCategoryErrorStatus(err error) int {
+	if errors.Is(err, accountpayableentity.ErrCategoryNameRequired) {
+		return http.StatusBadRequest
+	}
+	if errors.Is(err, accountpayableusecases.ErrExpenseCategoryNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
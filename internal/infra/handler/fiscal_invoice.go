@@ -28,6 +28,7 @@ func NewHandlerFiscalInvoice(service *fiscalinvoiceusecases.Service) *handler.Ha
 		c.Get("/nfce/{id}", h.handlerSearchNFCe)
 		c.Get("/nfce", h.handlerListNFCe)
 		c.Post("/nfce/{id}/cancel", h.handlerCancelNFCe)
+		c.Post("/nfce/export", h.handlerExportMonthlyArchive)
 	})
 
 	return handler.NewHandler("/fiscal", c)
@@ -198,3 +199,42 @@ func (h *handlerFiscalInvoiceImpl) handlerCancelNFCe(w http.ResponseWriter, r *h
 		"message": "NFC-e cancelada com sucesso",
 	})
 }
+
+// handlerExportMonthlyArchive godoc
+// @Summary Export monthly XML archive
+// @Description Build a zip with authorized and cancellation XMLs plus a CSV summary, optionally emailing the accountant
+// @Tags Fiscal Invoice
+// @Accept json
+// @Produce json
+// @Param request body fiscalinvoicedto.MonthlyArchiveRequestDTO true "Period"
+// @Success 200 {object} fiscalinvoicedto.MonthlyArchiveDTO
+// @Failure 400 {object} error
+// @Failure 404 {object} error
+// @Failure 500 {object} error
+// @Router /api/fiscal/nfce/export [post]
+func (h *handlerFiscalInvoiceImpl) handlerExportMonthlyArchive(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &fiscalinvoicedto.MonthlyArchiveRequestDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	archive, err := h.service.ExportMonthlyArchive(ctx, dto.Year, dto.Month, dto.SendToAccountant)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch err {
+		case fiscalinvoiceusecases.ErrInvalidArchivePeriod, fiscalinvoiceusecases.ErrAccountantEmailNotConfigured:
+			status = http.StatusBadRequest
+		case fiscalinvoiceusecases.ErrFiscalNotEnabled:
+			status = http.StatusForbidden
+		case fiscalinvoiceusecases.ErrNoInvoicesInPeriod:
+			status = http.StatusNotFound
+		}
+		jsonpkg.ResponseErrorJson(w, r, status, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, archive)
+}
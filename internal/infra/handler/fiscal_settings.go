@@ -51,7 +51,11 @@ func (h *handlerFiscalSettingsImpl) handlerUpdateFiscalSettings(w http.ResponseW
 	}
 
 	if err := h.s.UpdateFiscalSettings(ctx, dto); err != nil {
-		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		status := http.StatusInternalServerError
+		if err == fiscalsettingsusecases.ErrUnknownFiscalProvider {
+			status = http.StatusBadRequest
+		}
+		jsonpkg.ResponseErrorJson(w, r, status, err)
 		return
 	}
 
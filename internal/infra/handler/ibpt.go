@@ -0,0 +1,55 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerIbptImpl struct {
+	s *ibptusecases.Service
+}
+
+func NewHandlerIbpt(service *ibptusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerIbptImpl{
+		s: service,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/{uf}/{ncm}", h.handlerGetTaxRate)
+	})
+
+	return handler.NewHandler("/fiscal/ibpt", c)
+}
+
+// handlerGetTaxRate godoc
+// @Summary Get IBPT tax rate
+// @Description Returns the approximate tax rates in force for a NCM in a UF
+// @Tags Fiscal
+// @Produce json
+// @Param uf path string true "UF"
+// @Param ncm path string true "NCM"
+// @Success 200 {object} ibptdto.TaxRateDTO
+// @Failure 404 {object} map[string]string
+// @Router /fiscal/ibpt/{uf}/{ncm} [get]
+func (h *handlerIbptImpl) handlerGetTaxRate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto, err := h.s.GetTaxRate(ctx, chi.URLParam(r, "uf"), chi.URLParam(r, "ncm"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, ibptusecases.ErrTaxRateNotFound) {
+			status = http.StatusNotFound
+		}
+		jsonpkg.ResponseErrorJson(w, r, status, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, dto)
+}
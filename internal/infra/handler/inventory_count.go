@@ -0,0 +1,196 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	inventorycountentity "github.com/willjrcom/sales-backend-go/internal/domain/inventory_count"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	inventorycountdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/inventory_count"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	inventorycountusecases "github.com/willjrcom/sales-backend-go/internal/usecases/inventory_count"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerInventoryCountImpl struct {
+	s *inventorycountusecases.Service
+}
+
+func NewHandlerInventoryCount(inventoryCountService *inventorycountusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerInventoryCountImpl{
+		s: inventoryCountService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateInventoryCount)
+		c.Post("/{id}/count", h.handlerRecordCounts)
+		c.Post("/{id}/approve", h.handlerApproveInventoryCount)
+		c.Post("/{id}/cancel", h.handlerCancelInventoryCount)
+		c.Get("/all", h.handlerGetAllInventoryCounts)
+		c.Get("/{id}", h.handlerGetInventoryCountById)
+	})
+
+	return handler.NewHandler("/inventory-count", c)
+}
+
+func (h *handlerInventoryCountImpl) handlerCreateInventoryCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &inventorycountdto.InventoryCountCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateInventoryCount(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, inventoryCountErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerInventoryCountImpl) handlerRecordCounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &inventorycountdto.InventoryCountEntriesDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	inventoryCount, err := h.s.RecordCounts(ctx, dtoId, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, inventoryCountErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, inventoryCount)
+}
+
+func (h *handlerInventoryCountImpl) handlerApproveInventoryCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &inventorycountdto.InventoryCountApproveDTO{}
+	if r.ContentLength > 0 {
+		if err := jsonpkg.ParseBody(r, dto); err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	approval, err := h.s.ApproveInventoryCount(ctx, dtoId, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, inventoryCountErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, approval)
+}
+
+func (h *handlerInventoryCountImpl) handlerCancelInventoryCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelInventoryCount(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, inventoryCountErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerInventoryCountImpl) handlerGetInventoryCountById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	inventoryCount, err := h.s.GetInventoryCountById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, inventoryCount)
+}
+
+func (h *handlerInventoryCountImpl) handlerGetAllInventoryCounts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+
+	inventoryCounts, count, err := h.s.GetAllInventoryCounts(ctx, page, perPage, status)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, inventoryCounts)
+}
+
+// inventoryCountErrorStatus devolve 400 para regras da contagem e 500 para falhas de infraestrutura
+func inventoryCountErrorStatus(err error) int {
+	businessErrors := []error{
+		inventorycountentity.ErrCategoryRequired,
+		inventorycountentity.ErrInvalidScope,
+		inventorycountentity.ErrInventoryCountNotOpen,
+		inventorycountentity.ErrInventoryCountEmpty,
+		inventorycountentity.ErrItemNotFound,
+		inventorycountentity.ErrInvalidCountedQuantity,
+		inventorycountentity.ErrInvalidCountMode,
+		inventorycountentity.ErrItemsNotCounted,
+		inventorycountusecases.ErrNoStocksToCount,
+		inventorycountusecases.ErrNoEntries,
+		inventorycountusecases.ErrEntryItemUnknown,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
@@ -33,6 +35,8 @@ func NewHandlerItem(itemService *orderusecases.ItemService) *handler.Handler {
 		c.Delete("/delete/{id-additional}/additional", h.handlerDeleteAdditionalItem)
 		c.Post("/update/{id}/removed-item", h.handlerAddRemovedItem)
 		c.Delete("/delete/{id}/removed-item", h.handlerRemoveRemovedItem)
+		c.Post("/add-combo", h.handlerAddCombo)
+		c.Delete("/combo/{id}", h.handlerDeleteCombo)
 	})
 
 	unprotectedRoutes := []string{}
@@ -50,7 +54,7 @@ func (h *handlerItemImpl) handlerAddItem(w http.ResponseWriter, r *http.Request)
 
 	ids, err := h.s.AddItemOrder(ctx, dtoAddItem)
 	if err != nil {
-		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		jsonpkg.ResponseErrorJson(w, r, itemErrorStatus(err), err)
 		return
 	}
 
@@ -71,7 +75,7 @@ func (h *handlerItemImpl) handlerDeleteItem(w http.ResponseWriter, r *http.Reque
 
 	groupItemDeleted, err := h.s.DeleteItemOrder(ctx, dtoId)
 	if err != nil {
-		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		jsonpkg.ResponseErrorJson(w, r, itemErrorStatus(err), err)
 		return
 	}
 
@@ -98,7 +102,7 @@ func (h *handlerItemImpl) handlerAddAdditionalItem(w http.ResponseWriter, r *htt
 
 	additionalId, err := h.s.AddAdditionalItemOrder(ctx, dtoId, dtoAddAdditionalItem)
 	if err != nil {
-		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		jsonpkg.ResponseErrorJson(w, r, itemErrorStatus(err), err)
 		return
 	}
 
@@ -176,3 +180,65 @@ func (h *handlerItemImpl) handlerRemoveRemovedItem(w http.ResponseWriter, r *htt
 
 	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
 }
+
+func (h *handlerItemImpl) handlerAddCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoAddCombo := &itemdto.OrderComboCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dtoAddCombo); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	ids, err := h.s.AddComboOrder(ctx, dtoAddCombo)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, itemErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, ids)
+}
+
+func (h *handlerItemImpl) handlerDeleteCombo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	if err := h.s.DeleteComboOrder(ctx, uuid.MustParse(id)); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, itemErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+// itemErrorStatus devolve 400 quando a variação não pode ser vendida (indisponível ou sem saldo)
+// ou quando a escolha do combo é inválida
+func itemErrorStatus(err error) int {
+	businessErrors := []error{
+		orderusecases.ErrVariationNotAvailable,
+		stockentity.ErrInsufficientStock,
+		orderusecases.ErrComboItemDelete,
+		orderusecases.ErrComboNotFoundInOrder,
+		itemdto.ErrComboQuantityNotInteger,
+		itemdto.ErrComboChoicesRequired,
+		itemdto.ErrItemChannelInvalid,
+		comboentity.ErrComboInactive,
+		comboentity.ErrComboSlotNotFound,
+		comboentity.ErrComboChoiceRequired,
+		comboentity.ErrComboChoiceNotAllowed,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,166 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	pricelistdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/price_list"
+	pricelistusecases "github.com/willjrcom/sales-backend-go/internal/usecases/price_list"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerPriceListImpl struct {
+	s *pricelistusecases.Service
+}
+
+func NewHandlerPriceList(priceListService *pricelistusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerPriceListImpl{
+		s: priceListService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreatePriceList)
+		c.Patch("/update/{id}", h.handlerUpdatePriceList)
+		c.Delete("/{id}", h.handlerDeletePriceList)
+		c.Get("/all", h.handlerGetAllPriceLists)
+		c.Get("/{id}", h.handlerGetPriceListById)
+	})
+
+	return handler.NewHandler("/price-list", c)
+}
+
+func (h *handlerPriceListImpl) handlerCreatePriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &pricelistdto.PriceListCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreatePriceList(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, priceListErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerPriceListImpl) handlerUpdatePriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &pricelistdto.PriceListUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdatePriceList(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, priceListErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPriceListImpl) handlerDeletePriceList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeletePriceList(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPriceListImpl) handlerGetPriceListById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	priceList, err := h.s.GetPriceListById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, priceList)
+}
+
+func (h *handlerPriceListImpl) handlerGetAllPriceLists(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// only_active=true lista apenas as listas ativas
+	onlyActive := false
+	if onlyActiveParam := r.URL.Query().Get("only_active"); onlyActiveParam != "" {
+		var err error
+		onlyActive, err = strconv.ParseBool(onlyActiveParam)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid only_active parameter"))
+			return
+		}
+	}
+
+	priceLists, err := h.s.GetAllPriceLists(ctx, onlyActive)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, priceLists)
+}
+
+func priceListErrorStatus(err error) int {
+	businessErrors := []error{
+		pricelistentity.ErrPriceListNameRequired,
+		pricelistentity.ErrPriceListChannelInvalid,
+		pricelistentity.ErrPriceListWindowInvalid,
+		pricelistentity.ErrPriceListDateRangeInvalid,
+		pricelistentity.ErrPriceListPricesRequired,
+		pricelistentity.ErrPriceListPriceInvalid,
+		pricelistentity.ErrPriceListVariationDuplicate,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
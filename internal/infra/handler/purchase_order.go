@@ -0,0 +1,218 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	purchaseorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/purchase_order"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	purchaseorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/purchase_order"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerPurchaseOrderImpl struct {
+	s *purchaseorderusecases.Service
+}
+
+func NewHandlerPurchaseOrder(purchaseOrderService *purchaseorderusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerPurchaseOrderImpl{
+		s: purchaseOrderService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreatePurchaseOrder)
+		c.Patch("/update/{id}", h.handlerUpdatePurchaseOrder)
+		c.Post("/{id}/send", h.handlerSendPurchaseOrder)
+		c.Post("/{id}/cancel", h.handlerCancelPurchaseOrder)
+		c.Post("/{id}/receive", h.handlerReceivePurchaseOrder)
+		c.Get("/all", h.handlerGetAllPurchaseOrders)
+		c.Get("/{id}", h.handlerGetPurchaseOrderById)
+	})
+
+	return handler.NewHandler("/purchase-order", c)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerCreatePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &purchaseorderdto.PurchaseOrderCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreatePurchaseOrder(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, purchaseOrderErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerUpdatePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &purchaseorderdto.PurchaseOrderUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdatePurchaseOrder(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, purchaseOrderErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerSendPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.SendPurchaseOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, purchaseOrderErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerCancelPurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelPurchaseOrder(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, purchaseOrderErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerReceivePurchaseOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &purchaseorderdto.PurchaseOrderReceiveDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	receipt, err := h.s.ReceivePurchaseOrder(ctx, dtoId, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, purchaseOrderErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, receipt)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerGetPurchaseOrderById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	purchaseOrder, err := h.s.GetPurchaseOrderById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, purchaseOrder)
+}
+
+func (h *handlerPurchaseOrderImpl) handlerGetAllPurchaseOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+
+	purchaseOrders, count, err := h.s.GetAllPurchaseOrders(ctx, page, perPage, status)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, purchaseOrders)
+}
+
+// purchaseOrderErrorStatus devolve 400 para regras de negócio do pedido e 500 para falhas de infraestrutura
+func purchaseOrderErrorStatus(err error) int {
+	businessErrors := []error{
+		purchaseorderentity.ErrSupplierRequired,
+		purchaseorderentity.ErrPurchaseOrderNotDraft,
+		purchaseorderentity.ErrPurchaseOrderEmpty,
+		purchaseorderentity.ErrPurchaseOrderNotOrdered,
+		purchaseorderentity.ErrPurchaseOrderClosed,
+		purchaseorderentity.ErrPurchaseOrderHasReceipts,
+		purchaseorderentity.ErrStockRequired,
+		purchaseorderentity.ErrInvalidItemQuantity,
+		purchaseorderentity.ErrInvalidItemCost,
+		purchaseorderentity.ErrItemNotFound,
+		purchaseorderentity.ErrReceivedQuantityExceeds,
+		purchaseorderentity.ErrNothingToReceive,
+		purchaseorderusecases.ErrSupplierInactive,
+		stockentity.ErrInvalidQuantity,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
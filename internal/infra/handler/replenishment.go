@@ -0,0 +1,117 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+	replenishmententity "github.com/willjrcom/sales-backend-go/internal/domain/replenishment"
+	replenishmentdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/replenishment"
+	purchaseorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/purchase_order"
+	replenishmentusecases "github.com/willjrcom/sales-backend-go/internal/usecases/replenishment"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerReplenishmentImpl struct {
+	s *replenishmentusecases.Service
+}
+
+func NewHandlerReplenishment(replenishmentService *replenishmentusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerReplenishmentImpl{
+		s: replenishmentService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Get("/suggestions", h.handlerGetSuggestions)
+		c.Post("/purchase-orders", h.handlerCreateDraftPurchaseOrders)
+		c.Post("/stock-limits", h.handlerAdjustStockLimits)
+	})
+
+	return handler.NewHandler("/replenishment", c)
+}
+
+// handlerGetSuggestions aceita period_days, coverage_days e safety_days na query
+func (h *handlerReplenishmentImpl) handlerGetSuggestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &replenishmentdto.ReplenishmentParamsDTO{}
+	for param, target := range map[string]*int{"period_days": &dto.PeriodDays, "coverage_days": &dto.CoverageDays, "safety_days": &dto.SafetyDays} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+
+		days, err := strconv.Atoi(value)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid "+param+" parameter"))
+			return
+		}
+		*target = days
+	}
+
+	suggestions, err := h.s.GetSuggestions(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, replenishmentErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, suggestions)
+}
+
+func (h *handlerReplenishmentImpl) handlerCreateDraftPurchaseOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &replenishmentdto.DraftPurchaseOrdersCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	purchaseOrders, err := h.s.CreateDraftPurchaseOrders(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, replenishmentErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, purchaseOrders)
+}
+
+func (h *handlerReplenishmentImpl) handlerAdjustStockLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &replenishmentdto.ReplenishmentParamsDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	limits, err := h.s.AdjustStockLimits(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, replenishmentErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, limits)
+}
+
+func replenishmentErrorStatus(err error) int {
+	businessErrors := []error{
+		replenishmententity.ErrInvalidParameters,
+		replenishmentusecases.ErrNoSuggestions,
+		purchaseorderusecases.ErrSupplierInactive,
+		purchaseorderentity.ErrInvalidItemQuantity,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
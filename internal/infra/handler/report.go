@@ -1,7 +1,13 @@
 package handlerimpl
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"path"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
@@ -11,11 +17,15 @@ import (
 )
 
 // NewHandlerReport returns a handler for report endpoints.
+// Every report answers JSON or, with ?format=csv|xlsx|pdf (or the Accept header), a file attachment.
 func NewHandlerReport(s *reportusecases.Service) *handler.Handler {
 	r := chi.NewRouter()
 	h := &handlerReportImpl{s: s}
 	base := "/report"
 
+	// Qualquer relatório com "compare" no corpo responde os dois períodos lado a lado
+	r.Use(h.compareReport)
+
 	r.Post("/sales-total-by-day", h.handleSalesTotalByDay)
 	r.Post("/revenue-cumulative-by-month", h.handleRevenueCumulativeByMonth)
 	r.Post("/sales-by-hour", h.handleSalesByHour)
@@ -54,6 +64,25 @@ func NewHandlerReport(s *reportusecases.Service) *handler.Handler {
 	r.Post("/employee-payments-report", h.handleEmployeePaymentsReport)
 	// Daily sales report for a specific day
 	r.Post("/daily-sales", h.handleDailySales)
+	// Perdas de estoque por motivo, produto, funcionário ou dia
+	r.Post("/stock-losses", h.handleStockLosses)
+	// Valorização de estoque, CMV e margem bruta a custo de lote
+	r.Post("/stock-valuation", h.handleStockValuation)
+	r.Post("/cogs", h.handleCostOfGoodsSold)
+	r.Post("/gross-margin", h.handleGrossMargin)
+	r.Post("/stock-reconciliation", h.handleStockReconciliation)
+	r.Post("/combo-sales", h.handleComboSales)
+	r.Post("/price-list-sales", h.handlePriceListSales)
+	// DRE mensal comparada com o mês anterior e o mesmo mês do ano anterior
+	r.Post("/profit-and-loss", h.handleProfitAndLoss)
+	r.Post("/cash-flow-projection", h.handleCashFlowProjection)
+	// Engenharia de cardápio (popularidade × margem) e curva ABC de receita
+	r.Post("/menu-engineering", h.handleMenuEngineering)
+	r.Post("/abc-curve", h.handleAbcCurve)
+	// Previsão de vendas com lista de preparo por etapa e sugestão de equipe por hora
+	r.Post("/sales-forecast", h.handleSalesForecast)
+	// Consolidado das lojas em que o usuário tem a permissão statistics
+	r.Post("/consolidated", h.handleConsolidatedReport)
 	return handler.NewHandler(base, r)
 }
 
@@ -69,7 +98,289 @@ func (h *handlerReportImpl) handleDailySales(w http.ResponseWriter, r *http.Requ
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-do-dia", resp)
+}
+
+// handleStockLosses handles the loss report valued at batch cost.
+func (h *handlerReportImpl) handleStockLosses(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.StockLossesRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.StockLosses(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "perdas-estoque", resp)
+}
+
+// handleStockValuation handles the inventory value at batch cost at a date.
+func (h *handlerReportImpl) handleStockValuation(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.StockValuationRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.StockValuation(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "valorizacao-estoque", resp)
+}
+
+// handleCostOfGoodsSold handles the COGS per day from consumed batch costs.
+func (h *handlerReportImpl) handleCostOfGoodsSold(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.CostOfGoodsSoldRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.CostOfGoodsSold(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "cmv", resp)
+}
+
+// handleGrossMargin handles the gross margin per product.
+func (h *handlerReportImpl) handleGrossMargin(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.GrossMarginRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.GrossMargin(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "margem-bruta", resp)
+}
+
+// handleStockReconciliation handles the stored stock balances checked against the movement ledger.
+func (h *handlerReportImpl) handleStockReconciliation(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.StockReconciliationRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.StockReconciliation(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "conciliacao-estoque", resp)
+}
+
+// handleComboSales handles the combos sold per combo and per component.
+func (h *handlerReportImpl) handleComboSales(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.ComboSalesRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.ComboSales(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "vendas-combos", resp)
+}
+
+// handlePriceListSales handles the sales per applied price list.
+func (h *handlerReportImpl) handlePriceListSales(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.PriceListSalesRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.PriceListSales(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "vendas-listas-preco", resp)
+}
+
+// handleProfitAndLoss handles the monthly profit and loss statement.
+func (h *handlerReportImpl) handleProfitAndLoss(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.ProfitAndLossRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.ProfitAndLoss(r.Context(), &req)
+	if errors.Is(err, reportusecases.ErrInvalidMonth) {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "demonstrativo-de-resultado", resp)
+}
+
+// handleCashFlowProjection handles the daily cash-flow projection.
+func (h *handlerReportImpl) handleCashFlowProjection(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.CashFlowProjectionRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.CashFlowProjection(r.Context(), &req)
+	if errors.Is(err, reportusecases.ErrCashFlowPeriod) {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "projecao-fluxo-de-caixa", resp)
+}
+
+// handleMenuEngineering handles the star/plowhorse/puzzle/dog classification of the variations sold.
+func (h *handlerReportImpl) handleMenuEngineering(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.MenuEngineeringRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.MenuEngineering(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "engenharia-de-cardapio", resp)
+}
+
+// handleAbcCurve handles the ABC (Pareto) curve of revenue per product.
+func (h *handlerReportImpl) handleAbcCurve(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.AbcCurveRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.AbcCurve(r.Context(), &req)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "curva-abc", resp)
+}
+
+// handleSalesForecast handles the orders and units forecast with prep list and staffing per hour.
+func (h *handlerReportImpl) handleSalesForecast(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.SalesForecastRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.SalesForecast(r.Context(), &req)
+	if errors.Is(err, reportusecases.ErrSalesForecastDays) {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "previsao-de-vendas", resp)
+}
+
+// handleConsolidatedReport handles the sales summary of every store the user has statistics permission on.
+func (h *handlerReportImpl) handleConsolidatedReport(w http.ResponseWriter, r *http.Request) {
+	var req reportdto.ConsolidatedReportRequest
+	if !parseBody(r, &req, w) {
+		return
+	}
+
+	resp, err := h.s.ConsolidatedReport(r.Context(), &req)
+	if errors.Is(err, reportusecases.ErrConsolidatedNoCompanies) || errors.Is(err, reportusecases.ErrConsolidatedForbidden) {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusForbidden, err)
+		return
+	}
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	h.respondReport(w, r, "consolidado-lojas", resp)
+}
+
+// respondReport writes JSON by default or the report as a CSV, XLSX or PDF attachment,
+// chosen by ?format=csv|xlsx|pdf or by the Accept header.
+func (h *handlerReportImpl) respondReport(w http.ResponseWriter, r *http.Request, filename string, resp any) {
+	format := reportusecases.ParseExportFormat(r.URL.Query().Get("format"), r.Header.Get("Accept"))
+	if format == reportusecases.ExportJSON {
+		jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+		return
+	}
+
+	export, err := h.s.Export(r.Context(), format, reportusecases.ReportTitle(filename), resp)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", format.Filename(filename)))
+	w.WriteHeader(http.StatusOK)
+
+	// Cabeçalho já enviado: um erro aqui só pode interromper o arquivo
+	if err := export.Write(w); err != nil {
+		fmt.Printf("error writing report %s: %v\n", filename, err)
+	}
+}
+
+// compareReport answers, for any report route, the requests with a "compare" field through the report catalog.
+// Requests without it reach the report handler with the body untouched.
+func (h *handlerReportImpl) compareReport(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if !reportusecases.HasComparison(body) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		reportType := path.Base(r.URL.Path)
+		resp, err := h.s.CompareReport(r.Context(), reportType, body)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, reportComparisonErrorStatus(err), err)
+			return
+		}
+		h.respondReport(w, r, reportusecases.ReportFilename(reportType)+"-comparativo", resp)
+	})
+}
+
+func reportComparisonErrorStatus(err error) int {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, reportusecases.ErrReportTypeUnknown):
+		return http.StatusNotFound
+	case errors.Is(err, reportusecases.ErrComparisonMode),
+		errors.Is(err, reportusecases.ErrComparisonPeriod),
+		errors.Is(err, reportusecases.ErrComparisonCustom),
+		errors.Is(err, reportusecases.ErrInvalidMonth),
+		errors.Is(err, reportusecases.ErrCashFlowPeriod),
+		errors.Is(err, reportusecases.ErrSalesForecastDays),
+		errors.As(err, &syntaxErr),
+		errors.As(err, &typeErr):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
 type handlerReportImpl struct {
@@ -96,7 +407,7 @@ func (h *handlerReportImpl) handleSalesTotalByDay(w http.ResponseWriter, r *http
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-dia", resp)
 }
 
 func (h *handlerReportImpl) handleRevenueCumulativeByMonth(w http.ResponseWriter, r *http.Request) {
@@ -110,7 +421,7 @@ func (h *handlerReportImpl) handleRevenueCumulativeByMonth(w http.ResponseWriter
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "receita-acumulada-por-mes", resp)
 }
 
 func (h *handlerReportImpl) handleSalesByHour(w http.ResponseWriter, r *http.Request) {
@@ -124,7 +435,7 @@ func (h *handlerReportImpl) handleSalesByHour(w http.ResponseWriter, r *http.Req
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-hora", resp)
 }
 
 func (h *handlerReportImpl) handleSalesByChannel(w http.ResponseWriter, r *http.Request) {
@@ -138,7 +449,7 @@ func (h *handlerReportImpl) handleSalesByChannel(w http.ResponseWriter, r *http.
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-canal", resp)
 }
 
 func (h *handlerReportImpl) handleAvgTicketByDay(w http.ResponseWriter, r *http.Request) {
@@ -152,7 +463,7 @@ func (h *handlerReportImpl) handleAvgTicketByDay(w http.ResponseWriter, r *http.
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "ticket-medio-por-dia", resp)
 }
 
 func (h *handlerReportImpl) handleAvgTicketByChannel(w http.ResponseWriter, r *http.Request) {
@@ -166,7 +477,7 @@ func (h *handlerReportImpl) handleAvgTicketByChannel(w http.ResponseWriter, r *h
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "ticket-medio-por-canal", resp)
 }
 
 func (h *handlerReportImpl) handleProductsSoldByDay(w http.ResponseWriter, r *http.Request) {
@@ -180,7 +491,7 @@ func (h *handlerReportImpl) handleProductsSoldByDay(w http.ResponseWriter, r *ht
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "produtos-vendidos-por-dia", resp)
 }
 
 func (h *handlerReportImpl) handleTopProducts(w http.ResponseWriter, r *http.Request) {
@@ -194,7 +505,7 @@ func (h *handlerReportImpl) handleTopProducts(w http.ResponseWriter, r *http.Req
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "produtos-mais-vendidos", resp)
 }
 
 func (h *handlerReportImpl) handleSalesByCategory(w http.ResponseWriter, r *http.Request) {
@@ -208,7 +519,7 @@ func (h *handlerReportImpl) handleSalesByCategory(w http.ResponseWriter, r *http
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-categoria", resp)
 }
 
 func (h *handlerReportImpl) handleClientsRegisteredByDay(w http.ResponseWriter, r *http.Request) {
@@ -222,7 +533,7 @@ func (h *handlerReportImpl) handleClientsRegisteredByDay(w http.ResponseWriter,
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "clientes-cadastrados-por-dia", resp)
 }
 
 func (h *handlerReportImpl) handleNewVsRecurringClients(w http.ResponseWriter, r *http.Request) {
@@ -236,7 +547,7 @@ func (h *handlerReportImpl) handleNewVsRecurringClients(w http.ResponseWriter, r
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "clientes-novos-e-recorrentes", resp)
 }
 
 func (h *handlerReportImpl) handleOrdersByStatus(w http.ResponseWriter, r *http.Request) {
@@ -249,7 +560,7 @@ func (h *handlerReportImpl) handleOrdersByStatus(w http.ResponseWriter, r *http.
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "pedidos-por-status", resp)
 }
 
 func (h *handlerReportImpl) handleAvgProcessStepDurationByRule(w http.ResponseWriter, r *http.Request) {
@@ -262,7 +573,7 @@ func (h *handlerReportImpl) handleAvgProcessStepDurationByRule(w http.ResponseWr
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "duracao-media-por-etapa", resp)
 }
 
 func (h *handlerReportImpl) handleCancellationRate(w http.ResponseWriter, r *http.Request) {
@@ -275,7 +586,7 @@ func (h *handlerReportImpl) handleCancellationRate(w http.ResponseWriter, r *htt
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "taxa-de-cancelamento", resp)
 }
 
 func (h *handlerReportImpl) handleCurrentQueueLength(w http.ResponseWriter, r *http.Request) {
@@ -285,7 +596,7 @@ func (h *handlerReportImpl) handleCurrentQueueLength(w http.ResponseWriter, r *h
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "fila-atual", resp)
 }
 
 func (h *handlerReportImpl) handleAvgDeliveryTimeByDriver(w http.ResponseWriter, r *http.Request) {
@@ -298,7 +609,7 @@ func (h *handlerReportImpl) handleAvgDeliveryTimeByDriver(w http.ResponseWriter,
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "tempo-medio-de-entrega-por-entregador", resp)
 }
 
 func (h *handlerReportImpl) handleDeliveriesPerDriver(w http.ResponseWriter, r *http.Request) {
@@ -311,7 +622,7 @@ func (h *handlerReportImpl) handleDeliveriesPerDriver(w http.ResponseWriter, r *
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "entregas-por-entregador", resp)
 }
 
 func (h *handlerReportImpl) handleOrdersPerTable(w http.ResponseWriter, r *http.Request) {
@@ -324,7 +635,7 @@ func (h *handlerReportImpl) handleOrdersPerTable(w http.ResponseWriter, r *http.
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "pedidos-por-mesa", resp)
 }
 
 // handleAvgQueueDuration handles average queue duration report.
@@ -338,7 +649,7 @@ func (h *handlerReportImpl) handleAvgQueueDuration(w http.ResponseWriter, r *htt
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "duracao-media-da-fila", resp)
 }
 
 // handleAvgProcessDurationByProduct handles average process duration by product report.
@@ -352,7 +663,7 @@ func (h *handlerReportImpl) handleAvgProcessDurationByProduct(w http.ResponseWri
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "duracao-media-de-producao-por-produto", resp)
 }
 
 // handleTotalQueueTimeByGroupItem handles total queue time per group item report.
@@ -366,7 +677,7 @@ func (h *handlerReportImpl) handleTotalQueueTimeByGroupItem(w http.ResponseWrite
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "tempo-de-fila-por-grupo", resp)
 }
 
 func (h *handlerReportImpl) handleSalesByShift(w http.ResponseWriter, r *http.Request) {
@@ -380,7 +691,7 @@ func (h *handlerReportImpl) handleSalesByShift(w http.ResponseWriter, r *http.Re
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-turno", resp)
 }
 
 // handleTopTables handles the report for top 10 most used tables.
@@ -394,7 +705,7 @@ func (h *handlerReportImpl) handleTopTables(w http.ResponseWriter, r *http.Reque
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "mesas-mais-usadas", resp)
 }
 
 func (h *handlerReportImpl) handlePaymentsByMethod(w http.ResponseWriter, r *http.Request) {
@@ -408,7 +719,7 @@ func (h *handlerReportImpl) handlePaymentsByMethod(w http.ResponseWriter, r *htt
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "pagamentos-por-forma", resp)
 }
 
 func (h *handlerReportImpl) handleEmployeePaymentsReport(w http.ResponseWriter, r *http.Request) {
@@ -422,7 +733,7 @@ func (h *handlerReportImpl) handleEmployeePaymentsReport(w http.ResponseWriter,
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "pagamentos-de-funcionarios", resp)
 }
 
 // Custom reports handlers:
@@ -437,7 +748,7 @@ func (h *handlerReportImpl) handleSalesByPlace(w http.ResponseWriter, r *http.Re
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-ambiente", resp)
 }
 
 func (h *handlerReportImpl) handleSalesBySize(w http.ResponseWriter, r *http.Request) {
@@ -451,7 +762,7 @@ func (h *handlerReportImpl) handleSalesBySize(w http.ResponseWriter, r *http.Req
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "vendas-por-tamanho", resp)
 }
 
 func (h *handlerReportImpl) handleAdditionalItemsSold(w http.ResponseWriter, r *http.Request) {
@@ -465,7 +776,7 @@ func (h *handlerReportImpl) handleAdditionalItemsSold(w http.ResponseWriter, r *
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "adicionais-vendidos", resp)
 }
 
 func (h *handlerReportImpl) handleComplementItemsSold(w http.ResponseWriter, r *http.Request) {
@@ -479,7 +790,7 @@ func (h *handlerReportImpl) handleComplementItemsSold(w http.ResponseWriter, r *
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "complementos-vendidos", resp)
 }
 
 func (h *handlerReportImpl) handleAvgPickupTime(w http.ResponseWriter, r *http.Request) {
@@ -493,7 +804,7 @@ func (h *handlerReportImpl) handleAvgPickupTime(w http.ResponseWriter, r *http.R
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "tempo-medio-de-retirada", resp)
 }
 
 func (h *handlerReportImpl) handleGroupItemsByStatus(w http.ResponseWriter, r *http.Request) {
@@ -507,7 +818,7 @@ func (h *handlerReportImpl) handleGroupItemsByStatus(w http.ResponseWriter, r *h
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "grupos-por-status", resp)
 }
 
 func (h *handlerReportImpl) handleDeliveriesByCep(w http.ResponseWriter, r *http.Request) {
@@ -521,7 +832,7 @@ func (h *handlerReportImpl) handleDeliveriesByCep(w http.ResponseWriter, r *http
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "entregas-por-cep", resp)
 }
 
 func (h *handlerReportImpl) handleProcessedCountByRule(w http.ResponseWriter, r *http.Request) {
@@ -535,5 +846,5 @@ func (h *handlerReportImpl) handleProcessedCountByRule(w http.ResponseWriter, r
 		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
 		return
 	}
-	jsonpkg.ResponseJson(w, r, http.StatusOK, resp)
+	h.respondReport(w, r, "processados-por-etapa", resp)
 }
@@ -0,0 +1,212 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	reportsubscriptionentity "github.com/willjrcom/sales-backend-go/internal/domain/report_subscription"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	reportsubscriptiondto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report_subscription"
+	reportusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report"
+	reportsubscriptionusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report_subscription"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerReportSubscriptionImpl struct {
+	s *reportsubscriptionusecases.Service
+}
+
+func NewHandlerReportSubscription(reportSubscriptionService *reportsubscriptionusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerReportSubscriptionImpl{
+		s: reportSubscriptionService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateReportSubscription)
+		c.Patch("/update/{id}", h.handlerUpdateReportSubscription)
+		c.Delete("/{id}", h.handlerDeleteReportSubscription)
+		c.Get("/all", h.handlerGetAllReportSubscriptions)
+		c.Get("/report-types", h.handlerGetReportTypes)
+		c.Get("/{id}", h.handlerGetReportSubscriptionById)
+		c.Get("/{id}/deliveries", h.handlerGetDeliveries)
+		c.Post("/{id}/send", h.handlerSendReportSubscriptionNow)
+	})
+
+	return handler.NewHandler("/report-subscription", c)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerCreateReportSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &reportsubscriptiondto.ReportSubscriptionCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateReportSubscription(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerUpdateReportSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &reportsubscriptiondto.ReportSubscriptionUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateReportSubscription(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerDeleteReportSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteReportSubscription(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerGetReportSubscriptionById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	subscription, err := h.s.GetReportSubscriptionById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, subscription)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerGetAllReportSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subscriptions, err := h.s.GetAllReportSubscriptions(ctx)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, subscriptions)
+}
+
+// handlerGetReportTypes lists the report_type values accepted by a subscription
+func (h *handlerReportSubscriptionImpl) handlerGetReportTypes(w http.ResponseWriter, r *http.Request) {
+	jsonpkg.ResponseJson(w, r, http.StatusOK, reportusecases.ReportTypes())
+}
+
+func (h *handlerReportSubscriptionImpl) handlerGetDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	deliveries, err := h.s.GetDeliveries(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, deliveries)
+}
+
+func (h *handlerReportSubscriptionImpl) handlerSendReportSubscriptionNow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	// The delivery is returned even when it failed: its status and error are in the body
+	delivery, err := h.s.SendReportSubscriptionNow(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, reportSubscriptionErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, delivery)
+}
+
+func reportSubscriptionErrorStatus(err error) int {
+	if errors.Is(err, reportsubscriptionusecases.ErrReportSubscriptionNotFound) {
+		return http.StatusNotFound
+	}
+
+	businessErrors := []error{
+		reportsubscriptionentity.ErrReportSubscriptionTypeRequired,
+		reportsubscriptionentity.ErrReportSubscriptionRecipientsRequired,
+		reportsubscriptionentity.ErrReportSubscriptionRecipientInvalid,
+		reportsubscriptionentity.ErrReportSubscriptionFormatInvalid,
+		reportsubscriptionentity.ErrReportSubscriptionFrequencyInvalid,
+		reportsubscriptionentity.ErrReportSubscriptionHourInvalid,
+		reportsubscriptionentity.ErrReportSubscriptionWeekdayInvalid,
+		reportsubscriptionentity.ErrReportSubscriptionDayOfMonthInvalid,
+		reportusecases.ErrReportTypeUnknown,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,281 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	stocklocationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/stock_location"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	stocklocationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock_location"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerStockLocationImpl struct {
+	s *stocklocationusecases.Service
+}
+
+func NewHandlerStockLocation(stockLocationService *stocklocationusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerStockLocationImpl{
+		s: stockLocationService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateStockLocation)
+		c.Patch("/update/{id}", h.handlerUpdateStockLocation)
+		c.Delete("/{id}", h.handlerDeleteStockLocation)
+		c.Get("/all", h.handlerGetAllStockLocations)
+		c.Get("/stock/{stock_id}", h.handlerGetStockBalances)
+		c.Post("/transfer/new", h.handlerCreateStockTransfer)
+		c.Post("/transfer/{id}/receive", h.handlerReceiveStockTransfer)
+		c.Post("/transfer/{id}/cancel", h.handlerCancelStockTransfer)
+		c.Get("/transfer/all", h.handlerGetAllStockTransfers)
+		c.Get("/transfer/{id}", h.handlerGetStockTransferById)
+		c.Get("/{id}", h.handlerGetStockLocationById)
+	})
+
+	return handler.NewHandler("/stock-location", c)
+}
+
+func (h *handlerStockLocationImpl) handlerCreateStockLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &stocklocationdto.StockLocationCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateStockLocation(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLocationErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerStockLocationImpl) handlerUpdateStockLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &stocklocationdto.StockLocationUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateStockLocation(ctx, dtoId, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLocationErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStockLocationImpl) handlerDeleteStockLocation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteStockLocation(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStockLocationImpl) handlerGetStockLocationById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	location, err := h.s.GetStockLocationById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, location)
+}
+
+func (h *handlerStockLocationImpl) handlerGetAllStockLocations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	locations, err := h.s.GetAllStockLocations(ctx)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, locations)
+}
+
+func (h *handlerStockLocationImpl) handlerGetStockBalances(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	stockID := chi.URLParam(r, "stock_id")
+
+	if stockID == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("stock_id is required"))
+		return
+	}
+
+	balances, err := h.s.GetStockBalances(ctx, stockID)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, balances)
+}
+
+func (h *handlerStockLocationImpl) handlerCreateStockTransfer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &stocklocationdto.StockTransferCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	transfer, err := h.s.CreateStockTransfer(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLocationErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, transfer)
+}
+
+func (h *handlerStockLocationImpl) handlerReceiveStockTransfer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.ReceiveStockTransfer(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLocationErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStockLocationImpl) handlerCancelStockTransfer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.CancelStockTransfer(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLocationErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerStockLocationImpl) handlerGetStockTransferById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	transfer, err := h.s.GetStockTransferById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, transfer)
+}
+
+// handlerGetAllStockTransfers filtra por status (in_transit, received, cancelled) e por stock_id
+func (h *handlerStockLocationImpl) handlerGetAllStockTransfers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+	stockID := r.URL.Query().Get("stock_id")
+
+	transfers, count, err := h.s.GetAllStockTransfers(ctx, page, perPage, status, stockID)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, transfers)
+}
+
+// stockLocationErrorStatus devolve 400 para regras de locais/transferências e 500 para o restante
+func stockLocationErrorStatus(err error) int {
+	businessErrors := []error{
+		stocklocationentity.ErrLocationNameRequired,
+		stocklocationentity.ErrLocationInactive,
+		stocklocationentity.ErrSameLocation,
+		stocklocationentity.ErrInvalidTransferQuantity,
+		stocklocationentity.ErrTransferNotInTransit,
+		stocklocationentity.ErrTransferLocationRequired,
+		stockentity.ErrInvalidQuantity,
+		stockentity.ErrInsufficientStock,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,142 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	stocklossdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/stock_loss"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	stocklossusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock_loss"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerStockLossImpl struct {
+	s *stocklossusecases.Service
+}
+
+func NewHandlerStockLoss(stockLossService *stocklossusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerStockLossImpl{
+		s: stockLossService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerRegisterStockLoss)
+		c.Get("/reasons", h.handlerGetLossReasons)
+		c.Get("/all", h.handlerGetAllStockLosses)
+		c.Get("/{id}", h.handlerGetStockLossById)
+	})
+
+	return handler.NewHandler("/stock-loss", c)
+}
+
+func (h *handlerStockLossImpl) handlerRegisterStockLoss(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dto := &stocklossdto.StockLossCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	loss, err := h.s.RegisterStockLoss(ctx, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, stockLossErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, loss)
+}
+
+func (h *handlerStockLossImpl) handlerGetLossReasons(w http.ResponseWriter, r *http.Request) {
+	jsonpkg.ResponseJson(w, r, http.StatusOK, stocklossdto.GetAllLossReasons())
+}
+
+func (h *handlerStockLossImpl) handlerGetStockLossById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	loss, err := h.s.GetStockLossById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, loss)
+}
+
+// handlerGetAllStockLosses filtra por motivo e período (start/end em YYYY-MM-DD, end inclusivo)
+func (h *handlerStockLossImpl) handlerGetAllStockLosses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	reason := r.URL.Query().Get("reason")
+
+	var start, end *time.Time
+	for param, target := range map[string]**time.Time{"start": &start, "end": &end} {
+		value := r.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid "+param+" parameter"))
+			return
+		}
+		*target = &date
+	}
+
+	if end != nil {
+		nextDay := end.AddDate(0, 0, 1)
+		end = &nextDay
+	}
+
+	losses, count, err := h.s.GetAllStockLosses(ctx, page, perPage, reason, start, end)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, losses)
+}
+
+// stockLossErrorStatus devolve 400 para regras da perda e 500 para falhas de infraestrutura
+func stockLossErrorStatus(err error) int {
+	businessErrors := []error{
+		stocklossentity.ErrInvalidLossReason,
+		stocklossentity.ErrInvalidLossQuantity,
+		stocklossentity.ErrStockRequired,
+		stocklossentity.ErrMultipleLossSources,
+		stocklossusecases.ErrOrderProcessNotCancelled,
+		stocklossusecases.ErrGroupItemNotCancelled,
+		stockentity.ErrInvalidQuantity,
+		stockentity.ErrInsufficientStock,
+	}
+
+	for _, businessErr := range businessErrors {
+		if errors.Is(err, businessErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
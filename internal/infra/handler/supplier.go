@@ -0,0 +1,156 @@
+package handlerimpl
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	supplierdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/supplier"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	supplierusecases "github.com/willjrcom/sales-backend-go/internal/usecases/supplier"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+type handlerSupplierImpl struct {
+	s *supplierusecases.Service
+}
+
+func NewHandlerSupplier(supplierService *supplierusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerSupplierImpl{
+		s: supplierService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/new", h.handlerCreateSupplier)
+		c.Patch("/update/{id}", h.handlerUpdateSupplier)
+		c.Delete("/{id}", h.handlerDeleteSupplier)
+		c.Get("/all", h.handlerGetAllSuppliers)
+		c.Get("/{id}", h.handlerGetSupplierById)
+	})
+
+	return handler.NewHandler("/supplier", c)
+}
+
+func (h *handlerSupplierImpl) handlerCreateSupplier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dtoSupplier := &supplierdto.SupplierCreateDTO{}
+	if err := jsonpkg.ParseBody(r, dtoSupplier); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := h.s.CreateSupplier(ctx, dtoSupplier)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, supplierErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusCreated, id)
+}
+
+func (h *handlerSupplierImpl) handlerUpdateSupplier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dtoSupplier := &supplierdto.SupplierUpdateDTO{}
+	if err := jsonpkg.ParseBody(r, dtoSupplier); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.s.UpdateSupplier(ctx, dtoId, dtoSupplier); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, supplierErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerSupplierImpl) handlerDeleteSupplier(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	if err := h.s.DeleteSupplier(ctx, dtoId); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, nil)
+}
+
+func (h *handlerSupplierImpl) handlerGetSupplierById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	supplier, err := h.s.GetSupplierById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, supplier)
+}
+
+func (h *handlerSupplierImpl) handlerGetAllSuppliers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	isActive := true
+	if isActiveParam := r.URL.Query().Get("is_active"); isActiveParam != "" {
+		var err error
+		isActive, err = strconv.ParseBool(isActiveParam)
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("invalid is_active parameter"))
+			return
+		}
+	}
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+
+	suppliers, count, err := h.s.GetAllSuppliers(ctx, page, perPage, isActive)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, suppliers)
+}
+
+func supplierErrorStatus(err error) int {
+	if errors.Is(err, supplierentity.ErrSupplierNameRequired) || errors.Is(err, supplierentity.ErrInvalidCnpj) || errors.Is(err, supplierentity.ErrInvalidLeadTime) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
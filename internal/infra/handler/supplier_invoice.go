@@ -0,0 +1,202 @@
+package handlerimpl
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+	supplierinvoiceentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier_invoice"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	supplierinvoicedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/supplier_invoice"
+	headerservice "github.com/willjrcom/sales-backend-go/internal/infra/service/header"
+	supplierinvoiceusecases "github.com/willjrcom/sales-backend-go/internal/usecases/supplier_invoice"
+	jsonpkg "github.com/willjrcom/sales-backend-go/pkg/json"
+)
+
+// NF-e de compra raramente passa de algumas centenas de KB
+const maxSupplierInvoiceXMLSize = 5 << 20
+
+type handlerSupplierInvoiceImpl struct {
+	s *supplierinvoiceusecases.Service
+}
+
+func NewHandlerSupplierInvoice(supplierInvoiceService *supplierinvoiceusecases.Service) *handler.Handler {
+	c := chi.NewRouter()
+
+	h := &handlerSupplierInvoiceImpl{
+		s: supplierInvoiceService,
+	}
+
+	c.With().Group(func(c chi.Router) {
+		c.Post("/upload", h.handlerUploadSupplierInvoice)
+		c.Get("/all", h.handlerGetAllSupplierInvoices)
+		c.Get("/{id}", h.handlerGetSupplierInvoiceById)
+		c.Put("/{id}/mapping", h.handlerUpdateSupplierInvoiceMapping)
+		c.Post("/{id}/import", h.handlerImportSupplierInvoice)
+	})
+
+	return handler.NewHandler("/supplier-invoice", c)
+}
+
+// handlerUploadSupplierInvoice godoc
+// @Summary Upload supplier NF-e XML
+// @Description Parses a purchase NF-e (multipart field "file" or raw body) and returns the item preview with suggested stock mappings
+// @Tags Stock
+// @Accept xml
+// @Produce json
+// @Success 200 {object} supplierinvoicedto.SupplierInvoiceDTO
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /supplier-invoice/upload [post]
+func (h *handlerSupplierInvoiceImpl) handlerUploadSupplierInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxSupplierInvoiceXMLSize)
+
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+		defer file.Close()
+		body = file
+	}
+
+	invoice, err := h.s.UploadSupplierInvoice(ctx, body)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, supplierInvoiceErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, invoice)
+}
+
+func (h *handlerSupplierInvoiceImpl) handlerUpdateSupplierInvoiceMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	dto := &supplierinvoicedto.SupplierInvoiceMappingDTO{}
+	if err := jsonpkg.ParseBody(r, dto); err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	invoice, err := h.s.UpdateSupplierInvoiceMapping(ctx, dtoId, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, supplierInvoiceErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, invoice)
+}
+
+// handlerImportSupplierInvoice aceita, opcionalmente, os últimos ajustes de mapeamento no corpo
+func (h *handlerSupplierInvoiceImpl) handlerImportSupplierInvoice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	var dto *supplierinvoicedto.SupplierInvoiceMappingDTO
+	if r.ContentLength > 0 {
+		dto = &supplierinvoicedto.SupplierInvoiceMappingDTO{}
+		if err := jsonpkg.ParseBody(r, dto); err != nil {
+			jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	result, err := h.s.ImportSupplierInvoice(ctx, dtoId, dto)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, supplierInvoiceErrorStatus(err), err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, result)
+}
+
+func (h *handlerSupplierInvoiceImpl) handlerGetSupplierInvoiceById(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+
+	if id == "" {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusBadRequest, errors.New("id is required"))
+		return
+	}
+
+	dtoId := &entitydto.IDRequest{ID: uuid.MustParse(id)}
+
+	invoice, err := h.s.GetSupplierInvoiceById(ctx, dtoId)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonpkg.ResponseJson(w, r, http.StatusOK, invoice)
+}
+
+func (h *handlerSupplierInvoiceImpl) handlerGetAllSupplierInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	page, perPage := headerservice.GetPageAndPerPage(r, 0, 100)
+	status := r.URL.Query().Get("status")
+
+	invoices, count, err := h.s.GetAllSupplierInvoices(ctx, page, perPage, status)
+	if err != nil {
+		jsonpkg.ResponseErrorJson(w, r, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(count))
+	jsonpkg.ResponseJson(w, r, http.StatusOK, invoices)
+}
+
+func supplierInvoiceErrorStatus(err error) int {
+	if errors.Is(err, supplierinvoiceentity.ErrInvoiceAlreadyImported) {
+		return http.StatusConflict
+	}
+
+	badRequestErrors := []error{
+		supplierinvoiceusecases.ErrInvalidNFeXML,
+		supplierinvoiceusecases.ErrInvoiceNotAddressedToCompany,
+		supplierinvoiceusecases.ErrEmitterCnpjRequired,
+		supplierinvoiceentity.ErrInvalidAccessKey,
+		supplierinvoiceentity.ErrInvoiceWithoutItems,
+		supplierinvoiceentity.ErrInvoiceItemNotFound,
+		supplierinvoiceentity.ErrInvoiceItemsNotMapped,
+		supplierinvoiceentity.ErrInvalidConversionFactor,
+		supplierentity.ErrSupplierNameRequired,
+		supplierentity.ErrInvalidCnpj,
+	}
+
+	for _, badRequestErr := range badRequestErrors {
+		if errors.Is(err, badRequestErr) {
+			return http.StatusBadRequest
+		}
+	}
+
+	return http.StatusInternalServerError
+}
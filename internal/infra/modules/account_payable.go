@@ -0,0 +1,21 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	accountpayablerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/account_payable"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
+)
+
+func NewAccountPayableModule(db *bun.DB, chi *server.ServerChi) (model.AccountPayableRepository, *accountpayableusecases.Service, *handler.Handler) {
+	repository := accountpayablerepositorybun.NewAccountPayableRepositoryBun(db)
+	categoryRepository := accountpayablerepositorybun.NewExpenseCategoryRepositoryBun(db)
+	service := accountpayableusecases.NewService(db, repository, categoryRepository)
+	handler := handlerimpl.NewHandlerAccountPayable(service)
+	chi.AddHandler(handler)
+	chi.AddHandler(handlerimpl.NewHandlerExpenseCategory(service))
+	return repository, service, handler
+}
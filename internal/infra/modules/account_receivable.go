@@ -0,0 +1,22 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	accountreceivablerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/account_receivable"
+	accountreceivableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_receivable"
+)
+
+func NewAccountReceivableModule(db *bun.DB, chi *server.ServerChi) (model.AccountReceivableRepository, *accountreceivableusecases.Service, *handler.Handler) {
+	repository := accountreceivablerepositorybun.NewAccountReceivableRepositoryBun(db)
+	feeRepository := accountreceivablerepositorybun.NewPayMethodFeeRepositoryBun(db)
+	statementRepository := accountreceivablerepositorybun.NewSettlementStatementRepositoryBun(db)
+	service := accountreceivableusecases.NewService(db, repository, feeRepository, statementRepository)
+	handler := handlerimpl.NewHandlerAccountReceivable(service)
+	chi.AddHandler(handler)
+	chi.AddHandler(handlerimpl.NewHandlerCardSettlement(service))
+	return repository, service, handler
+}
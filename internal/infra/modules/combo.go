@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	comborepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/combo"
+	combousecases "github.com/willjrcom/sales-backend-go/internal/usecases/combo"
+)
+
+func NewComboModule(db *bun.DB, chi *server.ServerChi) (model.ComboRepository, *combousecases.Service, *handler.Handler) {
+	repository := comborepositorybun.NewComboRepositoryBun(db)
+	service := combousecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerCombo(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
@@ -17,7 +17,7 @@ import (
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
 )
 
-func NewCompanyModule(db *bun.DB, chi *server.ServerChi, costRepo model.CompanyUsageCostRepository, orderRepository model.OrderRepository, orderService *orderusecases.OrderService) (model.CompanyRepository, *companyusecases.Service, *billingusecases.CheckoutUseCase, *handler.Handler) {
+func NewCompanyModule(db *bun.DB, chi *server.ServerChi, costRepo model.CompanyUsageCostRepository, orderRepository model.OrderRepository, orderService *orderusecases.OrderService) (model.CompanyRepository, *companyusecases.Service, *billingusecases.CheckoutUseCase, *scheduler.DailyScheduler, *handler.Handler) {
 	companyRepository := companyrepositorybun.NewCompanyRepositoryBun(db)
 	companySubscriptionRepo := companyrepositorybun.NewCompanySubscriptionRepositoryBun(db)
 	companyPaymentRepo := companyrepositorybun.NewCompanyPaymentRepositoryBun(db)
@@ -35,5 +35,5 @@ func NewCompanyModule(db *bun.DB, chi *server.ServerChi, costRepo model.CompanyU
 
 	handler := handlerimpl.NewHandlerCompany(service, checkoutUC, costService, dailyScheduler)
 	chi.AddHandler(handler)
-	return companyRepository, service, checkoutUC, handler
+	return companyRepository, service, checkoutUC, dailyScheduler, handler
 }
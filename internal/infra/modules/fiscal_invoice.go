@@ -7,6 +7,7 @@ import (
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 	fiscalinvoicerepository "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/fiscal_invoice"
 	fiscalsettingsrepository "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/fiscal_settings"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/fiscalprovider"
 	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	fiscalinvoiceusecases "github.com/willjrcom/sales-backend-go/internal/usecases/fiscal_invoice"
@@ -27,7 +28,7 @@ func NewFiscalInvoiceModule(
 	fiscalSettingsRepo := fiscalsettingsrepository.NewFiscalSettingsRepositoryBun(db)
 
 	// Services
-	focusClient := focusnfe.NewClient()
+	fiscalProviders := newFiscalProviderRegistry()
 	usageCostService := companyusecases.NewUsageCostService(usageCostRepo, companyRepo)
 	fiscalInvoiceService := fiscalinvoiceusecases.NewService(
 		fiscalInvoiceRepo,
@@ -36,7 +37,7 @@ func NewFiscalInvoiceModule(
 		fiscalSettingsRepo,
 		orderRepo,
 		usageCostService,
-		focusClient,
+		fiscalProviders,
 	)
 
 	// Handlers
@@ -46,3 +47,10 @@ func NewFiscalInvoiceModule(
 
 	return fiscalInvoiceRepo, fiscalInvoiceService, usageCostService
 }
+
+// newFiscalProviderRegistry registers the available fiscal providers; FiscalSettings.Provider selects one per company
+func newFiscalProviderRegistry() *fiscalprovider.Registry {
+	return fiscalprovider.NewRegistry(
+		fiscalprovider.NewFocusNFeProvider(focusnfe.NewClient()),
+	)
+}
@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	ibptrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/ibpt"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
+)
+
+func NewIbptModule(db *bun.DB, chi *server.ServerChi) (*ibptusecases.Service, *handler.Handler) {
+	ibptRepo := ibptrepositorybun.NewIbptTaxRateRepositoryBun(db)
+	ibptService := ibptusecases.NewService(ibptRepo)
+	ibptHandler := handlerimpl.NewHandlerIbpt(ibptService)
+
+	chi.AddHandler(ibptHandler)
+
+	return ibptService, ibptHandler
+}
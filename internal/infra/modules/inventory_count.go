@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	inventorycountrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/inventory_count"
+	inventorycountusecases "github.com/willjrcom/sales-backend-go/internal/usecases/inventory_count"
+)
+
+func NewInventoryCountModule(db *bun.DB, chi *server.ServerChi) (model.InventoryCountRepository, *inventorycountusecases.Service, *handler.Handler) {
+	repository := inventorycountrepositorybun.NewInventoryCountRepositoryBun(db)
+	service := inventorycountusecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerInventoryCount(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
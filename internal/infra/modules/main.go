@@ -5,6 +5,7 @@ import (
 	"github.com/willjrcom/sales-backend-go/bootstrap/server"
 	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
 	companyrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/company"
+	fiscalsettingsrepository "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/fiscal_settings"
 	emailservice "github.com/willjrcom/sales-backend-go/internal/infra/service/email"
 	"github.com/willjrcom/sales-backend-go/internal/infra/service/rabbitmq"
 	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
@@ -15,6 +16,8 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 	productCategoryRepository, _, _ := NewProductCategoryModule(db, chi)
 	NewProductCategorySizeModule(db, chi)
 	processRuleRepository, processRuleService, _ := NewProductCategoryProcessRuleModule(db, chi)
+	comboRepository, _, _ := NewComboModule(db, chi)
+	priceListRepository, _, _ := NewPriceListModule(db, chi)
 
 	addressRepository := NewAddressModule(db, chi)
 	clientRepository, clientService, _ := NewClientModule(db, chi)
@@ -24,7 +27,7 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 	shiftRepository, shiftService, _ := NewShiftModule(db, chi)
 
 	// Stock module - deve ser inicializado antes do item module para injeção de dependência
-	stockRepo, stockMovementRepo, _, _, stockService, _ := NewStockModule(db, chi)
+	stockRepo, stockMovementRepo, _, stockBatchRepo, stockService, _ := NewStockModule(db, chi)
 
 	groupItemRepository, groupItemService, _ := NewGroupItemModule(db, chi)
 	itemRepository, itemService, _ := NewItemModule(db, chi)
@@ -46,7 +49,7 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 	usageCostRepo := companyrepositorybun.NewCompanyUsageCostRepository(db)
 	companySubscriptionRepo := companyrepositorybun.NewCompanySubscriptionRepositoryBun(db)
 
-	companyRepository, companyService, checkoutUC, _ := NewCompanyModule(db, chi, usageCostRepo, orderRepository, orderService)
+	companyRepository, companyService, checkoutUC, dailyScheduler, _ := NewCompanyModule(db, chi, usageCostRepo, orderRepository, orderService)
 	sponsorRepository, _, _ := NewSponsorModule(db, chi)
 	NewCompanyCategoryModule(db, chi)
 
@@ -58,12 +61,25 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 
 	go emailService.RunConsumer()
 	// Fiscal invoice and usage cost modules
-	_, _, _ = NewFiscalInvoiceModule(db, chi, companyRepository, companySubscriptionRepo, orderRepository, companyService, usageCostRepo)
+	_, fiscalInvoiceService, _ := NewFiscalInvoiceModule(db, chi, companyRepository, companySubscriptionRepo, orderRepository, companyService, usageCostRepo)
 	NewFiscalSettingsModule(db, chi, companyRepository, companyService)
+	ibptService, _ := NewIbptModule(db, chi)
+
+	// Purchasing: suppliers, purchase orders and accounts payable/receivable
+	supplierRepository, _, _ := NewSupplierModule(db, chi)
+	purchaseOrderRepository, purchaseOrderService, _ := NewPurchaseOrderModule(db, chi)
+	accountPayableRepository, accountPayableService, _ := NewAccountPayableModule(db, chi)
+	_, accountReceivableService, _ := NewAccountReceivableModule(db, chi)
+	supplierInvoiceService, _ := NewSupplierInvoiceModule(db, chi)
+	_, inventoryCountService, _ := NewInventoryCountModule(db, chi)
+	_, stockLossService, _ := NewStockLossModule(db, chi)
+	stockLocationRepository, stockLocationService, _ := NewStockLocationModule(db, chi)
+	replenishmentService, _ := NewReplenishmentModule(db, chi, stockRepo)
 
 	orderPrintService, _ := NewOrderPrintModule(db, chi)
 
-	NewReportModule(db, chi)
+	reportService := NewReportModule(db, chi)
+	_, reportSubscriptionService, _ := NewReportSubscriptionModule(db, chi)
 
 	// Add S3 handler
 	chi.AddHandler(handlerimpl.NewHandlerS3())
@@ -79,11 +95,18 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 	orderProcessService.AddDependencies(orderQueueService, processRuleRepository, groupItemService, orderRepository, employeeService, groupItemRepository, orderService)
 	processRuleService.AddDependencies(productCategoryRepository)
 
-	itemService.AddDependencies(groupItemRepository, orderRepository, productRepository, productCategoryRepository, employeeRepository, orderService, groupItemService, stockRepo, stockMovementRepo)
+	itemService.AddDependencies(groupItemRepository, orderRepository, productRepository, productCategoryRepository, employeeRepository, orderService, groupItemService, stockRepo, stockMovementRepo, companyRepository, comboRepository, priceListRepository)
 	groupItemService.AddDependencies(itemRepository, productRepository, orderService, orderProcessService, employeeRepository, itemService)
 
-	stockService.AddDependencies(productRepository, itemRepository, employeeRepository, orderRepository)
-	orderService.AddDependencies(orderRepository, shiftRepository, productRepository, processRuleRepository, orderDeliveryRepository, stockRepo, stockMovementRepo, stockService, companySubscriptionRepo, groupItemService, orderProcessService, orderQueueService, orderDeliveryService, orderPickupService, orderTableService, companyService, employeeRepository, rabbitmq, clientService)
+	stockService.AddDependencies(productRepository, itemRepository, employeeRepository, orderRepository, stockLocationRepository, processRuleRepository)
+	purchaseOrderService.AddDependencies(supplierRepository, stockRepo, stockService, accountPayableRepository, employeeRepository)
+	supplierInvoiceService.AddDependencies(supplierRepository, stockRepo, stockService, employeeRepository, companyRepository)
+	inventoryCountService.AddDependencies(stockRepo, stockBatchRepo, stockService, employeeRepository)
+	stockLossService.AddDependencies(stockRepo, stockService, orderProcessRepository, groupItemRepository, employeeRepository)
+	stockLocationService.AddDependencies(stockRepo, stockBatchRepo, stockService, employeeRepository)
+	replenishmentService.AddDependencies(stockMovementRepo, purchaseOrderRepository, supplierRepository, companyRepository, purchaseOrderService)
+	dailyScheduler.AddDependencies(replenishmentService, stockService, reportSubscriptionService, accountPayableService)
+	orderService.AddDependencies(orderRepository, shiftRepository, productRepository, processRuleRepository, orderDeliveryRepository, stockRepo, stockMovementRepo, stockService, companySubscriptionRepo, groupItemService, orderProcessService, orderQueueService, orderDeliveryService, orderPickupService, orderTableService, companyService, employeeRepository, rabbitmq, clientService, accountReceivableService)
 	orderDeliveryService.AddDependencies(addressRepository, clientRepository, orderRepository, orderService, deliveryDriverRepository, companyRepository, rabbitmq)
 	deliveryDriverService.AddDependencies(employeeRepository)
 	orderTableService.AddDependencies(tableRepository, orderService, companyService)
@@ -92,5 +115,12 @@ func MainModules(db *bun.DB, chi *server.ServerChi, s3 *s3service.S3Client, rabb
 	shiftService.AddDependencies(employeeService, orderRepository, deliveryDriverRepository, orderProcessRepository, orderQueueRepository, processRuleRepository, employeeRepository)
 	companyService.AddDependencies(addressRepository, *schemaService, userRepository, *userService, *employeeService, usageCostRepo, companySubscriptionRepo, rabbitmq)
 
-	orderPrintService.AddDependencies(orderService, orderRepository, shiftService, groupItemRepository, companyRepository, rabbitmq)
+	ibptService.AddDependencies(productRepository, companyRepository, fiscalsettingsrepository.NewFiscalSettingsRepositoryBun(db))
+	fiscalInvoiceService.AddDependencies(s3, emailService, ibptService)
+	accountPayableService.AddDependencies(s3)
+	accountReceivableService.AddDependencies(s3, companyRepository)
+
+	orderPrintService.AddDependencies(orderService, orderRepository, shiftService, groupItemRepository, companyRepository, rabbitmq, ibptService)
+	reportService.AddDependencies(companyRepository)
+	reportSubscriptionService.AddDependencies(reportService, emailService, companyRepository)
 }
@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	pricelistrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/price_list"
+	pricelistusecases "github.com/willjrcom/sales-backend-go/internal/usecases/price_list"
+)
+
+func NewPriceListModule(db *bun.DB, chi *server.ServerChi) (model.PriceListRepository, *pricelistusecases.Service, *handler.Handler) {
+	repository := pricelistrepositorybun.NewPriceListRepositoryBun(db)
+	service := pricelistusecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerPriceList(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
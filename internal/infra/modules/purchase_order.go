@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	purchaseorderrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/purchase_order"
+	purchaseorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/purchase_order"
+)
+
+func NewPurchaseOrderModule(db *bun.DB, chi *server.ServerChi) (model.PurchaseOrderRepository, *purchaseorderusecases.Service, *handler.Handler) {
+	repository := purchaseorderrepositorybun.NewPurchaseOrderRepositoryBun(db)
+	service := purchaseorderusecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerPurchaseOrder(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
@@ -0,0 +1,17 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	replenishmentusecases "github.com/willjrcom/sales-backend-go/internal/usecases/replenishment"
+)
+
+func NewReplenishmentModule(db *bun.DB, chi *server.ServerChi, stockRepo model.StockRepository) (*replenishmentusecases.Service, *handler.Handler) {
+	service := replenishmentusecases.NewService(db, stockRepo)
+	handler := handlerimpl.NewHandlerReplenishment(service)
+	chi.AddHandler(handler)
+	return service, handler
+}
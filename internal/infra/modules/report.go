@@ -10,7 +10,7 @@ import (
 )
 
 // NewReportModule registers report endpoints and services.
-func NewReportModule(db *bun.DB, chi *server.ServerChi) {
+func NewReportModule(db *bun.DB, chi *server.ServerChi) *reportusecases.Service {
 	// Initialize core report service
 	reportSvc := report.NewReportRepository(db)
 	// Wrap in usecase
@@ -19,4 +19,5 @@ func NewReportModule(db *bun.DB, chi *server.ServerChi) {
 	handler := handlerimpl.NewHandlerReport(usecase)
 	// Register handler
 	chi.AddHandler(handler)
+	return usecase
 }
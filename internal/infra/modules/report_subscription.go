@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	reportsubscriptionrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report_subscription"
+	reportsubscriptionusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report_subscription"
+)
+
+func NewReportSubscriptionModule(db *bun.DB, chi *server.ServerChi) (model.ReportSubscriptionRepository, *reportsubscriptionusecases.Service, *handler.Handler) {
+	repository := reportsubscriptionrepositorybun.NewReportSubscriptionRepositoryBun(db)
+	service := reportsubscriptionusecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerReportSubscription(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stocklocationrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/stock_location"
+	stocklocationusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock_location"
+)
+
+func NewStockLocationModule(db *bun.DB, chi *server.ServerChi) (model.StockLocationRepository, *stocklocationusecases.Service, *handler.Handler) {
+	repository := stocklocationrepositorybun.NewStockLocationRepositoryBun(db)
+	transferRepository := stocklocationrepositorybun.NewStockTransferRepositoryBun(db)
+	service := stocklocationusecases.NewService(db, repository, transferRepository)
+	handler := handlerimpl.NewHandlerStockLocation(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
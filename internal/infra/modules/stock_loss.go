@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stocklossrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/stock_loss"
+	stocklossusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock_loss"
+)
+
+func NewStockLossModule(db *bun.DB, chi *server.ServerChi) (model.StockLossRepository, *stocklossusecases.Service, *handler.Handler) {
+	repository := stocklossrepositorybun.NewStockLossRepositoryBun(db)
+	service := stocklossusecases.NewService(db, repository)
+	handler := handlerimpl.NewHandlerStockLoss(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
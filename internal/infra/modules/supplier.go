@@ -0,0 +1,19 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	supplierrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/supplier"
+	supplierusecases "github.com/willjrcom/sales-backend-go/internal/usecases/supplier"
+)
+
+func NewSupplierModule(db *bun.DB, chi *server.ServerChi) (model.SupplierRepository, *supplierusecases.Service, *handler.Handler) {
+	repository := supplierrepositorybun.NewSupplierRepositoryBun(db)
+	service := supplierusecases.NewService(repository)
+	handler := handlerimpl.NewHandlerSupplier(service)
+	chi.AddHandler(handler)
+	return repository, service, handler
+}
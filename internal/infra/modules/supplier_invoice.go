@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/handler"
+	"github.com/willjrcom/sales-backend-go/bootstrap/server"
+	handlerimpl "github.com/willjrcom/sales-backend-go/internal/infra/handler"
+	supplierrepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/supplier"
+	supplierinvoicerepositorybun "github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/supplier_invoice"
+	supplierinvoiceusecases "github.com/willjrcom/sales-backend-go/internal/usecases/supplier_invoice"
+)
+
+func NewSupplierInvoiceModule(db *bun.DB, chi *server.ServerChi) (*supplierinvoiceusecases.Service, *handler.Handler) {
+	repository := supplierinvoicerepositorybun.NewSupplierInvoiceRepositoryBun(db)
+	mappingRepository := supplierrepositorybun.NewSupplierProductMappingRepositoryBun(db)
+	service := supplierinvoiceusecases.NewService(db, repository, mappingRepository)
+	handler := handlerimpl.NewHandlerSupplierInvoice(service)
+	chi.AddHandler(handler)
+	return service, handler
+}
@@ -0,0 +1,117 @@
+package companyrepositorylocal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// CompanySubscriptionRepositoryLocal is an in-memory implementation of model.CompanySubscriptionRepository.
+type CompanySubscriptionRepositoryLocal struct {
+	mu            sync.RWMutex
+	subscriptions map[uuid.UUID]*model.CompanySubscription
+}
+
+func NewCompanySubscriptionRepositoryLocal() *CompanySubscriptionRepositoryLocal {
+	return &CompanySubscriptionRepositoryLocal{subscriptions: make(map[uuid.UUID]*model.CompanySubscription)}
+}
+
+func (r *CompanySubscriptionRepositoryLocal) CreateSubscription(ctx context.Context, subscription *model.CompanySubscription) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscriptions[subscription.ID] = subscription
+	return nil
+}
+
+func (r *CompanySubscriptionRepositoryLocal) UpdateSubscription(ctx context.Context, subscription *model.CompanySubscription) error {
+	return r.CreateSubscription(ctx, subscription)
+}
+
+func (r *CompanySubscriptionRepositoryLocal) MarkSubscriptionAsCancelled(ctx context.Context, companyID uuid.UUID, externalReference string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.subscriptions {
+		if s.CompanyID == companyID && s.ExternalReference != nil && *s.ExternalReference == externalReference {
+			s.IsCancelled = true
+		}
+	}
+	return nil
+}
+
+func (r *CompanySubscriptionRepositoryLocal) MarkSubscriptionAsActive(ctx context.Context, companyID uuid.UUID, externalReference string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.subscriptions {
+		if s.CompanyID == companyID && s.ExternalReference != nil && *s.ExternalReference == externalReference {
+			s.IsActive = true
+			s.IsCancelled = false
+		}
+	}
+	return nil
+}
+
+func (r *CompanySubscriptionRepositoryLocal) UpdateSubscriptionStatus(ctx context.Context, companyID uuid.UUID, status string, externalReference string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.subscriptions {
+		if s.CompanyID == companyID && s.ExternalReference != nil && *s.ExternalReference == externalReference {
+			s.Status = status
+		}
+	}
+	return nil
+}
+
+func (r *CompanySubscriptionRepositoryLocal) GetActiveSubscription(ctx context.Context, companyID uuid.UUID) (*model.CompanySubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.subscriptions {
+		if s.CompanyID == companyID && s.IsActive {
+			return s, nil
+		}
+	}
+	return nil, errors.New("subscription not found")
+}
+
+func (r *CompanySubscriptionRepositoryLocal) GetLastPlan(ctx context.Context, companyID uuid.UUID) (*model.CompanySubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var last *model.CompanySubscription
+	for _, s := range r.subscriptions {
+		if s.CompanyID == companyID && (last == nil || s.EndDate.After(last.EndDate)) {
+			last = s
+		}
+	}
+	if last == nil {
+		return nil, errors.New("subscription not found")
+	}
+	return last, nil
+}
+
+func (r *CompanySubscriptionRepositoryLocal) GetByPreapprovalID(ctx context.Context, preapprovalID string) (*model.CompanySubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.subscriptions {
+		if s.PreapprovalID != nil && *s.PreapprovalID == preapprovalID {
+			return s, nil
+		}
+	}
+	return nil, errors.New("subscription not found")
+}
+
+func (r *CompanySubscriptionRepositoryLocal) GetByExternalReference(ctx context.Context, externalReference string) (*model.CompanySubscription, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, s := range r.subscriptions {
+		if s.ExternalReference != nil && *s.ExternalReference == externalReference {
+			return s, nil
+		}
+	}
+	return nil, errors.New("subscription not found")
+}
+
+func (r *CompanySubscriptionRepositoryLocal) UpdateCompanyPlans(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,113 @@
+package companyrepositorylocal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// CompanyUsageCostRepositoryLocal is an in-memory implementation of model.CompanyUsageCostRepository.
+type CompanyUsageCostRepositoryLocal struct {
+	mu    sync.RWMutex
+	costs map[uuid.UUID]*model.CompanyUsageCost
+}
+
+func NewCompanyUsageCostRepositoryLocal() *CompanyUsageCostRepositoryLocal {
+	return &CompanyUsageCostRepositoryLocal{costs: make(map[uuid.UUID]*model.CompanyUsageCost)}
+}
+
+func (r *CompanyUsageCostRepositoryLocal) Create(ctx context.Context, cost *model.CompanyUsageCost) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.costs[cost.ID] = cost
+	return nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) GetByID(ctx context.Context, id uuid.UUID) (*model.CompanyUsageCost, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if cost, ok := r.costs[id]; ok {
+		return cost, nil
+	}
+	return nil, errors.New("usage cost not found")
+}
+
+func (r *CompanyUsageCostRepositoryLocal) GetMonthlyCosts(ctx context.Context, companyID uuid.UUID, month, year int) ([]*model.CompanyUsageCost, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*model.CompanyUsageCost{}
+	for _, cost := range r.costs {
+		if cost.CompanyID == companyID && int(cost.CreatedAt.Month()) == month && cost.CreatedAt.Year() == year {
+			result = append(result, cost)
+		}
+	}
+	return result, nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) GetMonthlyCostsPaginated(ctx context.Context, companyID uuid.UUID, month, year, page, perPage int) ([]*model.CompanyUsageCost, int, error) {
+	costs, _ := r.GetMonthlyCosts(ctx, companyID, month, year)
+	total := len(costs)
+	start := page * perPage
+	if start >= total {
+		return []*model.CompanyUsageCost{}, total, nil
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+	return costs[start:end], total, nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) Update(ctx context.Context, cost *model.CompanyUsageCost) error {
+	return r.Create(ctx, cost)
+}
+
+func (r *CompanyUsageCostRepositoryLocal) GetByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*model.CompanyUsageCost, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*model.CompanyUsageCost{}
+	for _, cost := range r.costs {
+		if cost.PaymentID != nil && *cost.PaymentID == paymentID {
+			result = append(result, cost)
+		}
+	}
+	return result, nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) GetPendingCosts(ctx context.Context, companyID uuid.UUID) ([]*model.CompanyUsageCost, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*model.CompanyUsageCost{}
+	for _, cost := range r.costs {
+		if cost.CompanyID == companyID && cost.PaymentID == nil {
+			result = append(result, cost)
+		}
+	}
+	return result, nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) UpdateCostsPaymentID(ctx context.Context, costIDs []uuid.UUID, paymentID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range costIDs {
+		if cost, ok := r.costs[id]; ok {
+			pid := paymentID
+			cost.PaymentID = &pid
+		}
+	}
+	return nil
+}
+
+func (r *CompanyUsageCostRepositoryLocal) UnlinkCostsFromPayment(ctx context.Context, paymentID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, cost := range r.costs {
+		if cost.PaymentID != nil && *cost.PaymentID == paymentID {
+			cost.PaymentID = nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package fiscalinvoicerepositorylocal
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// FiscalInvoiceRepositoryLocal is an in-memory implementation of model.FiscalInvoiceRepository.
+type FiscalInvoiceRepositoryLocal struct {
+	mu       sync.RWMutex
+	invoices map[uuid.UUID]*model.FiscalInvoice
+}
+
+func NewFiscalInvoiceRepositoryLocal() *FiscalInvoiceRepositoryLocal {
+	return &FiscalInvoiceRepositoryLocal{invoices: make(map[uuid.UUID]*model.FiscalInvoice)}
+}
+
+func (r *FiscalInvoiceRepositoryLocal) Create(ctx context.Context, invoice *model.FiscalInvoice) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.invoices[invoice.ID]; ok {
+		return errors.New("fiscal invoice already exists")
+	}
+	r.invoices[invoice.ID] = invoice
+	return nil
+}
+
+func (r *FiscalInvoiceRepositoryLocal) Update(ctx context.Context, invoice *model.FiscalInvoice) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.invoices[invoice.ID]; !ok {
+		return errors.New("fiscal invoice not found")
+	}
+	r.invoices[invoice.ID] = invoice
+	return nil
+}
+
+func (r *FiscalInvoiceRepositoryLocal) GetByID(ctx context.Context, id uuid.UUID) (*model.FiscalInvoice, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if invoice, ok := r.invoices[id]; ok {
+		return invoice, nil
+	}
+	return nil, errors.New("fiscal invoice not found")
+}
+
+func (r *FiscalInvoiceRepositoryLocal) GetByOrderID(ctx context.Context, orderID uuid.UUID) (*model.FiscalInvoice, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var last *model.FiscalInvoice
+	for _, invoice := range r.invoices {
+		if invoice.OrderID == orderID && (last == nil || invoice.CreatedAt.After(last.CreatedAt)) {
+			last = invoice
+		}
+	}
+	if last == nil {
+		return nil, errors.New("fiscal invoice not found")
+	}
+	return last, nil
+}
+
+func (r *FiscalInvoiceRepositoryLocal) GetByAccessKey(ctx context.Context, accessKey string) (*model.FiscalInvoice, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, invoice := range r.invoices {
+		if invoice.AccessKey == accessKey {
+			return invoice, nil
+		}
+	}
+	return nil, errors.New("fiscal invoice not found")
+}
+
+func (r *FiscalInvoiceRepositoryLocal) List(ctx context.Context, companyID uuid.UUID, page, perPage int) ([]*model.FiscalInvoice, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*model.FiscalInvoice{}
+	for _, invoice := range r.invoices {
+		if invoice.CompanyID == companyID {
+			result = append(result, invoice)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+
+	total := len(result)
+	start := page * perPage
+	if start >= total {
+		return []*model.FiscalInvoice{}, total, nil
+	}
+	end := start + perPage
+	if end > total {
+		end = total
+	}
+	return result[start:end], total, nil
+}
+
+func (r *FiscalInvoiceRepositoryLocal) ListByPeriod(ctx context.Context, companyID uuid.UUID, start, end time.Time) ([]*model.FiscalInvoice, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := []*model.FiscalInvoice{}
+	for _, invoice := range r.invoices {
+		if invoice.CompanyID == companyID && !invoice.CreatedAt.Before(start) && invoice.CreatedAt.Before(end) {
+			result = append(result, invoice)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Series != result[j].Series {
+			return result[i].Series < result[j].Series
+		}
+		return result[i].Number < result[j].Number
+	})
+	return result, nil
+}
+
+func (r *FiscalInvoiceRepositoryLocal) GetNextNumber(ctx context.Context, companyID uuid.UUID, series int) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	maxNumber := 0
+	for _, invoice := range r.invoices {
+		if invoice.CompanyID == companyID && invoice.Series == series && invoice.Number > maxNumber {
+			maxNumber = invoice.Number
+		}
+	}
+	return maxNumber + 1, nil
+}
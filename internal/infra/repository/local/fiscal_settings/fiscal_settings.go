@@ -0,0 +1,46 @@
+package fiscalsettingsrepositorylocal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// FiscalSettingsRepositoryLocal is an in-memory implementation of model.FiscalSettingsRepository.
+type FiscalSettingsRepositoryLocal struct {
+	mu       sync.RWMutex
+	settings map[uuid.UUID]*model.FiscalSettings
+}
+
+func NewFiscalSettingsRepositoryLocal() *FiscalSettingsRepositoryLocal {
+	return &FiscalSettingsRepositoryLocal{settings: make(map[uuid.UUID]*model.FiscalSettings)}
+}
+
+func (r *FiscalSettingsRepositoryLocal) Create(ctx context.Context, fiscalSettings *model.FiscalSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.settings[fiscalSettings.CompanyID]; ok {
+		return errors.New("fiscal settings already exists")
+	}
+	r.settings[fiscalSettings.CompanyID] = fiscalSettings
+	return nil
+}
+
+func (r *FiscalSettingsRepositoryLocal) Update(ctx context.Context, fiscalSettings *model.FiscalSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.settings[fiscalSettings.CompanyID] = fiscalSettings
+	return nil
+}
+
+func (r *FiscalSettingsRepositoryLocal) GetByCompanyID(ctx context.Context, companyID uuid.UUID) (*model.FiscalSettings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if settings, ok := r.settings[companyID]; ok {
+		return settings, nil
+	}
+	return nil, errors.New("fiscal settings not found")
+}
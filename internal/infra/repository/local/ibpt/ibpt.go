@@ -0,0 +1,69 @@
+package ibptrepositorylocal
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// IbptTaxRateRepositoryLocal is an in-memory implementation of model.IbptTaxRateRepository.
+type IbptTaxRateRepositoryLocal struct {
+	mu    sync.RWMutex
+	rates map[string]model.IbptTaxRate
+}
+
+func NewIbptTaxRateRepositoryLocal() *IbptTaxRateRepositoryLocal {
+	return &IbptTaxRateRepositoryLocal{rates: make(map[string]model.IbptTaxRate)}
+}
+
+func rateKey(ncm, exception, uf string) string {
+	return uf + "|" + ncm + "|" + exception
+}
+
+func (r *IbptTaxRateRepositoryLocal) UpsertMany(ctx context.Context, rates []model.IbptTaxRate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rate := range rates {
+		key := rateKey(rate.NCM, rate.Exception, rate.UF)
+		if existing, ok := r.rates[key]; ok {
+			rate.ID = existing.ID
+			rate.CreatedAt = existing.CreatedAt
+		}
+		r.rates[key] = rate
+	}
+	return nil
+}
+
+func (r *IbptTaxRateRepositoryLocal) GetByNCMs(ctx context.Context, uf string, ncms []string) ([]model.IbptTaxRate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[string]bool, len(ncms))
+	for _, ncm := range ncms {
+		wanted[ncm] = true
+	}
+
+	rates := []model.IbptTaxRate{}
+	for _, rate := range r.rates {
+		if rate.UF == uf && wanted[rate.NCM] {
+			rates = append(rates, rate)
+		}
+	}
+
+	sort.Slice(rates, func(i, j int) bool { return rates[i].Exception < rates[j].Exception })
+	return rates, nil
+}
+
+func (r *IbptTaxRateRepositoryLocal) CountByUF(ctx context.Context, uf string) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	count := 0
+	for _, rate := range r.rates {
+		if rate.UF == uf {
+			count++
+		}
+	}
+	return count, nil
+}
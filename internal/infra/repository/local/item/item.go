@@ -100,3 +100,15 @@ func (r *ItemRepositoryLocal) GetItemByAdditionalItemID(ctx context.Context, idA
 	}
 	return nil, nil
 }
+
+func (r *ItemRepositoryLocal) GetItemsByComboSaleID(ctx context.Context, comboSaleID string) ([]model.Item, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var result []model.Item
+	for _, item := range r.items {
+		if item.ComboSaleID != nil && item.ComboSaleID.String() == comboSaleID {
+			result = append(result, *item)
+		}
+	}
+	return result, nil
+}
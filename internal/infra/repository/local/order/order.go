@@ -75,6 +75,10 @@ func (r *OrderRepositoryLocal) UpdateOrder(ctx context.Context, order *model.Ord
 	return nil
 }
 
+func (r *OrderRepositoryLocal) UpdateOrderWithTx(ctx context.Context, tx *bun.Tx, order *model.Order) error {
+	return r.UpdateOrder(ctx, order)
+}
+
 func (r *OrderRepositoryLocal) UpdateOrderWithRelations(ctx context.Context, order *model.Order) error {
 	r.orders[order.ID] = order
 	return nil
@@ -306,6 +310,10 @@ func (r *OrderRepositoryLocal) AddPaymentOrder(ctx context.Context, payment *mod
 	return errors.New("order not found")
 }
 
+func (r *OrderRepositoryLocal) AddPaymentOrderWithTx(ctx context.Context, tx *bun.Tx, payment *model.PaymentOrder) error {
+	return r.AddPaymentOrder(ctx, payment)
+}
+
 func (r *OrderRepositoryLocal) GetStaleStagingOrders(ctx context.Context, minutes int) ([]model.Order, error) {
 	return []model.Order{}, nil
 }
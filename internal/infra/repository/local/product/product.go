@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 )
 
@@ -132,3 +133,39 @@ func (r *ProductRepositoryLocal) GetAllProductsMap(_ context.Context, isActive b
 
 	return products, nil
 }
+
+func (r *ProductRepositoryLocal) GetVariationsForUpdate(_ context.Context, _ bun.IDB, productID uuid.UUID, variationID *uuid.UUID) ([]model.ProductVariation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.products[productID]
+	if !ok {
+		return nil, errProductNotFound
+	}
+
+	variations := []model.ProductVariation{}
+	for _, v := range p.Variations {
+		if variationID == nil || v.ID == *variationID {
+			variations = append(variations, *v)
+		}
+	}
+	return variations, nil
+}
+
+func (r *ProductRepositoryLocal) UpdateVariationAvailability(_ context.Context, _ bun.IDB, v *model.ProductVariation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.products[v.ProductID]
+	if !ok {
+		return errProductNotFound
+	}
+
+	for _, existing := range p.Variations {
+		if existing.ID == v.ID {
+			existing.IsAvailable = v.IsAvailable
+			existing.AutoUnavailable = v.AutoUnavailable
+		}
+	}
+	return nil
+}
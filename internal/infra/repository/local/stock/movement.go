@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 )
@@ -53,6 +55,10 @@ func (r *StockMovementRepositoryLocal) GetMovementsByOrderID(ctx context.Context
 	return result, nil
 }
 
+func (r *StockMovementRepositoryLocal) GetMovementsByOrderIDWithTx(ctx context.Context, db bun.IDB, orderID string) ([]model.StockMovement, error) {
+	return r.GetMovementsByOrderID(ctx, orderID)
+}
+
 func (r *StockMovementRepositoryLocal) GetAllMovements(ctx context.Context) ([]model.StockMovement, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -66,3 +72,15 @@ func (r *StockMovementRepositoryLocal) GetAllMovements(ctx context.Context) ([]m
 func (r *StockMovementRepositoryLocal) GetMovementsByDateRange(ctx context.Context, start, end string) ([]model.StockMovement, error) {
 	return nil, errors.New("not implemented in local repo")
 }
+
+func (r *StockMovementRepositoryLocal) GetConsumptionByStock(ctx context.Context, start, end time.Time) ([]model.StockConsumption, error) {
+	return nil, errors.New("not implemented in local repo")
+}
+
+func (r *StockMovementRepositoryLocal) GetExpiredReservations(ctx context.Context, now time.Time) ([]model.ExpiredReservation, error) {
+	return nil, errors.New("not implemented in local repo")
+}
+
+func (r *StockMovementRepositoryLocal) GetStagingReservationWithTx(ctx context.Context, db bun.IDB, orderID, stockID string) (decimal.Decimal, error) {
+	return decimal.Zero, errors.New("not implemented in local repo")
+}
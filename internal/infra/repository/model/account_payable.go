@@ -0,0 +1,129 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type AccountPayable struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:account_payables,alias:account_payable"`
+	AccountPayableCommonAttributes
+}
+
+type AccountPayableCommonAttributes struct {
+	Description     string           `bun:"description,notnull"`
+	SupplierID      *uuid.UUID       `bun:"supplier_id,type:uuid"`
+	Supplier        *Supplier        `bun:"rel:belongs-to,join:supplier_id=id"`
+	PurchaseOrderID *uuid.UUID       `bun:"purchase_order_id,type:uuid"`
+	DocumentNumber  string           `bun:"document_number"`
+	Amount          *decimal.Decimal `bun:"amount,type:decimal(10,2),notnull"`
+	DueDate         time.Time        `bun:"due_date,notnull"`
+	Status          string           `bun:"status,notnull"`
+	PaidAt          *time.Time       `bun:"paid_at"`
+	PaidAmount      *decimal.Decimal `bun:"paid_amount,type:decimal(10,2)"`
+	CategoryID      *uuid.UUID       `bun:"category_id,type:uuid"`
+	Category        *ExpenseCategory `bun:"rel:belongs-to,join:category_id=id"`
+
+	Recurrence         string                `bun:"recurrence"`
+	RecurrenceEndsAt   *time.Time            `bun:"recurrence_ends_at"`
+	RecurrenceParentID *uuid.UUID            `bun:"recurrence_parent_id,type:uuid"`
+	Attachments        []FinancialAttachment `bun:"attachments,type:jsonb"`
+}
+
+// FinancialAttachment é o anexo (boleto, nota, comprovante) das contas a pagar e a receber
+type FinancialAttachment struct {
+	ID          uuid.UUID `json:"id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Key         string    `json:"key"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func attachmentsFromDomain(attachments []accountpayableentity.Attachment) []FinancialAttachment {
+	models := []FinancialAttachment{}
+	for _, attachment := range attachments {
+		models = append(models, FinancialAttachment(attachment))
+	}
+	return models
+}
+
+func attachmentsToDomain(models []FinancialAttachment) []accountpayableentity.Attachment {
+	attachments := []accountpayableentity.Attachment{}
+	for _, attachment := range models {
+		attachments = append(attachments, accountpayableentity.Attachment(attachment))
+	}
+	return attachments
+}
+
+func (a *AccountPayable) FromDomain(payable *accountpayableentity.AccountPayable) {
+	if payable == nil {
+		return
+	}
+	*a = AccountPayable{
+		Entity: entitymodel.FromDomain(payable.Entity),
+		AccountPayableCommonAttributes: AccountPayableCommonAttributes{
+			Description:     payable.Description,
+			SupplierID:      payable.SupplierID,
+			PurchaseOrderID: payable.PurchaseOrderID,
+			DocumentNumber:  payable.DocumentNumber,
+			Amount:          &payable.Amount,
+			DueDate:         payable.DueDate,
+			Status:          string(payable.Status),
+			PaidAt:          payable.PaidAt,
+			PaidAmount:      &payable.PaidAmount,
+			CategoryID:      payable.CategoryID,
+
+			Recurrence:         string(payable.Recurrence),
+			RecurrenceEndsAt:   payable.RecurrenceEndsAt,
+			RecurrenceParentID: payable.RecurrenceParentID,
+			Attachments:        attachmentsFromDomain(payable.Attachments),
+		},
+	}
+}
+
+func (a *AccountPayable) ToDomain() *accountpayableentity.AccountPayable {
+	if a == nil {
+		return nil
+	}
+	return &accountpayableentity.AccountPayable{
+		Entity: a.Entity.ToDomain(),
+		AccountPayableCommonAttributes: accountpayableentity.AccountPayableCommonAttributes{
+			Description:     a.Description,
+			SupplierID:      a.SupplierID,
+			PurchaseOrderID: a.PurchaseOrderID,
+			DocumentNumber:  a.DocumentNumber,
+			Amount:          a.GetAmount(),
+			DueDate:         a.DueDate,
+			Status:          accountpayableentity.AccountPayableStatus(a.Status),
+			PaidAt:          a.PaidAt,
+			PaidAmount:      a.GetPaidAmount(),
+			CategoryID:      a.CategoryID,
+
+			Recurrence:         accountpayableentity.Recurrence(a.Recurrence),
+			RecurrenceEndsAt:   a.RecurrenceEndsAt,
+			RecurrenceParentID: a.RecurrenceParentID,
+			Attachments:        attachmentsToDomain(a.Attachments),
+		},
+	}
+}
+
+func (a *AccountPayable) GetAmount() decimal.Decimal {
+	if a.Amount == nil {
+		return decimal.Zero
+	}
+	return *a.Amount
+}
+
+func (a *AccountPayable) GetPaidAmount() decimal.Decimal {
+	if a.PaidAmount == nil {
+		return decimal.Zero
+	}
+	return *a.PaidAmount
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type AccountPayableRepository interface {
+	CreateAccountPayable(ctx context.Context, db bun.IDB, a *AccountPayable) error
+	UpdateAccountPayable(ctx context.Context, a *AccountPayable) error
+	GetAccountPayableById(ctx context.Context, id string) (*AccountPayable, error)
+	GetAccountPayablesByPurchaseOrderID(ctx context.Context, purchaseOrderID string) ([]AccountPayable, error)
+	GetAllAccountPayables(ctx context.Context, page, perPage int, status string, dueFrom, dueTo *time.Time, categoryID string) ([]AccountPayable, int, error)
+	// GetLatestRecurringAccountPayables devolve a conta de vencimento mais recente de cada série recorrente
+	GetLatestRecurringAccountPayables(ctx context.Context) ([]AccountPayable, error)
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type AccountReceivable struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:account_receivables,alias:account_receivable"`
+	AccountReceivableCommonAttributes
+}
+
+type AccountReceivableCommonAttributes struct {
+	Description    string                `bun:"description,notnull"`
+	Source         string                `bun:"source,notnull"`
+	PayMethod      string                `bun:"pay_method"`
+	OrderID        *uuid.UUID            `bun:"order_id,type:uuid"`
+	PaymentID      *uuid.UUID            `bun:"payment_id,type:uuid"`
+	DocumentNumber string                `bun:"document_number"`
+	Amount         *decimal.Decimal      `bun:"amount,type:decimal(10,2),notnull"`
+	ExpectedDate   time.Time             `bun:"expected_date,notnull"`
+	Status         string                `bun:"status,notnull"`
+	ReceivedAt     *time.Time            `bun:"received_at"`
+	ReceivedAmount *decimal.Decimal      `bun:"received_amount,type:decimal(10,2)"`
+	Attachments    []FinancialAttachment `bun:"attachments,type:jsonb"`
+}
+
+func (a *AccountReceivable) FromDomain(receivable *accountreceivableentity.AccountReceivable) {
+	if receivable == nil {
+		return
+	}
+	*a = AccountReceivable{
+		Entity: entitymodel.FromDomain(receivable.Entity),
+		AccountReceivableCommonAttributes: AccountReceivableCommonAttributes{
+			Description:    receivable.Description,
+			Source:         string(receivable.Source),
+			PayMethod:      receivable.PayMethod,
+			OrderID:        receivable.OrderID,
+			PaymentID:      receivable.PaymentID,
+			DocumentNumber: receivable.DocumentNumber,
+			Amount:         &receivable.Amount,
+			ExpectedDate:   receivable.ExpectedDate,
+			Status:         string(receivable.Status),
+			ReceivedAt:     receivable.ReceivedAt,
+			ReceivedAmount: &receivable.ReceivedAmount,
+			Attachments:    attachmentsFromDomain(receivable.Attachments),
+		},
+	}
+}
+
+func (a *AccountReceivable) ToDomain() *accountreceivableentity.AccountReceivable {
+	if a == nil {
+		return nil
+	}
+	return &accountreceivableentity.AccountReceivable{
+		Entity: a.Entity.ToDomain(),
+		AccountReceivableCommonAttributes: accountreceivableentity.AccountReceivableCommonAttributes{
+			Description:    a.Description,
+			Source:         accountreceivableentity.Source(a.Source),
+			PayMethod:      a.PayMethod,
+			OrderID:        a.OrderID,
+			PaymentID:      a.PaymentID,
+			DocumentNumber: a.DocumentNumber,
+			Amount:         a.GetAmount(),
+			ExpectedDate:   a.ExpectedDate,
+			Status:         accountreceivableentity.AccountReceivableStatus(a.Status),
+			ReceivedAt:     a.ReceivedAt,
+			ReceivedAmount: a.GetReceivedAmount(),
+			Attachments:    attachmentsToDomain(a.Attachments),
+		},
+	}
+}
+
+func (a *AccountReceivable) GetAmount() decimal.Decimal {
+	if a.Amount == nil {
+		return decimal.Zero
+	}
+	return *a.Amount
+}
+
+func (a *AccountReceivable) GetReceivedAmount() decimal.Decimal {
+	if a.ReceivedAmount == nil {
+		return decimal.Zero
+	}
+	return *a.ReceivedAmount
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type AccountReceivableRepository interface {
+	CreateAccountReceivable(ctx context.Context, db bun.IDB, a *AccountReceivable) error
+	UpdateAccountReceivable(ctx context.Context, a *AccountReceivable) error
+	GetAccountReceivableById(ctx context.Context, id string) (*AccountReceivable, error)
+	GetAllAccountReceivables(ctx context.Context, page, perPage int, status string, expectedFrom, expectedTo *time.Time) ([]AccountReceivable, int, error)
+	// GetOpenCardSettlements lista os repasses de cartão em aberto previstos em [from, to]
+	GetOpenCardSettlements(ctx context.Context, from, to time.Time) ([]AccountReceivable, error)
+	// GetOpenCardSettlementsForUpdate lista e bloqueia os repasses de cartão em aberto previstos em [from, to]
+	GetOpenCardSettlementsForUpdate(ctx context.Context, db bun.IDB, from, to time.Time) ([]AccountReceivable, error)
+	// GetOpenCardSettlementsByOrder lista os repasses de cartão em aberto gerados pelos pagamentos do pedido
+	GetOpenCardSettlementsByOrder(ctx context.Context, db bun.IDB, orderID string) ([]AccountReceivable, error)
+	UpdateAccountReceivables(ctx context.Context, db bun.IDB, receivables []AccountReceivable) error
+}
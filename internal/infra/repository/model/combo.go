@@ -0,0 +1,101 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type Combo struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:combos,alias:combo"`
+	ComboCommonAttributes
+}
+
+type ComboCommonAttributes struct {
+	Name        string           `bun:"name,notnull"`
+	Description string           `bun:"description"`
+	Price       *decimal.Decimal `bun:"price,type:decimal(10,2),notnull"`
+	IsActive    bool             `bun:"is_active,notnull,default:true"`
+	Slots       []ComboSlot      `bun:"slots,type:jsonb"`
+}
+
+// ComboSlot é gravado dentro do jsonb slots do combo
+type ComboSlot struct {
+	ID      uuid.UUID         `json:"id"`
+	Name    string            `json:"name"`
+	Options []ComboSlotOption `json:"options"`
+}
+
+type ComboSlotOption struct {
+	ProductID  *uuid.UUID      `json:"product_id,omitempty"`
+	CategoryID *uuid.UUID      `json:"category_id,omitempty"`
+	Upcharge   decimal.Decimal `json:"upcharge"`
+}
+
+func (c *Combo) FromDomain(combo *comboentity.Combo) {
+	if combo == nil {
+		return
+	}
+	*c = Combo{
+		Entity: entitymodel.FromDomain(combo.Entity),
+		ComboCommonAttributes: ComboCommonAttributes{
+			Name:        combo.Name,
+			Description: combo.Description,
+			Price:       &combo.Price,
+			IsActive:    combo.IsActive,
+			Slots:       make([]ComboSlot, 0, len(combo.Slots)),
+		},
+	}
+
+	for _, slot := range combo.Slots {
+		slotModel := ComboSlot{ID: slot.ID, Name: slot.Name, Options: make([]ComboSlotOption, 0, len(slot.Options))}
+		for _, option := range slot.Options {
+			slotModel.Options = append(slotModel.Options, ComboSlotOption{
+				ProductID:  option.ProductID,
+				CategoryID: option.CategoryID,
+				Upcharge:   option.Upcharge,
+			})
+		}
+		c.Slots = append(c.Slots, slotModel)
+	}
+}
+
+func (c *Combo) ToDomain() *comboentity.Combo {
+	if c == nil {
+		return nil
+	}
+	combo := &comboentity.Combo{
+		Entity: c.Entity.ToDomain(),
+		ComboCommonAttributes: comboentity.ComboCommonAttributes{
+			Name:        c.Name,
+			Description: c.Description,
+			Price:       c.GetPrice(),
+			IsActive:    c.IsActive,
+			Slots:       make([]comboentity.ComboSlot, 0, len(c.Slots)),
+		},
+	}
+
+	for _, slotModel := range c.Slots {
+		slot := comboentity.ComboSlot{ID: slotModel.ID, Name: slotModel.Name, Options: make([]comboentity.ComboSlotOption, 0, len(slotModel.Options))}
+		for _, option := range slotModel.Options {
+			slot.Options = append(slot.Options, comboentity.ComboSlotOption{
+				ProductID:  option.ProductID,
+				CategoryID: option.CategoryID,
+				Upcharge:   option.Upcharge,
+			})
+		}
+		combo.Slots = append(combo.Slots, slot)
+	}
+
+	return combo
+}
+
+func (c *Combo) GetPrice() decimal.Decimal {
+	if c.Price == nil {
+		return decimal.Zero
+	}
+	return *c.Price
+}
@@ -0,0 +1,13 @@
+package model
+
+import (
+	"context"
+)
+
+type ComboRepository interface {
+	CreateCombo(ctx context.Context, c *Combo) error
+	UpdateCombo(ctx context.Context, c *Combo) error
+	DeleteCombo(ctx context.Context, id string) error
+	GetComboById(ctx context.Context, id string) (*Combo, error)
+	GetAllCombos(ctx context.Context, onlyActive bool) ([]Combo, error)
+}
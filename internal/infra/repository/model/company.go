@@ -27,6 +27,7 @@ type CompanyCommonAttributes struct {
 	Preferences  companyentity.Preferences `bun:"preferences,type:jsonb"`
 	IsBlocked    bool                      `bun:"is_blocked"`
 	ImagePath    string                    `bun:"image_path"`
+	TimeZone     string                    `bun:"time_zone,notnull,default:'America/Sao_Paulo'"`
 
 	// Opening Hours
 	Schedules []companyentity.Schedule `bun:"schedules,type:jsonb"`
@@ -57,6 +58,7 @@ func (c *Company) FromDomain(company *companyentity.Company) {
 			Preferences:                   company.Preferences,
 			IsBlocked:                     company.IsBlocked,
 			ImagePath:                     company.ImagePath,
+			TimeZone:                      company.TimeZone,
 			Schedules:                     company.Schedules,
 			Categories:                    []CompanyCategory{},
 			MonthlyPaymentDueDay:          company.MonthlyPaymentDueDay,
@@ -108,6 +110,7 @@ func (c *Company) ToDomain() *companyentity.Company {
 			Preferences:                   c.Preferences,
 			IsBlocked:                     c.IsBlocked,
 			ImagePath:                     c.ImagePath,
+			TimeZone:                      c.TimeZone,
 			Schedules:                     c.Schedules,
 			Categories:                    categories,
 			MonthlyPaymentDueDay:          c.MonthlyPaymentDueDay,
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"github.com/uptrace/bun"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type ExpenseCategory struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:expense_categories,alias:expense_category"`
+	ExpenseCategoryCommonAttributes
+}
+
+type ExpenseCategoryCommonAttributes struct {
+	Name        string `bun:"name,notnull"`
+	Description string `bun:"description"`
+	IsActive    bool   `bun:"is_active,notnull,default:true"`
+}
+
+func (c *ExpenseCategory) FromDomain(category *accountpayableentity.ExpenseCategory) {
+	if category == nil {
+		return
+	}
+	*c = ExpenseCategory{
+		Entity: entitymodel.FromDomain(category.Entity),
+		ExpenseCategoryCommonAttributes: ExpenseCategoryCommonAttributes{
+			Name:        category.Name,
+			Description: category.Description,
+			IsActive:    category.IsActive,
+		},
+	}
+}
+
+func (c *ExpenseCategory) ToDomain() *accountpayableentity.ExpenseCategory {
+	if c == nil {
+		return nil
+	}
+	return &accountpayableentity.ExpenseCategory{
+		Entity: c.Entity.ToDomain(),
+		ExpenseCategoryCommonAttributes: accountpayableentity.ExpenseCategoryCommonAttributes{
+			Name:        c.Name,
+			Description: c.Description,
+			IsActive:    c.IsActive,
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package model
+
+import (
+	"context"
+)
+
+type ExpenseCategoryRepository interface {
+	CreateExpenseCategory(ctx context.Context, c *ExpenseCategory) error
+	UpdateExpenseCategory(ctx context.Context, c *ExpenseCategory) error
+	DeleteExpenseCategory(ctx context.Context, id string) error
+	GetExpenseCategoryById(ctx context.Context, id string) (*ExpenseCategory, error)
+	GetAllExpenseCategories(ctx context.Context, onlyActive bool) ([]ExpenseCategory, error)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
 	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
@@ -13,19 +14,22 @@ type FiscalInvoice struct {
 	entitymodel.Entity
 	bun.BaseModel `bun:"table:fiscal_invoices"`
 
-	CompanyID          uuid.UUID  `bun:"company_id,type:uuid,notnull"`
-	OrderID            uuid.UUID  `bun:"order_id,type:uuid,notnull"`
-	AccessKey          string     `bun:"access_key,unique"` // ChaveAcesso
-	Number             int        `bun:"number"`            // Numero
-	Series             int        `bun:"series"`            // Serie
-	Status             string     `bun:"status,notnull"`
-	XMLPath            string     `bun:"xml_path"`
-	PDFPath            string     `bun:"pdf_path"`
-	Protocol           string     `bun:"protocol"` // Protocolo
-	ErrorMessage       string     `bun:"error_message"`
-	EmittedAt          *time.Time `bun:"emitted_at"`
-	CancelledAt        *time.Time `bun:"cancelled_at"`
-	CancellationReason string     `bun:"cancellation_reason"`
+	CompanyID           uuid.UUID        `bun:"company_id,type:uuid,notnull"`
+	OrderID             uuid.UUID        `bun:"order_id,type:uuid,notnull"`
+	AccessKey           string           `bun:"access_key,unique"` // ChaveAcesso
+	Number              int              `bun:"number"`            // Numero
+	Series              int              `bun:"series"`            // Serie
+	Status              string           `bun:"status,notnull"`
+	XMLPath             string           `bun:"xml_path"`
+	PDFPath             string           `bun:"pdf_path"`
+	Protocol            string           `bun:"protocol"` // Protocolo
+	ErrorMessage        string           `bun:"error_message"`
+	EmittedAt           *time.Time       `bun:"emitted_at"`
+	CancelledAt         *time.Time       `bun:"cancelled_at"`
+	CancellationReason  string           `bun:"cancellation_reason"`
+	CancellationXMLPath string           `bun:"cancellation_xml_path"`
+	TotalAmount         *decimal.Decimal `bun:"total_amount,type:decimal(10,2)"`
+	TaxAmount           *decimal.Decimal `bun:"tax_amount,type:decimal(10,2)"`
 }
 
 func (f *FiscalInvoice) FromDomain(invoice *fiscalinvoice.FiscalInvoice) {
@@ -33,18 +37,21 @@ func (f *FiscalInvoice) FromDomain(invoice *fiscalinvoice.FiscalInvoice) {
 		return
 	}
 	*f = FiscalInvoice{
-		Entity:             entitymodel.FromDomain(invoice.Entity),
-		CompanyID:          invoice.CompanyID,
-		OrderID:            invoice.OrderID,
-		AccessKey:          invoice.AccessKey,
-		Number:             invoice.Number,
-		Series:             invoice.Series,
-		Status:             string(invoice.Status),
-		XMLPath:            invoice.XMLPath,
-		PDFPath:            invoice.PDFPath,
-		Protocol:           invoice.Protocol,
-		ErrorMessage:       invoice.ErrorMessage,
-		CancellationReason: invoice.CancellationReason,
+		Entity:              entitymodel.FromDomain(invoice.Entity),
+		CompanyID:           invoice.CompanyID,
+		OrderID:             invoice.OrderID,
+		AccessKey:           invoice.AccessKey,
+		Number:              invoice.Number,
+		Series:              invoice.Series,
+		Status:              string(invoice.Status),
+		XMLPath:             invoice.XMLPath,
+		PDFPath:             invoice.PDFPath,
+		Protocol:            invoice.Protocol,
+		ErrorMessage:        invoice.ErrorMessage,
+		CancellationReason:  invoice.CancellationReason,
+		CancellationXMLPath: invoice.CancellationXMLPath,
+		TotalAmount:         &invoice.TotalAmount,
+		TaxAmount:           &invoice.TaxAmount,
 	}
 
 	if invoice.IsAuthorized() && f.EmittedAt == nil {
@@ -63,17 +70,34 @@ func (f *FiscalInvoice) ToDomain() *fiscalinvoice.FiscalInvoice {
 		return nil
 	}
 	return &fiscalinvoice.FiscalInvoice{
-		Entity:             f.Entity.ToDomain(),
-		CompanyID:          f.CompanyID,
-		OrderID:            f.OrderID,
-		AccessKey:          f.AccessKey,
-		Number:             f.Number,
-		Series:             f.Series,
-		Status:             fiscalinvoice.InvoiceStatus(f.Status),
-		XMLPath:            f.XMLPath,
-		PDFPath:            f.PDFPath,
-		Protocol:           f.Protocol,
-		ErrorMessage:       f.ErrorMessage,
-		CancellationReason: f.CancellationReason,
+		Entity:              f.Entity.ToDomain(),
+		CompanyID:           f.CompanyID,
+		OrderID:             f.OrderID,
+		AccessKey:           f.AccessKey,
+		Number:              f.Number,
+		Series:              f.Series,
+		Status:              fiscalinvoice.InvoiceStatus(f.Status),
+		XMLPath:             f.XMLPath,
+		PDFPath:             f.PDFPath,
+		Protocol:            f.Protocol,
+		ErrorMessage:        f.ErrorMessage,
+		CancellationReason:  f.CancellationReason,
+		CancellationXMLPath: f.CancellationXMLPath,
+		TotalAmount:         f.GetTotalAmount(),
+		TaxAmount:           f.GetTaxAmount(),
 	}
 }
+
+func (f *FiscalInvoice) GetTotalAmount() decimal.Decimal {
+	if f.TotalAmount == nil {
+		return decimal.Zero
+	}
+	return *f.TotalAmount
+}
+
+func (f *FiscalInvoice) GetTaxAmount() decimal.Decimal {
+	if f.TaxAmount == nil {
+		return decimal.Zero
+	}
+	return *f.TaxAmount
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,5 +15,6 @@ type FiscalInvoiceRepository interface {
 	GetByOrderID(ctx context.Context, orderID uuid.UUID) (*FiscalInvoice, error)
 	GetByAccessKey(ctx context.Context, accessKey string) (*FiscalInvoice, error)
 	List(ctx context.Context, companyID uuid.UUID, page, perPage int) ([]*FiscalInvoice, int, error)
+	ListByPeriod(ctx context.Context, companyID uuid.UUID, start, end time.Time) ([]*FiscalInvoice, error)
 	GetNextNumber(ctx context.Context, companyID uuid.UUID, series int) (int, error)
 }
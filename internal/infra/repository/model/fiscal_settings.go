@@ -14,6 +14,7 @@ type FiscalSettings struct {
 
 	ID                    uuid.UUID `bun:"id,pk,type:uuid"`
 	CompanyID             uuid.UUID `bun:"company_id,type:uuid,notnull"`
+	Provider              string    `bun:"provider"`
 	CompanyRegistryID     int64     `bun:"company_registry_id"`
 	TokenProduction       string    `bun:"token_production"`
 	TokenHomologation     string    `bun:"token_homologation"`
@@ -33,6 +34,9 @@ type FiscalSettings struct {
 	ShowTaxBreakdown     bool `bun:"show_tax_breakdown"`      // DiscriminaImpostos
 	SendEmailToRecipient bool `bun:"send_email_to_recipient"` // EnviarEmailDestinatario
 
+	// Accountant
+	AccountantEmail string `bun:"accountant_email"`
+
 	// Company Identity
 	BusinessName string `bun:"business_name"`
 	TradeName    string `bun:"trade_name"`
@@ -66,6 +70,7 @@ func (m *FiscalSettings) ToDomain() *fiscalsettingsentity.FiscalSettings {
 			UpdatedAt: updatedAt,
 		},
 		CompanyID:             m.CompanyID,
+		Provider:              m.Provider,
 		CompanyRegistryID:     m.CompanyRegistryID,
 		TokenProduction:       m.TokenProduction,
 		TokenHomologation:     m.TokenHomologation,
@@ -82,6 +87,7 @@ func (m *FiscalSettings) ToDomain() *fiscalsettingsentity.FiscalSettings {
 		MunicipalRegistration: m.MunicipalRegistration,
 		ShowTaxBreakdown:      m.ShowTaxBreakdown,
 		SendEmailToRecipient:  m.SendEmailToRecipient,
+		AccountantEmail:       m.AccountantEmail,
 		BusinessName:          m.BusinessName,
 		TradeName:             m.TradeName,
 		Cnpj:                  m.Cnpj,
@@ -102,6 +108,7 @@ func (m *FiscalSettings) ToDomain() *fiscalsettingsentity.FiscalSettings {
 func (m *FiscalSettings) FromDomain(d *fiscalsettingsentity.FiscalSettings) {
 	m.ID = d.ID
 	m.CompanyID = d.CompanyID
+	m.Provider = d.Provider
 	m.CompanyRegistryID = d.CompanyRegistryID
 	m.TokenProduction = d.TokenProduction
 	m.TokenHomologation = d.TokenHomologation
@@ -118,6 +125,7 @@ func (m *FiscalSettings) FromDomain(d *fiscalsettingsentity.FiscalSettings) {
 	m.MunicipalRegistration = d.MunicipalRegistration
 	m.ShowTaxBreakdown = d.ShowTaxBreakdown
 	m.SendEmailToRecipient = d.SendEmailToRecipient
+	m.AccountantEmail = d.AccountantEmail
 	m.BusinessName = d.BusinessName
 	m.TradeName = d.TradeName
 	m.Cnpj = d.Cnpj
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	ibptentity "github.com/willjrcom/sales-backend-go/internal/domain/ibpt"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+// IbptTaxRate is shared by every company (public schema), one line per NCM/EX/UF
+type IbptTaxRate struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:ibpt_tax_rates,alias:ibpt"`
+	IbptTaxRateCommonAttributes
+}
+
+type IbptTaxRateCommonAttributes struct {
+	NCM             string           `bun:"ncm,notnull,unique:ibpt_ncm_ex_uf"`
+	Exception       string           `bun:"exception,notnull,unique:ibpt_ncm_ex_uf"`
+	UF              string           `bun:"uf,notnull,unique:ibpt_ncm_ex_uf"`
+	Description     string           `bun:"description"`
+	FederalNational *decimal.Decimal `bun:"federal_national,type:decimal(10,2),notnull"`
+	FederalImported *decimal.Decimal `bun:"federal_imported,type:decimal(10,2),notnull"`
+	State           *decimal.Decimal `bun:"state,type:decimal(10,2),notnull"`
+	Municipal       *decimal.Decimal `bun:"municipal,type:decimal(10,2),notnull"`
+	ValidFrom       *time.Time       `bun:"valid_from"`
+	ValidUntil      *time.Time       `bun:"valid_until"`
+	Version         string           `bun:"version"`
+	Key             string           `bun:"key"`
+}
+
+func (m *IbptTaxRate) FromDomain(rate *ibptentity.TaxRate) {
+	if rate == nil {
+		return
+	}
+
+	*m = IbptTaxRate{
+		Entity: entitymodel.FromDomain(rate.Entity),
+		IbptTaxRateCommonAttributes: IbptTaxRateCommonAttributes{
+			NCM:             rate.NCM,
+			Exception:       rate.Exception,
+			UF:              rate.UF,
+			Description:     rate.Description,
+			FederalNational: &rate.FederalNational,
+			FederalImported: &rate.FederalImported,
+			State:           &rate.State,
+			Municipal:       &rate.Municipal,
+			ValidFrom:       rate.ValidFrom,
+			ValidUntil:      rate.ValidUntil,
+			Version:         rate.Version,
+			Key:             rate.Key,
+		},
+	}
+}
+
+func (m *IbptTaxRate) ToDomain() *ibptentity.TaxRate {
+	if m == nil {
+		return nil
+	}
+
+	return &ibptentity.TaxRate{
+		Entity: m.Entity.ToDomain(),
+		TaxRateCommonAttributes: ibptentity.TaxRateCommonAttributes{
+			NCM:             m.NCM,
+			Exception:       m.Exception,
+			UF:              m.UF,
+			Description:     m.Description,
+			FederalNational: m.GetFederalNational(),
+			FederalImported: m.GetFederalImported(),
+			State:           m.GetState(),
+			Municipal:       m.GetMunicipal(),
+			ValidFrom:       m.ValidFrom,
+			ValidUntil:      m.ValidUntil,
+			Version:         m.Version,
+			Key:             m.Key,
+		},
+	}
+}
+
+func (m *IbptTaxRate) GetFederalNational() decimal.Decimal {
+	if m.FederalNational == nil {
+		return decimal.Zero
+	}
+	return *m.FederalNational
+}
+
+func (m *IbptTaxRate) GetFederalImported() decimal.Decimal {
+	if m.FederalImported == nil {
+		return decimal.Zero
+	}
+	return *m.FederalImported
+}
+
+func (m *IbptTaxRate) GetState() decimal.Decimal {
+	if m.State == nil {
+		return decimal.Zero
+	}
+	return *m.State
+}
+
+func (m *IbptTaxRate) GetMunicipal() decimal.Decimal {
+	if m.Municipal == nil {
+		return decimal.Zero
+	}
+	return *m.Municipal
+}
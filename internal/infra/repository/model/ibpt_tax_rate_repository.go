@@ -0,0 +1,11 @@
+package model
+
+import (
+	"context"
+)
+
+type IbptTaxRateRepository interface {
+	UpsertMany(ctx context.Context, rates []IbptTaxRate) error
+	GetByNCMs(ctx context.Context, uf string, ncms []string) ([]IbptTaxRate, error)
+	CountByUF(ctx context.Context, uf string) (int, error)
+}
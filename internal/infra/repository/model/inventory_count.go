@@ -0,0 +1,190 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	inventorycountentity "github.com/willjrcom/sales-backend-go/internal/domain/inventory_count"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type InventoryCount struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:inventory_counts,alias:inventory_count"`
+	InventoryCountCommonAttributes
+	InventoryCountTimeLogs
+}
+
+type InventoryCountCommonAttributes struct {
+	Scope      string               `bun:"scope,notnull"`
+	CategoryID *uuid.UUID           `bun:"category_id,type:uuid"`
+	Status     string               `bun:"status,notnull"`
+	Notes      string               `bun:"notes"`
+	StartedBy  uuid.UUID            `bun:"started_by,type:uuid,notnull"`
+	ApprovedBy *uuid.UUID           `bun:"approved_by,type:uuid"`
+	Items      []InventoryCountItem `bun:"rel:has-many,join:id=inventory_count_id"`
+}
+
+type InventoryCountTimeLogs struct {
+	ApprovedAt  *time.Time `bun:"approved_at"`
+	CancelledAt *time.Time `bun:"cancelled_at"`
+}
+
+type InventoryCountItem struct {
+	entitymodel.Entity
+	bun.BaseModel      `bun:"table:inventory_count_items,alias:inventory_count_item"`
+	InventoryCountID   uuid.UUID        `bun:"inventory_count_id,type:uuid,notnull"`
+	StockID            uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
+	BatchID            *uuid.UUID       `bun:"batch_id,type:uuid"`
+	ProductID          uuid.UUID        `bun:"product_id,type:uuid,notnull"`
+	ProductVariationID *uuid.UUID       `bun:"product_variation_id,type:uuid"`
+	SKU                string           `bun:"sku"`
+	Description        string           `bun:"description"`
+	Unit               string           `bun:"unit"`
+	ExpectedQuantity   *decimal.Decimal `bun:"expected_quantity,type:decimal(10,3),notnull"`
+	CostPrice          *decimal.Decimal `bun:"cost_price,type:decimal(10,2),notnull"`
+	CountedQuantity    *decimal.Decimal `bun:"counted_quantity,type:decimal(10,3)"`
+	CountedBy          *uuid.UUID       `bun:"counted_by,type:uuid"`
+	CountedAt          *time.Time       `bun:"counted_at"`
+}
+
+// InventoryCountEntry guarda cada lançamento de contagem para auditoria (quem contou, quanto e como)
+type InventoryCountEntry struct {
+	entitymodel.Entity
+	bun.BaseModel    `bun:"table:inventory_count_entries,alias:inventory_count_entry"`
+	InventoryCountID uuid.UUID        `bun:"inventory_count_id,type:uuid,notnull"`
+	ItemID           uuid.UUID        `bun:"item_id,type:uuid,notnull"`
+	EmployeeID       uuid.UUID        `bun:"employee_id,type:uuid,notnull"`
+	Mode             string           `bun:"mode,notnull"`
+	Quantity         *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
+}
+
+func (c *InventoryCount) FromDomain(count *inventorycountentity.InventoryCount) {
+	if count == nil {
+		return
+	}
+	*c = InventoryCount{
+		Entity: entitymodel.FromDomain(count.Entity),
+		InventoryCountCommonAttributes: InventoryCountCommonAttributes{
+			Scope:      string(count.Scope),
+			CategoryID: count.CategoryID,
+			Status:     string(count.Status),
+			Notes:      count.Notes,
+			StartedBy:  count.StartedBy,
+			ApprovedBy: count.ApprovedBy,
+			Items:      []InventoryCountItem{},
+		},
+		InventoryCountTimeLogs: InventoryCountTimeLogs{
+			ApprovedAt:  count.ApprovedAt,
+			CancelledAt: count.CancelledAt,
+		},
+	}
+
+	for _, item := range count.Items {
+		itemModel := InventoryCountItem{}
+		itemModel.FromDomain(&item)
+		c.Items = append(c.Items, itemModel)
+	}
+}
+
+func (c *InventoryCount) ToDomain() *inventorycountentity.InventoryCount {
+	if c == nil {
+		return nil
+	}
+	count := &inventorycountentity.InventoryCount{
+		Entity: c.Entity.ToDomain(),
+		InventoryCountCommonAttributes: inventorycountentity.InventoryCountCommonAttributes{
+			Scope:      inventorycountentity.InventoryCountScope(c.Scope),
+			CategoryID: c.CategoryID,
+			Status:     inventorycountentity.InventoryCountStatus(c.Status),
+			Notes:      c.Notes,
+			StartedBy:  c.StartedBy,
+			ApprovedBy: c.ApprovedBy,
+			Items:      []inventorycountentity.InventoryCountItem{},
+		},
+		InventoryCountTimeLogs: inventorycountentity.InventoryCountTimeLogs{
+			ApprovedAt:  c.ApprovedAt,
+			CancelledAt: c.CancelledAt,
+		},
+	}
+
+	for _, item := range c.Items {
+		count.Items = append(count.Items, *item.ToDomain())
+	}
+
+	return count
+}
+
+func (i *InventoryCountItem) FromDomain(item *inventorycountentity.InventoryCountItem) {
+	if item == nil {
+		return
+	}
+	*i = InventoryCountItem{
+		Entity:             entitymodel.FromDomain(item.Entity),
+		InventoryCountID:   item.InventoryCountID,
+		StockID:            item.StockID,
+		BatchID:            item.BatchID,
+		ProductID:          item.ProductID,
+		ProductVariationID: item.ProductVariationID,
+		SKU:                item.SKU,
+		Description:        item.Description,
+		Unit:               item.Unit,
+		ExpectedQuantity:   &item.ExpectedQuantity,
+		CostPrice:          &item.CostPrice,
+		CountedQuantity:    item.CountedQuantity,
+		CountedBy:          item.CountedBy,
+		CountedAt:          item.CountedAt,
+	}
+}
+
+func (i *InventoryCountItem) ToDomain() *inventorycountentity.InventoryCountItem {
+	if i == nil {
+		return nil
+	}
+	return &inventorycountentity.InventoryCountItem{
+		Entity:             i.Entity.ToDomain(),
+		InventoryCountID:   i.InventoryCountID,
+		StockID:            i.StockID,
+		BatchID:            i.BatchID,
+		ProductID:          i.ProductID,
+		ProductVariationID: i.ProductVariationID,
+		SKU:                i.SKU,
+		Description:        i.Description,
+		Unit:               i.Unit,
+		ExpectedQuantity:   i.GetExpectedQuantity(),
+		CostPrice:          i.GetCostPrice(),
+		CountedQuantity:    i.CountedQuantity,
+		CountedBy:          i.CountedBy,
+		CountedAt:          i.CountedAt,
+	}
+}
+
+func (i *InventoryCountItem) GetExpectedQuantity() decimal.Decimal {
+	if i.ExpectedQuantity == nil {
+		return decimal.Zero
+	}
+	return *i.ExpectedQuantity
+}
+
+func (i *InventoryCountItem) GetCostPrice() decimal.Decimal {
+	if i.CostPrice == nil {
+		return decimal.Zero
+	}
+	return *i.CostPrice
+}
+
+func (e *InventoryCountEntry) FromDomain(entry *inventorycountentity.CountEntry) {
+	if entry == nil {
+		return
+	}
+	*e = InventoryCountEntry{
+		Entity:           entitymodel.FromDomain(entry.Entity),
+		InventoryCountID: entry.InventoryCountID,
+		ItemID:           entry.ItemID,
+		EmployeeID:       entry.EmployeeID,
+		Mode:             string(entry.Mode),
+		Quantity:         &entry.Quantity,
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type InventoryCountRepository interface {
+	CreateInventoryCount(ctx context.Context, c *InventoryCount) error
+	UpdateInventoryCount(ctx context.Context, db bun.IDB, c *InventoryCount) error
+	UpdateInventoryCountItems(ctx context.Context, db bun.IDB, items []InventoryCountItem) error
+	CreateInventoryCountEntries(ctx context.Context, db bun.IDB, entries []InventoryCountEntry) error
+	GetInventoryCountById(ctx context.Context, id string) (*InventoryCount, error)
+	GetInventoryCountByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*InventoryCount, error)
+	GetAllInventoryCounts(ctx context.Context, page, perPage int, status string) ([]InventoryCount, int, error)
+}
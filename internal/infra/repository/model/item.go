@@ -30,6 +30,10 @@ type ItemCommonAttributes struct {
 	Product            *Product         `bun:"rel:has-one,join:product_id=id"`
 	ProductVariationID uuid.UUID        `bun:"product_variation_id,type:uuid,notnull"`
 	Flavor             *string          `bun:"flavor"`
+	ComboID            *uuid.UUID       `bun:"combo_id,type:uuid"`
+	ComboSaleID        *uuid.UUID       `bun:"combo_sale_id,type:uuid"`
+	PriceListID        *uuid.UUID       `bun:"price_list_id,type:uuid"`
+	ListPrice          *decimal.Decimal `bun:"list_price,type:decimal(10,2)"`
 }
 
 func (i *Item) FromDomain(item *orderentity.Item) {
@@ -53,6 +57,10 @@ func (i *Item) FromDomain(item *orderentity.Item) {
 			ProductID:          item.ProductID,
 			ProductVariationID: item.ProductVariationID,
 			Flavor:             item.Flavor,
+			ComboID:            item.ComboID,
+			ComboSaleID:        item.ComboSaleID,
+			PriceListID:        item.PriceListID,
+			ListPrice:          item.ListPrice,
 		},
 	}
 
@@ -90,6 +98,10 @@ func (i *Item) ToDomain() *orderentity.Item {
 			ProductVariationID: i.ProductVariationID,
 			Product:            i.Product.ToDomain(),
 			Flavor:             i.Flavor,
+			ComboID:            i.ComboID,
+			ComboSaleID:        i.ComboSaleID,
+			PriceListID:        i.PriceListID,
+			ListPrice:          i.ListPrice,
 		},
 	}
 
@@ -18,4 +18,6 @@ type ItemRepository interface {
 	GetItemById(ctx context.Context, id string) (*Item, error)
 	GetItemByIdWithTx(ctx context.Context, tx *bun.Tx, id string) (*Item, error)
 	GetItemByAdditionalItemID(ctx context.Context, idAdditional uuid.UUID) (*Item, error)
+	// GetItemsByComboSaleID lista os componentes de um combo vendido
+	GetItemsByComboSaleID(ctx context.Context, comboSaleID string) ([]Item, error)
 }
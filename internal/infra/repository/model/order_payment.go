@@ -21,6 +21,13 @@ type PaymentCommonAttributes struct {
 	TotalPaid *decimal.Decimal `bun:"total_paid,type:decimal(10,2)"`
 	Method    string           `bun:"method,notnull"`
 	OrderID   uuid.UUID        `bun:"column:order_id,type:uuid,notnull"`
+	PaymentSettlement
+}
+
+type PaymentSettlement struct {
+	FeeAmount            *decimal.Decimal `bun:"fee_amount,type:decimal(10,2)"`
+	NetAmount            *decimal.Decimal `bun:"net_amount,type:decimal(10,2)"`
+	ExpectedSettlementAt *time.Time       `bun:"expected_settlement_at"`
 }
 
 type PaymentTimeLogs struct {
@@ -37,6 +44,11 @@ func (p *PaymentOrder) FromDomain(payment *orderentity.PaymentOrder) {
 			TotalPaid: &payment.TotalPaid,
 			Method:    string(payment.Method),
 			OrderID:   payment.OrderID,
+			PaymentSettlement: PaymentSettlement{
+				FeeAmount:            &payment.FeeAmount,
+				NetAmount:            &payment.NetAmount,
+				ExpectedSettlementAt: payment.ExpectedSettlementAt,
+			},
 		},
 		PaymentTimeLogs: PaymentTimeLogs{
 			PaidAt: payment.PaidAt,
@@ -54,6 +66,11 @@ func (p *PaymentOrder) ToDomain() *orderentity.PaymentOrder {
 			TotalPaid: p.GetTotalPaid(),
 			Method:    orderentity.PayMethod(p.Method),
 			OrderID:   p.OrderID,
+			PaymentSettlement: orderentity.PaymentSettlement{
+				FeeAmount:            p.GetFeeAmount(),
+				NetAmount:            p.GetNetAmount(),
+				ExpectedSettlementAt: p.ExpectedSettlementAt,
+			},
 		},
 		PaymentTimeLogs: orderentity.PaymentTimeLogs{
 			PaidAt: p.PaidAt,
@@ -67,3 +84,17 @@ func (p *PaymentOrder) GetTotalPaid() decimal.Decimal {
 	}
 	return *p.TotalPaid
 }
+
+func (p *PaymentOrder) GetFeeAmount() decimal.Decimal {
+	if p.FeeAmount == nil {
+		return decimal.Zero
+	}
+	return *p.FeeAmount
+}
+
+func (p *PaymentOrder) GetNetAmount() decimal.Decimal {
+	if p.NetAmount == nil {
+		return decimal.Zero
+	}
+	return *p.NetAmount
+}
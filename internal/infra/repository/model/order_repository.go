@@ -11,6 +11,7 @@ type OrderRepository interface {
 	CreateOrder(ctx context.Context, order *Order) error
 	PendingOrder(ctx context.Context, order *Order) error
 	UpdateOrder(ctx context.Context, order *Order) error
+	UpdateOrderWithTx(ctx context.Context, tx *bun.Tx, order *Order) error
 	UpdateOrderWithRelations(ctx context.Context, order *Order) error
 	UpdateOrderWithRelationsWithTx(ctx context.Context, tx *bun.Tx, order *Order) error
 	DeleteOrder(ctx context.Context, id string) error
@@ -28,4 +29,5 @@ type OrderRepository interface {
 	GetAllOrdersWithPickup(ctx context.Context, status orderentity.StatusOrderPickup, page, perPage int) ([]Order, error)
 	GetOrdersByStatus(ctx context.Context, status orderentity.StatusOrder) ([]Order, error)
 	AddPaymentOrder(ctx context.Context, payment *PaymentOrder) error
+	AddPaymentOrderWithTx(ctx context.Context, tx *bun.Tx, payment *PaymentOrder) error
 }
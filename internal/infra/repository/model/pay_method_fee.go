@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type PayMethodFee struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:pay_method_fees,alias:pay_method_fee"`
+	PayMethodFeeCommonAttributes
+}
+
+type PayMethodFeeCommonAttributes struct {
+	Method         string           `bun:"method,notnull"`
+	Acquirer       string           `bun:"acquirer"`
+	FeePercent     *decimal.Decimal `bun:"fee_percent,type:decimal(5,2),notnull"`
+	FixedFee       *decimal.Decimal `bun:"fixed_fee,type:decimal(10,2),notnull"`
+	SettlementDays int              `bun:"settlement_days,notnull"`
+	IsActive       bool             `bun:"is_active,notnull,default:true"`
+}
+
+func (f *PayMethodFee) FromDomain(fee *accountreceivableentity.PayMethodFee) {
+	if fee == nil {
+		return
+	}
+	*f = PayMethodFee{
+		Entity: entitymodel.FromDomain(fee.Entity),
+		PayMethodFeeCommonAttributes: PayMethodFeeCommonAttributes{
+			Method:         string(fee.Method),
+			Acquirer:       fee.Acquirer,
+			FeePercent:     &fee.FeePercent,
+			FixedFee:       &fee.FixedFee,
+			SettlementDays: fee.SettlementDays,
+			IsActive:       fee.IsActive,
+		},
+	}
+}
+
+func (f *PayMethodFee) ToDomain() *accountreceivableentity.PayMethodFee {
+	if f == nil {
+		return nil
+	}
+	return &accountreceivableentity.PayMethodFee{
+		Entity: f.Entity.ToDomain(),
+		PayMethodFeeCommonAttributes: accountreceivableentity.PayMethodFeeCommonAttributes{
+			Method:         orderentity.PayMethod(f.Method),
+			Acquirer:       f.Acquirer,
+			FeePercent:     f.GetFeePercent(),
+			FixedFee:       f.GetFixedFee(),
+			SettlementDays: f.SettlementDays,
+			IsActive:       f.IsActive,
+		},
+	}
+}
+
+func (f *PayMethodFee) GetFeePercent() decimal.Decimal {
+	if f.FeePercent == nil {
+		return decimal.Zero
+	}
+	return *f.FeePercent
+}
+
+func (f *PayMethodFee) GetFixedFee() decimal.Decimal {
+	if f.FixedFee == nil {
+		return decimal.Zero
+	}
+	return *f.FixedFee
+}
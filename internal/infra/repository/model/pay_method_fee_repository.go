@@ -0,0 +1,15 @@
+package model
+
+import (
+	"context"
+)
+
+type PayMethodFeeRepository interface {
+	CreatePayMethodFee(ctx context.Context, f *PayMethodFee) error
+	UpdatePayMethodFee(ctx context.Context, f *PayMethodFee) error
+	DeletePayMethodFee(ctx context.Context, id string) error
+	GetPayMethodFeeById(ctx context.Context, id string) (*PayMethodFee, error)
+	// GetPayMethodFeeByMethod devolve a configuração da forma de pagamento; onlyActive ignora as desativadas e nil quando não há
+	GetPayMethodFeeByMethod(ctx context.Context, method string, onlyActive bool) (*PayMethodFee, error)
+	GetAllPayMethodFees(ctx context.Context) ([]PayMethodFee, error)
+}
@@ -0,0 +1,111 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type PriceList struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:price_lists,alias:price_list"`
+	PriceListCommonAttributes
+}
+
+type PriceListCommonAttributes struct {
+	Name     string                    `bun:"name,notnull"`
+	Priority int                       `bun:"priority,notnull,default:0"`
+	IsActive bool                      `bun:"is_active,notnull,default:true"`
+	Channels []pricelistentity.Channel `bun:"channels,type:jsonb"`
+	Windows  []PriceListTimeWindow     `bun:"windows,type:jsonb"`
+	StartsAt *time.Time                `bun:"starts_at"`
+	EndsAt   *time.Time                `bun:"ends_at"`
+	Prices   []PriceListEntry          `bun:"prices,type:jsonb"`
+}
+
+// PriceListTimeWindow é gravado dentro do jsonb windows da lista
+type PriceListTimeWindow struct {
+	DaysOfWeek []int  `json:"days_of_week"`
+	StartTime  string `json:"start_time"`
+	EndTime    string `json:"end_time"`
+}
+
+// PriceListEntry é gravado dentro do jsonb prices da lista
+type PriceListEntry struct {
+	ProductVariationID uuid.UUID       `json:"product_variation_id"`
+	Price              decimal.Decimal `json:"price"`
+}
+
+func (p *PriceList) FromDomain(priceList *pricelistentity.PriceList) {
+	if priceList == nil {
+		return
+	}
+	*p = PriceList{
+		Entity: entitymodel.FromDomain(priceList.Entity),
+		PriceListCommonAttributes: PriceListCommonAttributes{
+			Name:     priceList.Name,
+			Priority: priceList.Priority,
+			IsActive: priceList.IsActive,
+			Channels: priceList.Channels,
+			Windows:  make([]PriceListTimeWindow, 0, len(priceList.Windows)),
+			StartsAt: priceList.StartsAt,
+			EndsAt:   priceList.EndsAt,
+			Prices:   make([]PriceListEntry, 0, len(priceList.Prices)),
+		},
+	}
+
+	for _, window := range priceList.Windows {
+		p.Windows = append(p.Windows, PriceListTimeWindow{
+			DaysOfWeek: window.DaysOfWeek,
+			StartTime:  window.StartTime,
+			EndTime:    window.EndTime,
+		})
+	}
+
+	for _, entry := range priceList.Prices {
+		p.Prices = append(p.Prices, PriceListEntry{
+			ProductVariationID: entry.ProductVariationID,
+			Price:              entry.Price,
+		})
+	}
+}
+
+func (p *PriceList) ToDomain() *pricelistentity.PriceList {
+	if p == nil {
+		return nil
+	}
+	priceList := &pricelistentity.PriceList{
+		Entity: p.Entity.ToDomain(),
+		PriceListCommonAttributes: pricelistentity.PriceListCommonAttributes{
+			Name:     p.Name,
+			Priority: p.Priority,
+			IsActive: p.IsActive,
+			Channels: p.Channels,
+			Windows:  make([]pricelistentity.TimeWindow, 0, len(p.Windows)),
+			StartsAt: p.StartsAt,
+			EndsAt:   p.EndsAt,
+			Prices:   make([]pricelistentity.PriceListEntry, 0, len(p.Prices)),
+		},
+	}
+
+	for _, window := range p.Windows {
+		priceList.Windows = append(priceList.Windows, pricelistentity.TimeWindow{
+			DaysOfWeek: window.DaysOfWeek,
+			StartTime:  window.StartTime,
+			EndTime:    window.EndTime,
+		})
+	}
+
+	for _, entry := range p.Prices {
+		priceList.Prices = append(priceList.Prices, pricelistentity.PriceListEntry{
+			ProductVariationID: entry.ProductVariationID,
+			Price:              entry.Price,
+		})
+	}
+
+	return priceList
+}
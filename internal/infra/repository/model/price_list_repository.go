@@ -0,0 +1,13 @@
+package model
+
+import (
+	"context"
+)
+
+type PriceListRepository interface {
+	CreatePriceList(ctx context.Context, p *PriceList) error
+	UpdatePriceList(ctx context.Context, p *PriceList) error
+	DeletePriceList(ctx context.Context, id string) error
+	GetPriceListById(ctx context.Context, id string) (*PriceList, error)
+	GetAllPriceLists(ctx context.Context, onlyActive bool) ([]PriceList, error)
+}
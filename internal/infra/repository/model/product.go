@@ -15,6 +15,7 @@ type Product struct {
 
 type ProductCommonAttributes struct {
 	SKU         string              `bun:"sku,notnull"`
+	NCM         string              `bun:"ncm"`
 	Name        string              `bun:"name,notnull"`
 	Flavors     []string            `bun:"flavors,type:jsonb,notnull"`
 	ImagePath   *string             `bun:"image_path"`
@@ -33,6 +34,7 @@ func (p *Product) FromDomain(product *productentity.Product) {
 		Entity: entitymodel.FromDomain(product.Entity),
 		ProductCommonAttributes: ProductCommonAttributes{
 			SKU:         product.SKU,
+			NCM:         product.NCM,
 			Name:        product.Name,
 			Flavors:     cloneFlavors(product.Flavors),
 			ImagePath:   product.ImagePath,
@@ -68,6 +70,7 @@ func (p *Product) ToDomain() *productentity.Product {
 		Entity: p.Entity.ToDomain(),
 		ProductCommonAttributes: productentity.ProductCommonAttributes{
 			SKU:         p.SKU,
+			NCM:         p.NCM,
 			Name:        p.Name,
 			Flavors:     cloneFlavors(p.Flavors),
 			ImagePath:   p.ImagePath,
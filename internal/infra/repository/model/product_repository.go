@@ -2,6 +2,9 @@ package model
 
 import (
 	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
 )
 
 type ProductRepository interface {
@@ -13,4 +16,7 @@ type ProductRepository interface {
 	GetAllProducts(ctx context.Context, page, perPage int, isActive bool, categoryID string) ([]Product, int, error)
 	GetDefaultProducts(ctx context.Context, page, perPage int, isActive bool) ([]Product, int, error)
 	GetAllProductsMap(ctx context.Context, isActive bool, categoryID string) ([]Product, error)
+	// GetVariationsForUpdate bloqueia a variação informada ou, com variationID nil, todas as do produto
+	GetVariationsForUpdate(ctx context.Context, db bun.IDB, productID uuid.UUID, variationID *uuid.UUID) ([]ProductVariation, error)
+	UpdateVariationAvailability(ctx context.Context, db bun.IDB, v *ProductVariation) error
 }
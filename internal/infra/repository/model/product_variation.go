@@ -10,22 +10,24 @@ import (
 
 type ProductVariation struct {
 	entitymodel.Entity
-	bun.BaseModel `bun:"table:product_variations,alias:product_variation"`
-	ProductID     uuid.UUID        `bun:"product_id,type:uuid,notnull"`
-	SizeID        uuid.UUID        `bun:"size_id,type:uuid,notnull"`
-	Size          *Size            `bun:"rel:belongs-to"`
-	Price         *decimal.Decimal `bun:"price,type:decimal(10,2),notnull"`
-	IsAvailable   bool             `bun:"is_available,notnull,default:true"`
+	bun.BaseModel   `bun:"table:product_variations,alias:product_variation"`
+	ProductID       uuid.UUID        `bun:"product_id,type:uuid,notnull"`
+	SizeID          uuid.UUID        `bun:"size_id,type:uuid,notnull"`
+	Size            *Size            `bun:"rel:belongs-to"`
+	Price           *decimal.Decimal `bun:"price,type:decimal(10,2),notnull"`
+	IsAvailable     bool             `bun:"is_available,notnull,default:true"`
+	AutoUnavailable bool             `bun:"auto_unavailable,notnull,default:false"`
 }
 
 // ToDomain converte model para domain
 func (pv *ProductVariation) ToDomain() productentity.ProductVariation {
 	variation := productentity.ProductVariation{
-		Entity:      pv.Entity.ToDomain(),
-		ProductID:   pv.ProductID,
-		SizeID:      pv.SizeID,
-		Price:       pv.GetPrice(),
-		IsAvailable: pv.IsAvailable,
+		Entity:          pv.Entity.ToDomain(),
+		ProductID:       pv.ProductID,
+		SizeID:          pv.SizeID,
+		Price:           pv.GetPrice(),
+		IsAvailable:     pv.IsAvailable,
+		AutoUnavailable: pv.AutoUnavailable,
 	}
 
 	if pv.Size != nil {
@@ -42,6 +44,7 @@ func (pv *ProductVariation) FromDomain(variation productentity.ProductVariation)
 	pv.SizeID = variation.SizeID
 	pv.Price = &variation.Price
 	pv.IsAvailable = variation.IsAvailable
+	pv.AutoUnavailable = variation.AutoUnavailable
 
 	if variation.Size != nil {
 		pv.Size = &Size{}
@@ -0,0 +1,162 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type PurchaseOrder struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:purchase_orders,alias:purchase_order"`
+	PurchaseOrderCommonAttributes
+	PurchaseOrderTimeLogs
+}
+
+type PurchaseOrderCommonAttributes struct {
+	SupplierID uuid.UUID           `bun:"supplier_id,type:uuid,notnull"`
+	Supplier   *Supplier           `bun:"rel:belongs-to,join:supplier_id=id"`
+	Status     string              `bun:"status,notnull"`
+	Notes      string              `bun:"notes"`
+	Items      []PurchaseOrderItem `bun:"rel:has-many,join:id=purchase_order_id"`
+}
+
+type PurchaseOrderTimeLogs struct {
+	ExpectedDeliveryAt *time.Time `bun:"expected_delivery_at"`
+	OrderedAt          *time.Time `bun:"ordered_at"`
+	ReceivedAt         *time.Time `bun:"received_at"`
+	CancelledAt        *time.Time `bun:"cancelled_at"`
+}
+
+type PurchaseOrderItem struct {
+	entitymodel.Entity
+	bun.BaseModel      `bun:"table:purchase_order_items,alias:purchase_order_item"`
+	PurchaseOrderID    uuid.UUID        `bun:"purchase_order_id,type:uuid,notnull"`
+	StockID            uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
+	ProductID          uuid.UUID        `bun:"product_id,type:uuid,notnull"`
+	ProductVariationID *uuid.UUID       `bun:"product_variation_id,type:uuid"`
+	Description        string           `bun:"description"`
+	Quantity           *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
+	ReceivedQuantity   *decimal.Decimal `bun:"received_quantity,type:decimal(10,3),notnull"`
+	UnitCost           *decimal.Decimal `bun:"unit_cost,type:decimal(10,2),notnull"`
+}
+
+func (p *PurchaseOrder) FromDomain(order *purchaseorderentity.PurchaseOrder) {
+	if order == nil {
+		return
+	}
+	*p = PurchaseOrder{
+		Entity: entitymodel.FromDomain(order.Entity),
+		PurchaseOrderCommonAttributes: PurchaseOrderCommonAttributes{
+			SupplierID: order.SupplierID,
+			Status:     string(order.Status),
+			Notes:      order.Notes,
+			Items:      []PurchaseOrderItem{},
+		},
+		PurchaseOrderTimeLogs: PurchaseOrderTimeLogs{
+			ExpectedDeliveryAt: order.ExpectedDeliveryAt,
+			OrderedAt:          order.OrderedAt,
+			ReceivedAt:         order.ReceivedAt,
+			CancelledAt:        order.CancelledAt,
+		},
+	}
+
+	for _, item := range order.Items {
+		itemModel := PurchaseOrderItem{}
+		itemModel.FromDomain(&item)
+		p.Items = append(p.Items, itemModel)
+	}
+}
+
+func (p *PurchaseOrder) ToDomain() *purchaseorderentity.PurchaseOrder {
+	if p == nil {
+		return nil
+	}
+	order := &purchaseorderentity.PurchaseOrder{
+		Entity: p.Entity.ToDomain(),
+		PurchaseOrderCommonAttributes: purchaseorderentity.PurchaseOrderCommonAttributes{
+			SupplierID: p.SupplierID,
+			Status:     purchaseorderentity.PurchaseOrderStatus(p.Status),
+			Notes:      p.Notes,
+			Items:      []purchaseorderentity.PurchaseOrderItem{},
+		},
+		PurchaseOrderTimeLogs: purchaseorderentity.PurchaseOrderTimeLogs{
+			ExpectedDeliveryAt: p.ExpectedDeliveryAt,
+			OrderedAt:          p.OrderedAt,
+			ReceivedAt:         p.ReceivedAt,
+			CancelledAt:        p.CancelledAt,
+		},
+	}
+
+	for _, item := range p.Items {
+		order.Items = append(order.Items, *item.ToDomain())
+	}
+
+	return order
+}
+
+func (i *PurchaseOrderItem) FromDomain(item *purchaseorderentity.PurchaseOrderItem) {
+	if item == nil {
+		return
+	}
+	*i = PurchaseOrderItem{
+		Entity:             entitymodel.FromDomain(item.Entity),
+		PurchaseOrderID:    item.PurchaseOrderID,
+		StockID:            item.StockID,
+		ProductID:          item.ProductID,
+		ProductVariationID: item.ProductVariationID,
+		Description:        item.Description,
+		Quantity:           &item.Quantity,
+		ReceivedQuantity:   &item.ReceivedQuantity,
+		UnitCost:           &item.UnitCost,
+	}
+}
+
+func (i *PurchaseOrderItem) ToDomain() *purchaseorderentity.PurchaseOrderItem {
+	if i == nil {
+		return nil
+	}
+	return &purchaseorderentity.PurchaseOrderItem{
+		Entity:             i.Entity.ToDomain(),
+		PurchaseOrderID:    i.PurchaseOrderID,
+		StockID:            i.StockID,
+		ProductID:          i.ProductID,
+		ProductVariationID: i.ProductVariationID,
+		Description:        i.Description,
+		Quantity:           i.GetQuantity(),
+		ReceivedQuantity:   i.GetReceivedQuantity(),
+		UnitCost:           i.GetUnitCost(),
+	}
+}
+
+func (i *PurchaseOrderItem) GetQuantity() decimal.Decimal {
+	if i.Quantity == nil {
+		return decimal.Zero
+	}
+	return *i.Quantity
+}
+
+func (i *PurchaseOrderItem) GetReceivedQuantity() decimal.Decimal {
+	if i.ReceivedQuantity == nil {
+		return decimal.Zero
+	}
+	return *i.ReceivedQuantity
+}
+
+func (i *PurchaseOrderItem) GetUnitCost() decimal.Decimal {
+	if i.UnitCost == nil {
+		return decimal.Zero
+	}
+	return *i.UnitCost
+}
+
+// StockSupplier liga um estoque ao fornecedor de quem ele costuma ser comprado
+type StockSupplier struct {
+	StockID    uuid.UUID       `bun:"stock_id"`
+	SupplierID uuid.UUID       `bun:"supplier_id"`
+	UnitCost   decimal.Decimal `bun:"unit_cost"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type PurchaseOrderRepository interface {
+	CreatePurchaseOrder(ctx context.Context, p *PurchaseOrder) error
+	UpdatePurchaseOrder(ctx context.Context, db bun.IDB, p *PurchaseOrder) error
+	GetPurchaseOrderById(ctx context.Context, id string) (*PurchaseOrder, error)
+	GetPurchaseOrderByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*PurchaseOrder, error)
+	GetAllPurchaseOrders(ctx context.Context, page, perPage int, status string) ([]PurchaseOrder, int, error)
+	// GetPreferredSuppliersByStock devolve o fornecedor do último pedido de compra de cada estoque,
+	// ou o fornecedor do mapeamento de NF-e quando o estoque nunca foi comprado por pedido
+	GetPreferredSuppliersByStock(ctx context.Context) ([]StockSupplier, error)
+}
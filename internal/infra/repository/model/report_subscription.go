@@ -0,0 +1,140 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	reportsubscriptionentity "github.com/willjrcom/sales-backend-go/internal/domain/report_subscription"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type ReportSubscription struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:report_subscriptions,alias:report_subscription"`
+	ReportSubscriptionCommonAttributes
+}
+
+type ReportSubscriptionCommonAttributes struct {
+	UserID     uuid.UUID                          `bun:"user_id,type:uuid,notnull"`
+	Name       string                             `bun:"name"`
+	ReportType string                             `bun:"report_type,notnull"`
+	Params     map[string]any                     `bun:"params,type:jsonb"`
+	Frequency  reportsubscriptionentity.Frequency `bun:"frequency,notnull"`
+	Hour       int                                `bun:"hour,notnull"`
+	Weekday    int                                `bun:"weekday,notnull,default:0"`
+	DayOfMonth int                                `bun:"day_of_month,notnull,default:0"`
+	Recipients []string                           `bun:"recipients,type:jsonb"`
+	Format     reportsubscriptionentity.Format    `bun:"format,notnull"`
+	IsActive   bool                               `bun:"is_active,notnull,default:true"`
+	NextRunAt  time.Time                          `bun:"next_run_at,notnull"`
+	LastRunAt  *time.Time                         `bun:"last_run_at"`
+}
+
+func (s *ReportSubscription) FromDomain(subscription *reportsubscriptionentity.ReportSubscription) {
+	if subscription == nil {
+		return
+	}
+	*s = ReportSubscription{
+		Entity: entitymodel.FromDomain(subscription.Entity),
+		ReportSubscriptionCommonAttributes: ReportSubscriptionCommonAttributes{
+			UserID:     subscription.UserID,
+			Name:       subscription.Name,
+			ReportType: subscription.ReportType,
+			Params:     subscription.Params,
+			Frequency:  subscription.Cadence.Frequency,
+			Hour:       subscription.Cadence.Hour,
+			Weekday:    subscription.Cadence.Weekday,
+			DayOfMonth: subscription.Cadence.DayOfMonth,
+			Recipients: subscription.Recipients,
+			Format:     subscription.Format,
+			IsActive:   subscription.IsActive,
+			NextRunAt:  subscription.NextRunAt,
+			LastRunAt:  subscription.LastRunAt,
+		},
+	}
+}
+
+func (s *ReportSubscription) ToDomain() *reportsubscriptionentity.ReportSubscription {
+	if s == nil {
+		return nil
+	}
+	return &reportsubscriptionentity.ReportSubscription{
+		Entity: s.Entity.ToDomain(),
+		ReportSubscriptionCommonAttributes: reportsubscriptionentity.ReportSubscriptionCommonAttributes{
+			UserID:     s.UserID,
+			Name:       s.Name,
+			ReportType: s.ReportType,
+			Params:     s.Params,
+			Cadence: reportsubscriptionentity.Cadence{
+				Frequency:  s.Frequency,
+				Hour:       s.Hour,
+				Weekday:    s.Weekday,
+				DayOfMonth: s.DayOfMonth,
+			},
+			Recipients: s.Recipients,
+			Format:     s.Format,
+			IsActive:   s.IsActive,
+			NextRunAt:  s.NextRunAt,
+			LastRunAt:  s.LastRunAt,
+		},
+	}
+}
+
+type ReportSubscriptionDelivery struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:report_subscription_deliveries,alias:delivery"`
+	ReportSubscriptionDeliveryCommonAttributes
+}
+
+type ReportSubscriptionDeliveryCommonAttributes struct {
+	SubscriptionID uuid.UUID                               `bun:"subscription_id,type:uuid,notnull"`
+	PeriodStart    time.Time                               `bun:"period_start,notnull"`
+	PeriodEnd      time.Time                               `bun:"period_end,notnull"`
+	Recipients     []string                                `bun:"recipients,type:jsonb"`
+	Format         reportsubscriptionentity.Format         `bun:"format,notnull"`
+	Filename       string                                  `bun:"filename"`
+	Status         reportsubscriptionentity.DeliveryStatus `bun:"status,notnull"`
+	Error          string                                  `bun:"error"`
+	Manual         bool                                    `bun:"manual,notnull,default:false"`
+}
+
+func (d *ReportSubscriptionDelivery) FromDomain(delivery *reportsubscriptionentity.Delivery) {
+	if delivery == nil {
+		return
+	}
+	*d = ReportSubscriptionDelivery{
+		Entity: entitymodel.FromDomain(delivery.Entity),
+		ReportSubscriptionDeliveryCommonAttributes: ReportSubscriptionDeliveryCommonAttributes{
+			SubscriptionID: delivery.SubscriptionID,
+			PeriodStart:    delivery.PeriodStart,
+			PeriodEnd:      delivery.PeriodEnd,
+			Recipients:     delivery.Recipients,
+			Format:         delivery.Format,
+			Filename:       delivery.Filename,
+			Status:         delivery.Status,
+			Error:          delivery.Error,
+			Manual:         delivery.Manual,
+		},
+	}
+}
+
+func (d *ReportSubscriptionDelivery) ToDomain() *reportsubscriptionentity.Delivery {
+	if d == nil {
+		return nil
+	}
+	return &reportsubscriptionentity.Delivery{
+		Entity: d.Entity.ToDomain(),
+		DeliveryCommonAttributes: reportsubscriptionentity.DeliveryCommonAttributes{
+			SubscriptionID: d.SubscriptionID,
+			PeriodStart:    d.PeriodStart,
+			PeriodEnd:      d.PeriodEnd,
+			Recipients:     d.Recipients,
+			Format:         d.Format,
+			Filename:       d.Filename,
+			Status:         d.Status,
+			Error:          d.Error,
+			Manual:         d.Manual,
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"context"
+	"time"
+)
+
+type ReportSubscriptionRepository interface {
+	CreateReportSubscription(ctx context.Context, s *ReportSubscription) error
+	UpdateReportSubscription(ctx context.Context, s *ReportSubscription) error
+	DeleteReportSubscription(ctx context.Context, id string) error
+	GetReportSubscriptionById(ctx context.Context, id string) (*ReportSubscription, error)
+	GetReportSubscriptionsByUserID(ctx context.Context, userID string) ([]ReportSubscription, error)
+	GetDueReportSubscriptions(ctx context.Context, now time.Time) ([]ReportSubscription, error)
+	CreateDelivery(ctx context.Context, d *ReportSubscriptionDelivery) error
+	GetDeliveriesBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]ReportSubscriptionDelivery, error)
+}
@@ -0,0 +1,124 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type SettlementStatement struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:settlement_statements,alias:settlement_statement"`
+	SettlementStatementCommonAttributes
+}
+
+type SettlementStatementCommonAttributes struct {
+	Acquirer    string                    `bun:"acquirer"`
+	Filename    string                    `bun:"filename"`
+	Format      string                    `bun:"format,notnull"`
+	PeriodStart time.Time                 `bun:"period_start,notnull"`
+	PeriodEnd   time.Time                 `bun:"period_end,notnull"`
+	Fingerprint string                    `bun:"fingerprint"`
+	Lines       []SettlementStatementLine `bun:"rel:has-many,join:id=statement_id"`
+}
+
+type SettlementStatementLine struct {
+	bun.BaseModel  `bun:"table:settlement_statement_lines,alias:settlement_statement_line"`
+	ID             uuid.UUID        `bun:"id,type:uuid,pk"`
+	StatementID    uuid.UUID        `bun:"statement_id,type:uuid,notnull"`
+	Date           time.Time        `bun:"date,notnull"`
+	Description    string           `bun:"description"`
+	Reference      string           `bun:"reference"`
+	PayMethod      string           `bun:"pay_method"`
+	Amount         *decimal.Decimal `bun:"amount,type:decimal(10,2),notnull"`
+	Status         string           `bun:"status,notnull"`
+	ExpectedAmount *decimal.Decimal `bun:"expected_amount,type:decimal(10,2)"`
+	Difference     *decimal.Decimal `bun:"difference,type:decimal(10,2)"`
+	ReceivableIDs  []uuid.UUID      `bun:"receivable_ids,type:jsonb"`
+}
+
+func (s *SettlementStatement) FromDomain(statement *accountreceivableentity.SettlementStatement) {
+	if statement == nil {
+		return
+	}
+	*s = SettlementStatement{
+		Entity: entitymodel.FromDomain(statement.Entity),
+		SettlementStatementCommonAttributes: SettlementStatementCommonAttributes{
+			Acquirer:    statement.Acquirer,
+			Filename:    statement.Filename,
+			Format:      string(statement.Format),
+			PeriodStart: statement.PeriodStart,
+			PeriodEnd:   statement.PeriodEnd,
+			Fingerprint: statement.Fingerprint,
+			Lines:       []SettlementStatementLine{},
+		},
+	}
+
+	for i := range statement.Lines {
+		line := &statement.Lines[i]
+		s.Lines = append(s.Lines, SettlementStatementLine{
+			ID:             line.ID,
+			StatementID:    statement.ID,
+			Date:           line.Date,
+			Description:    line.Description,
+			Reference:      line.Reference,
+			PayMethod:      line.PayMethod,
+			Amount:         &line.Amount,
+			Status:         string(line.Status),
+			ExpectedAmount: &line.ExpectedAmount,
+			Difference:     &line.Difference,
+			ReceivableIDs:  line.ReceivableIDs,
+		})
+	}
+}
+
+func (s *SettlementStatement) ToDomain() *accountreceivableentity.SettlementStatement {
+	if s == nil {
+		return nil
+	}
+	statement := &accountreceivableentity.SettlementStatement{
+		Entity: s.Entity.ToDomain(),
+		SettlementStatementCommonAttributes: accountreceivableentity.SettlementStatementCommonAttributes{
+			Acquirer:    s.Acquirer,
+			Filename:    s.Filename,
+			Format:      accountreceivableentity.StatementFormat(s.Format),
+			PeriodStart: s.PeriodStart,
+			PeriodEnd:   s.PeriodEnd,
+			Fingerprint: s.Fingerprint,
+			Lines:       []accountreceivableentity.StatementLine{},
+		},
+	}
+
+	for _, line := range s.Lines {
+		receivableIDs := line.ReceivableIDs
+		if receivableIDs == nil {
+			receivableIDs = []uuid.UUID{}
+		}
+
+		statement.Lines = append(statement.Lines, accountreceivableentity.StatementLine{
+			ID:             line.ID,
+			Date:           line.Date,
+			Description:    line.Description,
+			Reference:      line.Reference,
+			PayMethod:      line.PayMethod,
+			Amount:         decimalValue(line.Amount),
+			Status:         accountreceivableentity.StatementLineStatus(line.Status),
+			ExpectedAmount: decimalValue(line.ExpectedAmount),
+			Difference:     decimalValue(line.Difference),
+			ReceivableIDs:  receivableIDs,
+		})
+	}
+
+	return statement
+}
+
+func decimalValue(d *decimal.Decimal) decimal.Decimal {
+	if d == nil {
+		return decimal.Zero
+	}
+	return *d
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type SettlementStatementRepository interface {
+	CreateSettlementStatement(ctx context.Context, db bun.IDB, s *SettlementStatement) error
+	ExistsSettlementStatementByFingerprint(ctx context.Context, db bun.IDB, fingerprint string) (bool, error)
+	GetSettlementStatementById(ctx context.Context, id string) (*SettlementStatement, error)
+	GetAllSettlementStatements(ctx context.Context, page, perPage int) ([]SettlementStatement, int, error)
+}
@@ -25,6 +25,7 @@ type StockCommonAttributes struct {
 	MaxStock           *decimal.Decimal `bun:"max_stock,type:decimal(10,3),notnull"`
 	Unit               string           `bun:"unit,notnull"`
 	IsActive           bool             `bun:"is_active,notnull"`
+	AutoAvailability   bool             `bun:"auto_availability,notnull,default:false"`
 }
 
 // FromDomain converte domain para model
@@ -43,6 +44,7 @@ func (s *Stock) FromDomain(stock *stockentity.Stock) {
 			MaxStock:           &stock.MaxStock,
 			Unit:               stock.Unit,
 			IsActive:           stock.IsActive,
+			AutoAvailability:   stock.AutoAvailability,
 		},
 	}
 }
@@ -64,6 +66,7 @@ func (s *Stock) ToDomain() *stockentity.Stock {
 			MaxStock:           s.GetMaxStock(),
 			Unit:               s.Unit,
 			IsActive:           s.IsActive,
+			AutoAvailability:   s.AutoAvailability,
 		},
 	}
 }
@@ -24,6 +24,7 @@ type StockBatchCommonAttributes struct {
 	CurrentQuantity    *decimal.Decimal `bun:"current_quantity,type:decimal(10,3),notnull"`
 	CostPrice          *decimal.Decimal `bun:"cost_price,type:decimal(10,2),notnull"`
 	ExpiresAt          *time.Time       `bun:"expires_at"`
+	LocationID         *uuid.UUID       `bun:"location_id,type:uuid"`
 }
 
 // FromDomain converte domain para model
@@ -38,6 +39,7 @@ func (sb *StockBatch) FromDomain(batch *stockentity.StockBatch) {
 	sb.CurrentQuantity = &batch.CurrentQuantity
 	sb.CostPrice = &batch.CostPrice
 	sb.ExpiresAt = batch.ExpiresAt
+	sb.LocationID = batch.LocationID
 	sb.CreatedAt = batch.CreatedAt
 }
 
@@ -55,6 +57,7 @@ func (sb *StockBatch) ToDomain() *stockentity.StockBatch {
 			CurrentQuantity:    sb.GetCurrentQuantity(),
 			CostPrice:          sb.GetCostPrice(),
 			ExpiresAt:          sb.ExpiresAt,
+			LocationID:         sb.LocationID,
 		},
 	}
 }
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type StockLocation struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:stock_locations,alias:stock_location"`
+	StockLocationCommonAttributes
+}
+
+type StockLocationCommonAttributes struct {
+	Name           string      `bun:"name,notnull"`
+	Description    string      `bun:"description"`
+	IsDefault      bool        `bun:"is_default,notnull,default:false"`
+	IsActive       bool        `bun:"is_active,notnull,default:true"`
+	CategoryIDs    []uuid.UUID `bun:"category_ids,type:jsonb"`
+	ProcessRuleIDs []uuid.UUID `bun:"process_rule_ids,type:jsonb"`
+}
+
+func (l *StockLocation) FromDomain(location *stocklocationentity.StockLocation) {
+	if location == nil {
+		return
+	}
+	*l = StockLocation{
+		Entity: entitymodel.FromDomain(location.Entity),
+		StockLocationCommonAttributes: StockLocationCommonAttributes{
+			Name:           location.Name,
+			Description:    location.Description,
+			IsDefault:      location.IsDefault,
+			IsActive:       location.IsActive,
+			CategoryIDs:    location.CategoryIDs,
+			ProcessRuleIDs: location.ProcessRuleIDs,
+		},
+	}
+}
+
+func (l *StockLocation) ToDomain() *stocklocationentity.StockLocation {
+	if l == nil {
+		return nil
+	}
+	return &stocklocationentity.StockLocation{
+		Entity: l.Entity.ToDomain(),
+		StockLocationCommonAttributes: stocklocationentity.StockLocationCommonAttributes{
+			Name:           l.Name,
+			Description:    l.Description,
+			IsDefault:      l.IsDefault,
+			IsActive:       l.IsActive,
+			CategoryIDs:    l.CategoryIDs,
+			ProcessRuleIDs: l.ProcessRuleIDs,
+		},
+	}
+}
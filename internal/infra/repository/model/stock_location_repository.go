@@ -0,0 +1,25 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type StockLocationRepository interface {
+	CreateStockLocation(ctx context.Context, l *StockLocation) error
+	UpdateStockLocation(ctx context.Context, l *StockLocation) error
+	DeleteStockLocation(ctx context.Context, id string) error
+	GetStockLocationById(ctx context.Context, id string) (*StockLocation, error)
+	GetAllStockLocations(ctx context.Context) ([]StockLocation, error)
+}
+
+type StockTransferRepository interface {
+	CreateStockTransfer(ctx context.Context, db bun.IDB, t *StockTransfer) error
+	// UpdateStockTransfer grava o cabeçalho e o lote de destino de cada linha
+	UpdateStockTransfer(ctx context.Context, db bun.IDB, t *StockTransfer) error
+	GetStockTransferById(ctx context.Context, id string) (*StockTransfer, error)
+	GetStockTransferByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*StockTransfer, error)
+	GetAllStockTransfers(ctx context.Context, page, perPage int, status, stockID string) ([]StockTransfer, int, error)
+	GetInTransitTransfersByStockID(ctx context.Context, stockID string) ([]StockTransfer, error)
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type StockLoss struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:stock_losses,alias:stock_loss"`
+	StockLossCommonAttributes
+}
+
+type StockLossCommonAttributes struct {
+	StockID            uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
+	ProductID          uuid.UUID        `bun:"product_id,type:uuid,notnull"`
+	Product            *Product         `bun:"rel:belongs-to,join:product_id=id"`
+	ProductVariationID *uuid.UUID       `bun:"product_variation_id,type:uuid"`
+	BatchID            *uuid.UUID       `bun:"batch_id,type:uuid"`
+	Reason             string           `bun:"reason,notnull"`
+	Quantity           *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
+	TotalCost          *decimal.Decimal `bun:"total_cost,type:decimal(10,2),notnull"`
+	EmployeeID         uuid.UUID        `bun:"employee_id,type:uuid,notnull"`
+	OrderProcessID     *uuid.UUID       `bun:"order_process_id,type:uuid"`
+	GroupItemID        *uuid.UUID       `bun:"group_item_id,type:uuid"`
+	SourceReason       string           `bun:"source_reason"`
+	Notes              string           `bun:"notes"`
+}
+
+func (l *StockLoss) FromDomain(loss *stocklossentity.StockLoss) {
+	if loss == nil {
+		return
+	}
+	*l = StockLoss{
+		Entity: entitymodel.FromDomain(loss.Entity),
+		StockLossCommonAttributes: StockLossCommonAttributes{
+			StockID:            loss.StockID,
+			ProductID:          loss.ProductID,
+			ProductVariationID: loss.ProductVariationID,
+			BatchID:            loss.BatchID,
+			Reason:             string(loss.Reason),
+			Quantity:           &loss.Quantity,
+			TotalCost:          &loss.TotalCost,
+			EmployeeID:         loss.EmployeeID,
+			OrderProcessID:     loss.OrderProcessID,
+			GroupItemID:        loss.GroupItemID,
+			SourceReason:       loss.SourceReason,
+			Notes:              loss.Notes,
+		},
+	}
+}
+
+func (l *StockLoss) ToDomain() *stocklossentity.StockLoss {
+	if l == nil {
+		return nil
+	}
+	return &stocklossentity.StockLoss{
+		Entity: l.Entity.ToDomain(),
+		StockLossCommonAttributes: stocklossentity.StockLossCommonAttributes{
+			StockID:            l.StockID,
+			ProductID:          l.ProductID,
+			ProductVariationID: l.ProductVariationID,
+			BatchID:            l.BatchID,
+			Reason:             stocklossentity.LossReason(l.Reason),
+			Quantity:           l.GetQuantity(),
+			TotalCost:          l.GetTotalCost(),
+			EmployeeID:         l.EmployeeID,
+			OrderProcessID:     l.OrderProcessID,
+			GroupItemID:        l.GroupItemID,
+			SourceReason:       l.SourceReason,
+			Notes:              l.Notes,
+		},
+	}
+}
+
+func (l *StockLoss) GetQuantity() decimal.Decimal {
+	if l.Quantity == nil {
+		return decimal.Zero
+	}
+	return *l.Quantity
+}
+
+func (l *StockLoss) GetTotalCost() decimal.Decimal {
+	if l.TotalCost == nil {
+		return decimal.Zero
+	}
+	return *l.TotalCost
+}
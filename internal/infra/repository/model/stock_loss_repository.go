@@ -0,0 +1,14 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type StockLossRepository interface {
+	CreateStockLoss(ctx context.Context, db bun.IDB, l *StockLoss) error
+	GetStockLossById(ctx context.Context, id string) (*StockLoss, error)
+	GetAllStockLosses(ctx context.Context, page, perPage int, reason string, start, end *time.Time) ([]StockLoss, int, error)
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
@@ -16,14 +18,21 @@ type StockMovement struct {
 }
 
 type StockMovementCommonAttributes struct {
-	StockID    uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
-	BatchID    *uuid.UUID       `bun:"batch_id,type:uuid"`
-	Type       string           `bun:"type,notnull"`
-	Quantity   *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
-	Reason     string           `bun:"reason,notnull"`
-	OrderID    *uuid.UUID       `bun:"order_id"`
-	EmployeeID uuid.UUID        `bun:"employee_id,notnull"`
-	Price      *decimal.Decimal `bun:"price,type:decimal(10,2),notnull"`
+	StockID           uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
+	BatchID           *uuid.UUID       `bun:"batch_id,type:uuid"`
+	Type              string           `bun:"type,notnull"`
+	Quantity          *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
+	Reason            string           `bun:"reason,notnull"`
+	OrderID           *uuid.UUID       `bun:"order_id"`
+	PurchaseOrderID   *uuid.UUID       `bun:"purchase_order_id,type:uuid"`
+	SupplierInvoiceID *uuid.UUID       `bun:"supplier_invoice_id,type:uuid"`
+	InventoryCountID  *uuid.UUID       `bun:"inventory_count_id,type:uuid"`
+	StockLossID       *uuid.UUID       `bun:"stock_loss_id,type:uuid"`
+	LocationID        *uuid.UUID       `bun:"location_id,type:uuid"`
+	TransferID        *uuid.UUID       `bun:"transfer_id,type:uuid"`
+	ExpiresAt         *time.Time       `bun:"expires_at"`
+	EmployeeID        uuid.UUID        `bun:"employee_id,notnull"`
+	Price             *decimal.Decimal `bun:"price,type:decimal(10,2),notnull"`
 }
 
 // FromDomain converte domain para model
@@ -38,6 +47,13 @@ func (sm *StockMovement) FromDomain(movement *stockentity.StockMovement) {
 	sm.Quantity = &movement.Quantity
 	sm.Reason = movement.Reason
 	sm.OrderID = movement.OrderID
+	sm.PurchaseOrderID = movement.PurchaseOrderID
+	sm.SupplierInvoiceID = movement.SupplierInvoiceID
+	sm.InventoryCountID = movement.InventoryCountID
+	sm.StockLossID = movement.StockLossID
+	sm.LocationID = movement.LocationID
+	sm.TransferID = movement.TransferID
+	sm.ExpiresAt = movement.ExpiresAt
 	sm.EmployeeID = movement.EmployeeID
 	sm.Price = &movement.Price
 }
@@ -50,14 +66,21 @@ func (sm *StockMovement) ToDomain() *stockentity.StockMovement {
 	return &stockentity.StockMovement{
 		Entity: sm.Entity.ToDomain(),
 		StockMovementCommonAttributes: stockentity.StockMovementCommonAttributes{
-			StockID:    sm.StockID,
-			BatchID:    sm.BatchID,
-			Type:       stockentity.MovementType(sm.Type),
-			Quantity:   sm.GetQuantity(),
-			Reason:     sm.Reason,
-			OrderID:    sm.OrderID,
-			EmployeeID: sm.EmployeeID,
-			Price:      sm.GetPrice(),
+			StockID:           sm.StockID,
+			BatchID:           sm.BatchID,
+			Type:              stockentity.MovementType(sm.Type),
+			Quantity:          sm.GetQuantity(),
+			Reason:            sm.Reason,
+			OrderID:           sm.OrderID,
+			PurchaseOrderID:   sm.PurchaseOrderID,
+			SupplierInvoiceID: sm.SupplierInvoiceID,
+			InventoryCountID:  sm.InventoryCountID,
+			StockLossID:       sm.StockLossID,
+			LocationID:        sm.LocationID,
+			TransferID:        sm.TransferID,
+			ExpiresAt:         sm.ExpiresAt,
+			EmployeeID:        sm.EmployeeID,
+			Price:             sm.GetPrice(),
 		},
 	}
 }
@@ -75,3 +98,17 @@ func (sm *StockMovement) GetPrice() decimal.Decimal {
 	}
 	return *sm.Price
 }
+
+// StockConsumption é a quantidade líquida que saiu de um estoque no período
+type StockConsumption struct {
+	StockID  uuid.UUID       `bun:"stock_id"`
+	Quantity decimal.Decimal `bun:"quantity"`
+}
+
+// ExpiredReservation é o saldo reservado por um pedido em rascunho cuja reserva já venceu
+type ExpiredReservation struct {
+	OrderID    uuid.UUID       `bun:"order_id"`
+	StockID    uuid.UUID       `bun:"stock_id"`
+	EmployeeID uuid.UUID       `bun:"employee_id"`
+	Quantity   decimal.Decimal `bun:"quantity"`
+}
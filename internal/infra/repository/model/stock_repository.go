@@ -2,7 +2,9 @@ package model
 
 import (
 	"context"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 )
 
@@ -24,8 +26,15 @@ type StockMovementRepository interface {
 	GetMovementsByStockID(ctx context.Context, stockID string, date *string) ([]StockMovement, error)
 	GetMovementsByProductID(ctx context.Context, productID string) ([]StockMovement, error)
 	GetMovementsByOrderID(ctx context.Context, orderID string) ([]StockMovement, error)
+	GetMovementsByOrderIDWithTx(ctx context.Context, db bun.IDB, orderID string) ([]StockMovement, error)
 	GetAllMovements(ctx context.Context) ([]StockMovement, error)
 	GetMovementsByDateRange(ctx context.Context, start, end string) ([]StockMovement, error)
+	// GetConsumptionByStock soma as saídas de cada estoque em [start, end), descontando as restaurações de pedidos
+	GetConsumptionByStock(ctx context.Context, start, end time.Time) ([]StockConsumption, error)
+	// GetExpiredReservations lista, por pedido em rascunho e estoque, as reservas vencidas ainda abertas
+	GetExpiredReservations(ctx context.Context, now time.Time) ([]ExpiredReservation, error)
+	// GetStagingReservationWithTx soma a reserva ainda aberta do pedido no estoque; zero se o pedido saiu do rascunho
+	GetStagingReservationWithTx(ctx context.Context, db bun.IDB, orderID, stockID string) (decimal.Decimal, error)
 }
 
 type StockAlertRepository interface {
@@ -0,0 +1,158 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type StockTransfer struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:stock_transfers,alias:stock_transfer"`
+	StockTransferCommonAttributes
+	StockTransferTimeLogs
+}
+
+type StockTransferCommonAttributes struct {
+	StockID        uuid.UUID           `bun:"stock_id,type:uuid,notnull"`
+	FromLocationID uuid.UUID           `bun:"from_location_id,type:uuid,notnull"`
+	ToLocationID   uuid.UUID           `bun:"to_location_id,type:uuid,notnull"`
+	Quantity       *decimal.Decimal    `bun:"quantity,type:decimal(10,3),notnull"`
+	Status         string              `bun:"status,notnull"`
+	Notes          string              `bun:"notes"`
+	SentBy         uuid.UUID           `bun:"sent_by,type:uuid,notnull"`
+	ReceivedBy     *uuid.UUID          `bun:"received_by,type:uuid"`
+	Lines          []StockTransferLine `bun:"rel:has-many,join:id=transfer_id"`
+}
+
+type StockTransferTimeLogs struct {
+	SentAt      time.Time  `bun:"sent_at,notnull"`
+	ReceivedAt  *time.Time `bun:"received_at"`
+	CancelledAt *time.Time `bun:"cancelled_at"`
+}
+
+type StockTransferLine struct {
+	entitymodel.Entity
+	bun.BaseModel      `bun:"table:stock_transfer_lines,alias:stock_transfer_line"`
+	TransferID         uuid.UUID        `bun:"transfer_id,type:uuid,notnull"`
+	SourceBatchID      *uuid.UUID       `bun:"source_batch_id,type:uuid"`
+	DestinationBatchID *uuid.UUID       `bun:"destination_batch_id,type:uuid"`
+	Quantity           *decimal.Decimal `bun:"quantity,type:decimal(10,3),notnull"`
+	CostPrice          *decimal.Decimal `bun:"cost_price,type:decimal(10,2),notnull"`
+	ExpiresAt          *time.Time       `bun:"expires_at"`
+}
+
+func (t *StockTransfer) FromDomain(transfer *stocklocationentity.StockTransfer) {
+	if transfer == nil {
+		return
+	}
+	*t = StockTransfer{
+		Entity: entitymodel.FromDomain(transfer.Entity),
+		StockTransferCommonAttributes: StockTransferCommonAttributes{
+			StockID:        transfer.StockID,
+			FromLocationID: transfer.FromLocationID,
+			ToLocationID:   transfer.ToLocationID,
+			Quantity:       &transfer.Quantity,
+			Status:         string(transfer.Status),
+			Notes:          transfer.Notes,
+			SentBy:         transfer.SentBy,
+			ReceivedBy:     transfer.ReceivedBy,
+		},
+		StockTransferTimeLogs: StockTransferTimeLogs{
+			SentAt:      transfer.SentAt,
+			ReceivedAt:  transfer.ReceivedAt,
+			CancelledAt: transfer.CancelledAt,
+		},
+	}
+
+	for i := range transfer.Lines {
+		line := StockTransferLine{}
+		line.FromDomain(&transfer.Lines[i])
+		t.Lines = append(t.Lines, line)
+	}
+}
+
+func (t *StockTransfer) ToDomain() *stocklocationentity.StockTransfer {
+	if t == nil {
+		return nil
+	}
+	transfer := &stocklocationentity.StockTransfer{
+		Entity: t.Entity.ToDomain(),
+		StockTransferCommonAttributes: stocklocationentity.StockTransferCommonAttributes{
+			StockID:        t.StockID,
+			FromLocationID: t.FromLocationID,
+			ToLocationID:   t.ToLocationID,
+			Quantity:       t.GetQuantity(),
+			Status:         stocklocationentity.StockTransferStatus(t.Status),
+			Notes:          t.Notes,
+			SentBy:         t.SentBy,
+			ReceivedBy:     t.ReceivedBy,
+		},
+		StockTransferTimeLogs: stocklocationentity.StockTransferTimeLogs{
+			SentAt:      t.SentAt,
+			ReceivedAt:  t.ReceivedAt,
+			CancelledAt: t.CancelledAt,
+		},
+	}
+
+	for i := range t.Lines {
+		transfer.Lines = append(transfer.Lines, *t.Lines[i].ToDomain())
+	}
+
+	return transfer
+}
+
+func (t *StockTransfer) GetQuantity() decimal.Decimal {
+	if t.Quantity == nil {
+		return decimal.Zero
+	}
+	return *t.Quantity
+}
+
+func (l *StockTransferLine) FromDomain(line *stocklocationentity.StockTransferLine) {
+	if line == nil {
+		return
+	}
+	*l = StockTransferLine{
+		Entity:             entitymodel.FromDomain(line.Entity),
+		TransferID:         line.TransferID,
+		SourceBatchID:      line.SourceBatchID,
+		DestinationBatchID: line.DestinationBatchID,
+		Quantity:           &line.Quantity,
+		CostPrice:          &line.CostPrice,
+		ExpiresAt:          line.ExpiresAt,
+	}
+}
+
+func (l *StockTransferLine) ToDomain() *stocklocationentity.StockTransferLine {
+	if l == nil {
+		return nil
+	}
+	return &stocklocationentity.StockTransferLine{
+		Entity:             l.Entity.ToDomain(),
+		TransferID:         l.TransferID,
+		SourceBatchID:      l.SourceBatchID,
+		DestinationBatchID: l.DestinationBatchID,
+		Quantity:           l.GetQuantity(),
+		CostPrice:          l.GetCostPrice(),
+		ExpiresAt:          l.ExpiresAt,
+	}
+}
+
+func (l *StockTransferLine) GetQuantity() decimal.Decimal {
+	if l.Quantity == nil {
+		return decimal.Zero
+	}
+	return *l.Quantity
+}
+
+func (l *StockTransferLine) GetCostPrice() decimal.Decimal {
+	if l.CostPrice == nil {
+		return decimal.Zero
+	}
+	return *l.CostPrice
+}
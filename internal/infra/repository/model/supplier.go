@@ -0,0 +1,71 @@
+package model
+
+import (
+	"github.com/uptrace/bun"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type Supplier struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:suppliers,alias:supplier"`
+	SupplierCommonAttributes
+}
+
+type SupplierCommonAttributes struct {
+	Name              string `bun:"name,notnull"`
+	TradeName         string `bun:"trade_name"`
+	Cnpj              string `bun:"cnpj"`
+	StateRegistration string `bun:"state_registration"`
+	Email             string `bun:"email"`
+	Phone             string `bun:"phone"`
+	ContactName       string `bun:"contact_name"`
+	LeadTimeDays      int    `bun:"lead_time_days,notnull,default:0"`
+	PaymentTermDays   int    `bun:"payment_term_days,notnull,default:0"`
+	Notes             string `bun:"notes"`
+	IsActive          bool   `bun:"is_active,type:boolean,default:true"`
+}
+
+func (s *Supplier) FromDomain(supplier *supplierentity.Supplier) {
+	if supplier == nil {
+		return
+	}
+	*s = Supplier{
+		Entity: entitymodel.FromDomain(supplier.Entity),
+		SupplierCommonAttributes: SupplierCommonAttributes{
+			Name:              supplier.Name,
+			TradeName:         supplier.TradeName,
+			Cnpj:              supplier.Cnpj,
+			StateRegistration: supplier.StateRegistration,
+			Email:             supplier.Email,
+			Phone:             supplier.Phone,
+			ContactName:       supplier.ContactName,
+			LeadTimeDays:      supplier.LeadTimeDays,
+			PaymentTermDays:   supplier.PaymentTermDays,
+			Notes:             supplier.Notes,
+			IsActive:          supplier.IsActive,
+		},
+	}
+}
+
+func (s *Supplier) ToDomain() *supplierentity.Supplier {
+	if s == nil {
+		return nil
+	}
+	return &supplierentity.Supplier{
+		Entity: s.Entity.ToDomain(),
+		SupplierCommonAttributes: supplierentity.SupplierCommonAttributes{
+			Name:              s.Name,
+			TradeName:         s.TradeName,
+			Cnpj:              s.Cnpj,
+			StateRegistration: s.StateRegistration,
+			Email:             s.Email,
+			Phone:             s.Phone,
+			ContactName:       s.ContactName,
+			LeadTimeDays:      s.LeadTimeDays,
+			PaymentTermDays:   s.PaymentTermDays,
+			Notes:             s.Notes,
+			IsActive:          s.IsActive,
+		},
+	}
+}
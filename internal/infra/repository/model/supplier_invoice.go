@@ -0,0 +1,149 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	supplierinvoiceentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier_invoice"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type SupplierInvoice struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:supplier_invoices,alias:supplier_invoice"`
+	SupplierInvoiceCommonAttributes
+}
+
+type SupplierInvoiceCommonAttributes struct {
+	AccessKey   string                `bun:"access_key,notnull,unique"`
+	SupplierID  uuid.UUID             `bun:"supplier_id,type:uuid,notnull"`
+	Supplier    *Supplier             `bun:"rel:belongs-to,join:supplier_id=id"`
+	Number      string                `bun:"number"`
+	Series      string                `bun:"series"`
+	IssuedAt    *time.Time            `bun:"issued_at"`
+	TotalAmount *decimal.Decimal      `bun:"total_amount,type:decimal(10,2)"`
+	Status      string                `bun:"status,notnull"`
+	ImportedAt  *time.Time            `bun:"imported_at"`
+	Items       []SupplierInvoiceItem `bun:"items,type:jsonb"`
+}
+
+// SupplierInvoiceItem é gravado como JSON dentro da nota (snapshot da linha + mapeamento)
+type SupplierInvoiceItem struct {
+	ItemNumber   int                  `json:"item_number"`
+	SupplierCode string               `json:"supplier_code"`
+	EAN          string               `json:"ean,omitempty"`
+	Description  string               `json:"description"`
+	NCM          string               `json:"ncm,omitempty"`
+	Unit         string               `json:"unit,omitempty"`
+	Quantity     decimal.Decimal      `json:"quantity"`
+	UnitCost     decimal.Decimal      `json:"unit_cost"`
+	Total        decimal.Decimal      `json:"total"`
+	Lots         []SupplierInvoiceLot `json:"lots,omitempty"`
+	StockID      *uuid.UUID           `json:"stock_id,omitempty"`
+	Factor       decimal.Decimal      `json:"factor"`
+	Ignored      bool                 `json:"ignored,omitempty"`
+}
+
+type SupplierInvoiceLot struct {
+	Code           string          `json:"code"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	ManufacturedAt *time.Time      `json:"manufactured_at,omitempty"`
+	ExpiresAt      *time.Time      `json:"expires_at,omitempty"`
+}
+
+func (s *SupplierInvoice) FromDomain(invoice *supplierinvoiceentity.SupplierInvoice) {
+	if invoice == nil {
+		return
+	}
+	*s = SupplierInvoice{
+		Entity: entitymodel.FromDomain(invoice.Entity),
+		SupplierInvoiceCommonAttributes: SupplierInvoiceCommonAttributes{
+			AccessKey:   invoice.AccessKey,
+			SupplierID:  invoice.SupplierID,
+			Number:      invoice.Number,
+			Series:      invoice.Series,
+			IssuedAt:    invoice.IssuedAt,
+			TotalAmount: &invoice.TotalAmount,
+			Status:      string(invoice.Status),
+			ImportedAt:  invoice.ImportedAt,
+			Items:       []SupplierInvoiceItem{},
+		},
+	}
+
+	for _, item := range invoice.Items {
+		itemModel := SupplierInvoiceItem{
+			ItemNumber:   item.ItemNumber,
+			SupplierCode: item.SupplierCode,
+			EAN:          item.EAN,
+			Description:  item.Description,
+			NCM:          item.NCM,
+			Unit:         item.Unit,
+			Quantity:     item.Quantity,
+			UnitCost:     item.UnitCost,
+			Total:        item.Total,
+			StockID:      item.StockID,
+			Factor:       item.Factor,
+			Ignored:      item.Ignored,
+		}
+
+		for _, lot := range item.Lots {
+			itemModel.Lots = append(itemModel.Lots, SupplierInvoiceLot(lot))
+		}
+
+		s.Items = append(s.Items, itemModel)
+	}
+}
+
+func (s *SupplierInvoice) ToDomain() *supplierinvoiceentity.SupplierInvoice {
+	if s == nil {
+		return nil
+	}
+	invoice := &supplierinvoiceentity.SupplierInvoice{
+		Entity: s.Entity.ToDomain(),
+		SupplierInvoiceCommonAttributes: supplierinvoiceentity.SupplierInvoiceCommonAttributes{
+			AccessKey:   s.AccessKey,
+			SupplierID:  s.SupplierID,
+			Number:      s.Number,
+			Series:      s.Series,
+			IssuedAt:    s.IssuedAt,
+			TotalAmount: s.GetTotalAmount(),
+			Status:      supplierinvoiceentity.SupplierInvoiceStatus(s.Status),
+			ImportedAt:  s.ImportedAt,
+			Items:       []supplierinvoiceentity.SupplierInvoiceItem{},
+		},
+	}
+
+	for _, itemModel := range s.Items {
+		item := supplierinvoiceentity.SupplierInvoiceItem{
+			ItemNumber:   itemModel.ItemNumber,
+			SupplierCode: itemModel.SupplierCode,
+			EAN:          itemModel.EAN,
+			Description:  itemModel.Description,
+			NCM:          itemModel.NCM,
+			Unit:         itemModel.Unit,
+			Quantity:     itemModel.Quantity,
+			UnitCost:     itemModel.UnitCost,
+			Total:        itemModel.Total,
+			StockID:      itemModel.StockID,
+			Factor:       itemModel.Factor,
+			Ignored:      itemModel.Ignored,
+		}
+
+		for _, lot := range itemModel.Lots {
+			item.Lots = append(item.Lots, supplierinvoiceentity.InvoiceLot(lot))
+		}
+
+		invoice.Items = append(invoice.Items, item)
+	}
+
+	return invoice
+}
+
+func (s *SupplierInvoice) GetTotalAmount() decimal.Decimal {
+	if s.TotalAmount == nil {
+		return decimal.Zero
+	}
+	return *s.TotalAmount
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type SupplierInvoiceRepository interface {
+	CreateSupplierInvoice(ctx context.Context, i *SupplierInvoice) error
+	UpdateSupplierInvoice(ctx context.Context, db bun.IDB, i *SupplierInvoice) error
+	GetSupplierInvoiceById(ctx context.Context, id string) (*SupplierInvoice, error)
+	GetSupplierInvoiceByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*SupplierInvoice, error)
+	// GetSupplierInvoiceByAccessKey devolve nil quando a chave ainda não foi enviada
+	GetSupplierInvoiceByAccessKey(ctx context.Context, accessKey string) (*SupplierInvoice, error)
+	GetAllSupplierInvoices(ctx context.Context, page, perPage int, status string) ([]SupplierInvoice, int, error)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+)
+
+type SupplierProductMapping struct {
+	entitymodel.Entity
+	bun.BaseModel `bun:"table:supplier_product_mappings,alias:supplier_product_mapping"`
+	SupplierID    uuid.UUID        `bun:"supplier_id,type:uuid,notnull,unique:supplier_code"`
+	SupplierCode  string           `bun:"supplier_code,notnull,unique:supplier_code"`
+	StockID       uuid.UUID        `bun:"stock_id,type:uuid,notnull"`
+	Factor        *decimal.Decimal `bun:"factor,type:decimal(10,3),notnull"`
+}
+
+func (m *SupplierProductMapping) FromDomain(mapping *supplierentity.SupplierProductMapping) {
+	if mapping == nil {
+		return
+	}
+	*m = SupplierProductMapping{
+		Entity:       entitymodel.FromDomain(mapping.Entity),
+		SupplierID:   mapping.SupplierID,
+		SupplierCode: mapping.SupplierCode,
+		StockID:      mapping.StockID,
+		Factor:       &mapping.Factor,
+	}
+}
+
+func (m *SupplierProductMapping) ToDomain() *supplierentity.SupplierProductMapping {
+	if m == nil {
+		return nil
+	}
+	return &supplierentity.SupplierProductMapping{
+		Entity:       m.Entity.ToDomain(),
+		SupplierID:   m.SupplierID,
+		SupplierCode: m.SupplierCode,
+		StockID:      m.StockID,
+		Factor:       m.GetFactor(),
+	}
+}
+
+func (m *SupplierProductMapping) GetFactor() decimal.Decimal {
+	if m.Factor == nil {
+		return decimal.NewFromInt(1)
+	}
+	return *m.Factor
+}
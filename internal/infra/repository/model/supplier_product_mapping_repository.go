@@ -0,0 +1,12 @@
+package model
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type SupplierProductMappingRepository interface {
+	UpsertMappings(ctx context.Context, db bun.IDB, mappings []SupplierProductMapping) error
+	GetMappingsBySupplierID(ctx context.Context, supplierID string) ([]SupplierProductMapping, error)
+}
@@ -0,0 +1,13 @@
+package model
+
+import "context"
+
+type SupplierRepository interface {
+	CreateSupplier(ctx context.Context, s *Supplier) error
+	UpdateSupplier(ctx context.Context, s *Supplier) error
+	DeleteSupplier(ctx context.Context, id string) error
+	GetSupplierById(ctx context.Context, id string) (*Supplier, error)
+	// GetSupplierByCnpj devolve nil quando não há fornecedor com o CNPJ
+	GetSupplierByCnpj(ctx context.Context, cnpj string) (*Supplier, error)
+	GetAllSuppliers(ctx context.Context, page, perPage int, isActive bool) ([]Supplier, int, error)
+}
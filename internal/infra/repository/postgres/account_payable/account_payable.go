@@ -0,0 +1,168 @@
+package accountpayablerepositorybun
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type AccountPayableRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewAccountPayableRepositoryBun(db *bun.DB) model.AccountPayableRepository {
+	return &AccountPayableRepositoryBun{db: db}
+}
+
+func (r *AccountPayableRepositoryBun) CreateAccountPayable(ctx context.Context, db bun.IDB, a *model.AccountPayable) error {
+	if _, err := db.NewInsert().Model(a).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *AccountPayableRepositoryBun) UpdateAccountPayable(ctx context.Context, a *model.AccountPayable) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(a).Where("account_payable.id = ?", a.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AccountPayableRepositoryBun) GetAccountPayableById(ctx context.Context, id string) (*model.AccountPayable, error) {
+	payable := &model.AccountPayable{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(payable).
+		Where("account_payable.id = ?", id).
+		Relation("Supplier").
+		Relation("Category").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return payable, nil
+}
+
+func (r *AccountPayableRepositoryBun) GetAccountPayablesByPurchaseOrderID(ctx context.Context, purchaseOrderID string) ([]model.AccountPayable, error) {
+	payables := make([]model.AccountPayable, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(&payables).
+		Where("account_payable.purchase_order_id = ?", purchaseOrderID).
+		Order("account_payable.due_date ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return payables, nil
+}
+
+func (r *AccountPayableRepositoryBun) GetAllAccountPayables(ctx context.Context, page, perPage int, status string, dueFrom, dueTo *time.Time, categoryID string) ([]model.AccountPayable, int, error) {
+	payables := make([]model.AccountPayable, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&payables).
+		Relation("Supplier").
+		Relation("Category").
+		Order("account_payable.due_date ASC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("account_payable.status = ?", status)
+	}
+
+	if dueFrom != nil {
+		query = query.Where("account_payable.due_date >= ?", *dueFrom)
+	}
+
+	if dueTo != nil {
+		query = query.Where("account_payable.due_date < ?", *dueTo)
+	}
+
+	if categoryID != "" {
+		query = query.Where("account_payable.category_id = ?", categoryID)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return payables, count, nil
+}
+
+func (r *AccountPayableRepositoryBun) GetLatestRecurringAccountPayables(ctx context.Context) ([]model.AccountPayable, error) {
+	payables := make([]model.AccountPayable, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// A última conta da série decide se ela continua: remover a recorrência dela encerra a série
+	latest := tx.NewSelect().
+		Model((*model.AccountPayable)(nil)).
+		Column("account_payable.id").
+		DistinctOn("COALESCE(account_payable.recurrence_parent_id, account_payable.id)").
+		Where("account_payable.recurrence_parent_id IS NOT NULL OR account_payable.recurrence <> ''").
+		OrderExpr("COALESCE(account_payable.recurrence_parent_id, account_payable.id), account_payable.due_date DESC")
+
+	if err := tx.NewSelect().Model(&payables).
+		Where("account_payable.id IN (?)", latest).
+		Where("account_payable.recurrence <> ''").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return payables, nil
+}
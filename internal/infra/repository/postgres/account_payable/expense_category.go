@@ -0,0 +1,113 @@
+package accountpayablerepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type ExpenseCategoryRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewExpenseCategoryRepositoryBun(db *bun.DB) model.ExpenseCategoryRepository {
+	return &ExpenseCategoryRepositoryBun{db: db}
+}
+
+func (r *ExpenseCategoryRepositoryBun) CreateExpenseCategory(ctx context.Context, c *model.ExpenseCategory) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ExpenseCategoryRepositoryBun) UpdateExpenseCategory(ctx context.Context, c *model.ExpenseCategory) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(c).Where("expense_category.id = ?", c.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ExpenseCategoryRepositoryBun) DeleteExpenseCategory(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// deleted_at é soft delete: contas antigas mantêm a categoria na DRE
+	if _, err := tx.NewDelete().Model(&model.ExpenseCategory{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ExpenseCategoryRepositoryBun) GetExpenseCategoryById(ctx context.Context, id string) (*model.ExpenseCategory, error) {
+	category := &model.ExpenseCategory{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(category).Where("expense_category.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+func (r *ExpenseCategoryRepositoryBun) GetAllExpenseCategories(ctx context.Context, onlyActive bool) ([]model.ExpenseCategory, error) {
+	categories := make([]model.ExpenseCategory, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().Model(&categories).Order("expense_category.name ASC")
+	if onlyActive {
+		query = query.Where("expense_category.is_active = true")
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
@@ -0,0 +1,167 @@
+package accountreceivablerepositorybun
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type AccountReceivableRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewAccountReceivableRepositoryBun(db *bun.DB) model.AccountReceivableRepository {
+	return &AccountReceivableRepositoryBun{db: db}
+}
+
+func (r *AccountReceivableRepositoryBun) CreateAccountReceivable(ctx context.Context, db bun.IDB, a *model.AccountReceivable) error {
+	if _, err := db.NewInsert().Model(a).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *AccountReceivableRepositoryBun) UpdateAccountReceivable(ctx context.Context, a *model.AccountReceivable) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(a).Where("account_receivable.id = ?", a.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AccountReceivableRepositoryBun) GetAccountReceivableById(ctx context.Context, id string) (*model.AccountReceivable, error) {
+	receivable := &model.AccountReceivable{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(receivable).Where("account_receivable.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return receivable, nil
+}
+
+func (r *AccountReceivableRepositoryBun) GetAllAccountReceivables(ctx context.Context, page, perPage int, status string, expectedFrom, expectedTo *time.Time) ([]model.AccountReceivable, int, error) {
+	receivables := make([]model.AccountReceivable, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&receivables).
+		Order("account_receivable.expected_date ASC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("account_receivable.status = ?", status)
+	}
+
+	if expectedFrom != nil {
+		query = query.Where("account_receivable.expected_date >= ?", *expectedFrom)
+	}
+
+	if expectedTo != nil {
+		query = query.Where("account_receivable.expected_date < ?", *expectedTo)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return receivables, count, nil
+}
+
+func (r *AccountReceivableRepositoryBun) GetOpenCardSettlements(ctx context.Context, from, to time.Time) ([]model.AccountReceivable, error) {
+	receivables := make([]model.AccountReceivable, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := openCardSettlementsQuery(tx, &receivables, from, to).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return receivables, nil
+}
+
+func (r *AccountReceivableRepositoryBun) GetOpenCardSettlementsForUpdate(ctx context.Context, db bun.IDB, from, to time.Time) ([]model.AccountReceivable, error) {
+	receivables := make([]model.AccountReceivable, 0)
+
+	// outra importação espera o commit e relê o status, pulando os repasses já baixados
+	if err := openCardSettlementsQuery(db, &receivables, from, to).For("UPDATE").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return receivables, nil
+}
+
+func openCardSettlementsQuery(db bun.IDB, receivables *[]model.AccountReceivable, from, to time.Time) *bun.SelectQuery {
+	return db.NewSelect().
+		Model(receivables).
+		Where("account_receivable.source = ?", "card_settlement").
+		Where("account_receivable.status = ?", "open").
+		Where("account_receivable.expected_date BETWEEN ? AND ?", from, to).
+		Order("account_receivable.expected_date ASC")
+}
+
+func (r *AccountReceivableRepositoryBun) GetOpenCardSettlementsByOrder(ctx context.Context, db bun.IDB, orderID string) ([]model.AccountReceivable, error) {
+	receivables := make([]model.AccountReceivable, 0)
+
+	if err := db.NewSelect().
+		Model(&receivables).
+		Where("account_receivable.source = ?", "card_settlement").
+		Where("account_receivable.status = ?", "open").
+		Where("account_receivable.order_id = ?", orderID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return receivables, nil
+}
+
+func (r *AccountReceivableRepositoryBun) UpdateAccountReceivables(ctx context.Context, db bun.IDB, receivables []model.AccountReceivable) error {
+	for i := range receivables {
+		if _, err := db.NewUpdate().Model(&receivables[i]).Where("account_receivable.id = ?", receivables[i].ID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package accountreceivablerepositorybun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type PayMethodFeeRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewPayMethodFeeRepositoryBun(db *bun.DB) model.PayMethodFeeRepository {
+	return &PayMethodFeeRepositoryBun{db: db}
+}
+
+func (r *PayMethodFeeRepositoryBun) CreatePayMethodFee(ctx context.Context, f *model.PayMethodFee) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(f).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PayMethodFeeRepositoryBun) UpdatePayMethodFee(ctx context.Context, f *model.PayMethodFee) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(f).Where("pay_method_fee.id = ?", f.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PayMethodFeeRepositoryBun) DeletePayMethodFee(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewDelete().Model(&model.PayMethodFee{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PayMethodFeeRepositoryBun) GetPayMethodFeeById(ctx context.Context, id string) (*model.PayMethodFee, error) {
+	fee := &model.PayMethodFee{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(fee).Where("pay_method_fee.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+func (r *PayMethodFeeRepositoryBun) GetPayMethodFeeByMethod(ctx context.Context, method string, onlyActive bool) (*model.PayMethodFee, error) {
+	fee := &model.PayMethodFee{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().Model(fee).Where("pay_method_fee.method = ?", method)
+	if onlyActive {
+		query = query.Where("pay_method_fee.is_active = ?", true)
+	}
+
+	if err := query.Limit(1).Scan(ctx); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return fee, nil
+}
+
+func (r *PayMethodFeeRepositoryBun) GetAllPayMethodFees(ctx context.Context) ([]model.PayMethodFee, error) {
+	fees := make([]model.PayMethodFee, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(&fees).Order("pay_method_fee.method ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return fees, nil
+}
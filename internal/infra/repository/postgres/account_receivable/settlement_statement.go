@@ -0,0 +1,92 @@
+package accountreceivablerepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type SettlementStatementRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewSettlementStatementRepositoryBun(db *bun.DB) model.SettlementStatementRepository {
+	return &SettlementStatementRepositoryBun{db: db}
+}
+
+func (r *SettlementStatementRepositoryBun) CreateSettlementStatement(ctx context.Context, db bun.IDB, s *model.SettlementStatement) error {
+	if _, err := db.NewInsert().Model(s).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(s.Lines) > 0 {
+		if _, err := db.NewInsert().Model(&s.Lines).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SettlementStatementRepositoryBun) ExistsSettlementStatementByFingerprint(ctx context.Context, db bun.IDB, fingerprint string) (bool, error) {
+	return db.NewSelect().
+		Model((*model.SettlementStatement)(nil)).
+		Where("settlement_statement.fingerprint = ?", fingerprint).
+		Exists(ctx)
+}
+
+func (r *SettlementStatementRepositoryBun) GetSettlementStatementById(ctx context.Context, id string) (*model.SettlementStatement, error) {
+	statement := &model.SettlementStatement{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(statement).
+		Where("settlement_statement.id = ?", id).
+		Relation("Lines", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("settlement_statement_line.date ASC")
+		}).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return statement, nil
+}
+
+func (r *SettlementStatementRepositoryBun) GetAllSettlementStatements(ctx context.Context, page, perPage int) ([]model.SettlementStatement, int, error) {
+	statements := make([]model.SettlementStatement, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	count, err := tx.NewSelect().
+		Model(&statements).
+		Relation("Lines").
+		Order("settlement_statement.created_at DESC").
+		Limit(perPage).
+		Offset(page * perPage).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return statements, count, nil
+}
@@ -0,0 +1,117 @@
+package comborepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type ComboRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewComboRepositoryBun(db *bun.DB) model.ComboRepository {
+	return &ComboRepositoryBun{db: db}
+}
+
+func (r *ComboRepositoryBun) CreateCombo(ctx context.Context, c *model.Combo) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ComboRepositoryBun) UpdateCombo(ctx context.Context, c *model.Combo) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(c).Where("combo.id = ?", c.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ComboRepositoryBun) DeleteCombo(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// Soft delete: itens vendidos continuam apontando para o combo nos relatórios
+	if _, err := tx.NewUpdate().
+		Model(&model.Combo{}).
+		Set("is_active = ?", false).
+		Where("id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ComboRepositoryBun) GetComboById(ctx context.Context, id string) (*model.Combo, error) {
+	combo := &model.Combo{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(combo).Where("combo.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return combo, nil
+}
+
+func (r *ComboRepositoryBun) GetAllCombos(ctx context.Context, onlyActive bool) ([]model.Combo, error) {
+	combos := make([]model.Combo, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().Model(&combos)
+	if onlyActive {
+		query.Where("combo.is_active = ?", true)
+	}
+
+	if err := query.Order("combo.name ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return combos, nil
+}
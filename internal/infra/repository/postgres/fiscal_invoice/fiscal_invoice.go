@@ -2,6 +2,7 @@ package fiscalinvoicerepository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
@@ -154,6 +155,34 @@ func (r *FiscalInvoiceRepository) List(ctx context.Context, companyID uuid.UUID,
 	return invoices, total, nil
 }
 
+// ListByPeriod returns every invoice created in [start, end), ordered by series and number
+func (r *FiscalInvoiceRepository) ListByPeriod(ctx context.Context, companyID uuid.UUID, start, end time.Time) ([]*model.FiscalInvoice, error) {
+	ctx, tx, cancel, err := database.GetPublicTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	invoices := []*model.FiscalInvoice{}
+	if err := tx.NewSelect().
+		Model(&invoices).
+		Where("company_id = ?", companyID).
+		Where("created_at >= ?", start).
+		Where("created_at < ?", end).
+		Where("deleted_at IS NULL").
+		Order("series ASC", "number ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
 func (r *FiscalInvoiceRepository) GetNextNumber(ctx context.Context, companyID uuid.UUID, series int) (int, error) {
 	var maxNumber int
 	ctx, tx, cancel, err := database.GetPublicTenantTransaction(ctx, r.db)
@@ -0,0 +1,112 @@
+package ibptrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// upsertChunkSize keeps each INSERT below the postgres parameter limit (a UF table has ~13k lines)
+const upsertChunkSize = 500
+
+type IbptTaxRateRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewIbptTaxRateRepositoryBun(db *bun.DB) model.IbptTaxRateRepository {
+	return &IbptTaxRateRepositoryBun{db: db}
+}
+
+func (r *IbptTaxRateRepositoryBun) UpsertMany(ctx context.Context, rates []model.IbptTaxRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	ctx, tx, cancel, err := database.GetPublicTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	for start := 0; start < len(rates); start += upsertChunkSize {
+		end := min(start+upsertChunkSize, len(rates))
+		chunk := rates[start:end]
+
+		if _, err := tx.NewInsert().Model(&chunk).
+			On("CONFLICT (ncm, exception, uf) DO UPDATE").
+			Set("description = EXCLUDED.description").
+			Set("federal_national = EXCLUDED.federal_national").
+			Set("federal_imported = EXCLUDED.federal_imported").
+			Set("state = EXCLUDED.state").
+			Set("municipal = EXCLUDED.municipal").
+			Set("valid_from = EXCLUDED.valid_from").
+			Set("valid_until = EXCLUDED.valid_until").
+			Set("version = EXCLUDED.version").
+			Set("key = EXCLUDED.key").
+			Set("updated_at = EXCLUDED.updated_at").
+			Set("deleted_at = NULL").
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *IbptTaxRateRepositoryBun) GetByNCMs(ctx context.Context, uf string, ncms []string) ([]model.IbptTaxRate, error) {
+	rates := []model.IbptTaxRate{}
+	if len(ncms) == 0 {
+		return rates, nil
+	}
+
+	ctx, tx, cancel, err := database.GetPublicTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(&rates).
+		Where("uf = ?", uf).
+		Where("ncm IN (?)", bun.In(ncms)).
+		Order("exception ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (r *IbptTaxRateRepositoryBun) CountByUF(ctx context.Context, uf string) (int, error) {
+	ctx, tx, cancel, err := database.GetPublicTenantTransaction(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	count, err := tx.NewSelect().Model((*model.IbptTaxRate)(nil)).Where("uf = ?", uf).Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,145 @@
+package inventorycountrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type InventoryCountRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewInventoryCountRepositoryBun(db *bun.DB) model.InventoryCountRepository {
+	return &InventoryCountRepositoryBun{db: db}
+}
+
+func (r *InventoryCountRepositoryBun) CreateInventoryCount(ctx context.Context, c *model.InventoryCount) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(c).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(c.Items) > 0 {
+		if _, err := tx.NewInsert().Model(&c.Items).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UpdateInventoryCount grava apenas o cabeçalho; os itens são atualizados por UpdateInventoryCountItems
+func (r *InventoryCountRepositoryBun) UpdateInventoryCount(ctx context.Context, db bun.IDB, c *model.InventoryCount) error {
+	_, err := db.NewUpdate().Model(c).Where("inventory_count.id = ?", c.ID).Exec(ctx)
+	return err
+}
+
+func (r *InventoryCountRepositoryBun) UpdateInventoryCountItems(ctx context.Context, db bun.IDB, items []model.InventoryCountItem) error {
+	for i := range items {
+		if _, err := db.NewUpdate().Model(&items[i]).
+			Column("counted_quantity", "counted_by", "counted_at").
+			Where("inventory_count_item.id = ?", items[i].ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *InventoryCountRepositoryBun) CreateInventoryCountEntries(ctx context.Context, db bun.IDB, entries []model.InventoryCountEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	_, err := db.NewInsert().Model(&entries).Exec(ctx)
+	return err
+}
+
+func (r *InventoryCountRepositoryBun) GetInventoryCountById(ctx context.Context, id string) (*model.InventoryCount, error) {
+	inventoryCount := &model.InventoryCount{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(inventoryCount).
+		Where("inventory_count.id = ?", id).
+		Relation("Items", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Order("inventory_count_item.description ASC")
+		}).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return inventoryCount, nil
+}
+
+func (r *InventoryCountRepositoryBun) GetInventoryCountByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*model.InventoryCount, error) {
+	inventoryCount := &model.InventoryCount{}
+
+	if err := db.NewSelect().Model(inventoryCount).
+		Where("inventory_count.id = ?", id).
+		For("UPDATE").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := db.NewSelect().Model(&inventoryCount.Items).
+		Where("inventory_count_item.inventory_count_id = ?", id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return inventoryCount, nil
+}
+
+func (r *InventoryCountRepositoryBun) GetAllInventoryCounts(ctx context.Context, page, perPage int, status string) ([]model.InventoryCount, int, error) {
+	inventoryCounts := make([]model.InventoryCount, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&inventoryCounts).
+		Relation("Items").
+		Order("inventory_count.created_at DESC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("inventory_count.status = ?", status)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return inventoryCounts, count, nil
+}
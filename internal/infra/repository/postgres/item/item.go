@@ -219,3 +219,24 @@ func (r *ItemRepositoryBun) GetAllItems(ctx context.Context) ([]model.Item, erro
 	}
 	return items, nil
 }
+
+func (r *ItemRepositoryBun) GetItemsByComboSaleID(ctx context.Context, comboSaleID string) ([]model.Item, error) {
+	items := []model.Item{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(&items).Where("item.combo_sale_id = ?", comboSaleID).Relation("AdditionalItems").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
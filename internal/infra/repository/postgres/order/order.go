@@ -135,7 +135,7 @@ func (r *OrderRepositoryBun) UpdateOrder(ctx context.Context, order *model.Order
 	defer cancel()
 	defer tx.Rollback()
 
-	if _, err := tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+	if err := r.UpdateOrderWithTx(ctx, tx, order); err != nil {
 		return err
 	}
 
@@ -145,6 +145,13 @@ func (r *OrderRepositoryBun) UpdateOrder(ctx context.Context, order *model.Order
 	return nil
 }
 
+func (r *OrderRepositoryBun) UpdateOrderWithTx(ctx context.Context, tx *bun.Tx, order *model.Order) error {
+	if _, err := tx.NewUpdate().Model(order).Where("id = ?", order.ID).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *OrderRepositoryBun) UpdateOrderWithRelations(ctx context.Context, p *model.Order) error {
 	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
 	if err != nil {
@@ -782,7 +789,7 @@ func (r *OrderRepositoryBun) AddPaymentOrder(ctx context.Context, payment *model
 	defer cancel()
 	defer tx.Rollback()
 
-	if _, err := tx.NewInsert().Model(payment).Exec(ctx); err != nil {
+	if err := r.AddPaymentOrderWithTx(ctx, tx, payment); err != nil {
 		return err
 	}
 
@@ -792,6 +799,13 @@ func (r *OrderRepositoryBun) AddPaymentOrder(ctx context.Context, payment *model
 	return nil
 }
 
+func (r *OrderRepositoryBun) AddPaymentOrderWithTx(ctx context.Context, tx *bun.Tx, payment *model.PaymentOrder) error {
+	if _, err := tx.NewInsert().Model(payment).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *OrderRepositoryBun) GetStaleStagingOrders(ctx context.Context, minutes int) ([]model.Order, error) {
 	orders := []model.Order{}
 
@@ -0,0 +1,117 @@
+package pricelistrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type PriceListRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewPriceListRepositoryBun(db *bun.DB) model.PriceListRepository {
+	return &PriceListRepositoryBun{db: db}
+}
+
+func (r *PriceListRepositoryBun) CreatePriceList(ctx context.Context, p *model.PriceList) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(p).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PriceListRepositoryBun) UpdatePriceList(ctx context.Context, p *model.PriceList) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(p).Where("price_list.id = ?", p.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PriceListRepositoryBun) DeletePriceList(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// Soft delete: itens vendidos continuam apontando para a lista nos relatórios
+	if _, err := tx.NewUpdate().
+		Model(&model.PriceList{}).
+		Set("is_active = ?", false).
+		Where("id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PriceListRepositoryBun) GetPriceListById(ctx context.Context, id string) (*model.PriceList, error) {
+	priceList := &model.PriceList{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(priceList).Where("price_list.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return priceList, nil
+}
+
+func (r *PriceListRepositoryBun) GetAllPriceLists(ctx context.Context, onlyActive bool) ([]model.PriceList, error) {
+	priceLists := make([]model.PriceList, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().Model(&priceLists)
+	if onlyActive {
+		query.Where("price_list.is_active = ?", true)
+	}
+
+	if err := query.OrderExpr("price_list.priority DESC, price_list.name ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return priceLists, nil
+}
@@ -119,7 +119,7 @@ func (r *ProductRepositoryBun) UpdateProduct(ctx context.Context, p *model.Produ
 
 	for _, v := range toUpdate {
 		if _, err := tx.NewUpdate().Model(v).
-			Column("price", "is_available", "deleted_at").
+			Column("price", "is_available", "auto_unavailable", "deleted_at").
 			WherePK().
 			Exec(ctx); err != nil {
 			return err
@@ -315,3 +315,26 @@ func (r *ProductRepositoryBun) GetAllProductsMap(ctx context.Context, isActive b
 	}
 	return products, nil
 }
+
+func (r *ProductRepositoryBun) GetVariationsForUpdate(ctx context.Context, db bun.IDB, productID uuid.UUID, variationID *uuid.UUID) ([]model.ProductVariation, error) {
+	variations := []model.ProductVariation{}
+
+	query := db.NewSelect().Model(&variations).Where("product_variation.product_id = ?", productID)
+	if variationID != nil {
+		query = query.Where("product_variation.id = ?", *variationID)
+	}
+
+	if err := query.For("UPDATE").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return variations, nil
+}
+
+func (r *ProductRepositoryBun) UpdateVariationAvailability(ctx context.Context, db bun.IDB, v *model.ProductVariation) error {
+	_, err := db.NewUpdate().Model(v).
+		Column("is_available", "auto_unavailable").
+		WherePK().
+		Exec(ctx)
+	return err
+}
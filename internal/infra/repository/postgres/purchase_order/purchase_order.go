@@ -0,0 +1,172 @@
+package purchaseorderrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type PurchaseOrderRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewPurchaseOrderRepositoryBun(db *bun.DB) model.PurchaseOrderRepository {
+	return &PurchaseOrderRepositoryBun{db: db}
+}
+
+func (r *PurchaseOrderRepositoryBun) CreatePurchaseOrder(ctx context.Context, p *model.PurchaseOrder) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(p).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(p.Items) > 0 {
+		if _, err := tx.NewInsert().Model(&p.Items).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UpdatePurchaseOrder regrava o cabeçalho e substitui os itens do pedido
+func (r *PurchaseOrderRepositoryBun) UpdatePurchaseOrder(ctx context.Context, db bun.IDB, p *model.PurchaseOrder) error {
+	if _, err := db.NewUpdate().Model(p).Where("purchase_order.id = ?", p.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.NewDelete().Model(&model.PurchaseOrderItem{}).Where("purchase_order_id = ?", p.ID).ForceDelete().Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(p.Items) > 0 {
+		if _, err := db.NewInsert().Model(&p.Items).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PurchaseOrderRepositoryBun) GetPurchaseOrderById(ctx context.Context, id string) (*model.PurchaseOrder, error) {
+	purchaseOrder := &model.PurchaseOrder{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(purchaseOrder).
+		Where("purchase_order.id = ?", id).
+		Relation("Supplier").
+		Relation("Items").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return purchaseOrder, nil
+}
+
+func (r *PurchaseOrderRepositoryBun) GetPurchaseOrderByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*model.PurchaseOrder, error) {
+	purchaseOrder := &model.PurchaseOrder{}
+
+	if err := db.NewSelect().Model(purchaseOrder).
+		Where("purchase_order.id = ?", id).
+		For("UPDATE").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := db.NewSelect().Model(&purchaseOrder.Items).
+		Where("purchase_order_item.purchase_order_id = ?", id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return purchaseOrder, nil
+}
+
+func (r *PurchaseOrderRepositoryBun) GetAllPurchaseOrders(ctx context.Context, page, perPage int, status string) ([]model.PurchaseOrder, int, error) {
+	purchaseOrders := make([]model.PurchaseOrder, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&purchaseOrders).
+		Relation("Supplier").
+		Relation("Items").
+		Order("purchase_order.created_at DESC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("purchase_order.status = ?", status)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return purchaseOrders, count, nil
+}
+
+func (r *PurchaseOrderRepositoryBun) GetPreferredSuppliersByStock(ctx context.Context) ([]model.StockSupplier, error) {
+	suppliers := []model.StockSupplier{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := `
+		SELECT DISTINCT ON (source.stock_id) source.stock_id, source.supplier_id, source.unit_cost
+		FROM (
+			SELECT item.stock_id, purchase_order.supplier_id, item.unit_cost, 1 AS priority, purchase_order.created_at
+			FROM purchase_order_items AS item
+			JOIN purchase_orders AS purchase_order ON purchase_order.id = item.purchase_order_id
+			WHERE purchase_order.deleted_at IS NULL AND item.deleted_at IS NULL AND purchase_order.status <> ?
+			UNION ALL
+			SELECT mapping.stock_id, mapping.supplier_id, 0 AS unit_cost, 2 AS priority, mapping.created_at
+			FROM supplier_product_mappings AS mapping
+			WHERE mapping.deleted_at IS NULL
+		) AS source
+		ORDER BY source.stock_id, source.priority, source.created_at DESC`
+
+	if err := tx.NewRaw(query, string(purchaseorderentity.StatusCancelled)).Scan(ctx, &suppliers); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return suppliers, nil
+}
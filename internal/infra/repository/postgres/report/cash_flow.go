@@ -0,0 +1,105 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// cashFlowRevenueWeeks is how many past weeks feed the expected order revenue per weekday.
+const cashFlowRevenueWeeks = 8
+
+// CashFlowDayDTO holds the scheduled inflows and outflows of one day in the company time zone.
+type CashFlowDayDTO struct {
+	Day         time.Time       `bun:"day"`
+	Payables    decimal.Decimal `bun:"payables"`
+	Payroll     decimal.Decimal `bun:"payroll"`
+	Receivables decimal.Decimal `bun:"receivables"`
+}
+
+// CashFlowWeekdayDTO holds the average order revenue of one weekday (0 = Sunday).
+type CashFlowWeekdayDTO struct {
+	Weekday int             `bun:"weekday"`
+	Revenue decimal.Decimal `bun:"revenue"`
+}
+
+// CashFlowOverdueDTO holds the open bills and receivables already past their date at start.
+type CashFlowOverdueDTO struct {
+	Payables    decimal.Decimal `bun:"payables"`
+	Receivables decimal.Decimal `bun:"receivables"`
+}
+
+// CashFlowProjectionDTO holds the raw figures of the cash-flow projection.
+type CashFlowProjectionDTO struct {
+	TimeZone         string
+	Days             []CashFlowDayDTO
+	RevenueByWeekday []CashFlowWeekdayDTO
+	Overdue          CashFlowOverdueDTO
+}
+
+// CashFlowProjection gathers open account payables by due date, pending employee payments by payment date
+// and open account receivables by expected date for the days in [start, end), plus the average order revenue
+// per weekday over the weeks before now, used to estimate sales on the days still ahead.
+func (s *ReportService) CashFlowProjection(ctx context.Context, start, end, now time.Time) (*CashFlowProjectionDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tz := s.companyTimeZone(ctx, schemaName)
+	start, _ = localDay(start, tz)
+	end, _ = localDay(end, tz)
+	today, _ := localDay(now, tz)
+
+	resp := &CashFlowProjectionDTO{TimeZone: tz}
+
+	daysQuery := `
+        WITH flows AS (
+            SELECT (a.due_date AT TIME ZONE ?)::date AS day, a.amount AS payables, 0 AS payroll, 0 AS receivables
+            FROM ` + schemaName + `.account_payables a
+            WHERE a.status = 'open' AND a.deleted_at IS NULL AND a.due_date >= ? AND a.due_date < ?
+            UNION ALL
+            SELECT (emp.payment_date AT TIME ZONE ?)::date, 0, emp.amount, 0
+            FROM ` + schemaName + `.employee_payments emp
+            WHERE emp.status = 'Pending' AND emp.deleted_at IS NULL AND emp.payment_date >= ? AND emp.payment_date < ?
+            UNION ALL
+            SELECT (r.expected_date AT TIME ZONE ?)::date, 0, 0, r.amount
+            FROM ` + schemaName + `.account_receivables r
+            WHERE r.status = 'open' AND r.deleted_at IS NULL AND r.expected_date >= ? AND r.expected_date < ?
+        )
+        SELECT day, SUM(payables) AS payables, SUM(payroll) AS payroll, SUM(receivables) AS receivables
+        FROM flows
+        GROUP BY day
+        ORDER BY day`
+	args := []interface{}{tz, start, end, tz, start, end, tz, start, end}
+	if err := s.db.NewRaw(daysQuery, args...).Scan(ctx, &resp.Days); err != nil {
+		return nil, err
+	}
+
+	revenueQuery := `
+        SELECT EXTRACT(DOW FROM o.finished_at AT TIME ZONE ?)::int AS weekday, ROUND(SUM(o.total) / ?, 2) AS revenue
+        FROM ` + schemaName + `.orders o
+        WHERE o.status IN ('Finished', 'Archived') AND o.finished_at >= ? AND o.finished_at < ?
+        GROUP BY weekday
+        ORDER BY weekday`
+	since := today.AddDate(0, 0, -7*cashFlowRevenueWeeks)
+	if err := s.db.NewRaw(revenueQuery, tz, cashFlowRevenueWeeks, since, today).Scan(ctx, &resp.RevenueByWeekday); err != nil {
+		return nil, err
+	}
+
+	overdueQuery := `
+        SELECT
+            (SELECT COALESCE(SUM(a.amount), 0)
+                FROM ` + schemaName + `.account_payables a
+                WHERE a.status = 'open' AND a.deleted_at IS NULL AND a.due_date < ?) AS payables,
+            (SELECT COALESCE(SUM(r.amount), 0)
+                FROM ` + schemaName + `.account_receivables r
+                WHERE r.status = 'open' AND r.deleted_at IS NULL AND r.expected_date < ?) AS receivables`
+	if err := s.db.NewRaw(overdueQuery, start, start).Scan(ctx, &resp.Overdue); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
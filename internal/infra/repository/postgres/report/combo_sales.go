@@ -0,0 +1,87 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// ComboSalesDTO holds how many units of one combo were sold and their revenue.
+type ComboSalesDTO struct {
+	ComboID  string          `bun:"combo_id"`
+	Name     string          `bun:"name"`
+	Quantity decimal.Decimal `bun:"quantity"`
+	Revenue  decimal.Decimal `bun:"revenue"`
+}
+
+// ComboComponentSalesDTO holds quantity and allocated revenue of one product sold inside a combo.
+type ComboComponentSalesDTO struct {
+	ComboID   string          `bun:"combo_id"`
+	ProductID string          `bun:"product_id"`
+	Name      string          `bun:"name"`
+	Quantity  decimal.Decimal `bun:"quantity"`
+	Revenue   decimal.Decimal `bun:"revenue"`
+}
+
+// comboItemsQuery selects the combo component items of the orders finished in the period.
+func comboItemsQuery(schemaName string) string {
+	return `
+            SELECT i.*
+            FROM ` + schemaName + `.order_items i
+            JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+            JOIN ` + schemaName + `.orders o ON o.id = g.order_id
+            WHERE i.combo_id IS NOT NULL AND i.deleted_at IS NULL AND g.status <> 'Cancelled'
+                AND o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?`
+}
+
+// ComboSales sums the combos sold in the period. Every component of a sale carries the combo quantity,
+// so each sale (combo_sale_id) counts once; revenue is the sum of the prices allocated to the components.
+func (s *ReportService) ComboSales(ctx context.Context, start, end time.Time) ([]ComboSalesDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []ComboSalesDTO
+	query := `
+        WITH combo_items AS (` + comboItemsQuery(schemaName) + `
+        ), sales AS (
+            SELECT ci.combo_id, ci.combo_sale_id, MAX(ci.quantity) AS quantity, SUM(ci.sub_total * ci.quantity) AS revenue
+            FROM combo_items ci
+            GROUP BY ci.combo_id, ci.combo_sale_id
+        )
+        SELECT c.id::text AS combo_id, c.name, SUM(sa.quantity) AS quantity, ROUND(SUM(sa.revenue), 2) AS revenue
+        FROM sales sa
+        JOIN ` + schemaName + `.combos c ON c.id = sa.combo_id
+        GROUP BY c.id, c.name
+        ORDER BY revenue DESC`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ComboComponentSales breaks the combos sold in the period down by component product.
+func (s *ReportService) ComboComponentSales(ctx context.Context, start, end time.Time) ([]ComboComponentSalesDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []ComboComponentSalesDTO
+	query := `
+        WITH combo_items AS (` + comboItemsQuery(schemaName) + `
+        )
+        SELECT ci.combo_id::text AS combo_id, p.id::text AS product_id, p.name,
+            SUM(ci.quantity) AS quantity, ROUND(SUM(ci.sub_total * ci.quantity), 2) AS revenue
+        FROM combo_items ci
+        JOIN ` + schemaName + `.products p ON p.id = ci.product_id
+        GROUP BY ci.combo_id, p.id, p.name
+        ORDER BY revenue DESC`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// StatisticsCompanyDTO holds a company the user belongs to and may see statistics of.
+type StatisticsCompanyDTO struct {
+	CompanyID  string `bun:"company_id"`
+	SchemaName string `bun:"schema_name"`
+	TradeName  string `bun:"trade_name"`
+}
+
+// StoreSalesSummaryDTO holds the finished orders and revenue of one store in the period.
+type StoreSalesSummaryDTO struct {
+	Orders  int             `bun:"orders"`
+	Revenue decimal.Decimal `bun:"revenue"`
+}
+
+// ProductSalesDTO holds units and revenue of one product name; stores do not share product ids, so
+// consolidated rankings merge them by name.
+type ProductSalesDTO struct {
+	Name     string          `bun:"name"`
+	Quantity decimal.Decimal `bun:"quantity"`
+	Revenue  decimal.Decimal `bun:"revenue"`
+}
+
+// StatisticsCompanies lists the companies of the user (public.company_to_users) where the user is an active
+// employee with the statistics permission. Every company is a schema, so the permission is read one by one.
+func (s *ReportService) StatisticsCompanies(ctx context.Context, userID string) ([]StatisticsCompanyDTO, error) {
+	var companies []StatisticsCompanyDTO
+	query := `
+        SELECT c.id::text AS company_id, c.schema_name, c.trade_name
+        FROM public.company_to_users cu
+        JOIN public.companies c ON c.id = cu.company_id
+        WHERE cu.user_id = ? AND c.deleted_at IS NULL
+        ORDER BY c.trade_name`
+	if err := s.db.NewRaw(query, userID).Scan(ctx, &companies); err != nil {
+		return nil, err
+	}
+
+	allowed := []StatisticsCompanyDTO{}
+	for _, company := range companies {
+		var hasPermission bool
+		permissionQuery := `
+            SELECT EXISTS (
+                SELECT 1 FROM ` + company.SchemaName + `.employees e
+                WHERE e.user_id = ? AND e.deleted_at IS NULL AND e.is_active IS NOT FALSE
+                    AND COALESCE((e.permissions->>'statistics')::boolean, false)
+            )`
+		if err := s.db.NewRaw(permissionQuery, userID).Scan(ctx, &hasPermission); err != nil {
+			return nil, err
+		}
+		if hasPermission {
+			allowed = append(allowed, company)
+		}
+	}
+
+	return allowed, nil
+}
+
+// StoreSalesSummary counts the orders finished in the period and sums their totals.
+func (s *ReportService) StoreSalesSummary(ctx context.Context, start, end time.Time) (*StoreSalesSummaryDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &StoreSalesSummaryDTO{}
+	query := `
+        SELECT COUNT(*) AS orders, COALESCE(ROUND(SUM(o.total), 2), 0) AS revenue
+        FROM ` + schemaName + `.orders o
+        WHERE o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ProductSales sums units and item revenue per product name of the orders finished in the period.
+func (s *ReportService) ProductSales(ctx context.Context, start, end time.Time) ([]ProductSalesDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []ProductSalesDTO
+	query := `
+        SELECT p.name, SUM(i.quantity) AS quantity, ROUND(SUM(i.sub_total * i.quantity), 2) AS revenue
+        FROM ` + schemaName + `.order_items i
+        JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+        JOIN ` + schemaName + `.orders o ON o.id = g.order_id
+        JOIN ` + schemaName + `.products p ON p.id = i.product_id
+        WHERE o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?
+            AND g.status <> 'Cancelled' AND i.deleted_at IS NULL
+        GROUP BY p.name
+        ORDER BY quantity DESC, p.name`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
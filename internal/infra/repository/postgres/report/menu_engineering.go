@@ -0,0 +1,121 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// MenuEngineeringItemDTO holds units sold, revenue, menu price and unit cost of one product variation.
+// CostSource tells where the unit cost came from: "debited" (batches consumed by the period orders),
+// "on_hand" (average of the batches still in stock) or "none".
+type MenuEngineeringItemDTO struct {
+	ProductID   string          `bun:"product_id"`
+	VariationID string          `bun:"variation_id"`
+	Name        string          `bun:"name"`
+	Size        string          `bun:"size"`
+	Category    string          `bun:"category"`
+	Price       decimal.Decimal `bun:"price"`
+	Quantity    decimal.Decimal `bun:"quantity"`
+	Revenue     decimal.Decimal `bun:"revenue"`
+	UnitCost    decimal.Decimal `bun:"unit_cost"`
+	CostSource  string          `bun:"cost_source"`
+}
+
+// ProductRevenueDTO holds the revenue of one product, used by the ABC curve.
+type ProductRevenueDTO struct {
+	ProductID string          `bun:"product_id"`
+	Name      string          `bun:"name"`
+	Category  string          `bun:"category"`
+	Quantity  decimal.Decimal `bun:"quantity"`
+	Revenue   decimal.Decimal `bun:"revenue"`
+}
+
+// MenuEngineeringItems lists the variations sold in the period outside combos (the combo price is allocated,
+// not the menu price). Stocks without a variation cover every variation of the product.
+func (s *ReportService) MenuEngineeringItems(ctx context.Context, start, end time.Time) ([]MenuEngineeringItemDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []MenuEngineeringItemDTO
+	query := `
+        WITH sold_orders AS (
+            SELECT o.id
+            FROM ` + schemaName + `.orders o
+            WHERE o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?
+        ), sold AS (
+            SELECT i.product_id, i.product_variation_id, SUM(i.quantity) AS quantity, ROUND(SUM(i.sub_total * i.quantity), 2) AS revenue
+            FROM ` + schemaName + `.order_items i
+            JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+            WHERE g.order_id IN (SELECT id FROM sold_orders) AND g.status <> 'Cancelled'
+                AND i.deleted_at IS NULL AND i.combo_id IS NULL
+            GROUP BY i.product_id, i.product_variation_id
+        ), debited AS (
+            SELECT st.product_id, st.product_variation_id, SUM(m.quantity * m.price) / NULLIF(SUM(m.quantity), 0) AS unit_cost
+            FROM ` + schemaName + `.stock_movements m
+            JOIN ` + schemaName + `.stocks st ON st.id = m.stock_id
+            WHERE m.type = 'out' AND m.batch_id IS NOT NULL AND m.deleted_at IS NULL AND m.order_id IN (SELECT id FROM sold_orders)
+            GROUP BY st.product_id, st.product_variation_id
+        ), on_hand AS (
+            SELECT st.product_id, st.product_variation_id, SUM(b.current_quantity * b.cost_price) / NULLIF(SUM(b.current_quantity), 0) AS unit_cost
+            FROM ` + schemaName + `.stock_batches b
+            JOIN ` + schemaName + `.stocks st ON st.id = b.stock_id
+            WHERE b.current_quantity > 0 AND b.deleted_at IS NULL
+            GROUP BY st.product_id, st.product_variation_id
+        ), costs AS (
+            SELECT sd.product_id, sd.product_variation_id,
+                COALESCE(dv.unit_cost, dp.unit_cost) AS debited_cost,
+                COALESCE(hv.unit_cost, hp.unit_cost) AS on_hand_cost
+            FROM sold sd
+            LEFT JOIN debited dv ON dv.product_id = sd.product_id AND dv.product_variation_id = sd.product_variation_id
+            LEFT JOIN debited dp ON dp.product_id = sd.product_id AND dp.product_variation_id IS NULL
+            LEFT JOIN on_hand hv ON hv.product_id = sd.product_id AND hv.product_variation_id = sd.product_variation_id
+            LEFT JOIN on_hand hp ON hp.product_id = sd.product_id AND hp.product_variation_id IS NULL
+        )
+        SELECT p.id::text AS product_id, sd.product_variation_id::text AS variation_id, p.name,
+            COALESCE(sz.name, '') AS size, COALESCE(c.name, '') AS category,
+            COALESCE(v.price, 0) AS price, sd.quantity, sd.revenue,
+            ROUND(COALESCE(co.debited_cost, co.on_hand_cost, 0), 2) AS unit_cost,
+            CASE WHEN co.debited_cost IS NOT NULL THEN 'debited' WHEN co.on_hand_cost IS NOT NULL THEN 'on_hand' ELSE 'none' END AS cost_source
+        FROM sold sd
+        JOIN costs co ON co.product_id = sd.product_id AND co.product_variation_id = sd.product_variation_id
+        JOIN ` + schemaName + `.products p ON p.id = sd.product_id
+        LEFT JOIN ` + schemaName + `.product_variations v ON v.id = sd.product_variation_id
+        LEFT JOIN ` + schemaName + `.sizes sz ON sz.id = v.size_id
+        LEFT JOIN ` + schemaName + `.product_categories c ON c.id = p.category_id
+        ORDER BY sd.quantity DESC, p.name`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RevenueByProduct sums the item subtotals per product of the orders finished in the period, combos included.
+func (s *ReportService) RevenueByProduct(ctx context.Context, start, end time.Time) ([]ProductRevenueDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []ProductRevenueDTO
+	query := `
+        SELECT p.id::text AS product_id, p.name, COALESCE(c.name, '') AS category,
+            SUM(i.quantity) AS quantity, ROUND(SUM(i.sub_total * i.quantity), 2) AS revenue
+        FROM ` + schemaName + `.order_items i
+        JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+        JOIN ` + schemaName + `.orders o ON o.id = g.order_id
+        JOIN ` + schemaName + `.products p ON p.id = i.product_id
+        LEFT JOIN ` + schemaName + `.product_categories c ON c.id = p.category_id
+        WHERE o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?
+            AND g.status <> 'Cancelled' AND i.deleted_at IS NULL
+        GROUP BY p.id, p.name, c.name
+        ORDER BY revenue DESC, p.name`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// PriceListSalesDTO holds what was sold under one price list (empty id = variation price).
+type PriceListSalesDTO struct {
+	PriceListID string          `bun:"price_list_id"`
+	Name        string          `bun:"name"`
+	Items       int             `bun:"items"`
+	Quantity    decimal.Decimal `bun:"quantity"`
+	Revenue     decimal.Decimal `bun:"revenue"`
+	ListRevenue decimal.Decimal `bun:"list_revenue"`
+}
+
+// PriceListSales groups the items of the orders finished in the period by the price list applied.
+// ListRevenue is what the same items would cost at the variation price stored when the list was applied.
+func (s *ReportService) PriceListSales(ctx context.Context, start, end time.Time) ([]PriceListSalesDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []PriceListSalesDTO
+	query := `
+        SELECT COALESCE(i.price_list_id::text, '') AS price_list_id,
+            COALESCE(pl.name, '') AS name,
+            COUNT(*) AS items,
+            SUM(i.quantity) AS quantity,
+            ROUND(SUM(i.sub_total * i.quantity), 2) AS revenue,
+            ROUND(SUM(COALESCE(i.list_price, i.sub_total) * i.quantity), 2) AS list_revenue
+        FROM ` + schemaName + `.order_items i
+        JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+        JOIN ` + schemaName + `.orders o ON o.id = g.order_id
+        LEFT JOIN ` + schemaName + `.price_lists pl ON pl.id = i.price_list_id
+        WHERE i.deleted_at IS NULL AND i.is_additional = false AND i.combo_id IS NULL AND g.status <> 'Cancelled'
+            AND o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?
+        GROUP BY i.price_list_id, pl.name
+        ORDER BY revenue DESC`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
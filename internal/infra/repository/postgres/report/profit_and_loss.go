@@ -0,0 +1,143 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// ProfitAndLossChannelDTO holds the orders finished in the month for one sales channel.
+// Gross is the order total before price list discounts.
+type ProfitAndLossChannelDTO struct {
+	Channel  string          `bun:"channel"`
+	Orders   int             `bun:"orders"`
+	Gross    decimal.Decimal `bun:"gross"`
+	Discount decimal.Decimal `bun:"discount"`
+}
+
+// ProfitAndLossExpensesDTO holds the deductions, costs and expenses of the month.
+type ProfitAndLossExpensesDTO struct {
+	Taxes             decimal.Decimal `bun:"taxes"`
+	Cogs              decimal.Decimal `bun:"cogs"`
+	Payroll           decimal.Decimal `bun:"payroll"`
+	DriverFees        decimal.Decimal `bun:"driver_fees"`
+	PlatformFees      decimal.Decimal `bun:"platform_fees"`
+	OperatingExpenses decimal.Decimal `bun:"operating_expenses"`
+}
+
+// ProfitAndLossExpenseCategoryDTO holds the operating expenses of one expense category.
+// CategoryID and Category are empty for account payables without a category.
+type ProfitAndLossExpenseCategoryDTO struct {
+	CategoryID string          `bun:"category_id"`
+	Category   string          `bun:"category"`
+	Amount     decimal.Decimal `bun:"amount"`
+}
+
+// ProfitAndLossDTO holds the raw figures of one month in the company time zone.
+type ProfitAndLossDTO struct {
+	Start             time.Time
+	End               time.Time
+	Channels          []ProfitAndLossChannelDTO
+	ExpenseCategories []ProfitAndLossExpenseCategoryDTO
+	ProfitAndLossExpensesDTO
+}
+
+// ProfitAndLoss gathers the DRE figures of a calendar month in the company time zone.
+// Revenue, discounts, COGS and driver fees follow the orders finished in the month; taxes come from
+// authorized invoices, payroll from employee payments, platform fees from usage costs and operating
+// expenses from account payables not tied to a purchase order, all by their own date.
+func (s *ReportService) ProfitAndLoss(ctx context.Context, year int, month time.Month) (*ProfitAndLossDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	start, _ := localDay(time.Date(year, month, 1, 0, 0, 0, 0, time.UTC), s.companyTimeZone(ctx, schemaName))
+	end := start.AddDate(0, 1, 0)
+
+	resp := &ProfitAndLossDTO{Start: start, End: end}
+
+	soldOrders := `
+        SELECT o.id, o.total
+        FROM ` + schemaName + `.orders o
+        WHERE o.status IN ('Finished', 'Archived') AND o.finished_at >= ? AND o.finished_at < ?`
+
+	channelsQuery := `
+        WITH sold_orders AS (` + soldOrders + `
+        ), discounts AS (
+            SELECT g.order_id, SUM((COALESCE(i.list_price, i.sub_total) - i.sub_total) * i.quantity) AS discount
+            FROM ` + schemaName + `.order_items i
+            JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+            WHERE g.order_id IN (SELECT id FROM sold_orders) AND g.status <> 'Cancelled' AND i.deleted_at IS NULL
+            GROUP BY g.order_id
+        )
+        SELECT
+            CASE
+                WHEN d.id IS NOT NULL THEN 'delivery'
+                WHEN t.id IS NOT NULL THEN 'table'
+                WHEN p.id IS NOT NULL THEN 'pickup'
+                ELSE 'unknown'
+            END AS channel,
+            COUNT(*) AS orders,
+            ROUND(SUM(so.total + COALESCE(ds.discount, 0)), 2) AS gross,
+            ROUND(SUM(COALESCE(ds.discount, 0)), 2) AS discount
+        FROM sold_orders so
+        LEFT JOIN discounts ds ON ds.order_id = so.id
+        LEFT JOIN ` + schemaName + `.order_deliveries d ON d.order_id = so.id
+        LEFT JOIN ` + schemaName + `.order_tables t ON t.order_id = so.id
+        LEFT JOIN ` + schemaName + `.order_pickups p ON p.order_id = so.id
+        GROUP BY channel
+        ORDER BY channel`
+	if err := s.db.NewRaw(channelsQuery, start, end).Scan(ctx, &resp.Channels); err != nil {
+		return nil, err
+	}
+
+	expensesQuery := `
+        WITH sold_orders AS (` + soldOrders + `
+        ), company AS (
+            SELECT id FROM public.companies WHERE schema_name = ?
+        )
+        SELECT
+            (SELECT COALESCE(SUM(f.tax_amount), 0)
+                FROM public.fiscal_invoices f
+                WHERE f.company_id IN (SELECT id FROM company) AND f.status = 'authorized' AND f.deleted_at IS NULL
+                    AND f.emitted_at >= ? AND f.emitted_at < ?) AS taxes,
+            (SELECT ROUND(COALESCE(SUM(m.quantity * m.price), 0), 2)
+                FROM ` + schemaName + `.stock_movements m
+                WHERE m.type = 'out' AND m.deleted_at IS NULL AND m.order_id IN (SELECT id FROM sold_orders)) AS cogs,
+            (SELECT COALESCE(SUM(emp.amount), 0)
+                FROM ` + schemaName + `.employee_payments emp
+                WHERE emp.status <> 'Cancelled' AND emp.deleted_at IS NULL
+                    AND emp.payment_date >= ? AND emp.payment_date < ?) AS payroll,
+            (SELECT COALESCE(SUM(d.delivery_tax), 0)
+                FROM ` + schemaName + `.order_deliveries d
+                WHERE d.driver_id IS NOT NULL AND d.order_id IN (SELECT id FROM sold_orders)) AS driver_fees,
+            (SELECT ROUND(COALESCE(SUM(u.amount), 0), 2)
+                FROM public.company_usage_costs u
+                WHERE u.company_id IN (SELECT id FROM company) AND u.status <> 'WAIVED' AND u.deleted_at IS NULL
+                    AND u.created_at >= ? AND u.created_at < ?) AS platform_fees,
+            (SELECT COALESCE(SUM(a.amount), 0)
+                FROM ` + schemaName + `.account_payables a
+                WHERE a.purchase_order_id IS NULL AND a.status <> 'cancelled' AND a.deleted_at IS NULL
+                    AND a.due_date >= ? AND a.due_date < ?) AS operating_expenses`
+	args := []interface{}{start, end, schemaName, start, end, start, end, start, end, start, end}
+	if err := s.db.NewRaw(expensesQuery, args...).Scan(ctx, &resp.ProfitAndLossExpensesDTO); err != nil {
+		return nil, err
+	}
+
+	categoriesQuery := `
+        SELECT COALESCE(c.id::text, '') AS category_id, COALESCE(c.name, '') AS category, SUM(a.amount) AS amount
+        FROM ` + schemaName + `.account_payables a
+        LEFT JOIN ` + schemaName + `.expense_categories c ON c.id = a.category_id
+        WHERE a.purchase_order_id IS NULL AND a.status <> 'cancelled' AND a.deleted_at IS NULL
+            AND a.due_date >= ? AND a.due_date < ?
+        GROUP BY c.id, c.name
+        ORDER BY c.name NULLS LAST`
+	if err := s.db.NewRaw(categoriesQuery, start, end).Scan(ctx, &resp.ExpenseCategories); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package report
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -34,12 +35,12 @@ func (s *ReportService) SalesTotalByDay(ctx context.Context, start, end time.Tim
 
 	var resp []SalesByDayDTO
 	query := `
-        SELECT TO_CHAR(created_at, 'DD/MM') AS day, SUM(total) AS total
+        SELECT TO_CHAR(created_at AT TIME ZONE ?, 'DD/MM') AS day, SUM(total) AS total
         FROM ` + schemaName + `.orders
         WHERE created_at BETWEEN ? AND ?
         GROUP BY day
         ORDER BY day`
-	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+	if err := s.db.NewRaw(query, s.companyTimeZone(ctx, schemaName), start, end).Scan(ctx, &resp); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -61,14 +62,14 @@ func (s *ReportService) RevenueCumulativeByMonth(ctx context.Context, start, end
 	var resp []CumulativeRevenueDTO
 	query := `
         WITH M AS (
-            SELECT date_trunc('month', created_at)::date AS mon, SUM(total) AS rev
+            SELECT date_trunc('month', created_at AT TIME ZONE ?)::date AS mon, SUM(total) AS rev
             FROM ` + schemaName + `.orders
             WHERE created_at BETWEEN ? AND ?
             GROUP BY mon
         )
         SELECT mon, SUM(rev) OVER (ORDER BY mon) AS cumulative_rev
         FROM M`
-	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+	if err := s.db.NewRaw(query, s.companyTimeZone(ctx, schemaName), start, end).Scan(ctx, &resp); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -88,15 +89,15 @@ func (s *ReportService) SalesByHour(ctx context.Context, day time.Time) ([]Hourl
 	}
 
 	var resp []HourlySalesDTO
-	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
-	end := start.Add(24 * time.Hour)
+	timeZone := s.companyTimeZone(ctx, schemaName)
+	start, end := localDay(day, timeZone)
 	query := `
-        SELECT EXTRACT(hour FROM created_at)::int AS hr, SUM(total) AS total
+        SELECT EXTRACT(hour FROM created_at AT TIME ZONE ?)::int AS hr, SUM(total) AS total
         FROM ` + schemaName + `.orders
         WHERE created_at >= ? AND created_at < ?
         GROUP BY hr
         ORDER BY hr`
-	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+	if err := s.db.NewRaw(query, timeZone, start, end).Scan(ctx, &resp); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -207,14 +208,14 @@ func (s *ReportService) ProductsSoldByDay(ctx context.Context, start, end time.T
 
 	var resp []ProductsSoldByDayDTO
 	query := `
-		SELECT TO_CHAR(created_at, 'DD/MM') AS day, SUM(quantity_items) AS quantity
+		SELECT TO_CHAR(created_at AT TIME ZONE ?, 'DD/MM') AS day, SUM(quantity_items) AS quantity
 		FROM ` + schemaName + `.orders
 		WHERE created_at BETWEEN ? AND ?
 		GROUP BY day
 		ORDER BY day
 	`
 
-	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+	if err := s.db.NewRaw(query, s.companyTimeZone(ctx, schemaName), start, end).Scan(ctx, &resp); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -293,12 +294,12 @@ func (s *ReportService) ClientsRegisteredByDay(ctx context.Context, start, end t
 
 	var resp []ClientsRegisteredDTO
 	query := `
-        SELECT TO_CHAR(created_at, 'DD/MM') AS day, COUNT(*) AS count
+        SELECT TO_CHAR(created_at AT TIME ZONE ?, 'DD/MM') AS day, COUNT(*) AS count
         FROM ` + schemaName + `.clients
         WHERE created_at BETWEEN ? AND ?
         GROUP BY day
         ORDER BY day`
-	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+	if err := s.db.NewRaw(query, s.companyTimeZone(ctx, schemaName), start, end).Scan(ctx, &resp); err != nil {
 		return nil, err
 	}
 	return resp, nil
@@ -948,8 +949,7 @@ func (s *ReportService) DailySales(ctx context.Context, day time.Time) (*DailySa
 		return nil, err
 	}
 
-	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
-	end := start.Add(24 * time.Hour)
+	start, end := localDay(day, s.companyTimeZone(ctx, schemaName))
 	var resp DailySalesDTO
 	query := `
         SELECT COUNT(*) AS total_orders, SUM(total) AS total_sales
@@ -960,3 +960,65 @@ func (s *ReportService) DailySales(ctx context.Context, day time.Time) (*DailySa
 	}
 	return &resp, nil
 }
+
+// StockLossDTO holds loss totals (valued at batch cost) for one group key.
+type StockLossDTO struct {
+	Key       string          `bun:"key"`
+	Label     string          `bun:"label"`
+	Count     int             `bun:"count"`
+	Quantity  decimal.Decimal `bun:"quantity"`
+	TotalCost decimal.Decimal `bun:"total_cost"`
+}
+
+// stockLossGroupings maps the allowed group_by values to their key/label expressions.
+var stockLossGroupings = map[string][2]string{
+	"reason":   {"sl.reason", "sl.reason"},
+	"product":  {"sl.product_id::text", "p.name"},
+	"employee": {"sl.employee_id::text", "us.name::text"},
+	"day":      {"TO_CHAR(sl.created_at AT TIME ZONE ?, 'YYYY-MM-DD')", "TO_CHAR(sl.created_at AT TIME ZONE ?, 'DD/MM')"},
+}
+
+// StockLosses returns losses in the period grouped by reason, product, employee or day.
+func (s *ReportService) StockLosses(ctx context.Context, start, end time.Time, groupBy, reason string) ([]StockLossDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grouping, ok := stockLossGroupings[groupBy]
+	if !ok {
+		grouping = stockLossGroupings["reason"]
+	}
+
+	// Um fuso por placeholder da expressão de agrupamento (só o agrupamento por dia usa)
+	args := []interface{}{}
+	if placeholders := strings.Count(grouping[0]+grouping[1], "?"); placeholders > 0 {
+		timeZone := s.companyTimeZone(ctx, schemaName)
+		for i := 0; i < placeholders; i++ {
+			args = append(args, timeZone)
+		}
+	}
+	args = append(args, start, end)
+
+	reasonFilter := ""
+	if reason != "" {
+		reasonFilter = " AND sl.reason = ?"
+		args = append(args, reason)
+	}
+
+	var resp []StockLossDTO
+	query := `
+        SELECT ` + grouping[0] + ` AS key, ` + grouping[1] + ` AS label,
+			COUNT(*) AS count, SUM(sl.quantity) AS quantity, SUM(sl.total_cost) AS total_cost
+        FROM ` + schemaName + `.stock_losses sl
+		JOIN ` + schemaName + `.products p ON p.id = sl.product_id
+		JOIN ` + schemaName + `.employees em ON em.id = sl.employee_id
+		JOIN public.users us ON us.id = em.user_id
+        WHERE sl.created_at BETWEEN ? AND ?` + reasonFilter + `
+        GROUP BY key, label
+        ORDER BY total_cost DESC`
+	if err := s.db.NewRaw(query, args...).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// ForecastHourDTO holds the orders placed in one hour of one day in the company time zone.
+type ForecastHourDTO struct {
+	Day     time.Time       `bun:"day"`
+	Hour    int             `bun:"hour"`
+	Orders  int             `bun:"orders"`
+	Revenue decimal.Decimal `bun:"revenue"`
+}
+
+// ForecastProductDayDTO holds the units of one product ordered on one day in the company time zone.
+type ForecastProductDayDTO struct {
+	Day        time.Time       `bun:"day"`
+	ProductID  string          `bun:"product_id"`
+	Name       string          `bun:"name"`
+	CategoryID string          `bun:"category_id"`
+	Quantity   decimal.Decimal `bun:"quantity"`
+}
+
+// ForecastProcessRuleDTO holds an active process rule; IdealTime is in nanoseconds, as stored.
+type ForecastProcessRuleDTO struct {
+	ProcessRuleID string `bun:"process_rule_id"`
+	Name          string `bun:"name"`
+	Order         int    `bun:"order"`
+	CategoryID    string `bun:"category_id"`
+	IdealTime     int64  `bun:"ideal_time"`
+}
+
+// SalesForecastHistoryDTO holds the raw figures behind the sales forecast.
+type SalesForecastHistoryDTO struct {
+	TimeZone     string
+	Hours        []ForecastHourDTO
+	Products     []ForecastProductDayDTO
+	ProcessRules []ForecastProcessRuleDTO
+}
+
+// SalesForecastHistory gathers the orders per local day and hour and the units per product and day for the
+// local days in [start, end), counting every order that left staging and was not cancelled, by creation time.
+// The same figures serve as history before the forecast and as actuals for the forecast days already past.
+func (s *ReportService) SalesForecastHistory(ctx context.Context, start, end time.Time) (*SalesForecastHistoryDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tz := s.companyTimeZone(ctx, schemaName)
+	start, _ = localDay(start, tz)
+	end, _ = localDay(end, tz)
+
+	resp := &SalesForecastHistoryDTO{TimeZone: tz}
+
+	hoursQuery := `
+        SELECT (o.created_at AT TIME ZONE ?)::date AS day, EXTRACT(hour FROM o.created_at AT TIME ZONE ?)::int AS hour,
+            COUNT(*) AS orders, COALESCE(SUM(o.total), 0) AS revenue
+        FROM ` + schemaName + `.orders o
+        WHERE o.status NOT IN ('Staging', 'Cancelled') AND o.created_at >= ? AND o.created_at < ?
+        GROUP BY day, hour
+        ORDER BY day, hour`
+	if err := s.db.NewRaw(hoursQuery, tz, tz, start, end).Scan(ctx, &resp.Hours); err != nil {
+		return nil, err
+	}
+
+	productsQuery := `
+        SELECT (o.created_at AT TIME ZONE ?)::date AS day, p.id::text AS product_id, p.name, p.category_id::text AS category_id,
+            SUM(i.quantity) AS quantity
+        FROM ` + schemaName + `.order_items i
+        JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+        JOIN ` + schemaName + `.orders o ON o.id = g.order_id
+        JOIN ` + schemaName + `.products p ON p.id = i.product_id
+        WHERE o.status NOT IN ('Staging', 'Cancelled') AND o.created_at >= ? AND o.created_at < ?
+            AND g.status <> 'Cancelled' AND i.deleted_at IS NULL
+        GROUP BY day, p.id, p.name, p.category_id
+        ORDER BY day, p.name`
+	if err := s.db.NewRaw(productsQuery, tz, start, end).Scan(ctx, &resp.Products); err != nil {
+		return nil, err
+	}
+
+	rulesQuery := `
+        SELECT pr.id::text AS process_rule_id, pr.name, pr."order", pr.category_id::text AS category_id, pr.ideal_time
+        FROM ` + schemaName + `.process_rules pr
+        WHERE pr.is_active AND pr.deleted_at IS NULL
+        ORDER BY pr.category_id, pr."order"`
+	if err := s.db.NewRaw(rulesQuery).Scan(ctx, &resp.ProcessRules); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,144 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+)
+
+// StockValuationDTO holds the quantity and value at batch cost of one stock at a given date.
+type StockValuationDTO struct {
+	StockID     string          `bun:"stock_id"`
+	ProductName string          `bun:"product_name"`
+	SizeName    string          `bun:"size_name"`
+	Unit        string          `bun:"unit"`
+	Quantity    decimal.Decimal `bun:"quantity"`
+	TotalValue  decimal.Decimal `bun:"total_value"`
+}
+
+// StockValuation rebuilds each batch balance at the given date by reverting the batch movements
+// made after it, and values the balance at the batch cost. Quantities in transit between
+// locations are out of every batch until received, so they are not valued.
+func (s *ReportService) StockValuation(ctx context.Context, at time.Time) ([]StockValuationDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []StockValuationDTO
+	query := `
+        WITH batch_balance AS (
+            SELECT b.stock_id, b.cost_price,
+                b.current_quantity - COALESCE((
+                    SELECT SUM(CASE
+                        WHEN m.type IN ('in', 'adjust_in', 'restore', 'transfer_in') THEN m.quantity
+                        WHEN m.type IN ('out', 'adjust_out', 'transfer_out') THEN -m.quantity
+                        ELSE 0 END)
+                    FROM ` + schemaName + `.stock_movements m
+                    WHERE m.batch_id = b.id AND m.created_at > ? AND m.deleted_at IS NULL
+                ), 0) AS quantity
+            FROM ` + schemaName + `.stock_batches b
+            WHERE b.created_at <= ? AND b.deleted_at IS NULL
+        )
+        SELECT st.id::text AS stock_id, p.name AS product_name, COALESCE(sz.name, '') AS size_name, st.unit,
+            SUM(bb.quantity) AS quantity, ROUND(SUM(bb.quantity * bb.cost_price), 2) AS total_value
+        FROM batch_balance bb
+        JOIN ` + schemaName + `.stocks st ON st.id = bb.stock_id
+        JOIN ` + schemaName + `.products p ON p.id = st.product_id
+        LEFT JOIN ` + schemaName + `.product_variations pv ON pv.id = st.product_variation_id
+        LEFT JOIN ` + schemaName + `.sizes sz ON sz.id = pv.size_id
+        WHERE bb.quantity > 0
+        GROUP BY st.id, p.name, sz.name, st.unit
+        ORDER BY total_value DESC`
+	if err := s.db.NewRaw(query, at, at).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CostOfGoodsSoldDTO holds the batch cost consumed by orders in one day.
+type CostOfGoodsSoldDTO struct {
+	Day      string          `bun:"day"`
+	Quantity decimal.Decimal `bun:"quantity"`
+	Cost     decimal.Decimal `bun:"cost"`
+}
+
+// CostOfGoodsSold sums the order debits (quantity × batch cost of the out movement) per day,
+// minus the restores of finished orders that were cancelled afterwards.
+func (s *ReportService) CostOfGoodsSold(ctx context.Context, start, end time.Time) ([]CostOfGoodsSoldDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []CostOfGoodsSoldDTO
+	query := `
+        SELECT TO_CHAR(m.created_at AT TIME ZONE ?, 'YYYY-MM-DD') AS day,
+            SUM(CASE WHEN m.type = 'out' THEN m.quantity ELSE -m.quantity END) AS quantity,
+            ROUND(SUM(CASE WHEN m.type = 'out' THEN m.quantity * m.price ELSE -m.quantity * m.price END), 2) AS cost
+        FROM ` + schemaName + `.stock_movements m
+        WHERE m.order_id IS NOT NULL AND m.deleted_at IS NULL
+            AND (m.type = 'out' OR (m.type = 'restore' AND m.batch_id IS NOT NULL))
+            AND m.created_at BETWEEN ? AND ?
+        GROUP BY day
+        ORDER BY day`
+	if err := s.db.NewRaw(query, s.companyTimeZone(ctx, schemaName), start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GrossMarginByProductDTO holds revenue and consumed batch cost of one product.
+type GrossMarginByProductDTO struct {
+	ProductID         string          `bun:"product_id"`
+	Name              string          `bun:"name"`
+	Quantity          decimal.Decimal `bun:"quantity"`
+	Revenue           decimal.Decimal `bun:"revenue"`
+	Cost              decimal.Decimal `bun:"cost"`
+	UntrackedQuantity decimal.Decimal `bun:"untracked_quantity"`
+}
+
+// GrossMarginByProduct joins the item subtotals of the orders finished in the period with the
+// batch cost debited for those same orders. UntrackedQuantity is what left without a batch (zero cost).
+func (s *ReportService) GrossMarginByProduct(ctx context.Context, start, end time.Time) ([]GrossMarginByProductDTO, error) {
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []GrossMarginByProductDTO
+	query := `
+        WITH sold_orders AS (
+            SELECT o.id
+            FROM ` + schemaName + `.orders o
+            WHERE o.status IN ('Finished', 'Archived') AND o.finished_at BETWEEN ? AND ?
+        ), revenue AS (
+            SELECT i.product_id, SUM(i.quantity) AS quantity, ROUND(SUM(i.sub_total * i.quantity), 2) AS revenue
+            FROM ` + schemaName + `.order_items i
+            JOIN ` + schemaName + `.order_group_items g ON g.id = i.group_item_id
+            WHERE g.order_id IN (SELECT id FROM sold_orders) AND g.status <> 'Cancelled' AND i.deleted_at IS NULL
+            GROUP BY i.product_id
+        ), cost AS (
+            SELECT st.product_id, SUM(m.quantity * m.price) AS cost,
+                SUM(CASE WHEN m.batch_id IS NULL THEN m.quantity ELSE 0 END) AS untracked_quantity
+            FROM ` + schemaName + `.stock_movements m
+            JOIN ` + schemaName + `.stocks st ON st.id = m.stock_id
+            WHERE m.type = 'out' AND m.deleted_at IS NULL AND m.order_id IN (SELECT id FROM sold_orders)
+            GROUP BY st.product_id
+        )
+        SELECT p.id::text AS product_id, p.name,
+            COALESCE(r.quantity, 0) AS quantity,
+            COALESCE(r.revenue, 0) AS revenue,
+            ROUND(COALESCE(c.cost, 0), 2) AS cost,
+            COALESCE(c.untracked_quantity, 0) AS untracked_quantity
+        FROM revenue r
+        FULL OUTER JOIN cost c ON c.product_id = r.product_id
+        JOIN ` + schemaName + `.products p ON p.id = COALESCE(r.product_id, c.product_id)
+        ORDER BY revenue DESC`
+	if err := s.db.NewRaw(query, start, end).Scan(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
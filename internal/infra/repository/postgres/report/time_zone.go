@@ -0,0 +1,25 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+)
+
+// companyTimeZone reads the IANA time zone of the tenant; timestamps are stored in UTC and
+// every day/hour grouping converts them with AT TIME ZONE before truncating.
+func (s *ReportService) companyTimeZone(ctx context.Context, schemaName string) string {
+	var timeZone string
+	if err := s.db.NewRaw("SELECT time_zone FROM public.companies WHERE schema_name = ?", schemaName).Scan(ctx, &timeZone); err != nil {
+		return companyentity.DefaultTimeZone
+	}
+
+	return companyentity.LoadLocation(timeZone).String()
+}
+
+// localDay returns the [start, end) bounds of the calendar day of day in the company time zone.
+func localDay(day time.Time, timeZone string) (time.Time, time.Time) {
+	start := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, companyentity.LoadLocation(timeZone))
+	return start, start.AddDate(0, 0, 1)
+}
@@ -0,0 +1,181 @@
+package reportsubscriptionrepositorybun
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type ReportSubscriptionRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewReportSubscriptionRepositoryBun(db *bun.DB) model.ReportSubscriptionRepository {
+	return &ReportSubscriptionRepositoryBun{db: db}
+}
+
+func (r *ReportSubscriptionRepositoryBun) CreateReportSubscription(ctx context.Context, s *model.ReportSubscription) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(s).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ReportSubscriptionRepositoryBun) UpdateReportSubscription(ctx context.Context, s *model.ReportSubscription) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(s).Where("report_subscription.id = ?", s.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ReportSubscriptionRepositoryBun) DeleteReportSubscription(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// deleted_at é soft delete: o histórico de envios continua consultável
+	if _, err := tx.NewDelete().Model(&model.ReportSubscription{}).Where("id = ?", id).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ReportSubscriptionRepositoryBun) GetReportSubscriptionById(ctx context.Context, id string) (*model.ReportSubscription, error) {
+	subscription := &model.ReportSubscription{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(subscription).Where("report_subscription.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (r *ReportSubscriptionRepositoryBun) GetReportSubscriptionsByUserID(ctx context.Context, userID string) ([]model.ReportSubscription, error) {
+	subscriptions := make([]model.ReportSubscription, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().
+		Model(&subscriptions).
+		Where("report_subscription.user_id = ?", userID).
+		OrderExpr("report_subscription.created_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *ReportSubscriptionRepositoryBun) GetDueReportSubscriptions(ctx context.Context, now time.Time) ([]model.ReportSubscription, error) {
+	subscriptions := make([]model.ReportSubscription, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().
+		Model(&subscriptions).
+		Where("report_subscription.is_active = ?", true).
+		Where("report_subscription.next_run_at <= ?", now).
+		OrderExpr("report_subscription.next_run_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *ReportSubscriptionRepositoryBun) CreateDelivery(ctx context.Context, d *model.ReportSubscriptionDelivery) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(d).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ReportSubscriptionRepositoryBun) GetDeliveriesBySubscriptionID(ctx context.Context, subscriptionID string, limit int) ([]model.ReportSubscriptionDelivery, error) {
+	deliveries := make([]model.ReportSubscriptionDelivery, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().
+		Model(&deliveries).
+		Where("delivery.subscription_id = ?", subscriptionID).
+		OrderExpr("delivery.created_at DESC").
+		Limit(limit).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
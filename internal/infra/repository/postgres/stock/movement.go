@@ -2,9 +2,12 @@ package stockrepositorybun
 
 import (
 	"context"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 )
 
@@ -81,7 +84,7 @@ func (r *StockMovementRepositoryBun) GetMovementsByOrderID(ctx context.Context,
 	defer cancel()
 	defer tx.Rollback()
 
-	if err := tx.NewSelect().Model(&movements).Where("stock_movement.order_id = ?", orderID).Order("created_at DESC").Scan(ctx); err != nil {
+	if movements, err = r.GetMovementsByOrderIDWithTx(ctx, tx, orderID); err != nil {
 		return nil, err
 	}
 
@@ -91,6 +94,16 @@ func (r *StockMovementRepositoryBun) GetMovementsByOrderID(ctx context.Context,
 	return movements, nil
 }
 
+func (r *StockMovementRepositoryBun) GetMovementsByOrderIDWithTx(ctx context.Context, db bun.IDB, orderID string) ([]model.StockMovement, error) {
+	movements := []model.StockMovement{}
+
+	if err := db.NewSelect().Model(&movements).Where("stock_movement.order_id = ?", orderID).Order("created_at DESC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
 func (r *StockMovementRepositoryBun) GetAllMovements(ctx context.Context) ([]model.StockMovement, error) {
 	movements := []model.StockMovement{}
 
@@ -132,3 +145,113 @@ func (r *StockMovementRepositoryBun) GetMovementsByDateRange(ctx context.Context
 	}
 	return movements, nil
 }
+
+func (r *StockMovementRepositoryBun) GetConsumptionByStock(ctx context.Context, start, end time.Time) ([]model.StockConsumption, error) {
+	consumption := []model.StockConsumption{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// Restaurações só descontam quando devolvem uma saída (pedido finalizado e depois cancelado);
+	// restaurações de reserva não têm saída correspondente e ficam de fora.
+	query := `
+		SELECT movement.stock_id,
+			SUM(CASE WHEN movement.type = 'out' THEN movement.quantity ELSE -movement.quantity END) AS quantity
+		FROM stock_movements AS movement
+		WHERE movement.deleted_at IS NULL
+			AND movement.created_at >= ? AND movement.created_at < ?
+			AND (
+				movement.type = 'out'
+				OR (movement.type = 'restore' AND EXISTS (
+					SELECT 1 FROM stock_movements AS debit
+					WHERE debit.deleted_at IS NULL
+						AND debit.type = 'out'
+						AND debit.order_id = movement.order_id
+						AND debit.stock_id = movement.stock_id
+				))
+			)
+		GROUP BY movement.stock_id
+		HAVING SUM(CASE WHEN movement.type = 'out' THEN movement.quantity ELSE -movement.quantity END) > 0`
+
+	if err := tx.NewRaw(query, start, end).Scan(ctx, &consumption); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return consumption, nil
+}
+
+func (r *StockMovementRepositoryBun) GetExpiredReservations(ctx context.Context, now time.Time) ([]model.ExpiredReservation, error) {
+	reservations := []model.ExpiredReservation{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// A validade vale para o pedido inteiro: qualquer item novo renova todas as reservas dele.
+	// Reservas antigas, sem expires_at, ficam para a limpeza diária dos pedidos em rascunho.
+	query := `
+		WITH order_expiry AS (
+			SELECT movement.order_id, MAX(movement.expires_at) AS expires_at
+			FROM stock_movements AS movement
+			WHERE movement.deleted_at IS NULL
+				AND movement.type = 'reserve'
+				AND movement.order_id IS NOT NULL
+			GROUP BY movement.order_id
+		)
+		SELECT movement.order_id, movement.stock_id,
+			(ARRAY_AGG(movement.employee_id ORDER BY movement.created_at) FILTER (WHERE movement.type = 'reserve'))[1] AS employee_id,
+			SUM(CASE WHEN movement.type = 'reserve' THEN movement.quantity ELSE -movement.quantity END) AS quantity
+		FROM stock_movements AS movement
+		JOIN order_expiry ON order_expiry.order_id = movement.order_id
+		JOIN orders AS o ON o.id = movement.order_id
+		WHERE movement.deleted_at IS NULL
+			AND movement.type IN ('reserve', 'restore', 'out')
+			AND o.status = ?
+			AND order_expiry.expires_at < ?
+		GROUP BY movement.order_id, movement.stock_id
+		HAVING SUM(CASE WHEN movement.type = 'reserve' THEN movement.quantity ELSE -movement.quantity END) > 0`
+
+	if err := tx.NewRaw(query, orderentity.OrderStatusStaging, now).Scan(ctx, &reservations); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return reservations, nil
+}
+
+func (r *StockMovementRepositoryBun) GetStagingReservationWithTx(ctx context.Context, db bun.IDB, orderID, stockID string) (decimal.Decimal, error) {
+	var quantity decimal.NullDecimal
+
+	query := `
+		SELECT SUM(CASE WHEN movement.type = 'reserve' THEN movement.quantity ELSE -movement.quantity END)
+		FROM stock_movements AS movement
+		JOIN orders AS o ON o.id = movement.order_id
+		WHERE movement.deleted_at IS NULL
+			AND movement.type IN ('reserve', 'restore', 'out')
+			AND movement.order_id = ?
+			AND movement.stock_id = ?
+			AND o.status = ?`
+
+	if err := db.NewRaw(query, orderID, stockID, orderentity.OrderStatusStaging).Scan(ctx, &quantity); err != nil {
+		return decimal.Zero, err
+	}
+
+	if !quantity.Valid || !quantity.Decimal.IsPositive() {
+		return decimal.Zero, nil
+	}
+	return quantity.Decimal, nil
+}
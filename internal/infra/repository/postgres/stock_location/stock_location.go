@@ -0,0 +1,139 @@
+package stocklocationrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type StockLocationRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewStockLocationRepositoryBun(db *bun.DB) model.StockLocationRepository {
+	return &StockLocationRepositoryBun{db: db}
+}
+
+// unsetOtherDefaults garante um único local padrão por empresa
+func unsetOtherDefaults(ctx context.Context, tx bun.IDB, l *model.StockLocation) error {
+	if !l.IsDefault {
+		return nil
+	}
+
+	_, err := tx.NewUpdate().
+		Model(&model.StockLocation{}).
+		Set("is_default = ?", false).
+		Where("id <> ?", l.ID).
+		Where("is_default = ?", true).
+		Exec(ctx)
+	return err
+}
+
+func (r *StockLocationRepositoryBun) CreateStockLocation(ctx context.Context, l *model.StockLocation) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := unsetOtherDefaults(ctx, tx, l); err != nil {
+		return err
+	}
+
+	if _, err := tx.NewInsert().Model(l).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *StockLocationRepositoryBun) UpdateStockLocation(ctx context.Context, l *model.StockLocation) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := unsetOtherDefaults(ctx, tx, l); err != nil {
+		return err
+	}
+
+	if _, err := tx.NewUpdate().Model(l).Where("stock_location.id = ?", l.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *StockLocationRepositoryBun) DeleteStockLocation(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// Soft delete: lotes e movimentos antigos continuam apontando para o local
+	if _, err := tx.NewUpdate().
+		Model(&model.StockLocation{}).
+		Set("is_active = ?", false).
+		Set("is_default = ?", false).
+		Where("id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *StockLocationRepositoryBun) GetStockLocationById(ctx context.Context, id string) (*model.StockLocation, error) {
+	location := &model.StockLocation{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(location).Where("stock_location.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+func (r *StockLocationRepositoryBun) GetAllStockLocations(ctx context.Context) ([]model.StockLocation, error) {
+	locations := make([]model.StockLocation, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().
+		Model(&locations).
+		Order("stock_location.is_default DESC", "stock_location.name ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
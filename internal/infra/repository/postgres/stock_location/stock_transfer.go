@@ -0,0 +1,155 @@
+package stocklocationrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type StockTransferRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewStockTransferRepositoryBun(db *bun.DB) model.StockTransferRepository {
+	return &StockTransferRepositoryBun{db: db}
+}
+
+func (r *StockTransferRepositoryBun) CreateStockTransfer(ctx context.Context, db bun.IDB, t *model.StockTransfer) error {
+	if _, err := db.NewInsert().Model(t).Exec(ctx); err != nil {
+		return err
+	}
+
+	if len(t.Lines) > 0 {
+		if _, err := db.NewInsert().Model(&t.Lines).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *StockTransferRepositoryBun) UpdateStockTransfer(ctx context.Context, db bun.IDB, t *model.StockTransfer) error {
+	if _, err := db.NewUpdate().Model(t).Where("stock_transfer.id = ?", t.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	for i := range t.Lines {
+		if _, err := db.NewUpdate().Model(&t.Lines[i]).
+			Column("destination_batch_id").
+			Where("stock_transfer_line.id = ?", t.Lines[i].ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *StockTransferRepositoryBun) GetStockTransferById(ctx context.Context, id string) (*model.StockTransfer, error) {
+	transfer := &model.StockTransfer{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(transfer).
+		Where("stock_transfer.id = ?", id).
+		Relation("Lines").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (r *StockTransferRepositoryBun) GetStockTransferByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*model.StockTransfer, error) {
+	transfer := &model.StockTransfer{}
+
+	if err := db.NewSelect().Model(transfer).
+		Where("stock_transfer.id = ?", id).
+		For("UPDATE").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := db.NewSelect().Model(&transfer.Lines).
+		Where("stock_transfer_line.transfer_id = ?", id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (r *StockTransferRepositoryBun) GetAllStockTransfers(ctx context.Context, page, perPage int, status, stockID string) ([]model.StockTransfer, int, error) {
+	transfers := make([]model.StockTransfer, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&transfers).
+		Relation("Lines").
+		Order("stock_transfer.sent_at DESC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("stock_transfer.status = ?", status)
+	}
+
+	if stockID != "" {
+		query = query.Where("stock_transfer.stock_id = ?", stockID)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return transfers, count, nil
+}
+
+func (r *StockTransferRepositoryBun) GetInTransitTransfersByStockID(ctx context.Context, stockID string) ([]model.StockTransfer, error) {
+	transfers := make([]model.StockTransfer, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().
+		Model(&transfers).
+		Where("stock_transfer.stock_id = ?", stockID).
+		Where("stock_transfer.status = ?", string(stocklocationentity.TransferStatusInTransit)).
+		Order("stock_transfer.sent_at ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
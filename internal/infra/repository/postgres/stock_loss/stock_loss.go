@@ -0,0 +1,88 @@
+package stocklossrepositorybun
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type StockLossRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewStockLossRepositoryBun(db *bun.DB) model.StockLossRepository {
+	return &StockLossRepositoryBun{db: db}
+}
+
+func (r *StockLossRepositoryBun) CreateStockLoss(ctx context.Context, db bun.IDB, l *model.StockLoss) error {
+	_, err := db.NewInsert().Model(l).Exec(ctx)
+	return err
+}
+
+func (r *StockLossRepositoryBun) GetStockLossById(ctx context.Context, id string) (*model.StockLoss, error) {
+	stockLoss := &model.StockLoss{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(stockLoss).
+		Where("stock_loss.id = ?", id).
+		Relation("Product").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return stockLoss, nil
+}
+
+func (r *StockLossRepositoryBun) GetAllStockLosses(ctx context.Context, page, perPage int, reason string, start, end *time.Time) ([]model.StockLoss, int, error) {
+	stockLosses := make([]model.StockLoss, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&stockLosses).
+		Relation("Product").
+		Order("stock_loss.created_at DESC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if reason != "" {
+		query = query.Where("stock_loss.reason = ?", reason)
+	}
+
+	if start != nil {
+		query = query.Where("stock_loss.created_at >= ?", *start)
+	}
+
+	if end != nil {
+		query = query.Where("stock_loss.created_at < ?", *end)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return stockLosses, count, nil
+}
@@ -0,0 +1,59 @@
+package supplierrepositorybun
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type SupplierProductMappingRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewSupplierProductMappingRepositoryBun(db *bun.DB) model.SupplierProductMappingRepository {
+	return &SupplierProductMappingRepositoryBun{db: db}
+}
+
+// UpsertMappings grava o mapeamento por (fornecedor, código); um novo vínculo substitui o anterior
+func (r *SupplierProductMappingRepositoryBun) UpsertMappings(ctx context.Context, db bun.IDB, mappings []model.SupplierProductMapping) error {
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	if _, err := db.NewInsert().
+		Model(&mappings).
+		On("CONFLICT (supplier_id, supplier_code) DO UPDATE").
+		Set("stock_id = EXCLUDED.stock_id").
+		Set("factor = EXCLUDED.factor").
+		Set("updated_at = EXCLUDED.updated_at").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *SupplierProductMappingRepositoryBun) GetMappingsBySupplierID(ctx context.Context, supplierID string) ([]model.SupplierProductMapping, error) {
+	mappings := make([]model.SupplierProductMapping, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(&mappings).
+		Where("supplier_product_mapping.supplier_id = ?", supplierID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
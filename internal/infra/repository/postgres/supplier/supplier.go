@@ -0,0 +1,144 @@
+package supplierrepositorybun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type SupplierRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewSupplierRepositoryBun(db *bun.DB) model.SupplierRepository {
+	return &SupplierRepositoryBun{db: db}
+}
+
+func (r *SupplierRepositoryBun) CreateSupplier(ctx context.Context, s *model.Supplier) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(s).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SupplierRepositoryBun) UpdateSupplier(ctx context.Context, s *model.Supplier) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewUpdate().Model(s).Where("supplier.id = ?", s.ID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SupplierRepositoryBun) DeleteSupplier(ctx context.Context, id string) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	// Soft delete: pedidos e contas antigos continuam apontando para o fornecedor
+	if _, err := tx.NewUpdate().
+		Model(&model.Supplier{}).
+		Set("is_active = ?", false).
+		Where("id = ?", id).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SupplierRepositoryBun) GetSupplierById(ctx context.Context, id string) (*model.Supplier, error) {
+	supplier := &model.Supplier{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(supplier).Where("supplier.id = ?", id).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return supplier, nil
+}
+
+func (r *SupplierRepositoryBun) GetSupplierByCnpj(ctx context.Context, cnpj string) (*model.Supplier, error) {
+	supplier := &model.Supplier{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(supplier).Where("supplier.cnpj = ?", cnpj).Limit(1).Scan(ctx); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return supplier, nil
+}
+
+func (r *SupplierRepositoryBun) GetAllSuppliers(ctx context.Context, page, perPage int, isActive bool) ([]model.Supplier, int, error) {
+	suppliers := make([]model.Supplier, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	count, err := tx.NewSelect().
+		Model(&suppliers).
+		Where("supplier.is_active = ?", isActive).
+		Order("supplier.name ASC").
+		Limit(perPage).
+		Offset(page * perPage).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return suppliers, count, nil
+}
@@ -0,0 +1,136 @@
+package supplierinvoicerepositorybun
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type SupplierInvoiceRepositoryBun struct {
+	db *bun.DB
+}
+
+func NewSupplierInvoiceRepositoryBun(db *bun.DB) model.SupplierInvoiceRepository {
+	return &SupplierInvoiceRepositoryBun{db: db}
+}
+
+func (r *SupplierInvoiceRepositoryBun) CreateSupplierInvoice(ctx context.Context, i *model.SupplierInvoice) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if _, err := tx.NewInsert().Model(i).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SupplierInvoiceRepositoryBun) UpdateSupplierInvoice(ctx context.Context, db bun.IDB, i *model.SupplierInvoice) error {
+	if _, err := db.NewUpdate().Model(i).Where("supplier_invoice.id = ?", i.ID).Exec(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *SupplierInvoiceRepositoryBun) GetSupplierInvoiceById(ctx context.Context, id string) (*model.SupplierInvoice, error) {
+	invoice := &model.SupplierInvoice{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(invoice).
+		Where("supplier_invoice.id = ?", id).
+		Relation("Supplier").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (r *SupplierInvoiceRepositoryBun) GetSupplierInvoiceByIDForUpdate(ctx context.Context, db bun.IDB, id string) (*model.SupplierInvoice, error) {
+	invoice := &model.SupplierInvoice{}
+
+	if err := db.NewSelect().Model(invoice).
+		Where("supplier_invoice.id = ?", id).
+		For("UPDATE").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (r *SupplierInvoiceRepositoryBun) GetSupplierInvoiceByAccessKey(ctx context.Context, accessKey string) (*model.SupplierInvoice, error) {
+	invoice := &model.SupplierInvoice{}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := tx.NewSelect().Model(invoice).
+		Where("supplier_invoice.access_key = ?", accessKey).
+		Scan(ctx); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (r *SupplierInvoiceRepositoryBun) GetAllSupplierInvoices(ctx context.Context, page, perPage int, status string) ([]model.SupplierInvoice, int, error) {
+	invoices := make([]model.SupplierInvoice, 0)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, r.db)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	query := tx.NewSelect().
+		Model(&invoices).
+		Relation("Supplier").
+		Order("supplier_invoice.created_at DESC").
+		Limit(perPage).
+		Offset(page * perPage)
+
+	if status != "" {
+		query = query.Where("supplier_invoice.status = ?", status)
+	}
+
+	count, err := query.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return invoices, count, nil
+}
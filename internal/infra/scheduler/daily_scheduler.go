@@ -6,25 +6,37 @@ import (
 	"time"
 
 	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
 	billing "github.com/willjrcom/sales-backend-go/internal/usecases/checkout"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
+	replenishmentusecases "github.com/willjrcom/sales-backend-go/internal/usecases/replenishment"
+	reportsubscriptionusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report_subscription"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
 )
 
 type DailyScheduler struct {
-	db                      *bun.DB
-	companyRepo             model.CompanyRepository
-	orderRepo               model.OrderRepository
-	companyPaymentRepo      model.CompanyPaymentRepository
-	companySubscriptionRepo model.CompanySubscriptionRepository
-	checkoutUseCase         *billing.CheckoutUseCase
-	companyUseCase          *companyusecases.Service
-	orderUseCase            *orderusecases.OrderService
+	db                        *bun.DB
+	companyRepo               model.CompanyRepository
+	orderRepo                 model.OrderRepository
+	companyPaymentRepo        model.CompanyPaymentRepository
+	companySubscriptionRepo   model.CompanySubscriptionRepository
+	checkoutUseCase           *billing.CheckoutUseCase
+	companyUseCase            *companyusecases.Service
+	orderUseCase              *orderusecases.OrderService
+	replenishmentUseCase      *replenishmentusecases.Service
+	stockUseCase              *stockusecases.Service
+	reportSubscriptionUseCase *reportsubscriptionusecases.Service
+	accountPayableUseCase     *accountpayableusecases.Service
 }
 
+// reservationReleaseInterval é a frequência da liberação de reservas vencidas
+const reservationReleaseInterval = 10 * time.Minute
+
 func NewDailyScheduler(db *bun.DB, companyRepo model.CompanyRepository, orderRepo model.OrderRepository, companyPaymentRepo model.CompanyPaymentRepository, companySubscriptionRepo model.CompanySubscriptionRepository, checkoutUseCase *billing.CheckoutUseCase, companyUseCase *companyusecases.Service, orderUseCase *orderusecases.OrderService) *DailyScheduler {
 	return &DailyScheduler{
 		db:                      db,
@@ -38,15 +50,28 @@ func NewDailyScheduler(db *bun.DB, companyRepo model.CompanyRepository, orderRep
 	}
 }
 
+func (s *DailyScheduler) AddDependencies(replenishmentUseCase *replenishmentusecases.Service, stockUseCase *stockusecases.Service, reportSubscriptionUseCase *reportsubscriptionusecases.Service, accountPayableUseCase *accountpayableusecases.Service) {
+	s.replenishmentUseCase = replenishmentUseCase
+	s.stockUseCase = stockUseCase
+	s.reportSubscriptionUseCase = reportSubscriptionUseCase
+	s.accountPayableUseCase = accountPayableUseCase
+}
+
 func (s *DailyScheduler) Start(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Hour)
+	reservationTicker := time.NewTicker(reservationReleaseInterval)
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
 				ticker.Stop()
+				reservationTicker.Stop()
 				return
+			case <-reservationTicker.C:
+				s.ReleaseExpiredReservations(ctx)
 			case t := <-ticker.C:
+				s.SendDueReportSubscriptions(ctx, t)
+
 				// Run billing checks at 5 AM
 				if t.Hour() == 5 {
 					log.Println("Running Daily Batch...")
@@ -54,22 +79,49 @@ func (s *DailyScheduler) Start(ctx context.Context) {
 					s.UpdateCompanyPlans(ctx)
 					s.CheckOverdueAccounts(ctx)
 					s.CheckExpiredOptionalPayments(ctx)
-					s.CleanStagingOrders(ctx)
 					log.Println("Daily Batch Completed.")
 				}
+
+				// Tenant jobs run at 5 AM in each company's time zone
+				if schemas := s.schemasAtLocalHour(ctx, t, 5); len(schemas) > 0 {
+					s.CleanStagingOrders(ctx, schemas)
+					s.AdjustStockLimits(ctx, schemas)
+					s.GenerateRecurringAccountPayables(ctx, schemas, t)
+				}
 			}
 		}
 	}()
 }
 
-func (s *DailyScheduler) CleanStagingOrders(ctx context.Context) {
-	log.Println("Scheduler: Cleaning up staging orders...")
-	var schemas []string
-	if err := s.db.NewRaw("SELECT nspname FROM pg_catalog.pg_namespace WHERE nspname LIKE 'company_%'").Scan(ctx, &schemas); err != nil {
+// schemasAtLocalHour devolve os schemas cuja hora local (fuso da empresa) em t é hour
+func (s *DailyScheduler) schemasAtLocalHour(ctx context.Context, t time.Time, hour int) []string {
+	var tenants []struct {
+		SchemaName string `bun:"schema_name"`
+		TimeZone   string `bun:"time_zone"`
+	}
+
+	query := `
+		SELECT n.nspname AS schema_name, COALESCE(c.time_zone, '') AS time_zone
+		FROM pg_catalog.pg_namespace n
+		LEFT JOIN public.companies c ON c.schema_name = n.nspname
+		WHERE n.nspname LIKE 'company_%'`
+	if err := s.db.NewRaw(query).Scan(ctx, &tenants); err != nil {
 		log.Printf("Scheduler: Error fetching schemas: %v", err)
-		return
+		return nil
+	}
+
+	schemas := []string{}
+	for _, tenant := range tenants {
+		if t.In(companyentity.LoadLocation(tenant.TimeZone)).Hour() == hour {
+			schemas = append(schemas, tenant.SchemaName)
+		}
 	}
 
+	return schemas
+}
+
+func (s *DailyScheduler) CleanStagingOrders(ctx context.Context, schemas []string) {
+	log.Println("Scheduler: Cleaning up staging orders...")
 	for _, schema := range schemas {
 		ctxSchema := context.WithValue(ctx, model.Schema("schema"), schema)
 
@@ -90,6 +142,102 @@ func (s *DailyScheduler) CleanStagingOrders(ctx context.Context) {
 	}
 }
 
+// AdjustStockLimits recalcula mínimo/máximo dos estoques nas empresas que ativaram enable_auto_stock_limits
+func (s *DailyScheduler) AdjustStockLimits(ctx context.Context, schemas []string) {
+	if s.replenishmentUseCase == nil {
+		return
+	}
+
+	for _, schema := range schemas {
+		ctxSchema := context.WithValue(ctx, model.Schema("schema"), schema)
+
+		adjusted, err := s.replenishmentUseCase.AdjustStockLimitsIfEnabled(ctxSchema)
+		if err != nil {
+			log.Printf("Scheduler: Error adjusting stock limits in schema %s: %v", schema, err)
+			continue
+		}
+
+		if adjusted {
+			log.Printf("Scheduler: Adjusted stock limits in schema %s", schema)
+		}
+	}
+}
+
+// GenerateRecurringAccountPayables lança as próximas contas das séries recorrentes (aluguel, energia, internet)
+func (s *DailyScheduler) GenerateRecurringAccountPayables(ctx context.Context, schemas []string, now time.Time) {
+	if s.accountPayableUseCase == nil {
+		return
+	}
+
+	for _, schema := range schemas {
+		ctxSchema := context.WithValue(ctx, model.Schema("schema"), schema)
+
+		created, err := s.accountPayableUseCase.GenerateRecurringAccountPayables(ctxSchema, now)
+		if err != nil {
+			log.Printf("Scheduler: Error generating recurring accounts payable in schema %s: %v", schema, err)
+			continue
+		}
+
+		if created > 0 {
+			log.Printf("Scheduler: Generated %d recurring accounts payable in schema %s", created, schema)
+		}
+	}
+}
+
+// ReleaseExpiredReservations devolve ao estoque as reservas vencidas de pedidos abandonados em rascunho
+func (s *DailyScheduler) ReleaseExpiredReservations(ctx context.Context) {
+	if s.stockUseCase == nil {
+		return
+	}
+
+	var schemas []string
+	if err := s.db.NewRaw("SELECT nspname FROM pg_catalog.pg_namespace WHERE nspname LIKE 'company_%'").Scan(ctx, &schemas); err != nil {
+		log.Printf("Scheduler: Error fetching schemas: %v", err)
+		return
+	}
+
+	for _, schema := range schemas {
+		ctxSchema := context.WithValue(ctx, model.Schema("schema"), schema)
+
+		released, err := s.stockUseCase.ReleaseExpiredReservations(ctxSchema)
+		if err != nil {
+			log.Printf("Scheduler: Error releasing expired reservations in schema %s: %v", schema, err)
+			continue
+		}
+
+		if released > 0 {
+			log.Printf("Scheduler: Released %d expired reservations in schema %s", released, schema)
+		}
+	}
+}
+
+// SendDueReportSubscriptions envia por email os relatórios assinados cujo horário já passou
+func (s *DailyScheduler) SendDueReportSubscriptions(ctx context.Context, now time.Time) {
+	if s.reportSubscriptionUseCase == nil {
+		return
+	}
+
+	var schemas []string
+	if err := s.db.NewRaw("SELECT nspname FROM pg_catalog.pg_namespace WHERE nspname LIKE 'company_%'").Scan(ctx, &schemas); err != nil {
+		log.Printf("Scheduler: Error fetching schemas: %v", err)
+		return
+	}
+
+	for _, schema := range schemas {
+		ctxSchema := context.WithValue(ctx, model.Schema("schema"), schema)
+
+		sent, err := s.reportSubscriptionUseCase.SendDueReportSubscriptions(ctxSchema, now)
+		if err != nil {
+			log.Printf("Scheduler: Error sending report subscriptions in schema %s: %v", schema, err)
+			continue
+		}
+
+		if sent > 0 {
+			log.Printf("Scheduler: Sent %d report subscriptions in schema %s", sent, schema)
+		}
+	}
+}
+
 func (s *DailyScheduler) UpdateCompanyPlans(ctx context.Context) error {
 	return s.companySubscriptionRepo.UpdateCompanyPlans(ctx)
 }
@@ -3,6 +3,7 @@ package emailservice
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"strconv"
@@ -32,8 +33,6 @@ func (s *Service) RunConsumer() error {
 
 	go func() {
 		for d := range msgs {
-			log.Printf("Email Worker: received a message: %s", d.Body)
-
 			var bodyEmail BodyEmail
 			if err := json.Unmarshal(d.Body, &bodyEmail); err != nil {
 				log.Printf("Email Worker: error unmarshaling message: %v", err)
@@ -41,6 +40,9 @@ func (s *Service) RunConsumer() error {
 				continue
 			}
 
+			// Attachments are logged by name only: the body would dump them base64-encoded
+			log.Printf("Email Worker: received a message to %s (%q, %d attachments)", bodyEmail.Email, bodyEmail.Subject, len(bodyEmail.Attachments))
+
 			if err := s.processSendEmail(&bodyEmail); err != nil {
 				log.Printf("Email Worker: error sending email: %v", err)
 				d.Nack(false, true) // Requeue if it fails to send
@@ -79,6 +81,17 @@ func (s *Service) processSendEmail(bodyEmail *BodyEmail) error {
 	m.SetHeader("Subject", bodyEmail.Subject)
 	m.SetBody("text/html", bodyEmail.Body)
 
+	for _, attachment := range bodyEmail.Attachments {
+		content := attachment.Content
+		m.Attach(attachment.Filename,
+			gomail.SetHeader(map[string][]string{"Content-Type": {attachment.ContentType}}),
+			gomail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(content)
+				return err
+			}),
+		)
+	}
+
 	// Configurar servidor SMTP
 	d := gomail.NewDialer(smtpHost, port, senderEmail, senderPass)
 
@@ -0,0 +1,195 @@
+package fiscalprovider
+
+import (
+	"context"
+	"fmt"
+
+	fiscalsettingsentity "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_settings"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe"
+)
+
+// FocusNFeProvider adapts focusnfe.Client to FiscalProvider
+type FocusNFeProvider struct {
+	client *focusnfe.Client
+}
+
+func NewFocusNFeProvider(client *focusnfe.Client) *FocusNFeProvider {
+	return &FocusNFeProvider{client: client}
+}
+
+func (p *FocusNFeProvider) Name() string {
+	return fiscalsettingsentity.ProviderFocusNFe
+}
+
+func (p *FocusNFeProvider) Enabled() bool {
+	return p != nil && p.client.Enabled()
+}
+
+// token selects the company token for the client environment
+func (p *FocusNFeProvider) token(settings *fiscalsettingsentity.FiscalSettings) string {
+	if p.client.GetEnvironment() == "production" {
+		return settings.TokenProduction
+	}
+	return settings.TokenHomologation
+}
+
+func (p *FocusNFeProvider) RegisterCompany(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings) (*CompanyRegistration, error) {
+	regime := "1" // Simples Nacional
+	if settings.TaxRegime == 3 {
+		regime = "3" // Regime Normal
+	}
+
+	req := &focusnfe.CompanyRegistryRequest{
+		Nome:                    settings.BusinessName,
+		NomeFantasia:            settings.TradeName,
+		InscricaoEstadual:       settings.StateRegistration,
+		InscricaoMunicipal:      settings.MunicipalRegistration,
+		CNPJ:                    settings.Cnpj,
+		RegimeTributario:        regime,
+		Email:                   settings.Email,
+		Telefone:                settings.Phone,
+		Logradouro:              settings.Address.Street,
+		Numero:                  settings.Address.Number,
+		Complemento:             settings.Address.Complement,
+		Bairro:                  settings.Address.Neighborhood,
+		CEP:                     settings.Address.Cep,
+		Municipio:               settings.Address.City,
+		UF:                      settings.Address.UF,
+		DiscriminaImpostos:      settings.ShowTaxBreakdown,
+		EnviarEmailDestinatario: settings.SendEmailToRecipient,
+		CscNfceProducao:         settings.CSCProductionCode,
+		IdTokenNfceProducao:     settings.CSCProductionID,
+		CscNfceHomologacao:      settings.CSCHomologationCode,
+		IdTokenNfceHomologacao:  settings.CSCHomologationID,
+	}
+
+	resp, err := p.client.CadastrarEmpresa(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompanyRegistration{
+		RegistryID:        resp.ID,
+		TokenProduction:   resp.TokenProduction,
+		TokenHomologation: resp.TokenHomologation,
+	}, nil
+}
+
+func (p *FocusNFeProvider) EmitNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference string, req *NFCeRequest) (*NFCeResult, error) {
+	items := make([]focusnfe.NFCeItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		quantity, _ := item.Quantity.Float64()
+		unitPrice, _ := item.UnitPrice.Float64()
+		grossValue, _ := item.GrossValue.Float64()
+		approximateTaxes, _ := item.ApproximateTaxes.Float64()
+
+		items = append(items, focusnfe.NFCeItem{
+			NumeroItem:             item.Number,
+			CodigoProduto:          item.ProductCode,
+			Descricao:              item.Description,
+			NCM:                    item.NCM,
+			CFOP:                   item.CFOP,
+			UnidadeComercial:       item.Unit,
+			QuantidadeComercial:    quantity,
+			ValorUnitarioComercial: unitPrice,
+			ValorBruto:             grossValue,
+			ICMSOrigem:             item.ICMSOrigin,
+			ICMSSituacaoTributaria: item.ICMSSituation,
+			ValorTotalTributos:     approximateTaxes,
+		})
+	}
+
+	payments := make([]focusnfe.PaymentMethod, 0, len(req.Payments))
+	for _, payment := range req.Payments {
+		amount, _ := payment.Amount.Float64()
+		payments = append(payments, focusnfe.PaymentMethod{
+			FormaPagamento: payment.Method,
+			ValorPagamento: amount,
+		})
+	}
+
+	presence := "0"
+	if req.InPerson {
+		presence = "1" // Operação presencial
+	}
+
+	focusReq := &focusnfe.NFCeRequest{
+		NaturezaOperacao:  req.Nature,
+		DataEmissao:       req.EmittedAt.Format("2006-01-02T15:04:05-07:00"),
+		Itens:             items,
+		FormasPagamento:   payments,
+		Numero:            fmt.Sprintf("%d", req.Number),
+		Serie:             fmt.Sprintf("%d", req.Series),
+		CNPJ:              req.CNPJ,
+		PresencaComprador: presence,
+
+		InformacoesAdicionaisContribuinte: req.AdditionalInfo,
+	}
+
+	if req.CustomerCPF != "" {
+		focusReq.Cliente = &focusnfe.NFCeClient{CPF: req.CustomerCPF, Nome: req.CustomerName}
+	}
+
+	resp, err := p.client.EmitNFCe(ctx, reference, focusReq, p.token(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	return toNFCeResult(resp), nil
+}
+
+func (p *FocusNFeProvider) SearchNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference string) (*NFCeResult, error) {
+	resp, err := p.client.SearchNFCe(ctx, reference, p.token(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	return toNFCeResult(resp), nil
+}
+
+func (p *FocusNFeProvider) CancelNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference, justification string) (*CancelResult, error) {
+	resp, err := p.client.CancelNFCe(ctx, reference, &focusnfe.CancelRequest{Justificativa: justification}, p.token(settings))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CancelResult{
+		Status:              toStatus(resp.Status),
+		Message:             resp.Mensagem,
+		CancellationXMLPath: resp.CaminhoXMLCancelamento,
+	}, nil
+}
+
+func (p *FocusNFeProvider) DownloadFile(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, path string) ([]byte, error) {
+	return p.client.DownloadFile(ctx, path, p.token(settings))
+}
+
+func toNFCeResult(resp *focusnfe.NFCeResponse) *NFCeResult {
+	message := resp.Mensagem
+	if len(resp.Erros) > 0 {
+		message += fmt.Sprintf(" %s", string(resp.Erros))
+	}
+
+	return &NFCeResult{
+		Status:    toStatus(resp.Status),
+		AccessKey: resp.ChaveNFe,
+		Protocol:  resp.Protocolo,
+		XMLPath:   resp.CaminhoXML,
+		PDFPath:   resp.CaminhoPDF,
+		Message:   message,
+	}
+}
+
+// toStatus maps Focus NFe statuses ("autorizado", "erro_autorizacao", ...)
+func toStatus(status string) Status {
+	switch status {
+	case "autorizado":
+		return StatusAuthorized
+	case "cancelado":
+		return StatusCancelled
+	case "erro_autorizacao", "denegado":
+		return StatusRejected
+	default:
+		return StatusProcessing
+	}
+}
@@ -0,0 +1,160 @@
+package fiscalprovider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	fiscalsettingsentity "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_settings"
+)
+
+var (
+	ErrProviderNotFound   = errors.New("fiscal provider not found")
+	ErrProviderNotEnabled = errors.New("fiscal provider is not configured")
+)
+
+// FiscalProvider is an API able to emit NFC-e (Focus NFe, other Brazilian fiscal APIs,
+// or a direct SEFAZ integration). Credentials come from the company FiscalSettings.
+type FiscalProvider interface {
+	Name() string
+	Enabled() bool
+	RegisterCompany(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings) (*CompanyRegistration, error)
+	EmitNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference string, req *NFCeRequest) (*NFCeResult, error)
+	SearchNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference string) (*NFCeResult, error)
+	CancelNFCe(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, reference, justification string) (*CancelResult, error)
+	DownloadFile(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings, path string) ([]byte, error)
+}
+
+// Status is the provider independent status of an emission
+type Status string
+
+const (
+	StatusAuthorized Status = "authorized"
+	StatusProcessing Status = "processing"
+	StatusRejected   Status = "rejected"
+	StatusCancelled  Status = "cancelled"
+)
+
+// NFCeRequest is the provider independent NFC-e payload
+type NFCeRequest struct {
+	Nature       string // Natureza da operação
+	EmittedAt    time.Time
+	Number       int
+	Series       int
+	CNPJ         string // only digits
+	InPerson     bool   // Presença do comprador
+	Items        []NFCeItem
+	Payments     []NFCePayment
+	CustomerCPF  string
+	CustomerName string
+	// AdditionalInfo is printed on the DANFE (approximate taxes message, for instance)
+	AdditionalInfo string
+}
+
+type NFCeItem struct {
+	Number        int
+	ProductCode   string
+	Description   string
+	NCM           string
+	CFOP          string
+	Unit          string
+	Quantity      decimal.Decimal
+	UnitPrice     decimal.Decimal
+	GrossValue    decimal.Decimal
+	ICMSOrigin    string
+	ICMSSituation string // CSOSN/CST
+	// ApproximateTaxes is the vTotTrib of the item (Lei 12.741/2012)
+	ApproximateTaxes decimal.Decimal
+}
+
+type NFCePayment struct {
+	Method string // SEFAZ tPag code (01, 03, 17...)
+	Amount decimal.Decimal
+}
+
+// NFCeResult is returned by emit and search
+type NFCeResult struct {
+	Status    Status
+	AccessKey string
+	Protocol  string
+	XMLPath   string
+	PDFPath   string
+	Message   string
+}
+
+// CancelResult is returned by cancel
+type CancelResult struct {
+	Status              Status
+	Message             string
+	CancellationXMLPath string
+}
+
+// CompanyRegistration is returned when the company is registered in the provider
+type CompanyRegistration struct {
+	RegistryID        int64
+	TokenProduction   string
+	TokenHomologation string
+}
+
+// Registry resolves the provider configured in FiscalSettings
+type Registry struct {
+	providers   map[string]FiscalProvider
+	defaultName string
+}
+
+// NewRegistry registers the providers; the first one is used when settings don't choose one
+func NewRegistry(providers ...FiscalProvider) *Registry {
+	r := &Registry{providers: map[string]FiscalProvider{}}
+	for _, provider := range providers {
+		if provider == nil {
+			continue
+		}
+		if r.defaultName == "" {
+			r.defaultName = provider.Name()
+		}
+		r.providers[provider.Name()] = provider
+	}
+	return r
+}
+
+// Get returns the provider by name ("" means default)
+func (r *Registry) Get(name string) (FiscalProvider, error) {
+	if r == nil {
+		return nil, ErrProviderNotFound
+	}
+
+	if name == "" {
+		name = r.defaultName
+	}
+
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+	return provider, nil
+}
+
+// For returns the enabled provider selected by the settings
+func (r *Registry) For(settings *fiscalsettingsentity.FiscalSettings) (FiscalProvider, error) {
+	name := ""
+	if settings != nil {
+		name = settings.Provider
+	}
+
+	provider, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !provider.Enabled() {
+		return nil, ErrProviderNotEnabled
+	}
+	return provider, nil
+}
+
+// Has reports if a provider name is registered
+func (r *Registry) Has(name string) bool {
+	_, err := r.Get(name)
+	return err == nil
+}
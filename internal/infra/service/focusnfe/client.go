@@ -21,6 +21,7 @@ const (
 // Client wraps HTTP client for Focus NFe API
 type Client struct {
 	baseURL             string
+	companiesBaseURL    string // Companies API only exists in production
 	httpClient          *http.Client
 	mainProductionToken string
 	environment         string // "production" or "homologation"
@@ -75,6 +76,11 @@ func NewClient() *Client {
 		baseURL = productionBaseURL
 	}
 
+	// Points to a local stand-in (see focusnfetest / "focusnfe-fake" command) in development
+	if customURL := strings.TrimSpace(os.Getenv("FOCUS_NFE_BASE_URL")); customURL != "" {
+		return NewClientWithBaseURL(customURL, token, environment)
+	}
+
 	timeout := defaultTimeout
 	if timeoutStr := os.Getenv("FOCUS_NFE_TIMEOUT"); timeoutStr != "" {
 		if d, err := time.ParseDuration(timeoutStr); err == nil {
@@ -83,7 +89,8 @@ func NewClient() *Client {
 	}
 
 	return &Client{
-		baseURL: baseURL,
+		baseURL:          baseURL,
+		companiesBaseURL: productionBaseURL,
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
@@ -92,6 +99,24 @@ func NewClient() *Client {
 	}
 }
 
+// NewClientWithBaseURL creates a client pointing every endpoint (companies included)
+// to baseURL. Used with the local stand-in server (focusnfetest) in dev and tests.
+func NewClientWithBaseURL(baseURL, token, environment string) *Client {
+	if environment == "" {
+		environment = "homologation"
+	}
+
+	return &Client{
+		baseURL:          strings.TrimRight(baseURL, "/"),
+		companiesBaseURL: strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{
+			Timeout: defaultTimeout,
+		},
+		mainProductionToken: token,
+		environment:         environment,
+	}
+}
+
 // CadastrarEmpresa registers a new company in Focus NFe
 func (c *Client) CadastrarEmpresa(ctx context.Context, req *CompanyRegistryRequest) (*CompanyRegistryResponse, error) {
 	// The Companies API operates EXCLUSIVELY in the production environment.
@@ -106,7 +131,7 @@ func (c *Client) CadastrarEmpresa(ctx context.Context, req *CompanyRegistryReque
 	// For this specific call, we MUST use the production URL, regardless of the environment
 	// We'll temporarily override the base URL for this request
 	originalBaseURL := c.baseURL
-	c.baseURL = productionBaseURL
+	c.baseURL = c.companiesBaseURL
 	defer func() { c.baseURL = originalBaseURL }()
 
 	resp := &CompanyRegistryResponse{}
@@ -174,6 +199,8 @@ type NFCeRequest struct {
 	Numero            string `json:"numero,omitempty"`
 	PresencaComprador string `json:"presenca_comprador,omitempty"` // 1=Presencial
 	CNPJ              string `json:"cnpj_emitente,omitempty"`      // sometimes used if token covers multiple
+	// InformacoesAdicionaisContribuinte is printed on the DANFE (infCpl)
+	InformacoesAdicionaisContribuinte string `json:"informacoes_adicionais_contribuinte,omitempty"`
 }
 
 type NFCeItem struct {
@@ -186,8 +213,9 @@ type NFCeItem struct {
 	ValorUnitarioComercial float64 `json:"valor_unitario_comercial"`
 	ValorBruto             float64 `json:"valor_bruto"` // Qty * UnitPrice
 	NCM                    string  `json:"ncm"`
-	ICMSOrigem             string  `json:"icms_origem"`              // 0
-	ICMSSituacaoTributaria string  `json:"icms_situacao_tributaria"` // 102, etc
+	ICMSOrigem             string  `json:"icms_origem"`                    // 0
+	ICMSSituacaoTributaria string  `json:"icms_situacao_tributaria"`       // 102, etc
+	ValorTotalTributos     float64 `json:"valor_total_tributos,omitempty"` // vTotTrib (Lei 12.741/2012)
 	// PIS/COFINS usually needed too
 }
 
@@ -225,6 +253,13 @@ type CancelRequest struct {
 	Justificativa string `json:"justificativa"`
 }
 
+// CancelResponse represents the cancellation event returned by Focus NFe
+type CancelResponse struct {
+	Status                 string `json:"status"`
+	Mensagem               string `json:"mensagem_sefaz,omitempty"`
+	CaminhoXMLCancelamento string `json:"caminho_xml_cancelamento"`
+}
+
 // EmitNFCe emits a new NFC-e
 // Reference maps "reference" to our internal ID to query later if stuck in processing
 func (c *Client) EmitNFCe(ctx context.Context, reference string, req *NFCeRequest, token string) (*NFCeResponse, error) {
@@ -258,7 +293,7 @@ func (c *Client) SearchNFCe(ctx context.Context, reference string, token string)
 }
 
 // CancelNFCe cancels an NFC-e using its reference (or key)
-func (c *Client) CancelNFCe(ctx context.Context, reference string, req *CancelRequest, token string) error {
+func (c *Client) CancelNFCe(ctx context.Context, reference string, req *CancelRequest, token string) (*CancelResponse, error) {
 	endpoint := fmt.Sprintf("/v2/nfce/%s", reference)
 
 	// Cancellation in Focus NFe: DELETE /v2/nfce/{ref} with body?
@@ -268,14 +303,40 @@ func (c *Client) CancelNFCe(ctx context.Context, reference string, req *CancelRe
 	// Usually DELETE accepts body with justificativa.
 	// Check if doRequest supports body in DELETE.
 
-	resp := &struct {
-		Status string `json:"status"`
-	}{}
+	resp := &CancelResponse{}
 	if err := c.doRequest(ctx, "DELETE", endpoint, req, resp, token); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp, nil
+}
+
+// DownloadFile fetches a file (XML/DANFE) by the path returned in NFCeResponse or CancelResponse.
+// Focus returns relative paths like "/arquivos/..."; absolute URLs are used as they come.
+func (c *Client) DownloadFile(ctx context.Context, path string, token string) ([]byte, error) {
+	url := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		url = c.baseURL + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.SetBasicAuth(token, "")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("download error (status %d): %s", resp.StatusCode, path)
+	}
+
+	return io.ReadAll(resp.Body)
 }
 
 // Enabled checks if client is properly configured with credentials
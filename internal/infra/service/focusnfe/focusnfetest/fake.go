@@ -0,0 +1,427 @@
+// Package focusnfetest provides an in-process stand-in for the Focus NFe HTTP API.
+//
+// It understands the endpoints used by focusnfe.Client (emit, search, cancel, companies
+// and file downloads), keeps every note in memory and produces deterministic access keys,
+// so emission flows can be exercised in tests and local development without SEFAZ.
+package focusnfetest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe"
+)
+
+// Outcome is the status the fake answers for the next emission
+type Outcome string
+
+const (
+	OutcomeAuthorize  Outcome = "autorizado"
+	OutcomeReject     Outcome = "erro_autorizacao"
+	OutcomeProcessing Outcome = "processando_autorizacao"
+)
+
+const (
+	statusCancelled = "cancelado"
+
+	// DefaultUFCode is the IBGE state code used in generated access keys (35 = SP)
+	DefaultUFCode = "35"
+
+	// RejectionMessage is returned for rejected emissions
+	RejectionMessage = "Rejeição: Informado NCM inexistente"
+)
+
+var onlyDigits = regexp.MustCompile("[^0-9]+")
+
+// Note is the state the fake keeps for each reference
+type Note struct {
+	Reference           string
+	Request             focusnfe.NFCeRequest
+	Status              string
+	AccessKey           string
+	Protocol            string
+	XMLPath             string
+	PDFPath             string
+	CancellationXMLPath string
+	Justification       string
+	Message             string
+	pendingPolls        int
+}
+
+// Fake implements http.Handler with the Focus NFe endpoints
+type Fake struct {
+	mu           sync.Mutex
+	mux          *http.ServeMux
+	notes        map[string]*Note
+	files        map[string][]byte
+	companies    []focusnfe.CompanyRegistryRequest
+	nextOutcomes []Outcome
+
+	// DefaultOutcome is used when no outcome was queued with QueueOutcome
+	DefaultOutcome Outcome
+
+	// ProcessingPolls is how many searches a processing note takes to be authorized
+	ProcessingPolls int
+
+	// UFCode is the state code used in access keys
+	UFCode string
+}
+
+// NewFake creates a fake that authorizes every emission by default
+func NewFake() *Fake {
+	f := &Fake{
+		mux:             http.NewServeMux(),
+		notes:           map[string]*Note{},
+		files:           map[string][]byte{},
+		DefaultOutcome:  OutcomeAuthorize,
+		ProcessingPolls: 1,
+		UFCode:          DefaultUFCode,
+	}
+
+	f.mux.HandleFunc("POST /v2/nfce", f.handleEmit)
+	f.mux.HandleFunc("GET /v2/nfce/{ref}", f.handleSearch)
+	f.mux.HandleFunc("DELETE /v2/nfce/{ref}", f.handleCancel)
+	f.mux.HandleFunc("POST /v2/empresas", f.handleRegisterCompany)
+	f.mux.HandleFunc("GET /arquivos/", f.handleFile)
+
+	return f
+}
+
+// Server is a Fake listening on a local httptest server
+type Server struct {
+	*Fake
+	*httptest.Server
+}
+
+// NewServer starts a fake on a random local port. Call Close when done.
+func NewServer() *Server {
+	fake := NewFake()
+	return &Server{Fake: fake, Server: httptest.NewServer(fake)}
+}
+
+// FocusClient returns a focusnfe.Client pointing to the server
+func (s *Server) FocusClient(mainToken string) *focusnfe.Client {
+	return focusnfe.NewClientWithBaseURL(s.URL, mainToken, "homologation")
+}
+
+// QueueOutcome sets the result of the next emissions, in order
+func (f *Fake) QueueOutcome(outcomes ...Outcome) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.nextOutcomes = append(f.nextOutcomes, outcomes...)
+}
+
+// Note returns a copy of the stored note for reference
+func (f *Fake) Note(reference string) (Note, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	note, ok := f.notes[reference]
+	if !ok {
+		return Note{}, false
+	}
+	return *note, true
+}
+
+// Companies returns the companies registered through /v2/empresas
+func (f *Fake) Companies() []focusnfe.CompanyRegistryRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]focusnfe.CompanyRegistryRequest{}, f.companies...)
+}
+
+func (f *Fake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if token, _, ok := r.BasicAuth(); !ok || token == "" {
+		writeJSON(w, http.StatusUnauthorized, apiError("acesso_nao_autorizado", "Token de acesso inválido"))
+		return
+	}
+
+	f.mux.ServeHTTP(w, r)
+}
+
+func (f *Fake) handleEmit(w http.ResponseWriter, r *http.Request) {
+	reference := r.URL.Query().Get("ref")
+	if reference == "" {
+		writeJSON(w, http.StatusBadRequest, apiError("requisicao_invalida", "Parâmetro ref não informado"))
+		return
+	}
+
+	req := focusnfe.NFCeRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError("requisicao_invalida", err.Error()))
+		return
+	}
+
+	if err := validateRequest(&req); err != nil {
+		writeJSON(w, http.StatusUnprocessableEntity, apiError("requisicao_invalida", err.Error()))
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Focus answers the current state when the reference was already sent
+	if note, ok := f.notes[reference]; ok {
+		writeJSON(w, http.StatusOK, noteResponse(note))
+		return
+	}
+
+	note := &Note{Reference: reference, Request: req}
+	f.notes[reference] = note
+
+	outcome := f.DefaultOutcome
+	if len(f.nextOutcomes) > 0 {
+		outcome = f.nextOutcomes[0]
+		f.nextOutcomes = f.nextOutcomes[1:]
+	}
+
+	for _, item := range req.Itens {
+		if len(onlyDigits.ReplaceAllString(item.NCM, "")) != 8 {
+			outcome = OutcomeReject
+		}
+	}
+
+	switch outcome {
+	case OutcomeReject:
+		note.Status = string(OutcomeReject)
+		note.Message = RejectionMessage
+	case OutcomeProcessing:
+		note.Status = string(OutcomeProcessing)
+		note.pendingPolls = f.ProcessingPolls
+	default:
+		f.authorize(note)
+	}
+
+	writeJSON(w, http.StatusCreated, noteResponse(note))
+}
+
+func (f *Fake) handleSearch(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	note, ok := f.notes[r.PathValue("ref")]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, apiError("nao_encontrado", "Nota fiscal não encontrada"))
+		return
+	}
+
+	if note.Status == string(OutcomeProcessing) {
+		note.pendingPolls--
+		if note.pendingPolls <= 0 {
+			f.authorize(note)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, noteResponse(note))
+}
+
+func (f *Fake) handleCancel(w http.ResponseWriter, r *http.Request) {
+	req := focusnfe.CancelRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError("requisicao_invalida", err.Error()))
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	note, ok := f.notes[r.PathValue("ref")]
+	if !ok {
+		writeJSON(w, http.StatusNotFound, apiError("nao_encontrado", "Nota fiscal não encontrada"))
+		return
+	}
+
+	if size := len([]rune(req.Justificativa)); size < 15 || size > 255 {
+		writeJSON(w, http.StatusUnprocessableEntity, apiError("requisicao_invalida", "Justificativa deve ter entre 15 e 255 caracteres"))
+		return
+	}
+
+	if note.Status != string(OutcomeAuthorize) {
+		writeJSON(w, http.StatusUnprocessableEntity, apiError("requisicao_invalida", "Nota fiscal não autorizada não pode ser cancelada"))
+		return
+	}
+
+	note.Status = statusCancelled
+	note.Justification = req.Justificativa
+	note.CancellationXMLPath = f.filePath(note, "can")
+	f.files[note.CancellationXMLPath] = cancellationXML(note)
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"status":                   statusCancelled,
+		"status_sefaz":             "135",
+		"mensagem_sefaz":           "Evento registrado e vinculado a NF-e",
+		"caminho_xml_cancelamento": note.CancellationXMLPath,
+	})
+}
+
+func (f *Fake) handleRegisterCompany(w http.ResponseWriter, r *http.Request) {
+	req := focusnfe.CompanyRegistryRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, apiError("requisicao_invalida", err.Error()))
+		return
+	}
+
+	cnpj := onlyDigits.ReplaceAllString(req.CNPJ, "")
+	if len(cnpj) != 14 {
+		writeJSON(w, http.StatusUnprocessableEntity, apiError("requisicao_invalida", "CNPJ inválido"))
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.companies = append(f.companies, req)
+
+	writeJSON(w, http.StatusOK, focusnfe.CompanyRegistryResponse{
+		ID:                int64(len(f.companies)),
+		TokenProduction:   "prod-" + cnpj,
+		TokenHomologation: "hom-" + cnpj,
+	})
+}
+
+func (f *Fake) handleFile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	content, ok := f.files[r.URL.Path]
+	f.mu.Unlock()
+
+	if !ok {
+		writeJSON(w, http.StatusNotFound, apiError("nao_encontrado", "Arquivo não encontrado"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+// authorize fills the key, protocol and files of a note. Caller must hold f.mu.
+func (f *Fake) authorize(note *Note) {
+	note.Status = string(OutcomeAuthorize)
+	note.AccessKey = AccessKey(f.UFCode, &note.Request, note.Reference)
+	note.Protocol = "1" + note.AccessKey[2:4] + note.AccessKey[len(note.AccessKey)-12:]
+	note.Message = "Autorizado o uso da NF-e"
+	note.XMLPath = f.filePath(note, "nfe")
+	note.PDFPath = "/notas_fiscais_consumidor/NFe" + note.AccessKey + ".html"
+	f.files[note.XMLPath] = authorizedXML(note)
+}
+
+func (f *Fake) filePath(note *Note, suffix string) string {
+	cnpj := onlyDigits.ReplaceAllString(note.Request.CNPJ, "")
+	return fmt.Sprintf("/arquivos/%s/%s-%s.xml", cnpj, note.AccessKey, suffix)
+}
+
+// AccessKey builds the 44-digit key (cUF, AAMM, CNPJ, modelo 65, série, número, tpEmis, cNF, DV).
+// cNF comes from the reference, so the same request and reference always produce the same key.
+func AccessKey(ufCode string, req *focusnfe.NFCeRequest, reference string) string {
+	yearMonth := "0000"
+	if emittedAt, err := time.Parse(time.RFC3339, req.DataEmissao); err == nil {
+		yearMonth = emittedAt.Format("0601")
+	}
+
+	series, _ := strconv.Atoi(req.Serie)
+	number, _ := strconv.Atoi(req.Numero)
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(reference))
+	code := hash.Sum32() % 100000000
+
+	cnpj := fmt.Sprintf("%014s", onlyDigits.ReplaceAllString(req.CNPJ, ""))
+	key := fmt.Sprintf("%02s%s%s65%03d%09d1%08d", ufCode, yearMonth, cnpj, series, number, code)
+	return key + strconv.Itoa(checkDigit(key))
+}
+
+// checkDigit is the módulo 11 digit used by SEFAZ (weights 2..9 from right to left)
+func checkDigit(key string) int {
+	sum, weight := 0, 2
+	for i := len(key) - 1; i >= 0; i-- {
+		sum += int(key[i]-'0') * weight
+		weight++
+		if weight > 9 {
+			weight = 2
+		}
+	}
+
+	digit := 11 - sum%11
+	if digit >= 10 {
+		return 0
+	}
+	return digit
+}
+
+func validateRequest(req *focusnfe.NFCeRequest) error {
+	if len(onlyDigits.ReplaceAllString(req.CNPJ, "")) != 14 {
+		return errors.New("cnpj_emitente inválido")
+	}
+
+	if len(req.Itens) == 0 {
+		return errors.New("nota fiscal sem itens")
+	}
+
+	if len(req.FormasPagamento) == 0 && req.Pagamento == nil {
+		return errors.New("formas_pagamento não informadas")
+	}
+
+	return nil
+}
+
+func noteResponse(note *Note) map[string]interface{} {
+	resp := map[string]interface{}{
+		"status":     note.Status,
+		"referencia": note.Reference,
+		"numero":     note.Request.Numero,
+		"serie":      note.Request.Serie,
+	}
+
+	if note.Message != "" {
+		resp["mensagem_sefaz"] = note.Message
+	}
+
+	if note.AccessKey != "" {
+		resp["chave_nfe"] = note.AccessKey
+		resp["protocolo"] = note.Protocol
+		resp["caminho_xml_nota_fiscal"] = note.XMLPath
+		resp["caminho_danfe"] = note.PDFPath
+	}
+
+	if note.CancellationXMLPath != "" {
+		resp["caminho_xml_cancelamento"] = note.CancellationXMLPath
+	}
+
+	return resp
+}
+
+func authorizedXML(note *Note) []byte {
+	items := strings.Builder{}
+	for _, item := range note.Request.Itens {
+		fmt.Fprintf(&items, `<det nItem="%d"><prod><cProd>%s</cProd><xProd>%s</xProd><NCM>%s</NCM><CFOP>%s</CFOP><qCom>%.4f</qCom><vUnCom>%.2f</vUnCom><vProd>%.2f</vProd></prod></det>`,
+			item.NumeroItem, item.CodigoProduto, html.EscapeString(item.Descricao), item.NCM, item.CFOP, item.QuantidadeComercial, item.ValorUnitarioComercial, item.ValorBruto)
+	}
+
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><nfeProc versao="4.00"><NFe><infNFe Id="NFe%s"><ide><mod>65</mod><serie>%s</serie><nNF>%s</nNF></ide><emit><CNPJ>%s</CNPJ></emit>%s</infNFe></NFe><protNFe><infProt><chNFe>%s</chNFe><nProt>%s</nProt><cStat>100</cStat></infProt></protNFe></nfeProc>`,
+		note.AccessKey, note.Request.Serie, note.Request.Numero, note.Request.CNPJ, items.String(), note.AccessKey, note.Protocol))
+}
+
+func cancellationXML(note *Note) []byte {
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?><procEventoNFe versao="1.00"><evento><infEvento><chNFe>%s</chNFe><tpEvento>110111</tpEvento><detEvento><descEvento>Cancelamento</descEvento><nProt>%s</nProt><xJust>%s</xJust></detEvento></infEvento></evento><retEvento><infEvento><cStat>135</cStat></infEvento></retEvento></procEventoNFe>`,
+		note.AccessKey, note.Protocol, html.EscapeString(note.Justification)))
+}
+
+func apiError(code, message string) map[string]string {
+	return map[string]string{"codigo": code, "mensagem": message}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
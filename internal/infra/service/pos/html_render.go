@@ -12,20 +12,20 @@ import (
 	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
 )
 
-// RenderShiftHTML returns the rendered HTML for a shift report
-func RenderShiftHTML(shift *shiftentity.Shift) ([]byte, error) {
+// RenderShiftHTML returns the rendered HTML for a shift report, with times in the company time zone
+func RenderShiftHTML(shift *shiftentity.Shift, loc *time.Location) ([]byte, error) {
 	funcMap := template.FuncMap{
 		"formatDate": func(t *time.Time) string {
 			if t == nil {
 				return ""
 			}
-			return t.Format("02/01/2006 15:04")
+			return t.In(loc).Format("02/01/2006 15:04")
 		},
 		"formatMoney": func(d decimal.Decimal) string {
 			return "R$ " + d.StringFixed(2)
 		},
 		"now": func() string {
-			return time.Now().Format("02/01/2006 15:04:05")
+			return time.Now().In(loc).Format("02/01/2006 15:04:05")
 		},
 	}
 
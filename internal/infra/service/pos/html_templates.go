@@ -208,7 +208,7 @@ const OrderReceiptTemplate = `
             {{range .Items}}
                 <div class="row">
                     <span class="col-name">{{.Quantity}}x {{.Name}}</span>
-                    <span class="col-price">{{ multiply .Price .Quantity | formatMoney }}</span>
+                    <span class="col-price">{{ multiply .SubTotal .Quantity | formatMoney }}</span>
                 </div>
                 {{range .AdditionalItems}}
                     <div class="row">
@@ -224,6 +224,9 @@ const OrderReceiptTemplate = `
                 {{if .Observation}}
                     <div class="obs">Obs: {{.Observation}}</div>
                 {{end}}
+                {{with .TotalApproximateTaxes}}
+                    <div class="obs">Trib. aprox.: {{formatMoney .Total}}</div>
+                {{end}}
             {{end}}
             
             {{if .ComplementItem}}
@@ -253,6 +256,29 @@ const OrderReceiptTemplate = `
         </div>
     </div>
 
+    {{with .ApproximateTaxes}}
+    <div class="divider"></div>
+    <div class="header bold">TRIBUTOS APROXIMADOS</div>
+    <div class="row">
+        <span class="col-name">Federal:</span>
+        <span class="col-price">{{formatMoney .Federal}}</span>
+    </div>
+    <div class="row">
+        <span class="col-name">Estadual:</span>
+        <span class="col-price">{{formatMoney .State}}</span>
+    </div>
+    <div class="row">
+        <span class="col-name">Municipal:</span>
+        <span class="col-price">{{formatMoney .Municipal}}</span>
+    </div>
+    <div class="row bold">
+        <span class="col-name">Total:</span>
+        <span class="col-price">{{formatMoney .Total}}</span>
+    </div>
+    <div class="obs">Fonte: {{.Source}} (Lei 12.741/2012)</div>
+    <div class="divider"></div>
+    {{end}}
+
     <div class="payments">
         {{range .Payments}}
             <div class="row">
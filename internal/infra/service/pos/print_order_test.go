@@ -223,3 +223,41 @@ func Test_FormatOrder(t *testing.T) {
 	// Deve terminar com código de corte
 	assert.True(t, strings.HasSuffix(s, escCut))
 }
+
+// Test FormatOrder and RenderOrderHTML print the approximate taxes when calculated.
+func Test_FormatOrder_TaxBreakdown(t *testing.T) {
+	item := orderentity.NewItem("Pizza", decimal.NewFromFloat(50), 1, "M", uuid.New(), uuid.New(), uuid.New(), nil)
+	item.Taxes = &orderentity.ApproximateTaxes{
+		Federal:   decimal.NewFromFloat(6.73),
+		State:     decimal.NewFromFloat(9),
+		Municipal: decimal.Zero,
+		Source:    "IBPT",
+	}
+
+	group := orderentity.GroupItem{}
+	group.Items = []orderentity.Item{*item}
+
+	o := &orderentity.Order{}
+	o.OrderNumber = 2
+	o.GroupItems = []orderentity.GroupItem{group}
+
+	out, err := FormatOrder(o, nil)
+	assert.NoError(t, err)
+	s := string(out)
+	assert.Contains(t, s, "TRIB. APROX.:")
+	assert.Contains(t, s, "R$   15.73")
+	assert.Contains(t, s, "TRIBUTOS APROXIMADOS")
+	assert.Contains(t, s, "FEDERAL:")
+	assert.Contains(t, s, "FONTE: IBPT")
+
+	html, err := RenderOrderHTML(o, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, string(html), "Trib. aprox.: R$ 15.73")
+	assert.Contains(t, string(html), "Fonte: IBPT (Lei 12.741/2012)")
+
+	// Sem cálculo de tributos a seção não aparece
+	o.GroupItems[0].Items[0].Taxes = nil
+	out, err = FormatOrder(o, nil)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(out), "TRIBUTOS APROXIMADOS")
+}
@@ -53,6 +53,7 @@ func FormatOrder(o *orderentity.Order, company *companydto.CompanyDTO) ([]byte,
 
 	printGroupItemsSection(&bodyRaw, o.GroupItems)
 	formatTotalFooter(&bodyRaw, o)
+	formatTaxBreakdownSection(&bodyRaw, o)
 	formatPaymentsSection(&bodyRaw, o)
 	formatOrderValuesFooter(&bodyRaw, o)
 	bodyRaw.WriteString(strings.Repeat(newline, 3))
@@ -183,6 +184,11 @@ func printItem(buf *bytes.Buffer, item *orderentity.Item) {
 		printAdditionalItem(buf, &add)
 	}
 
+	// Approximate taxes (item + additional items)
+	if taxes := item.TotalApproximateTaxes(); taxes != nil {
+		fmt.Fprintf(buf, "TRIB. APROX.:\tR$ %7.2f%s", d2f(taxes.Total()), newline)
+	}
+
 	// Removed items for item
 	if len(item.RemovedItems) > 0 {
 		for _, rm := range item.RemovedItems {
@@ -212,6 +218,24 @@ func formatTotalFooter(buf *bytes.Buffer, o *orderentity.Order) {
 	fmt.Fprintf(buf, "TOTAL:\tR$ %7.2f%s", d2f(o.SubTotal), newline)
 }
 
+// formatTaxBreakdownSection prints the approximate taxes of the order (Lei 12.741/2012), when calculated.
+func formatTaxBreakdownSection(buf *bytes.Buffer, o *orderentity.Order) {
+	taxes := o.ApproximateTaxes()
+	if taxes == nil {
+		return
+	}
+
+	fmt.Fprintf(buf, escAlignCenter)
+	fmt.Fprintf(buf, "TRIBUTOS APROXIMADOS"+newline)
+	fmt.Fprintf(buf, escAlignLeft)
+	fmt.Fprintf(buf, "FEDERAL:\tR$ %7.2f%s", d2f(taxes.Federal), newline)
+	fmt.Fprintf(buf, "ESTADUAL:\tR$ %7.2f%s", d2f(taxes.State), newline)
+	fmt.Fprintf(buf, "MUNICIPAL:\tR$ %7.2f%s", d2f(taxes.Municipal), newline)
+	fmt.Fprintf(buf, "TOTAL:\tR$ %7.2f%s", d2f(taxes.Total()), newline)
+	fmt.Fprintf(buf, "FONTE: %s (LEI 12.741/2012)%s", taxes.Source, newline)
+	fmt.Fprintf(buf, strings.Repeat("-", 40)+newline)
+}
+
 // formatDeliverySection prints delivery-related details if present.
 func formatDeliverySection(buf *bytes.Buffer, o *orderentity.Order, company *companydto.CompanyDTO) {
 	if o.Delivery == nil {
@@ -5,13 +5,15 @@ import (
 	"fmt"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/shopspring/decimal"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 	shiftentity "github.com/willjrcom/sales-backend-go/internal/domain/shift"
 )
 
-func FormatShift(shift *shiftentity.Shift) ([]byte, error) {
+// FormatShift renders the shift report for ESC/POS printers, with times in the company time zone
+func FormatShift(shift *shiftentity.Shift, loc *time.Location) ([]byte, error) {
 	var buf bytes.Buffer
 	buf.WriteString(escInit)
 
@@ -21,7 +23,7 @@ func FormatShift(shift *shiftentity.Shift) ([]byte, error) {
 	raw.WriteString("RELATORIO DE PEDIDOS")
 	raw.WriteString(escBoldOff)
 	raw.WriteString(newline)
-	fmt.Fprintf(&raw, "Abertura: %s%s", shift.OpenedAt.Format("02/01/2006 15:04"), newline)
+	fmt.Fprintf(&raw, "Abertura: %s%s", shift.OpenedAt.In(loc).Format("02/01/2006 15:04"), newline)
 
 	raw.WriteString(escAlignLeft)
 	raw.WriteString(strings.Repeat("-", 40) + newline)
@@ -3,6 +3,7 @@ package pos
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
@@ -47,7 +48,7 @@ func Test_FormatShift(t *testing.T) {
 		},
 	}
 
-	out, err := FormatShift(shift)
+	out, err := FormatShift(shift, time.UTC)
 	assert.NoError(t, err)
 	if err := os.WriteFile("printer_shift.txt", out, 0644); err != nil {
 		t.Fatalf("failed to write printer buffer to file: %v", err)
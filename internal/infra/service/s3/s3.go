@@ -1,10 +1,13 @@
 package s3service
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"mime/multipart"
+	"net/url"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -63,6 +66,52 @@ func (s *S3Client) UploadFile(file *multipart.File) (*string, error) {
 	return &key, nil
 }
 
+// UploadBytes envia um conteúdo privado (ex.: zip de XMLs, boletos) com uma chave definida;
+// a leitura é feita só por URL assinada (PresignedURL)
+func (s *S3Client) UploadBytes(key string, data []byte, contentType string) (*string, error) {
+	uploadInput := &s3.PutObjectInput{
+		Bucket:      aws.String(s.BucketName),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+		ACL:         types.ObjectCannedACLPrivate,
+	}
+
+	if _, err := s.Client.PutObject(context.TODO(), uploadInput); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func bucketHost() string {
+	return fmt.Sprintf("%s.s3.%s.amazonaws.com", bucketName, region)
+}
+
+// IsBucketURL indica se a URL aponta para um objeto deste bucket; URLs informadas pela empresa
+// (ex.: logo) só são baixadas pelo servidor quando passam aqui
+func IsBucketURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "https" && u.Host == bucketHost() && u.User == nil && len(u.Path) > 1
+}
+
+// PresignedURL retorna uma URL de leitura de um objeto privado que expira após expires
+func (s *S3Client) PresignedURL(ctx context.Context, key string, expires time.Duration) (string, error) {
+	request, err := s3.NewPresignClient(s.Client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.BucketName),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL, nil
+}
+
 func (s *S3Client) ListObjects() error {
 	// Liste os objetos no bucket
 	output, err := s.Client.ListObjectsV2(context.TODO(), &s3.ListObjectsV2Input{
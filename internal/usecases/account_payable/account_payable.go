@@ -0,0 +1,215 @@
+package accountpayableusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+)
+
+var ErrExpenseCategoryNotFound = errors.New("expense category not found")
+
+// recurringHorizon é a antecedência com que as contas recorrentes aparecem em aberto
+const recurringHorizon = 30 * 24 * time.Hour
+
+type Service struct {
+	db        *bun.DB
+	r         model.AccountPayableRepository
+	rcategory model.ExpenseCategoryRepository
+	s3        *s3service.S3Client
+}
+
+func NewService(db *bun.DB, r model.AccountPayableRepository, rcategory model.ExpenseCategoryRepository) *Service {
+	return &Service{db: db, r: r, rcategory: rcategory}
+}
+
+func (s *Service) AddDependencies(s3 *s3service.S3Client) {
+	s.s3 = s3
+}
+
+// CreateAccountPayable lança uma conta manual; contas de pedido de compra nascem no recebimento
+func (s *Service) CreateAccountPayable(ctx context.Context, dto *accountpayabledto.AccountPayableCreateDTO) (uuid.UUID, error) {
+	payable, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.checkCategory(ctx, payable.CategoryID); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.createAccountPayable(ctx, payable); err != nil {
+		return uuid.Nil, err
+	}
+
+	return payable.ID, nil
+}
+
+func (s *Service) UpdateAccountPayable(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountpayabledto.AccountPayableUpdateDTO) error {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	payable := payableModel.ToDomain()
+	if err := dto.UpdateDomain(payable); err != nil {
+		return err
+	}
+
+	if err := s.checkCategory(ctx, payable.CategoryID); err != nil {
+		return err
+	}
+
+	payableModel.FromDomain(payable)
+	return s.r.UpdateAccountPayable(ctx, payableModel)
+}
+
+func (s *Service) GetAccountPayableById(ctx context.Context, dto *entitydto.IDRequest) (*accountpayabledto.AccountPayableDTO, error) {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAccountPayableDTO(ctx, payableModel), nil
+}
+
+func (s *Service) GetAllAccountPayables(ctx context.Context, page, perPage int, status string, dueFrom, dueTo *time.Time, categoryID string) ([]accountpayabledto.AccountPayableDTO, int, error) {
+	payableModels, count, err := s.r.GetAllAccountPayables(ctx, page, perPage, status, dueFrom, dueTo, categoryID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	payableDTOs := []accountpayabledto.AccountPayableDTO{}
+	for i := range payableModels {
+		payableDTOs = append(payableDTOs, *s.toAccountPayableDTO(ctx, &payableModels[i]))
+	}
+
+	return payableDTOs, count, nil
+}
+
+func (s *Service) PayAccountPayable(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountpayabledto.AccountPayablePayDTO) error {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	paidAt := time.Now().UTC()
+	if dto.PaidAt != nil {
+		paidAt = *dto.PaidAt
+	}
+
+	payable := payableModel.ToDomain()
+	if err := payable.Pay(dto.PaidAmount, paidAt); err != nil {
+		return err
+	}
+
+	payableModel.FromDomain(payable)
+	return s.r.UpdateAccountPayable(ctx, payableModel)
+}
+
+func (s *Service) CancelAccountPayable(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	payable := payableModel.ToDomain()
+	if err := payable.Cancel(); err != nil {
+		return err
+	}
+
+	payableModel.FromDomain(payable)
+	return s.r.UpdateAccountPayable(ctx, payableModel)
+}
+
+// GenerateRecurringAccountPayables cria as próximas contas das séries recorrentes até recurringHorizon
+// depois de now e devolve quantas foram criadas. Uma conta cancelada não interrompe a série.
+func (s *Service) GenerateRecurringAccountPayables(ctx context.Context, now time.Time) (int, error) {
+	latestModels, err := s.r.GetLatestRecurringAccountPayables(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	horizon := now.Add(recurringHorizon)
+	created := 0
+	for i := range latestModels {
+		latest := latestModels[i].ToDomain()
+
+		anchor := latest.DueDate
+		if latest.RecurrenceParentID != nil {
+			parentModel, err := s.r.GetAccountPayableById(ctx, latest.RecurrenceParentID.String())
+			if err != nil {
+				fmt.Printf("error fetching first account payable of series %s: %v\n", latest.SeriesID(), err)
+				continue
+			}
+			anchor = parentModel.DueDate
+		}
+
+		for !latest.DueDate.After(horizon) {
+			next := latest.NextOccurrence(anchor)
+			if next == nil {
+				break
+			}
+
+			if err := s.createAccountPayable(ctx, next); err != nil {
+				fmt.Printf("error creating recurring account payable of series %s: %v\n", latest.SeriesID(), err)
+				break
+			}
+
+			created++
+			latest = next
+		}
+	}
+
+	return created, nil
+}
+
+func (s *Service) createAccountPayable(ctx context.Context, payable *accountpayableentity.AccountPayable) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	payableModel := &model.AccountPayable{}
+	payableModel.FromDomain(payable)
+	if err := s.r.CreateAccountPayable(ctx, tx, payableModel); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) checkCategory(ctx context.Context, categoryID *uuid.UUID) error {
+	if categoryID == nil {
+		return nil
+	}
+
+	if _, err := s.rcategory.GetExpenseCategoryById(ctx, categoryID.String()); err != nil {
+		return ErrExpenseCategoryNotFound
+	}
+	return nil
+}
+
+func (s *Service) toAccountPayableDTO(ctx context.Context, payableModel *model.AccountPayable) *accountpayabledto.AccountPayableDTO {
+	payableDTO := &accountpayabledto.AccountPayableDTO{}
+	payableDTO.FromDomain(payableModel.ToDomain())
+	if payableModel.Supplier != nil {
+		payableDTO.SupplierName = payableModel.Supplier.Name
+	}
+	if payableModel.Category != nil {
+		payableDTO.CategoryName = payableModel.Category.Name
+	}
+	FillAttachmentURLs(ctx, s.s3, payableDTO.Attachments)
+	return payableDTO
+}
@@ -0,0 +1,182 @@
+package accountpayableusecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+)
+
+var (
+	ErrStorageNotConfigured = errors.New("attachment storage not configured")
+	ErrAttachmentTooLarge   = errors.New("attachment must be up to 10 MB")
+	ErrAttachmentType       = errors.New("attachment must be a PDF, PNG, JPEG or XML file")
+)
+
+const (
+	// MaxAttachmentSize limita boletos, notas e comprovantes anexados
+	MaxAttachmentSize = 10 << 20
+	// attachmentURLExpiration é a validade do link de download devolvido na conta
+	attachmentURLExpiration = 15 * time.Minute
+)
+
+// AttachmentUpload é o arquivo recebido pelo handler; o tipo sai do conteúdo (sniffAttachmentType)
+type AttachmentUpload struct {
+	Filename string
+	Content  io.Reader
+}
+
+// UploadAttachment envia o arquivo ao S3 sob a pasta da conta (a pagar ou a receber) e devolve o anexo
+func UploadAttachment(ctx context.Context, s3 *s3service.S3Client, ownerID uuid.UUID, upload *AttachmentUpload) (*accountpayableentity.Attachment, error) {
+	if s3 == nil {
+		return nil, ErrStorageNotConfigured
+	}
+
+	schemaName, err := database.GetCurrentSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(upload.Content, MaxAttachmentSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > MaxAttachmentSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	contentType, ok := sniffAttachmentType(content)
+	if !ok {
+		return nil, ErrAttachmentType
+	}
+
+	attachment, err := accountpayableentity.NewAttachment(schemaName, ownerID, upload.Filename, contentType, int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s3.UploadBytes(attachment.Key, content, contentType); err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+
+	return attachment, nil
+}
+
+// DeleteAttachmentFile apaga o arquivo no S3; falha só é registrada, o anexo já saiu da conta
+func DeleteAttachmentFile(s3 *s3service.S3Client, attachment *accountpayableentity.Attachment) {
+	if s3 == nil || attachment == nil {
+		return
+	}
+
+	if err := s3.DeleteObject(attachment.Key); err != nil {
+		fmt.Printf("error deleting attachment %s: %v\n", attachment.Key, err)
+	}
+}
+
+// sniffAttachmentType identifica o arquivo pelos primeiros bytes; só PDF, PNG, JPEG e XML (NF-e) são aceitos
+func sniffAttachmentType(content []byte) (string, bool) {
+	switch detected := http.DetectContentType(content); detected {
+	case "application/pdf", "image/png", "image/jpeg":
+		return detected, true
+	case "text/xml; charset=utf-8", "text/plain; charset=utf-8":
+		// XML sem prólogo é detectado como texto; HTML já sai como text/html e fica de fora
+		if isXML(content) {
+			return "application/xml", true
+		}
+	}
+	return "", false
+}
+
+// isXML aceita o arquivo quando o primeiro elemento do documento é lido sem erro
+func isXML(content []byte) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	// só o primeiro elemento importa: a codificação declarada não precisa ser convertida
+	decoder.CharsetReader = func(_ string, input io.Reader) (io.Reader, error) { return input, nil }
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			return true
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return false
+			}
+		}
+	}
+}
+
+// FillAttachmentURLs preenche cada anexo com um link assinado que expira; os arquivos são privados no S3
+func FillAttachmentURLs(ctx context.Context, s3 *s3service.S3Client, attachments []accountpayabledto.AttachmentDTO) {
+	if s3 == nil {
+		return
+	}
+
+	for i := range attachments {
+		url, err := s3.PresignedURL(ctx, attachments[i].Key, attachmentURLExpiration)
+		if err != nil {
+			fmt.Printf("error signing attachment %s: %v\n", attachments[i].Key, err)
+			continue
+		}
+		attachments[i].URL = url
+	}
+}
+
+func (s *Service) AddAccountPayableAttachment(ctx context.Context, dtoId *entitydto.IDRequest, upload *AttachmentUpload) (*accountpayabledto.AccountPayableDTO, error) {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := UploadAttachment(ctx, s.s3, payableModel.ID, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	payable := payableModel.ToDomain()
+	payable.AddAttachment(*attachment)
+
+	payableModel.FromDomain(payable)
+	if err := s.r.UpdateAccountPayable(ctx, payableModel); err != nil {
+		DeleteAttachmentFile(s.s3, attachment)
+		return nil, err
+	}
+
+	return s.toAccountPayableDTO(ctx, payableModel), nil
+}
+
+func (s *Service) RemoveAccountPayableAttachment(ctx context.Context, dtoId *entitydto.IDRequest, attachmentID uuid.UUID) error {
+	payableModel, err := s.r.GetAccountPayableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	payable := payableModel.ToDomain()
+	removed, err := payable.RemoveAttachment(attachmentID)
+	if err != nil {
+		return err
+	}
+
+	payableModel.FromDomain(payable)
+	if err := s.r.UpdateAccountPayable(ctx, payableModel); err != nil {
+		return err
+	}
+
+	DeleteAttachmentFile(s.s3, removed)
+	return nil
+}
@@ -0,0 +1,30 @@
+package accountpayableusecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSniffAttachmentType(t *testing.T) {
+	cases := map[string]struct {
+		content string
+		want    string
+		ok      bool
+	}{
+		"pdf":             {"%PDF-1.4\n1 0 obj", "application/pdf", true},
+		"png":             {"\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", "image/png", true},
+		"jpeg":            {"\xff\xd8\xff\xe0\x00\x10JFIF", "image/jpeg", true},
+		"nfe com prólogo": {`<?xml version="1.0" encoding="UTF-8"?><nfeProc versao="4.00"></nfeProc>`, "application/xml", true},
+		"nfe sem prólogo": {"\ufeff\n<nfeProc versao=\"4.00\"><NFe/></nfeProc>", "application/xml", true},
+		"html disfarçado": {"<!DOCTYPE html><html><script>alert(1)</script></html>", "", false},
+		"texto":           {"boleto 123", "", false},
+		"executável":      {"MZ\x90\x00\x03\x00\x00\x00", "", false},
+	}
+
+	for name, c := range cases {
+		got, ok := sniffAttachmentType([]byte(c.content))
+		assert.Equal(t, c.ok, ok, name)
+		assert.Equal(t, c.want, got, name)
+	}
+}
@@ -0,0 +1,64 @@
+package accountpayableusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	accountpayabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_payable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+func (s *Service) CreateExpenseCategory(ctx context.Context, dto *accountpayabledto.ExpenseCategoryCreateDTO) (uuid.UUID, error) {
+	category, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	categoryModel := &model.ExpenseCategory{}
+	categoryModel.FromDomain(category)
+	if err := s.rcategory.CreateExpenseCategory(ctx, categoryModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return category.ID, nil
+}
+
+func (s *Service) UpdateExpenseCategory(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountpayabledto.ExpenseCategoryUpdateDTO) error {
+	categoryModel, err := s.rcategory.GetExpenseCategoryById(ctx, dtoId.ID.String())
+	if err != nil {
+		return ErrExpenseCategoryNotFound
+	}
+
+	category := categoryModel.ToDomain()
+	if err := dto.UpdateDomain(category); err != nil {
+		return err
+	}
+
+	categoryModel.FromDomain(category)
+	return s.rcategory.UpdateExpenseCategory(ctx, categoryModel)
+}
+
+func (s *Service) DeleteExpenseCategory(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	if _, err := s.rcategory.GetExpenseCategoryById(ctx, dtoId.ID.String()); err != nil {
+		return ErrExpenseCategoryNotFound
+	}
+
+	return s.rcategory.DeleteExpenseCategory(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetAllExpenseCategories(ctx context.Context, onlyActive bool) ([]accountpayabledto.ExpenseCategoryDTO, error) {
+	categoryModels, err := s.rcategory.GetAllExpenseCategories(ctx, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryDTOs := []accountpayabledto.ExpenseCategoryDTO{}
+	for i := range categoryModels {
+		categoryDTO := accountpayabledto.ExpenseCategoryDTO{}
+		categoryDTO.FromDomain(categoryModels[i].ToDomain())
+		categoryDTOs = append(categoryDTOs, categoryDTO)
+	}
+
+	return categoryDTOs, nil
+}
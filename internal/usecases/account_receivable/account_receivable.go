@@ -0,0 +1,183 @@
+package accountreceivableusecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountreceivabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_receivable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+	accountpayableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_payable"
+)
+
+type Service struct {
+	db         *bun.DB
+	r          model.AccountReceivableRepository
+	rfee       model.PayMethodFeeRepository
+	rstatement model.SettlementStatementRepository
+	rcompany   model.CompanyRepository
+	s3         *s3service.S3Client
+}
+
+func NewService(db *bun.DB, r model.AccountReceivableRepository, rfee model.PayMethodFeeRepository, rstatement model.SettlementStatementRepository) *Service {
+	return &Service{db: db, r: r, rfee: rfee, rstatement: rstatement}
+}
+
+func (s *Service) AddDependencies(s3 *s3service.S3Client, rcompany model.CompanyRepository) {
+	s.s3 = s3
+	s.rcompany = rcompany
+}
+
+func (s *Service) CreateAccountReceivable(ctx context.Context, dto *accountreceivabledto.AccountReceivableCreateDTO) (uuid.UUID, error) {
+	receivable, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	receivableModel := &model.AccountReceivable{}
+	receivableModel.FromDomain(receivable)
+	if err := s.r.CreateAccountReceivable(ctx, tx, receivableModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return uuid.Nil, err
+	}
+
+	return receivable.ID, nil
+}
+
+func (s *Service) UpdateAccountReceivable(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountreceivabledto.AccountReceivableUpdateDTO) error {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	receivable := receivableModel.ToDomain()
+	if err := dto.UpdateDomain(receivable); err != nil {
+		return err
+	}
+
+	receivableModel.FromDomain(receivable)
+	return s.r.UpdateAccountReceivable(ctx, receivableModel)
+}
+
+func (s *Service) GetAccountReceivableById(ctx context.Context, dto *entitydto.IDRequest) (*accountreceivabledto.AccountReceivableDTO, error) {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toAccountReceivableDTO(ctx, receivableModel), nil
+}
+
+func (s *Service) GetAllAccountReceivables(ctx context.Context, page, perPage int, status string, expectedFrom, expectedTo *time.Time) ([]accountreceivabledto.AccountReceivableDTO, int, error) {
+	receivableModels, count, err := s.r.GetAllAccountReceivables(ctx, page, perPage, status, expectedFrom, expectedTo)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	receivableDTOs := []accountreceivabledto.AccountReceivableDTO{}
+	for i := range receivableModels {
+		receivableDTOs = append(receivableDTOs, *s.toAccountReceivableDTO(ctx, &receivableModels[i]))
+	}
+
+	return receivableDTOs, count, nil
+}
+
+func (s *Service) ReceiveAccountReceivable(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountreceivabledto.AccountReceivableReceiveDTO) error {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	receivedAt := time.Now().UTC()
+	if dto.ReceivedAt != nil {
+		receivedAt = *dto.ReceivedAt
+	}
+
+	receivable := receivableModel.ToDomain()
+	if err := receivable.Receive(dto.ReceivedAmount, receivedAt); err != nil {
+		return err
+	}
+
+	receivableModel.FromDomain(receivable)
+	return s.r.UpdateAccountReceivable(ctx, receivableModel)
+}
+
+func (s *Service) CancelAccountReceivable(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	receivable := receivableModel.ToDomain()
+	if err := receivable.Cancel(); err != nil {
+		return err
+	}
+
+	receivableModel.FromDomain(receivable)
+	return s.r.UpdateAccountReceivable(ctx, receivableModel)
+}
+
+func (s *Service) AddAccountReceivableAttachment(ctx context.Context, dtoId *entitydto.IDRequest, upload *accountpayableusecases.AttachmentUpload) (*accountreceivabledto.AccountReceivableDTO, error) {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, err := accountpayableusecases.UploadAttachment(ctx, s.s3, receivableModel.ID, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	receivable := receivableModel.ToDomain()
+	receivable.AddAttachment(*attachment)
+
+	receivableModel.FromDomain(receivable)
+	if err := s.r.UpdateAccountReceivable(ctx, receivableModel); err != nil {
+		accountpayableusecases.DeleteAttachmentFile(s.s3, attachment)
+		return nil, err
+	}
+
+	return s.toAccountReceivableDTO(ctx, receivableModel), nil
+}
+
+func (s *Service) RemoveAccountReceivableAttachment(ctx context.Context, dtoId *entitydto.IDRequest, attachmentID uuid.UUID) error {
+	receivableModel, err := s.r.GetAccountReceivableById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	receivable := receivableModel.ToDomain()
+	removed, err := receivable.RemoveAttachment(attachmentID)
+	if err != nil {
+		return err
+	}
+
+	receivableModel.FromDomain(receivable)
+	if err := s.r.UpdateAccountReceivable(ctx, receivableModel); err != nil {
+		return err
+	}
+
+	accountpayableusecases.DeleteAttachmentFile(s.s3, removed)
+	return nil
+}
+
+func (s *Service) toAccountReceivableDTO(ctx context.Context, receivableModel *model.AccountReceivable) *accountreceivabledto.AccountReceivableDTO {
+	receivableDTO := &accountreceivabledto.AccountReceivableDTO{}
+	receivableDTO.FromDomain(receivableModel.ToDomain())
+	accountpayableusecases.FillAttachmentURLs(ctx, s.s3, receivableDTO.Attachments)
+	return receivableDTO
+}
@@ -0,0 +1,229 @@
+package accountreceivableusecases
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+)
+
+var (
+	ErrStatementColumns = errors.New("statement csv must have date and amount columns")
+	ErrStatementInvalid = errors.New("statement file could not be read")
+)
+
+// csvColumns lista os nomes aceitos por coluna, na ordem de preferência (o líquido vence o valor genérico).
+// Os cabeçalhos são comparados sem acento, em minúsculas e com _ no lugar de espaços.
+var csvColumns = map[string][]string{
+	"date":        {"data_credito", "data_de_credito", "data_pagamento", "data_de_pagamento", "data", "date"},
+	"amount":      {"valor_liquido", "liquido", "net_amount", "net", "valor", "amount"},
+	"reference":   {"nsu", "codigo_de_autorizacao", "autorizacao", "referencia", "reference", "id"},
+	"pay_method":  {"bandeira", "brand", "forma_de_pagamento", "pay_method"},
+	"description": {"descricao", "historico", "description"},
+}
+
+var dateLayouts = []string{time.DateOnly, "02/01/2006", "02/01/06", "2006/01/02", time.RFC3339}
+
+// parseStatement lê um extrato OFX ou CSV; o formato vem da extensão ou do conteúdo
+func parseStatement(filename string, content []byte) (accountreceivableentity.StatementFormat, []accountreceivableentity.StatementLine, error) {
+	if strings.HasSuffix(strings.ToLower(filename), ".ofx") || bytes.Contains(bytes.ToUpper(content), []byte("<OFX>")) {
+		lines, err := parseOFX(content)
+		return accountreceivableentity.StatementFormatOFX, lines, err
+	}
+
+	lines, err := parseCSV(content)
+	return accountreceivableentity.StatementFormatCSV, lines, err
+}
+
+func parseCSV(content []byte) ([]accountreceivableentity.StatementLine, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+
+	firstLine, _, _ := bytes.Cut(content, []byte("\n"))
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil || len(records) == 0 {
+		return nil, ErrStatementInvalid
+	}
+
+	index := csvHeaderIndex(records[0])
+	if _, ok := index["date"]; !ok {
+		return nil, ErrStatementColumns
+	}
+	if _, ok := index["amount"]; !ok {
+		return nil, ErrStatementColumns
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := index[column]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	lines := []accountreceivableentity.StatementLine{}
+	for n, record := range records[1:] {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		date, err := parseStatementDate(field(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+
+		amount, err := parseStatementAmount(field(record, "amount"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+
+		lines = append(lines, accountreceivableentity.StatementLine{
+			Date:        date,
+			Description: field(record, "description"),
+			Reference:   field(record, "reference"),
+			PayMethod:   normalizePayMethod(field(record, "pay_method")),
+			Amount:      amount,
+		})
+	}
+
+	return lines, nil
+}
+
+func csvHeaderIndex(header []string) map[string]int {
+	positions := map[string]int{}
+	for i, name := range header {
+		positions[normalizeHeader(name)] = i
+	}
+
+	index := map[string]int{}
+	for column, aliases := range csvColumns {
+		for _, alias := range aliases {
+			if i, ok := positions[alias]; ok {
+				index[column] = i
+				break
+			}
+		}
+	}
+	return index
+}
+
+var headerReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c", " ", "_", "-", "_", ".", "",
+)
+
+func normalizeHeader(name string) string {
+	return headerReplacer.Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// ofxTransaction casa cada bloco STMTTRN; o OFX 1.x (SGML) não fecha as tags de valor
+var (
+	ofxTransaction = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+	ofxTag         = regexp.MustCompile(`(?i)<([A-Z0-9.]+)>([^<\r\n]*)`)
+)
+
+func parseOFX(content []byte) ([]accountreceivableentity.StatementLine, error) {
+	blocks := ofxTransaction.FindAllSubmatch(content, -1)
+	if len(blocks) == 0 {
+		return nil, ErrStatementInvalid
+	}
+
+	lines := []accountreceivableentity.StatementLine{}
+	for _, block := range blocks {
+		tags := map[string]string{}
+		for _, tag := range ofxTag.FindAllSubmatch(block[1], -1) {
+			tags[strings.ToUpper(string(tag[1]))] = strings.TrimSpace(string(tag[2]))
+		}
+
+		posted := tags["DTPOSTED"]
+		if len(posted) < 8 {
+			return nil, ErrStatementInvalid
+		}
+
+		date, err := time.Parse("20060102", posted[:8])
+		if err != nil {
+			return nil, ErrStatementInvalid
+		}
+
+		amount, err := parseStatementAmount(tags["TRNAMT"])
+		if err != nil {
+			return nil, err
+		}
+
+		description := tags["MEMO"]
+		if description == "" {
+			description = tags["NAME"]
+		}
+
+		lines = append(lines, accountreceivableentity.StatementLine{
+			Date:        date,
+			Description: description,
+			Reference:   tags["FITID"],
+			PayMethod:   payMethodIn(description),
+			Amount:      amount,
+		})
+	}
+
+	return lines, nil
+}
+
+func parseStatementDate(value string) (time.Time, error) {
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+// parseStatementAmount aceita 1.234,56 (pt-BR), 1234.56 e prefixo R$
+func parseStatementAmount(value string) (decimal.Decimal, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value), "R$")), " ", "")
+	if strings.Contains(value, ",") {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
+
+// normalizePayMethod devolve a bandeira com a grafia do cadastro (ex.: "VISA" → "Visa")
+func normalizePayMethod(value string) string {
+	for _, method := range orderentity.GetAllPayMethod() {
+		if strings.EqualFold(value, string(method)) {
+			return string(method)
+		}
+	}
+	return value
+}
+
+// payMethodIn procura a bandeira no histórico do OFX; a mais longa vence (Visa Electron antes de Visa)
+func payMethodIn(description string) string {
+	description = strings.ToLower(description)
+	found := ""
+	for _, method := range orderentity.GetAllPayMethod() {
+		if method == orderentity.Dinheiro || method == orderentity.Outros {
+			continue
+		}
+		if strings.Contains(description, strings.ToLower(string(method))) && len(method) > len(found) {
+			found = string(method)
+		}
+	}
+	return found
+}
@@ -0,0 +1,78 @@
+package accountreceivableusecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+)
+
+const sampleCSV = "\ufeffData de Crédito;Bandeira;NSU;Valor Bruto;Valor Líquido\n" +
+	"15/10/2026;VISA;000123;R$ 1.000,00;R$ 970,00\n" +
+	"16/10/2026;MasterCard;000124;200,00;194,60\n"
+
+const sampleOFX = `OFXHEADER:100
+DATA:OFXSGML
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS><BANKTRANLIST>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20261015120000[-3:BRT]
+<TRNAMT>970.00
+<FITID>A1
+<MEMO>REPASSE VISA CREDITO
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20261016
+<TRNAMT>-12.50
+<FITID>A2
+<MEMO>TARIFA ALUGUEL POS
+</STMTTRN>
+</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+// ─────────────────────────────────────────────────────────────
+// parseStatement
+// ─────────────────────────────────────────────────────────────
+
+func TestParseStatement_CSVWithBrazilianFormat(t *testing.T) {
+	format, lines, err := parseStatement("extrato.csv", []byte(sampleCSV))
+	require.NoError(t, err)
+
+	assert.Equal(t, accountreceivableentity.StatementFormatCSV, format)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "2026-10-15", lines[0].Date.Format("2006-01-02"))
+	assert.Equal(t, "Visa", lines[0].PayMethod, "bandeira deve seguir a grafia do cadastro")
+	assert.Equal(t, "000123", lines[0].Reference)
+	assert.Equal(t, "970", lines[0].Amount.String(), "o valor líquido vence o bruto")
+	assert.Equal(t, "194.6", lines[1].Amount.String())
+}
+
+func TestParseStatement_CSVWithoutAmountColumn(t *testing.T) {
+	_, _, err := parseStatement("extrato.csv", []byte("data,nsu\n2026-10-15,1\n"))
+	assert.ErrorIs(t, err, ErrStatementColumns)
+}
+
+func TestParseStatement_OFX(t *testing.T) {
+	format, lines, err := parseStatement("extrato.txt", []byte(sampleOFX))
+	require.NoError(t, err)
+
+	assert.Equal(t, accountreceivableentity.StatementFormatOFX, format)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "2026-10-15", lines[0].Date.Format("2006-01-02"))
+	assert.Equal(t, "Visa", lines[0].PayMethod)
+	assert.Equal(t, "A1", lines[0].Reference)
+	assert.Equal(t, "970", lines[0].Amount.String())
+
+	assert.Equal(t, "", lines[1].PayMethod)
+	assert.Equal(t, "-12.5", lines[1].Amount.String())
+}
+
+func TestParseStatement_OFXWithoutTransactions(t *testing.T) {
+	_, _, err := parseStatement("extrato.ofx", []byte("<OFX></OFX>"))
+	assert.ErrorIs(t, err, ErrStatementInvalid)
+}
@@ -0,0 +1,127 @@
+package accountreceivableusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	accountreceivabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_receivable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+var (
+	ErrPayMethodFeeExists   = errors.New("pay method already has a fee configuration")
+	ErrPayMethodFeeNotFound = errors.New("pay method fee not found")
+)
+
+func (s *Service) CreatePayMethodFee(ctx context.Context, dto *accountreceivabledto.PayMethodFeeCreateDTO) (uuid.UUID, error) {
+	fee, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	existing, err := s.rfee.GetPayMethodFeeByMethod(ctx, string(fee.Method), false)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if existing != nil {
+		return uuid.Nil, ErrPayMethodFeeExists
+	}
+
+	feeModel := &model.PayMethodFee{}
+	feeModel.FromDomain(fee)
+	if err := s.rfee.CreatePayMethodFee(ctx, feeModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return fee.ID, nil
+}
+
+func (s *Service) UpdatePayMethodFee(ctx context.Context, dtoId *entitydto.IDRequest, dto *accountreceivabledto.PayMethodFeeUpdateDTO) error {
+	feeModel, err := s.rfee.GetPayMethodFeeById(ctx, dtoId.ID.String())
+	if err != nil {
+		return ErrPayMethodFeeNotFound
+	}
+
+	fee := feeModel.ToDomain()
+	if err := dto.UpdateDomain(fee); err != nil {
+		return err
+	}
+
+	feeModel.FromDomain(fee)
+	return s.rfee.UpdatePayMethodFee(ctx, feeModel)
+}
+
+func (s *Service) DeletePayMethodFee(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	if _, err := s.rfee.GetPayMethodFeeById(ctx, dtoId.ID.String()); err != nil {
+		return ErrPayMethodFeeNotFound
+	}
+
+	return s.rfee.DeletePayMethodFee(ctx, dtoId.ID.String())
+}
+
+func (s *Service) GetAllPayMethodFees(ctx context.Context) ([]accountreceivabledto.PayMethodFeeDTO, error) {
+	feeModels, err := s.rfee.GetAllPayMethodFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feeDTOs := []accountreceivabledto.PayMethodFeeDTO{}
+	for i := range feeModels {
+		feeDTO := accountreceivabledto.PayMethodFeeDTO{}
+		feeDTO.FromDomain(feeModels[i].ToDomain())
+		feeDTOs = append(feeDTOs, feeDTO)
+	}
+
+	return feeDTOs, nil
+}
+
+// ExpectCardSettlement aplica a taxa da forma de pagamento ao pagamento (líquido e data do crédito)
+// e devolve o repasse a receber; nil quando a forma não tem taxa ativa, como dinheiro.
+func (s *Service) ExpectCardSettlement(ctx context.Context, payment *orderentity.PaymentOrder) (*accountreceivableentity.AccountReceivable, error) {
+	feeModel, err := s.rfee.GetPayMethodFeeByMethod(ctx, string(payment.Method), true)
+	if err != nil || feeModel == nil {
+		return nil, err
+	}
+
+	return feeModel.ToDomain().ApplyTo(payment)
+}
+
+// SaveCardSettlementWithTx grava o repasse na mesma transação do pagamento, para não sobrar repasse sem pagamento
+func (s *Service) SaveCardSettlementWithTx(ctx context.Context, tx *bun.Tx, receivable *accountreceivableentity.AccountReceivable) error {
+	receivableModel := &model.AccountReceivable{}
+	receivableModel.FromDomain(receivable)
+	if err := s.r.CreateAccountReceivable(ctx, tx, receivableModel); err != nil {
+		return fmt.Errorf("failed to save card settlement: %w", err)
+	}
+
+	return nil
+}
+
+// CancelOrderCardSettlementsWithTx cancela os repasses de cartão em aberto do pedido cancelado
+func (s *Service) CancelOrderCardSettlementsWithTx(ctx context.Context, tx *bun.Tx, orderID uuid.UUID) error {
+	receivableModels, err := s.r.GetOpenCardSettlementsByOrder(ctx, tx, orderID.String())
+	if err != nil {
+		return err
+	}
+
+	for i := range receivableModels {
+		receivable := receivableModels[i].ToDomain()
+		if err := receivable.Cancel(); err != nil {
+			return err
+		}
+		receivableModels[i].FromDomain(receivable)
+	}
+
+	if err := s.r.UpdateAccountReceivables(ctx, tx, receivableModels); err != nil {
+		return fmt.Errorf("failed to cancel card settlements: %w", err)
+	}
+
+	return nil
+}
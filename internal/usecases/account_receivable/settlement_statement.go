@@ -0,0 +1,181 @@
+package accountreceivableusecases
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	accountreceivabledto "github.com/willjrcom/sales-backend-go/internal/infra/dto/account_receivable"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+var ErrStatementTooLarge = errors.New("statement must be up to 5 MB")
+
+// MaxStatementSize limita o extrato importado; um mês de vendas de uma loja fica bem abaixo
+const MaxStatementSize = 5 << 20
+
+// statementSearchDays amplia o período do extrato na busca dos repasses em aberto,
+// cobrindo créditos adiantados ou atrasados por feriado
+const statementSearchDays = 5
+
+// ImportSettlementStatement lê o extrato da adquirente (CSV ou OFX), concilia com os repasses de cartão em aberto
+// e baixa os confirmados. Linhas sem repasse ou com valor diferente ficam marcadas para conferência.
+func (s *Service) ImportSettlementStatement(ctx context.Context, acquirer, filename string, file io.Reader) (*accountreceivabledto.SettlementStatementDTO, error) {
+	content, err := io.ReadAll(io.LimitReader(file, MaxStatementSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) > MaxStatementSize {
+		return nil, ErrStatementTooLarge
+	}
+
+	format, lines, err := parseStatement(filename, content)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := accountreceivableentity.NewSettlementStatement(acquirer, filename, format, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := s.companyLocation(ctx)
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	duplicated, err := s.rstatement.ExistsSettlementStatementByFingerprint(ctx, tx, statement.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if duplicated {
+		return nil, accountreceivableentity.ErrStatementDuplicated
+	}
+
+	// Lê e bloqueia os repasses dentro da transação: duas importações não baixam o mesmo repasse
+	from := statement.PeriodStart.AddDate(0, 0, -statementSearchDays)
+	to := statement.PeriodEnd.AddDate(0, 0, statementSearchDays+1)
+	receivableModels, err := s.r.GetOpenCardSettlementsForUpdate(ctx, tx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	receivables := make([]accountreceivableentity.AccountReceivable, len(receivableModels))
+	for i := range receivableModels {
+		receivables[i] = *receivableModels[i].ToDomain()
+	}
+
+	matched := statement.Reconcile(receivables, loc)
+
+	statementModel := &model.SettlementStatement{}
+	statementModel.FromDomain(statement)
+	if err := s.rstatement.CreateSettlementStatement(ctx, tx, statementModel); err != nil {
+		// importação simultânea do mesmo extrato esbarra no índice único
+		if strings.Contains(err.Error(), "idx_settlement_statements_fingerprint") {
+			return nil, accountreceivableentity.ErrStatementDuplicated
+		}
+		return nil, err
+	}
+
+	matchedModels := make([]model.AccountReceivable, len(matched))
+	for i, receivable := range matched {
+		matchedModels[i].FromDomain(receivable)
+	}
+
+	if err := s.r.UpdateAccountReceivables(ctx, tx, matchedModels); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.toSettlementStatementDTO(ctx, statement)
+}
+
+func (s *Service) GetSettlementStatementById(ctx context.Context, dto *entitydto.IDRequest) (*accountreceivabledto.SettlementStatementDTO, error) {
+	statementModel, err := s.rstatement.GetSettlementStatementById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSettlementStatementDTO(ctx, statementModel.ToDomain())
+}
+
+func (s *Service) GetAllSettlementStatements(ctx context.Context, page, perPage int) ([]accountreceivabledto.SettlementStatementDTO, int, error) {
+	statementModels, count, err := s.rstatement.GetAllSettlementStatements(ctx, page, perPage)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	statementDTOs := []accountreceivabledto.SettlementStatementDTO{}
+	for i := range statementModels {
+		statementDTO := accountreceivabledto.SettlementStatementDTO{}
+		statementDTO.FromDomain(statementModels[i].ToDomain(), false)
+		statementDTOs = append(statementDTOs, statementDTO)
+	}
+
+	return statementDTOs, count, nil
+}
+
+// toSettlementStatementDTO monta a visão de conciliação: linhas e repasses previstos no período que não foram creditados
+func (s *Service) toSettlementStatementDTO(ctx context.Context, statement *accountreceivableentity.SettlementStatement) (*accountreceivabledto.SettlementStatementDTO, error) {
+	statementDTO := &accountreceivabledto.SettlementStatementDTO{}
+	statementDTO.FromDomain(statement, true)
+
+	linked := map[uuid.UUID]bool{}
+	for _, line := range statement.Lines {
+		for _, id := range line.ReceivableIDs {
+			linked[id] = true
+		}
+	}
+
+	start, end := s.localPeriod(ctx, statement.PeriodStart, statement.PeriodEnd)
+	openModels, err := s.r.GetOpenCardSettlements(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	statementDTO.Missing = []accountreceivabledto.AccountReceivableDTO{}
+	for i := range openModels {
+		if linked[openModels[i].ID] {
+			continue
+		}
+		statementDTO.Missing = append(statementDTO.Missing, *s.toAccountReceivableDTO(ctx, &openModels[i]))
+	}
+
+	return statementDTO, nil
+}
+
+// localPeriod converte as datas do extrato (dias sem horário) no intervalo desses dias no fuso da empresa
+func (s *Service) localPeriod(ctx context.Context, first, last time.Time) (time.Time, time.Time) {
+	loc := s.companyLocation(ctx)
+	start := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).Add(-time.Microsecond)
+	return start, end
+}
+
+func (s *Service) companyLocation(ctx context.Context) *time.Location {
+	if s.rcompany == nil {
+		return companyentity.LoadLocation("")
+	}
+
+	companyModel, err := s.rcompany.GetCompany(ctx, true)
+	if err != nil {
+		return companyentity.LoadLocation("")
+	}
+
+	return companyModel.ToDomain().Location()
+}
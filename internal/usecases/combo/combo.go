@@ -0,0 +1,85 @@
+package combousecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	combodto "github.com/willjrcom/sales-backend-go/internal/infra/dto/combo"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type Service struct {
+	db *bun.DB
+	r  model.ComboRepository
+}
+
+func NewService(db *bun.DB, r model.ComboRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) CreateCombo(ctx context.Context, dto *combodto.ComboCreateDTO) (uuid.UUID, error) {
+	combo, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	comboModel := &model.Combo{}
+	comboModel.FromDomain(combo)
+	if err := s.r.CreateCombo(ctx, comboModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return combo.ID, nil
+}
+
+func (s *Service) UpdateCombo(ctx context.Context, dtoID *entitydto.IDRequest, dto *combodto.ComboUpdateDTO) error {
+	comboModel, err := s.r.GetComboById(ctx, dtoID.ID.String())
+	if err != nil {
+		return err
+	}
+
+	combo := comboModel.ToDomain()
+	if err := dto.UpdateDomain(combo); err != nil {
+		return err
+	}
+
+	comboModel.FromDomain(combo)
+	return s.r.UpdateCombo(ctx, comboModel)
+}
+
+func (s *Service) DeleteCombo(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	if _, err := s.r.GetComboById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeleteCombo(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetComboById(ctx context.Context, dtoID *entitydto.IDRequest) (*combodto.ComboDTO, error) {
+	comboModel, err := s.r.GetComboById(ctx, dtoID.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	comboDTO := &combodto.ComboDTO{}
+	comboDTO.FromDomain(comboModel.ToDomain())
+	return comboDTO, nil
+}
+
+func (s *Service) GetAllCombos(ctx context.Context, onlyActive bool) ([]combodto.ComboDTO, error) {
+	comboModels, err := s.r.GetAllCombos(ctx, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+
+	comboDTOs := []combodto.ComboDTO{}
+	for i := range comboModels {
+		comboDTO := combodto.ComboDTO{}
+		comboDTO.FromDomain(comboModels[i].ToDomain())
+		comboDTOs = append(comboDTOs, comboDTO)
+	}
+
+	return comboDTOs, nil
+}
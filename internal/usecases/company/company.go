@@ -173,7 +173,9 @@ func (s *Service) UpdateCompany(ctx context.Context, dto *companydto.CompanyUpda
 		company.MonthlyPaymentDueDayUpdatedAt = &now
 	}
 
-	dto.UpdateDomain(company)
+	if err := dto.UpdateDomain(company); err != nil {
+		return err
+	}
 
 	if company.Cnpj != companyModel.Cnpj {
 		cnpjData, err := cnpj.Get(company.Cnpj)
@@ -14,14 +14,17 @@ import (
 	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
 	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
-	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe"
+	emailservice "github.com/willjrcom/sales-backend-go/internal/infra/service/email"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/fiscalprovider"
+	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
 )
 
 var (
 	ErrFiscalNotEnabled                 = errors.New("fiscal invoice functionality is not enabled for this company")
 	ErrMissingFiscalData                = errors.New("company is missing required fiscal data (IE, regime tributário)")
-	ErrTransmitenotaNotConfigured       = errors.New("focus nfe client is not configured")
+	ErrTransmitenotaNotConfigured       = errors.New("fiscal provider is not configured")
 	ErrInvoiceAlreadyExists             = errors.New("invoice already exists for this order")
 	ErrInvoiceNotFound                  = errors.New("fiscal invoice not found")
 	ErrCannotCancelInvoice              = errors.New("invoice cannot be cancelled (not authorized or already cancelled)")
@@ -36,7 +39,10 @@ type Service struct {
 	fiscalSettingsRepo      model.FiscalSettingsRepository
 	orderRepo               model.OrderRepository
 	usageCostService        *companyusecases.UsageCostService
-	focusClient             *focusnfe.Client
+	providers               *fiscalprovider.Registry
+	s3                      *s3service.S3Client
+	emailService            *emailservice.Service
+	ibptService             *ibptusecases.Service
 }
 
 func NewService(
@@ -46,7 +52,7 @@ func NewService(
 	fiscalSettingsRepo model.FiscalSettingsRepository,
 	orderRepo model.OrderRepository,
 	usageCostService *companyusecases.UsageCostService,
-	focusClient *focusnfe.Client,
+	providers *fiscalprovider.Registry,
 ) *Service {
 	return &Service{
 		invoiceRepo:             invoiceRepo,
@@ -55,10 +61,16 @@ func NewService(
 		fiscalSettingsRepo:      fiscalSettingsRepo,
 		orderRepo:               orderRepo,
 		usageCostService:        usageCostService,
-		focusClient:             focusClient,
+		providers:               providers,
 	}
 }
 
+func (s *Service) AddDependencies(s3 *s3service.S3Client, emailService *emailservice.Service, ibptService *ibptusecases.Service) {
+	s.s3 = s3
+	s.emailService = emailService
+	s.ibptService = ibptService
+}
+
 // EmitNFCeOrder emits NFC-e for an order and registers the cost
 func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscalinvoice.FiscalInvoice, error) {
 	// Get company from context
@@ -99,8 +111,9 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 		return nil, ErrInvoiceAlreadyExists
 	}
 
-	// Check Focus client
-	if s.focusClient == nil || !s.focusClient.Enabled() {
+	// Resolve the provider chosen in the fiscal settings
+	provider, err := s.providers.For(settings.ToDomain())
+	if err != nil {
 		return nil, ErrTransmitenotaNotConfigured
 	}
 
@@ -120,49 +133,63 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 		return nil, ErrOrderNotFound
 	}
 
+	// Approximate taxes per item (Lei 12.741/2012), from the IBPT table of the company UF
+	order := orderModel.ToDomain()
+	if s.ibptService != nil {
+		if err := s.ibptService.ApplyToOrder(ctx, order, settings.UF); err != nil {
+			fmt.Printf("Warning: failed to calculate approximate taxes for order %s: %v\n", orderID, err)
+		}
+	}
+
 	// Build NFC-e items from order using default food fiscal values
-	nfceItems := make([]focusnfe.NFCeItem, 0)
+	nfceItems := make([]fiscalprovider.NFCeItem, 0)
 	itemNumber := 1
-	for _, group := range orderModel.GroupItems {
+	for _, group := range order.GroupItems {
 		for _, item := range group.Items {
-			valorUnitario, _ := item.SubTotal.Float64()
-			valorTotal, _ := item.Total.Float64()
-
-			nfceItem := focusnfe.NFCeItem{
-				NumeroItem:             itemNumber,
-				CodigoProduto:          item.ProductID.String()[:8], // First 8 chars of product ID
-				Descricao:              item.Name,
-				NCM:                    fiscalinvoice.DefaultFoodNCM,
-				CFOP:                   fiscalinvoice.DefaultCFOP,
-				UnidadeComercial:       fiscalinvoice.DefaultUnidade,
-				QuantidadeComercial:    float64(item.Quantity),
-				ValorUnitarioComercial: valorUnitario,
-				ValorBruto:             valorTotal,
-				ICMSOrigem:             fmt.Sprintf("%d", fiscalinvoice.DefaultOrigem),
-				ICMSSituacaoTributaria: fiscalinvoice.GetCSOSNForRegime(settings.TaxRegime),
+			nfceItem := fiscalprovider.NFCeItem{
+				Number:        itemNumber,
+				ProductCode:   item.ProductID.String()[:8], // First 8 chars of product ID
+				Description:   item.Name,
+				NCM:           ibptusecases.ItemNCM(&item),
+				CFOP:          fiscalinvoice.DefaultCFOP,
+				Unit:          fiscalinvoice.DefaultUnidade,
+				Quantity:      decimal.NewFromFloat(item.Quantity),
+				UnitPrice:     item.SubTotal,
+				GrossValue:    item.Total,
+				ICMSOrigin:    fmt.Sprintf("%d", fiscalinvoice.DefaultOrigem),
+				ICMSSituation: fiscalinvoice.GetCSOSNForRegime(settings.TaxRegime),
+			}
+
+			if taxes := item.TotalApproximateTaxes(); taxes != nil {
+				nfceItem.ApproximateTaxes = taxes.Total()
 			}
+
 			nfceItems = append(nfceItems, nfceItem)
 			itemNumber++
 		}
 	}
 
+	taxes := order.ApproximateTaxes()
+	if taxes != nil {
+		invoice.SetAmounts(orderModel.GetTotal(), taxes.Total())
+	} else {
+		invoice.SetAmounts(orderModel.GetTotal(), decimal.Zero)
+	}
+
 	// Build payment info from order
-	formasPagamento := make([]focusnfe.PaymentMethod, 0)
+	payments := make([]fiscalprovider.NFCePayment, 0)
 	for _, payment := range orderModel.Payments {
-		valor, _ := payment.TotalPaid.Float64()
-		forma := mapPaymentMethod(payment.Method)
-		formasPagamento = append(formasPagamento, focusnfe.PaymentMethod{
-			FormaPagamento: forma,
-			ValorPagamento: valor,
+		payments = append(payments, fiscalprovider.NFCePayment{
+			Method: mapPaymentMethod(payment.Method),
+			Amount: *payment.TotalPaid,
 		})
 	}
 
 	// If no payments yet, add "dinheiro" with total
-	if len(formasPagamento) == 0 {
-		valorTotal, _ := orderModel.SubTotal.Float64()
-		formasPagamento = append(formasPagamento, focusnfe.PaymentMethod{
-			FormaPagamento: "01", // 01 = Dinheiro
-			ValorPagamento: valorTotal,
+	if len(payments) == 0 {
+		payments = append(payments, fiscalprovider.NFCePayment{
+			Method: "01", // 01 = Dinheiro
+			Amount: orderModel.GetSubTotal(),
 		})
 	}
 
@@ -170,33 +197,27 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 	reg, _ := regexp.Compile("[^0-9]+")
 	sanitizedCNPJ := reg.ReplaceAllString(settings.Cnpj, "")
 
-	nfceRequest := &focusnfe.NFCeRequest{
-		NaturezaOperacao:  "Venda ao Consumidor",
-		DataEmissao:       time.Now().UTC().Format("2006-01-02T15:04:05-07:00"),
-		Itens:             nfceItems,
-		FormasPagamento:   formasPagamento,
-		Numero:            fmt.Sprintf("%d", number),
-		Serie:             fmt.Sprintf("%d", series),
-		CNPJ:              sanitizedCNPJ,
-		PresencaComprador: "1", // Operação presencial
+	nfceRequest := &fiscalprovider.NFCeRequest{
+		Nature:    "Venda ao Consumidor",
+		EmittedAt: time.Now().UTC(),
+		Items:     nfceItems,
+		Payments:  payments,
+		Number:    number,
+		Series:    series,
+		CNPJ:      sanitizedCNPJ,
+		InPerson:  true, // Operação presencial
+	}
+
+	if taxes != nil {
+		nfceRequest.AdditionalInfo = fmt.Sprintf("Trib aprox R$ %s Federal, R$ %s Estadual e R$ %s Municipal. Fonte: %s",
+			taxes.Federal.StringFixed(2), taxes.State.StringFixed(2), taxes.Municipal.StringFixed(2), taxes.Source)
 	}
 
 	// Use invoice ID as reference
 	reference := invoice.ID.String()
 
-	// Select correct token based on environment
-	token := settings.TokenHomologation
-	if s.focusClient.GetEnvironment() == "production" {
-		token = settings.TokenProduction
-	}
-
-	// Emit NFC-e via Focus NFe API
-	response, err := s.focusClient.EmitNFCe(
-		ctx,
-		reference,
-		nfceRequest,
-		token,
-	)
+	// Emit NFC-e via the fiscal provider
+	response, err := provider.EmitNFCe(ctx, settings.ToDomain(), reference, nfceRequest)
 
 	if err != nil {
 		// Mark as rejected
@@ -208,13 +229,8 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 	}
 
 	// Check response status
-	// Focus NFe returns status like "autorizado", "processando", "erro_autorizacao"
-	if response.Status == "erro_autorizacao" {
-		// ... handle error
-		errorMsg := response.Mensagem
-		if len(response.Erros) > 0 {
-			errorMsg += fmt.Sprintf(" %s", string(response.Erros))
-		}
+	if response.Status == fiscalprovider.StatusRejected {
+		errorMsg := response.Message
 		invoice.Reject(errorMsg)
 		invoiceModel := &model.FiscalInvoice{}
 		invoiceModel.FromDomain(invoice)
@@ -222,12 +238,12 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 		return nil, fmt.Errorf("NFC-e rejected: %s", errorMsg)
 	}
 
-	// Note: If status is "processando", we might need to poll later.
+	// Note: If status is processing, SearchNFCe completes it later.
 	// For now, we save what we have. If it's authorized, we get paths.
 
-	if response.Status == "autorizado" {
+	if response.Status == fiscalprovider.StatusAuthorized {
 		// Mark as authorized
-		invoice.Authorize(response.ChaveNFe, response.Protocolo, response.CaminhoXML, response.CaminhoPDF)
+		invoice.Authorize(response.AccessKey, response.Protocol, response.XMLPath, response.PDFPath)
 	}
 
 	// Save invoice
@@ -238,26 +254,35 @@ func (s *Service) EmitNFCeOrder(ctx context.Context, orderID uuid.UUID) (*fiscal
 	}
 
 	// Register cost (R$ 0.10 per NFC-e)
-	if s.usageCostService != nil && response.Status == "autorizado" {
-		description := fmt.Sprintf("Emissão NFC-e #%d - Série %d", number, series)
-		pricePerInvoice, _ := decimal.NewFromString(os.Getenv("PRICE_PER_NFCE"))
-
-		costDTO := &companydto.CompanyUsageCostCreateDTO{
-			CompanyID:   &company.ID,
-			CostType:    string(companyentity.CostTypeNFCe),
-			Description: description,
-			Amount:      pricePerInvoice,
-			ReferenceID: &invoice.ID,
-		}
-		if err := s.usageCostService.RegisterUsageCost(ctx, costDTO); err != nil {
-			// Log error but don't fail the emission
-			fmt.Printf("Warning: failed to register NFC-e cost: %v\n", err)
-		}
+	if response.Status == fiscalprovider.StatusAuthorized {
+		s.registerNFCeCost(ctx, invoice)
 	}
 
 	return invoice, nil
 }
 
+// registerNFCeCost charges the company for an authorized NFC-e
+func (s *Service) registerNFCeCost(ctx context.Context, invoice *fiscalinvoice.FiscalInvoice) {
+	if s.usageCostService == nil {
+		return
+	}
+
+	description := fmt.Sprintf("Emissão NFC-e #%d - Série %d", invoice.Number, invoice.Series)
+	pricePerInvoice, _ := decimal.NewFromString(os.Getenv("PRICE_PER_NFCE"))
+
+	costDTO := &companydto.CompanyUsageCostCreateDTO{
+		CompanyID:   &invoice.CompanyID,
+		CostType:    string(companyentity.CostTypeNFCe),
+		Description: description,
+		Amount:      pricePerInvoice,
+		ReferenceID: &invoice.ID,
+	}
+	if err := s.usageCostService.RegisterUsageCost(ctx, costDTO); err != nil {
+		// Log error but don't fail the emission
+		fmt.Printf("Warning: failed to register NFC-e cost: %v\n", err)
+	}
+}
+
 // SearchNFCe queries NFC-e status
 func (s *Service) SearchNFCe(ctx context.Context, invoiceID uuid.UUID) (*fiscalinvoice.FiscalInvoice, error) {
 	invoiceModel, err := s.invoiceRepo.GetByID(ctx, invoiceID)
@@ -267,49 +292,41 @@ func (s *Service) SearchNFCe(ctx context.Context, invoiceID uuid.UUID) (*fiscali
 
 	invoice := invoiceModel.ToDomain()
 
-	if s.focusClient == nil || !s.focusClient.Enabled() {
-		return invoice, nil
-	}
-
 	settingsModel, err := s.fiscalSettingsRepo.GetByCompanyID(ctx, invoice.CompanyID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get fiscal settings: %w", err)
 	}
 
 	settings := settingsModel.ToDomain()
-	token := settings.TokenHomologation
-	if s.focusClient.GetEnvironment() == "production" {
-		token = settings.TokenProduction
+	provider, err := s.providers.For(settings)
+	if err != nil {
+		return invoice, nil
 	}
 
-	response, err := s.focusClient.SearchNFCe(
-		ctx,
-		invoice.ID.String(),
-		token,
-	)
-
+	response, err := provider.SearchNFCe(ctx, settings, invoice.ID.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to consult NFC-e: %w", err)
 	}
 
 	// Update status if authorized
 	switch response.Status {
-	case "autorizado":
+	case fiscalprovider.StatusAuthorized:
 		if invoice.Status != fiscalinvoice.StatusAuthorized {
-			invoice.Authorize(response.ChaveNFe, response.Protocolo, response.CaminhoXML, response.CaminhoPDF)
+			invoice.Authorize(response.AccessKey, response.Protocol, response.XMLPath, response.PDFPath)
 		}
 		// Ensure paths are updated
-		if response.CaminhoXML != "" {
-			invoice.XMLPath = response.CaminhoXML
+		if response.XMLPath != "" {
+			invoice.XMLPath = response.XMLPath
 		}
-		if response.CaminhoPDF != "" {
-			invoice.PDFPath = response.CaminhoPDF
+		if response.PDFPath != "" {
+			invoice.PDFPath = response.PDFPath
 		}
-	case "erro_autorizacao", "cancelado":
-		// handle other statuses
-		if response.Status == "cancelado" {
-			invoice.Cancel("Cancelado na SEFAZ") // Or keep original logic
+	case fiscalprovider.StatusRejected:
+		if invoice.Status == fiscalinvoice.StatusPending {
+			invoice.Reject(response.Message)
 		}
+	case fiscalprovider.StatusCancelled:
+		invoice.Cancel("Cancelado na SEFAZ") // Or keep original logic
 	}
 
 	// Update model and save
@@ -339,38 +356,28 @@ func (s *Service) CancelNFCe(ctx context.Context, invoiceID uuid.UUID, justifica
 		return errors.New("justify must be at least 15 characters long")
 	}
 
-	// Cancel via Focus NFe API
-	if s.focusClient == nil || !s.focusClient.Enabled() {
-		return errors.New("focus client not enabled")
-	}
-
-	cancelRequest := &focusnfe.CancelRequest{
-		Justificativa: justification,
-	}
-
-	// Fetch settings to get token
+	// Fetch settings to resolve the provider
 	settingsModel, err := s.fiscalSettingsRepo.GetByCompanyID(ctx, invoice.CompanyID)
 	if err != nil {
 		return fmt.Errorf("failed to get fiscal settings: %w", err)
 	}
 
 	settings := settingsModel.ToDomain()
-	token := settings.TokenHomologation
-	if s.focusClient.GetEnvironment() == "production" {
-		token = settings.TokenProduction
+	provider, err := s.providers.For(settings)
+	if err != nil {
+		return ErrTransmitenotaNotConfigured
 	}
 
-	if err = s.focusClient.CancelNFCe(
-		ctx,
-		invoice.ID.String(), // Use reference
-		cancelRequest,
-		token,
-	); err != nil {
+	cancelResponse, err := provider.CancelNFCe(ctx, settings, invoice.ID.String(), justification)
+	if err != nil {
 		return fmt.Errorf("failed to cancel NFC-e: %w", err)
 	}
 
 	// Mark as cancelled
 	invoice.Cancel(justification)
+	if cancelResponse != nil && cancelResponse.CancellationXMLPath != "" {
+		invoice.AttachCancellationXML(cancelResponse.CancellationXMLPath)
+	}
 	invoiceModel.FromDomain(invoice)
 
 	if err := s.invoiceRepo.Update(ctx, invoiceModel); err != nil {
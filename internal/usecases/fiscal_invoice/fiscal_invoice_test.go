@@ -0,0 +1,395 @@
+package fiscalinvoiceusecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
+	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
+	fiscalsettingsentity "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_settings"
+	companyrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/company"
+	fiscalinvoicerepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/fiscal_invoice"
+	fiscalsettingsrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/fiscal_settings"
+	ibptrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/ibpt"
+	orderrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/order"
+	productrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/product"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	entitymodel "github.com/willjrcom/sales-backend-go/internal/infra/repository/model/entity"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/fiscalprovider"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe/focusnfetest"
+	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
+)
+
+var (
+	ctx        context.Context
+	fakeServer *focusnfetest.Server
+)
+
+func TestMain(m *testing.M) {
+	ctx = context.Background()
+	os.Setenv("PRICE_PER_NFCE", "0.10")
+
+	fakeServer = focusnfetest.NewServer()
+	code := m.Run()
+	fakeServer.Close()
+
+	os.Exit(code)
+}
+
+// ─────────────────────────────────────────────────────────────
+// Helpers
+// ─────────────────────────────────────────────────────────────
+
+type testEnv struct {
+	svc          *Service
+	companyID    uuid.UUID
+	invoiceRepo  *fiscalinvoicerepositorylocal.FiscalInvoiceRepositoryLocal
+	settingsRepo *fiscalsettingsrepositorylocal.FiscalSettingsRepositoryLocal
+	costRepo     *companyrepositorylocal.CompanyUsageCostRepositoryLocal
+	orderRepo    model.OrderRepository
+	subRepo      *companyrepositorylocal.CompanySubscriptionRepositoryLocal
+}
+
+func newTestEnv(t *testing.T, plan companyentity.PlanType) *testEnv {
+	t.Helper()
+
+	env := &testEnv{
+		companyID:    uuid.New(),
+		invoiceRepo:  fiscalinvoicerepositorylocal.NewFiscalInvoiceRepositoryLocal(),
+		settingsRepo: fiscalsettingsrepositorylocal.NewFiscalSettingsRepositoryLocal(),
+		costRepo:     companyrepositorylocal.NewCompanyUsageCostRepositoryLocal(),
+		orderRepo:    orderrepositorylocal.NewOrderRepositoryLocal(),
+		subRepo:      companyrepositorylocal.NewCompanySubscriptionRepositoryLocal(),
+	}
+
+	companyRepo := companyrepositorylocal.NewCompanyRepositoryLocal()
+	company := &model.Company{Entity: entitymodel.Entity{ID: env.companyID}}
+	company.TradeName = "Pizzaria Teste"
+	require.NoError(t, companyRepo.NewCompany(ctx, company))
+
+	require.NoError(t, env.subRepo.CreateSubscription(ctx, &model.CompanySubscription{
+		Entity:    entitymodel.FromDomain(entity.NewEntity()),
+		CompanyID: env.companyID,
+		PlanType:  string(plan),
+		IsActive:  true,
+		StartDate: time.Now().AddDate(0, -1, 0),
+		EndDate:   time.Now().AddDate(0, 1, 0),
+	}))
+
+	settings := fiscalsettingsentity.NewFiscalSettings(env.companyID)
+	settings.IsActive = true
+	settings.TaxRegime = 1
+	settings.Cnpj = "12.345.678/0001-95"
+	settings.SetTokens("token-prod", "token-hom")
+	settingsModel := &model.FiscalSettings{}
+	settingsModel.FromDomain(settings)
+	require.NoError(t, env.settingsRepo.Create(ctx, settingsModel))
+
+	usageCostService := companyusecases.NewUsageCostService(env.costRepo, companyRepo)
+	env.svc = NewService(env.invoiceRepo, companyRepo, env.subRepo, env.settingsRepo, env.orderRepo, usageCostService, fiscalprovider.NewRegistry(fiscalprovider.NewFocusNFeProvider(fakeServer.FocusClient("main-token"))))
+
+	return env
+}
+
+func (env *testEnv) newOrder(t *testing.T) uuid.UUID {
+	t.Helper()
+
+	subTotal := decimal.NewFromFloat(25.50)
+	total := decimal.NewFromFloat(51.00)
+	paid := decimal.NewFromFloat(51.00)
+
+	order := &model.Order{Entity: entitymodel.FromDomain(entity.NewEntity())}
+	order.Total = &total
+	order.SubTotal = &total
+	order.GroupItems = []model.GroupItem{{}}
+	order.GroupItems[0].Items = []model.Item{{}}
+	order.GroupItems[0].Items[0].Name = "Pizza Calabresa"
+	order.GroupItems[0].Items[0].ProductID = uuid.New()
+	order.GroupItems[0].Items[0].Quantity = 2
+	order.GroupItems[0].Items[0].SubTotal = &subTotal
+	order.GroupItems[0].Items[0].Total = &total
+	order.Payments = []model.PaymentOrder{{}}
+	order.Payments[0].Method = "PIX"
+	order.Payments[0].TotalPaid = &paid
+
+	require.NoError(t, env.orderRepo.CreateOrder(ctx, order))
+	return order.ID
+}
+
+func (env *testEnv) costs(t *testing.T) []*model.CompanyUsageCost {
+	t.Helper()
+	now := time.Now()
+	costs, err := env.costRepo.GetMonthlyCosts(ctx, env.companyID, int(now.Month()), now.Year())
+	require.NoError(t, err)
+	return costs
+}
+
+// ─────────────────────────────────────────────────────────────
+// Emissão
+// ─────────────────────────────────────────────────────────────
+
+func TestEmitNFCeOrder_Authorized(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	assert.Equal(t, fiscalinvoice.StatusAuthorized, invoice.Status)
+	assert.Len(t, invoice.AccessKey, 44, "chave de acesso deve ter 44 dígitos")
+	assert.NotEmpty(t, invoice.Protocol)
+	assert.NotEmpty(t, invoice.XMLPath)
+	assert.Equal(t, 1, invoice.Number)
+	assert.True(t, decimal.NewFromFloat(51).Equal(invoice.TotalAmount), "total da nota deve vir do pedido")
+
+	note, ok := fakeServer.Note(invoice.ID.String())
+	require.True(t, ok)
+	assert.Equal(t, note.AccessKey, invoice.AccessKey)
+	assert.Equal(t, "12345678000195", note.Request.CNPJ, "CNPJ deve ser enviado só com dígitos")
+	require.Len(t, note.Request.FormasPagamento, 1)
+	assert.Equal(t, "17", note.Request.FormasPagamento[0].FormaPagamento, "PIX deve mapear para 17")
+
+	// Custo de uso registrado para a nota autorizada
+	costs := env.costs(t)
+	require.Len(t, costs, 1)
+	assert.Equal(t, string(companyentity.CostTypeNFCe), costs[0].CostType)
+	assert.Equal(t, invoice.ID, *costs[0].ReferenceID)
+	assert.Equal(t, "0.1", costs[0].Amount.String())
+}
+
+func TestEmitNFCeOrder_ApproximateTaxes(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	settings, err := env.settingsRepo.GetByCompanyID(ctx, env.companyID)
+	require.NoError(t, err)
+	settings.UF = "SP"
+
+	table := "codigo;ex;tipo;descricao;nacionalfederal;importadosfederal;estadual;municipal\n" +
+		fiscalinvoice.DefaultFoodNCM + ";;0;Outras preparações alimentícias;13.45;15.45;18.00;0.00\n"
+	ibptService := ibptusecases.NewService(ibptrepositorylocal.NewIbptTaxRateRepositoryLocal())
+	ibptService.AddDependencies(productrepositorylocal.NewProductRepositoryLocal(), nil, nil)
+	_, err = ibptService.ImportTable(ctx, "SP", strings.NewReader(table))
+	require.NoError(t, err)
+	env.svc.AddDependencies(nil, nil, ibptService)
+
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	// 2 x R$ 25,50 = R$ 51,00: 13,45% federal (6,86) + 18% estadual (9,18)
+	assert.Equal(t, "16.04", invoice.TaxAmount.StringFixed(2), "tributos aproximados devem ser gravados na nota")
+
+	note, ok := fakeServer.Note(invoice.ID.String())
+	require.True(t, ok)
+	require.Len(t, note.Request.Itens, 1)
+	assert.Equal(t, 16.04, note.Request.Itens[0].ValorTotalTributos, "vTotTrib deve ir por item")
+	assert.Contains(t, note.Request.InformacoesAdicionaisContribuinte, "Trib aprox R$ 6.86 Federal, R$ 9.18 Estadual")
+}
+
+func TestEmitNFCeOrder_AlreadyExists(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	_, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	_, err = env.svc.EmitNFCeOrder(ctx, orderID)
+	assert.ErrorIs(t, err, ErrInvoiceAlreadyExists)
+	assert.Len(t, env.costs(t), 1, "segunda tentativa não deve gerar custo")
+}
+
+func TestEmitNFCeOrder_Rejected(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	fakeServer.QueueOutcome(focusnfetest.OutcomeReject)
+
+	_, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), focusnfetest.RejectionMessage)
+
+	stored, err := env.invoiceRepo.GetByOrderID(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, string(fiscalinvoice.StatusRejected), stored.Status)
+	assert.Empty(t, stored.AccessKey)
+	assert.Empty(t, env.costs(t), "nota rejeitada não gera custo")
+}
+
+func TestEmitNFCeOrder_ProcessingThenAuthorized(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	fakeServer.QueueOutcome(focusnfetest.OutcomeProcessing)
+
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+	assert.Equal(t, fiscalinvoice.StatusPending, invoice.Status)
+	assert.Empty(t, env.costs(t), "nota em processamento ainda não gera custo")
+
+	searched, err := env.svc.SearchNFCe(ctx, invoice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, fiscalinvoice.StatusAuthorized, searched.Status)
+	assert.Len(t, searched.AccessKey, 44)
+}
+
+func TestEmitNFCeOrder_FreePlan(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanFree)
+	orderID := env.newOrder(t)
+
+	_, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	assert.ErrorIs(t, err, ErrFunctionalityNotAvailableForPlan)
+}
+
+func TestEmitNFCeOrder_FiscalDisabled(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	settings, err := env.settingsRepo.GetByCompanyID(ctx, env.companyID)
+	require.NoError(t, err)
+	settings.IsActive = false
+
+	_, err = env.svc.EmitNFCeOrder(ctx, orderID)
+	assert.ErrorIs(t, err, ErrFiscalNotEnabled)
+}
+
+func TestEmitNFCeOrder_UnknownProvider(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	settings, err := env.settingsRepo.GetByCompanyID(ctx, env.companyID)
+	require.NoError(t, err)
+	settings.Provider = "sefaz-direto"
+
+	_, err = env.svc.EmitNFCeOrder(ctx, orderID)
+	assert.ErrorIs(t, err, ErrTransmitenotaNotConfigured, "provedor não registrado não pode emitir")
+}
+
+// ─────────────────────────────────────────────────────────────
+// Consulta e cancelamento
+// ─────────────────────────────────────────────────────────────
+
+func TestSearchNFCe_NotFound(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+
+	_, err := env.svc.SearchNFCe(ctx, uuid.New())
+	assert.ErrorIs(t, err, ErrInvoiceNotFound)
+}
+
+func TestCancelNFCe(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	err = env.svc.CancelNFCe(ctx, invoice.ID, "curta")
+	assert.Error(t, err, "justificativa com menos de 15 caracteres deve falhar")
+
+	require.NoError(t, env.svc.CancelNFCe(ctx, invoice.ID, "Pedido cancelado pelo cliente"))
+
+	stored, err := env.invoiceRepo.GetByID(ctx, invoice.ID)
+	require.NoError(t, err)
+	assert.Equal(t, string(fiscalinvoice.StatusCancelled), stored.Status)
+	assert.Equal(t, "Pedido cancelado pelo cliente", stored.CancellationReason)
+	assert.NotEmpty(t, stored.CancellationXMLPath, "XML do evento de cancelamento deve ser guardado")
+
+	note, _ := fakeServer.Note(invoice.ID.String())
+	assert.Equal(t, "cancelado", note.Status)
+
+	err = env.svc.CancelNFCe(ctx, invoice.ID, "Pedido cancelado pelo cliente")
+	assert.ErrorIs(t, err, ErrCannotCancelInvoice)
+}
+
+func TestCancelNFCe_NotAuthorized(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	fakeServer.QueueOutcome(focusnfetest.OutcomeProcessing)
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	err = env.svc.CancelNFCe(ctx, invoice.ID, "Pedido cancelado pelo cliente")
+	assert.ErrorIs(t, err, ErrCannotCancelInvoice)
+}
+
+// ─────────────────────────────────────────────────────────────
+// Chave de acesso e arquivo mensal
+// ─────────────────────────────────────────────────────────────
+
+func TestAccessKey_Deterministic(t *testing.T) {
+	env := newTestEnv(t, companyentity.PlanBasic)
+	orderID := env.newOrder(t)
+
+	invoice, err := env.svc.EmitNFCeOrder(ctx, orderID)
+	require.NoError(t, err)
+
+	note, _ := fakeServer.Note(invoice.ID.String())
+	assert.Equal(t, invoice.AccessKey, focusnfetest.AccessKey(focusnfetest.DefaultUFCode, &note.Request, invoice.ID.String()))
+	assert.Equal(t, "65", invoice.AccessKey[20:22], "modelo 65 (NFC-e)")
+	assert.Equal(t, "12345678000195", invoice.AccessKey[6:20])
+}
+
+func TestBuildMonthlyArchiveZip(t *testing.T) {
+	authorized := fiscalinvoice.NewFiscalInvoice(uuid.New(), uuid.New(), 1, 1)
+	authorized.Authorize("KEY1", "P1", "/x.xml", "/x.html")
+	authorized.SetAmounts(decimal.NewFromFloat(10.5), decimal.NewFromFloat(1.2))
+	authorized.CreatedAt = time.Date(2026, 11, 1, 0, 30, 0, 0, time.UTC)
+
+	cancelled := fiscalinvoice.NewFiscalInvoice(uuid.New(), uuid.New(), 2, 1)
+	cancelled.Authorize("KEY2", "P2", "/y.xml", "/y.html")
+	cancelled.Cancel("Pedido cancelado pelo cliente")
+
+	files := []archiveFile{
+		{Name: "autorizadas/KEY1.xml", Content: []byte("<nfe/>")},
+		{Name: "canceladas/KEY2-cancelamento.xml", Content: []byte("<evento/>")},
+	}
+
+	saoPaulo := companyentity.LoadLocation("America/Sao_Paulo")
+	content, err := buildMonthlyArchiveZip(files, []*fiscalinvoice.FiscalInvoice{authorized, cancelled}, saoPaulo)
+	require.NoError(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	require.NoError(t, err)
+
+	names := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		names[file.Name] = string(data)
+	}
+
+	assert.Equal(t, "<nfe/>", names["autorizadas/KEY1.xml"])
+	assert.Equal(t, "<evento/>", names["canceladas/KEY2-cancelamento.xml"])
+	require.Contains(t, names, "resumo.csv")
+	assert.Contains(t, names["resumo.csv"], "numero;serie;chave;data;valor_total;tributos;status")
+	assert.Contains(t, names["resumo.csv"], "1;1;KEY1;2026-10-31 21:30:00;", "data no fuso da empresa")
+	assert.Contains(t, names["resumo.csv"], ";10.50;1.20;authorized")
+	assert.Contains(t, names["resumo.csv"], ";cancelled")
+}
+
+func TestMonthlyArchivePeriod_CompanyTimeZone(t *testing.T) {
+	saoPaulo := companyentity.LoadLocation("America/Sao_Paulo")
+	start, end := monthlyArchivePeriod(2026, 10, saoPaulo)
+
+	lastNight := time.Date(2026, 11, 1, 0, 30, 0, 0, time.UTC) // 31/10 21:30 em São Paulo
+	assert.False(t, lastNight.Before(start))
+	assert.True(t, lastNight.Before(end), "nota da noite do último dia fica no pacote de outubro")
+
+	nextMonth := time.Date(2026, 11, 1, 3, 30, 0, 0, time.UTC) // 01/11 00:30 em São Paulo
+	assert.False(t, nextMonth.Before(end))
+}
@@ -0,0 +1,259 @@
+package fiscalinvoiceusecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
+	fiscalsettingsentity "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_settings"
+	fiscalinvoicedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/fiscal_invoice"
+	emailservice "github.com/willjrcom/sales-backend-go/internal/infra/service/email"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/fiscalprovider"
+)
+
+var (
+	ErrInvalidArchivePeriod         = errors.New("invalid archive period (month must be between 1 and 12)")
+	ErrArchiveStorageNotConfigured  = errors.New("file storage is not configured")
+	ErrAccountantEmailNotConfigured = errors.New("accountant email is not configured in fiscal settings")
+	ErrNoInvoicesInPeriod           = errors.New("no authorized or cancelled invoices in period")
+)
+
+// archiveURLExpiration is how long the download link sent to the accountant stays valid (S3 max is 7 days)
+const archiveURLExpiration = 7 * 24 * time.Hour
+
+// archiveFile is a single entry inside the monthly zip
+type archiveFile struct {
+	Name    string
+	Content []byte
+}
+
+// ExportMonthlyArchive builds a zip with the authorized XMLs, the cancellation event XMLs
+// and a CSV summary of the month, stores it on S3 and optionally emails the accountant.
+func (s *Service) ExportMonthlyArchive(ctx context.Context, year, month int, sendToAccountant bool) (*fiscalinvoicedto.MonthlyArchiveDTO, error) {
+	if month < 1 || month > 12 || year < 2000 {
+		return nil, ErrInvalidArchivePeriod
+	}
+
+	if s.s3 == nil {
+		return nil, ErrArchiveStorageNotConfigured
+	}
+
+	companyModel, err := s.companyRepo.GetCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settingsModel, err := s.fiscalSettingsRepo.GetByCompanyID(ctx, companyModel.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFiscalNotEnabled
+	}
+	if err != nil {
+		return nil, err
+	}
+	if settingsModel == nil {
+		return nil, ErrFiscalNotEnabled
+	}
+
+	settings := settingsModel.ToDomain()
+	if sendToAccountant && settings.AccountantEmail == "" {
+		return nil, ErrAccountantEmailNotConfigured
+	}
+
+	loc := companyModel.ToDomain().Location()
+	start, end := monthlyArchivePeriod(year, month, loc)
+
+	invoiceModels, err := s.invoiceRepo.ListByPeriod(ctx, companyModel.ID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list invoices: %w", err)
+	}
+
+	invoices := make([]*fiscalinvoice.FiscalInvoice, 0, len(invoiceModels))
+	for _, invoiceModel := range invoiceModels {
+		invoice := invoiceModel.ToDomain()
+		if invoice.IsAuthorized() || invoice.IsCancelled() {
+			invoices = append(invoices, invoice)
+		}
+	}
+
+	if len(invoices) == 0 {
+		return nil, ErrNoInvoicesInPeriod
+	}
+
+	provider, _ := s.providers.For(settings)
+
+	archive := &fiscalinvoicedto.MonthlyArchiveDTO{Year: year, Month: month}
+	files := make([]archiveFile, 0, len(invoices))
+
+	for _, invoice := range invoices {
+		name := invoice.AccessKey
+		if name == "" {
+			name = invoice.ID.String()
+		}
+
+		folder := "autorizadas"
+		if invoice.IsCancelled() {
+			folder = "canceladas"
+			archive.CancelledCount++
+		} else {
+			archive.AuthorizedCount++
+		}
+
+		if content, ok := s.downloadFiscalFile(ctx, provider, settings, invoice.XMLPath); ok {
+			files = append(files, archiveFile{Name: folder + "/" + name + ".xml", Content: content})
+		} else {
+			archive.MissingFiles = append(archive.MissingFiles, name+".xml")
+		}
+
+		if !invoice.IsCancelled() {
+			continue
+		}
+
+		if content, ok := s.downloadFiscalFile(ctx, provider, settings, invoice.CancellationXMLPath); ok {
+			files = append(files, archiveFile{Name: folder + "/" + name + "-cancelamento.xml", Content: content})
+		} else {
+			archive.MissingFiles = append(archive.MissingFiles, name+"-cancelamento.xml")
+		}
+	}
+
+	zipContent, err := buildMonthlyArchiveZip(files, invoices, loc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build archive: %w", err)
+	}
+
+	key := fmt.Sprintf("fiscal/%s/xml-%04d-%02d-%s.zip", companyModel.ID.String(), year, month, uuid.NewString())
+	if _, err := s.s3.UploadBytes(key, zipContent, "application/zip"); err != nil {
+		return nil, fmt.Errorf("failed to upload archive: %w", err)
+	}
+
+	url, err := s.s3.PresignedURL(ctx, key, archiveURLExpiration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign archive url: %w", err)
+	}
+
+	archive.FileKey = key
+	archive.URL = url
+	archive.URLExpiresAt = time.Now().Add(archiveURLExpiration)
+
+	if sendToAccountant {
+		if s.emailService == nil {
+			return nil, errors.New("email service not configured")
+		}
+
+		bodyEmail := &emailservice.BodyEmail{
+			Email:   settings.AccountantEmail,
+			Subject: fmt.Sprintf("XMLs fiscais %02d/%04d - %s", month, year, companyModel.TradeName),
+			Body: fmt.Sprintf(`<div style="font-family: Arial, sans-serif; max-width: 480px; margin: 0 auto; padding: 32px;">
+			<h2 style="color: #eab308; margin-bottom: 16px;">Arquivo fiscal de %02d/%04d</h2>
+			<p style="color: #333; font-size: 16px;">
+				Empresa: %s (CNPJ %s)<br>
+				Notas autorizadas: %d<br>
+				Notas canceladas: %d
+			</p>
+			<p style="color: #333; font-size: 16px;">
+				<a href="%s">Baixar arquivo (XMLs + resumo CSV)</a><br>
+				<small>O link expira em 7 dias.</small>
+			</p>
+			</div>`, month, year, companyModel.BusinessName, companyModel.Cnpj, archive.AuthorizedCount, archive.CancelledCount, archive.URL),
+		}
+
+		if err := s.emailService.SendEmail(bodyEmail); err != nil {
+			return nil, fmt.Errorf("failed to send archive email: %w", err)
+		}
+
+		archive.SentTo = settings.AccountantEmail
+	}
+
+	return archive, nil
+}
+
+func (s *Service) downloadFiscalFile(ctx context.Context, provider fiscalprovider.FiscalProvider, settings *fiscalsettingsentity.FiscalSettings, path string) ([]byte, bool) {
+	if path == "" || provider == nil {
+		return nil, false
+	}
+
+	content, err := provider.DownloadFile(ctx, settings, path)
+	if err != nil {
+		fmt.Printf("Warning: failed to download fiscal file %s: %v\n", path, err)
+		return nil, false
+	}
+
+	return content, true
+}
+
+// monthlyArchivePeriod is the calendar month in the company time zone, so late-night
+// invoices on the last day are not pushed into the next month's package
+func monthlyArchivePeriod(year, month int, loc *time.Location) (time.Time, time.Time) {
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// buildMonthlyArchiveZip writes the XML files plus resumo.csv into a zip
+func buildMonthlyArchiveZip(files []archiveFile, invoices []*fiscalinvoice.FiscalInvoice, loc *time.Location) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+
+	for _, file := range files {
+		w, err := zw.Create(file.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := w.Write(file.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	w, err := zw.Create("resumo.csv")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeArchiveSummary(w, invoices, loc); err != nil {
+		return nil, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeArchiveSummary writes one line per invoice using ";" as separator (spreadsheet pt-BR default)
+// with the issue date in the company time zone
+func writeArchiveSummary(w io.Writer, invoices []*fiscalinvoice.FiscalInvoice, loc *time.Location) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+
+	if err := cw.Write([]string{"numero", "serie", "chave", "data", "valor_total", "tributos", "status"}); err != nil {
+		return err
+	}
+
+	for _, invoice := range invoices {
+		record := []string{
+			strconv.Itoa(invoice.Number),
+			strconv.Itoa(invoice.Series),
+			invoice.AccessKey,
+			invoice.CreatedAt.In(loc).Format("2006-01-02 15:04:05"),
+			invoice.TotalAmount.StringFixed(2),
+			invoice.TaxAmount.StringFixed(2),
+			string(invoice.Status),
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
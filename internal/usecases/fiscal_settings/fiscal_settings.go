@@ -2,26 +2,30 @@ package fiscalsettingsusecases
 
 import (
 	"context"
-
+	"errors"
 	"fmt"
 
 	fiscalsettingsentity "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_settings"
 	fiscalsettingsdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/fiscal_settings"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
-	"github.com/willjrcom/sales-backend-go/internal/infra/service/focusnfe"
+	"github.com/willjrcom/sales-backend-go/internal/infra/service/fiscalprovider"
+)
+
+var (
+	ErrUnknownFiscalProvider = errors.New("unknown fiscal provider")
 )
 
 type Service struct {
 	fiscalSettingsRepo model.FiscalSettingsRepository
 	companyRepo        model.CompanyRepository
-	focusClient        *focusnfe.Client
+	providers          *fiscalprovider.Registry
 }
 
-func NewService(fiscalSettingsRepo model.FiscalSettingsRepository, companyRepo model.CompanyRepository, focusClient *focusnfe.Client) *Service {
+func NewService(fiscalSettingsRepo model.FiscalSettingsRepository, companyRepo model.CompanyRepository, providers *fiscalprovider.Registry) *Service {
 	return &Service{
 		fiscalSettingsRepo: fiscalSettingsRepo,
 		companyRepo:        companyRepo,
-		focusClient:        focusClient,
+		providers:          providers,
 	}
 }
 
@@ -71,6 +75,10 @@ func (s *Service) UpdateFiscalSettings(ctx context.Context, input *fiscalsetting
 		settings = fiscalsettingsentity.NewFiscalSettings(companyModel.ID)
 	}
 
+	if input.Provider != nil && *input.Provider != "" && !s.providers.Has(*input.Provider) {
+		return ErrUnknownFiscalProvider
+	}
+
 	// Update fields
 	updateEntityFromDTO(settings, input)
 
@@ -94,7 +102,7 @@ func (s *Service) UpdateFiscalSettings(ctx context.Context, input *fiscalsetting
 	}
 
 	if settings.IsActive {
-		if err := s.registerCompanyInFocus(ctx, settings); err != nil {
+		if err := s.registerCompanyInProvider(ctx, settings); err != nil {
 			return err
 		}
 	}
@@ -102,48 +110,20 @@ func (s *Service) UpdateFiscalSettings(ctx context.Context, input *fiscalsetting
 	return nil
 }
 
-func (s *Service) registerCompanyInFocus(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings) error {
-	if s.focusClient == nil || !s.focusClient.Enabled() {
-		return fmt.Errorf("focus nfe client is not enabled")
-	}
-
-	regime := "1" // Simples Nacional
-	if settings.TaxRegime == 3 {
-		regime = "3" // Regime Normal
-	}
-
-	req := &focusnfe.CompanyRegistryRequest{
-		Nome:                    settings.BusinessName,
-		NomeFantasia:            settings.TradeName,
-		InscricaoEstadual:       settings.StateRegistration,
-		InscricaoMunicipal:      settings.MunicipalRegistration,
-		CNPJ:                    settings.Cnpj,
-		RegimeTributario:        regime,
-		Email:                   settings.Email,
-		Telefone:                settings.Phone,
-		Logradouro:              settings.Address.Street,
-		Numero:                  settings.Address.Number,
-		Complemento:             settings.Address.Complement,
-		Bairro:                  settings.Address.Neighborhood,
-		CEP:                     settings.Address.Cep,
-		Municipio:               settings.Address.City,
-		UF:                      settings.Address.UF,
-		DiscriminaImpostos:      settings.ShowTaxBreakdown,
-		EnviarEmailDestinatario: settings.SendEmailToRecipient,
-		CscNfceProducao:         settings.CSCProductionCode,
-		IdTokenNfceProducao:     settings.CSCProductionID,
-		CscNfceHomologacao:      settings.CSCHomologationCode,
-		IdTokenNfceHomologacao:  settings.CSCHomologationID,
+func (s *Service) registerCompanyInProvider(ctx context.Context, settings *fiscalsettingsentity.FiscalSettings) error {
+	provider, err := s.providers.For(settings)
+	if err != nil {
+		return fmt.Errorf("fiscal provider unavailable: %w", err)
 	}
 
-	resp, err := s.focusClient.CadastrarEmpresa(ctx, req)
+	resp, err := provider.RegisterCompany(ctx, settings)
 	if err != nil {
-		return fmt.Errorf("failed to register company in Focus NFe: %w", err)
+		return fmt.Errorf("failed to register company in %s: %w", provider.Name(), err)
 	}
 
 	// Update settings with the returned ID if successful
-	if resp != nil && resp.ID > 0 {
-		settings.SetCompanyRegistryID(resp.ID)
+	if resp != nil && resp.RegistryID > 0 {
+		settings.SetCompanyRegistryID(resp.RegistryID)
 		settings.SetTokens(resp.TokenProduction, resp.TokenHomologation)
 
 		settingsModel := &model.FiscalSettings{}
@@ -159,6 +139,7 @@ func (s *Service) registerCompanyInFocus(ctx context.Context, settings *fiscalse
 }
 
 func mapEntityToDTO(entity *fiscalsettingsentity.FiscalSettings, dto *fiscalsettingsdto.FiscalSettingsDTO) {
+	dto.Provider = entity.Provider
 	dto.FiscalEnabled = entity.IsActive
 	dto.StateRegistration = entity.StateRegistration
 	dto.TaxRegime = entity.TaxRegime
@@ -168,6 +149,7 @@ func mapEntityToDTO(entity *fiscalsettingsentity.FiscalSettings, dto *fiscalsett
 	dto.MunicipalRegistration = entity.MunicipalRegistration
 	dto.ShowTaxBreakdown = entity.ShowTaxBreakdown
 	dto.SendEmailToRecipient = entity.SendEmailToRecipient
+	dto.AccountantEmail = entity.AccountantEmail
 	dto.BusinessName = entity.BusinessName
 	dto.TradeName = entity.TradeName
 	dto.Cnpj = entity.Cnpj
@@ -190,6 +172,9 @@ func updateEntityFromDTO(entity *fiscalsettingsentity.FiscalSettings, dto *fisca
 	if dto.FiscalEnabled != nil {
 		entity.IsActive = *dto.FiscalEnabled
 	}
+	if dto.Provider != nil {
+		entity.Provider = *dto.Provider
+	}
 	if dto.StateRegistration != nil {
 		entity.StateRegistration = *dto.StateRegistration
 	}
@@ -224,6 +209,9 @@ func updateEntityFromDTO(entity *fiscalsettingsentity.FiscalSettings, dto *fisca
 	if dto.SendEmailToRecipient != nil {
 		entity.SendEmailToRecipient = *dto.SendEmailToRecipient
 	}
+	if dto.AccountantEmail != nil {
+		entity.AccountantEmail = *dto.AccountantEmail
+	}
 	if dto.BusinessName != nil {
 		entity.BusinessName = *dto.BusinessName
 	}
@@ -0,0 +1,231 @@
+package ibptusecases
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
+	ibptentity "github.com/willjrcom/sales-backend-go/internal/domain/ibpt"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	ibptdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/ibpt"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+var (
+	ErrEmptyTable      = errors.New("IBPT table has no NCM lines")
+	ErrTaxRateNotFound = errors.New("IBPT tax rate not found for this NCM")
+)
+
+type Service struct {
+	repo               model.IbptTaxRateRepository
+	productRepo        model.ProductRepository
+	companyRepo        model.CompanyRepository
+	fiscalSettingsRepo model.FiscalSettingsRepository
+}
+
+func NewService(repo model.IbptTaxRateRepository) *Service {
+	return &Service{repo: repo}
+}
+
+func (s *Service) AddDependencies(productRepo model.ProductRepository, companyRepo model.CompanyRepository, fiscalSettingsRepo model.FiscalSettingsRepository) {
+	s.productRepo = productRepo
+	s.companyRepo = companyRepo
+	s.fiscalSettingsRepo = fiscalSettingsRepo
+}
+
+// ImportTable replaces the rates of the UF with the lines of the IBPT CSV
+func (s *Service) ImportTable(ctx context.Context, uf string, r io.Reader) (*ibptdto.ImportResultDTO, error) {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+	if len(uf) != 2 {
+		return nil, ibptentity.ErrInvalidUF
+	}
+
+	rates, skipped, err := parseTable(r, uf)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rates) == 0 {
+		return nil, ErrEmptyTable
+	}
+
+	rateModels := make([]model.IbptTaxRate, len(rates))
+	for i, rate := range rates {
+		rateModels[i].FromDomain(rate)
+	}
+
+	if err := s.repo.UpsertMany(ctx, rateModels); err != nil {
+		return nil, err
+	}
+
+	return &ibptdto.ImportResultDTO{
+		UF:       uf,
+		Version:  rates[0].Version,
+		Imported: len(rates),
+		Skipped:  skipped,
+	}, nil
+}
+
+// GetTaxRate returns the rate in force for the NCM in the UF
+func (s *Service) GetTaxRate(ctx context.Context, uf, ncm string) (*ibptdto.TaxRateDTO, error) {
+	rates, err := s.loadRates(ctx, strings.ToUpper(uf), []string{onlyDigits(ncm)})
+	if err != nil {
+		return nil, err
+	}
+
+	rate, ok := rates[onlyDigits(ncm)]
+	if !ok {
+		return nil, ErrTaxRateNotFound
+	}
+
+	dto := &ibptdto.TaxRateDTO{}
+	dto.FromDomain(rate)
+	return dto, nil
+}
+
+// ApplyToReceipt fills the approximate taxes of the items when the company asked
+// for the breakdown in the fiscal settings; otherwise the order is left untouched.
+func (s *Service) ApplyToReceipt(ctx context.Context, order *orderentity.Order) error {
+	if s.companyRepo == nil || s.fiscalSettingsRepo == nil {
+		return nil
+	}
+
+	company, err := s.companyRepo.GetCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	settingsModel, err := s.fiscalSettingsRepo.GetByCompanyID(ctx, company.ID)
+	if err != nil || settingsModel == nil {
+		return nil
+	}
+
+	settings := settingsModel.ToDomain()
+	if !settings.ShowTaxBreakdown || settings.Address.UF == "" {
+		return nil
+	}
+
+	return s.ApplyToOrder(ctx, order, settings.Address.UF)
+}
+
+// ApplyToOrder loads the product of each item (for its NCM) and calculates Item.Taxes.
+// Items whose NCM is missing from the imported table are left without taxes.
+func (s *Service) ApplyToOrder(ctx context.Context, order *orderentity.Order, uf string) error {
+	items := orderItems(order)
+	if len(items) == 0 {
+		return nil
+	}
+
+	products := map[uuid.UUID]*productentity.Product{}
+	ncms := []string{}
+
+	for _, item := range items {
+		if item.Product == nil {
+			product, ok := products[item.ProductID]
+			if !ok {
+				product = s.loadProduct(ctx, item.ProductID)
+				products[item.ProductID] = product
+			}
+			item.Product = product
+		}
+
+		ncms = append(ncms, ItemNCM(item))
+	}
+
+	rates, err := s.loadRates(ctx, strings.ToUpper(uf), ncms)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		rate, ok := rates[ItemNCM(item)]
+		if !ok {
+			continue
+		}
+
+		item.Taxes = rate.Calculate(item.SubTotal.Mul(decimal.NewFromFloat(item.Quantity)))
+	}
+
+	return nil
+}
+
+// ItemNCM returns the product NCM, or the default food NCM when the product has none
+func ItemNCM(item *orderentity.Item) string {
+	if item.Product != nil {
+		if ncm := onlyDigits(item.Product.NCM); ncm != "" {
+			return ncm
+		}
+	}
+
+	return fiscalinvoice.DefaultFoodNCM
+}
+
+func (s *Service) loadProduct(ctx context.Context, productID uuid.UUID) *productentity.Product {
+	if s.productRepo == nil || productID == uuid.Nil {
+		return nil
+	}
+
+	productModel, err := s.productRepo.GetProductById(ctx, productID.String())
+	if err != nil || productModel == nil {
+		return nil
+	}
+
+	return productModel.ToDomain()
+}
+
+// loadRates returns the rate in force by NCM, preferring the line without EX TIPI
+func (s *Service) loadRates(ctx context.Context, uf string, ncms []string) (map[string]*ibptentity.TaxRate, error) {
+	rateModels, err := s.repo.GetByNCMs(ctx, uf, ncms)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	rates := map[string]*ibptentity.TaxRate{}
+	for i := range rateModels {
+		rate := rateModels[i].ToDomain()
+		if !rate.IsValidAt(now) {
+			continue
+		}
+
+		if current, ok := rates[rate.NCM]; ok && current.Exception == "" {
+			continue
+		}
+
+		rates[rate.NCM] = rate
+	}
+
+	return rates, nil
+}
+
+// orderItems flattens items, additional items and complements of the order
+func orderItems(order *orderentity.Order) []*orderentity.Item {
+	items := []*orderentity.Item{}
+
+	var walk func(item *orderentity.Item)
+	walk = func(item *orderentity.Item) {
+		items = append(items, item)
+		for j := range item.AdditionalItems {
+			walk(&item.AdditionalItems[j])
+		}
+	}
+
+	for i := range order.GroupItems {
+		group := &order.GroupItems[i]
+		for j := range group.Items {
+			walk(&group.Items[j])
+		}
+
+		if group.ComplementItem != nil {
+			walk(group.ComplementItem)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,181 @@
+package ibptusecases
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	fiscalinvoice "github.com/willjrcom/sales-backend-go/internal/domain/fiscal_invoice"
+	ibptentity "github.com/willjrcom/sales-backend-go/internal/domain/ibpt"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	ibptrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/ibpt"
+	productrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/product"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+var (
+	ctx         context.Context
+	productRepo model.ProductRepository
+	service     *Service
+)
+
+// Trecho no formato da TabelaIBPTaxSP (separador ";"), incluindo uma linha de serviço (tipo 1) e uma EX
+const tableSP = `codigo;ex;tipo;descricao;nacionalfederal;importadosfederal;estadual;municipal;vigenciainicio;vigenciafim;chave;versao;fonte
+21069090;;0;Outras preparações alimentícias;13.45;15.45;18.00;0.00;01/01/2020;31/12/2099;A1B2C3;24.1.A;IBPT/empresometro.com.br
+21069090;01;0;Complementos alimentares;9.00;11.00;12.00;0.00;01/01/2020;31/12/2099;A1B2C3;24.1.A;IBPT/empresometro.com.br
+22021000;;0;Águas, incluídas as águas minerais;11.20;13.10;20.00;0.00;01/01/2020;31/12/2099;A1B2C3;24.1.A;IBPT/empresometro.com.br
+01.07;;1;Serviços de suporte técnico;13.45;15.45;0.00;2.00;01/01/2020;31/12/2099;A1B2C3;24.1.A;IBPT/empresometro.com.br
+`
+
+func TestMain(m *testing.M) {
+	ctx = context.Background()
+
+	productRepo = productrepositorylocal.NewProductRepositoryLocal()
+	service = NewService(ibptrepositorylocal.NewIbptTaxRateRepositoryLocal())
+	service.AddDependencies(productRepo, nil, nil)
+
+	if _, err := service.ImportTable(ctx, "sp", strings.NewReader(tableSP)); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+func newProduct(t *testing.T, ncm string) uuid.UUID {
+	t.Helper()
+
+	product := productentity.NewProduct(productentity.ProductCommonAttributes{SKU: uuid.NewString(), NCM: ncm, Name: "produto"})
+	productModel := &model.Product{}
+	productModel.FromDomain(product)
+	require.NoError(t, productRepo.CreateProduct(ctx, productModel))
+	return product.ID
+}
+
+func newItem(name string, price float64, quantity float64, productID uuid.UUID) orderentity.Item {
+	return *orderentity.NewItem(name, decimal.NewFromFloat(price), quantity, "M", productID, uuid.New(), uuid.New(), nil)
+}
+
+// ─────────────────────────────────────────────────────────────
+// Importação
+// ─────────────────────────────────────────────────────────────
+
+func TestImportTable(t *testing.T) {
+	repo := ibptrepositorylocal.NewIbptTaxRateRepositoryLocal()
+	svc := NewService(repo)
+
+	result, err := svc.ImportTable(ctx, "rj", strings.NewReader(tableSP))
+	require.NoError(t, err)
+	assert.Equal(t, "RJ", result.UF)
+	assert.Equal(t, "24.1.A", result.Version)
+	assert.Equal(t, 3, result.Imported, "somente linhas de NCM (tipo 0) são importadas")
+	assert.Equal(t, 1, result.Skipped)
+
+	// Reimportar a mesma versão não duplica as linhas
+	_, err = svc.ImportTable(ctx, "RJ", strings.NewReader(tableSP))
+	require.NoError(t, err)
+
+	count, err := repo.CountByUF(ctx, "RJ")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestImportTable_Latin1(t *testing.T) {
+	latin1 := make([]byte, 0, len(tableSP))
+	for _, r := range tableSP {
+		latin1 = append(latin1, byte(r))
+	}
+
+	_, err := service.ImportTable(ctx, "MG", bytes.NewReader(latin1))
+	require.NoError(t, err)
+
+	rate, err := service.GetTaxRate(ctx, "MG", "2202.10.00")
+	require.NoError(t, err)
+	assert.Equal(t, "Águas, incluídas as águas minerais", rate.Description)
+}
+
+func TestImportTable_Invalid(t *testing.T) {
+	_, err := service.ImportTable(ctx, "SPX", strings.NewReader(tableSP))
+	assert.ErrorIs(t, err, ibptentity.ErrInvalidUF)
+
+	_, err = service.ImportTable(ctx, "SP", strings.NewReader("ncm;aliquota\n21069090;10\n"))
+	assert.ErrorIs(t, err, ErrInvalidTableHeader)
+
+	_, err = service.ImportTable(ctx, "SP", strings.NewReader("codigo;ex;tipo;descricao;nacionalfederal;importadosfederal;estadual;municipal\n21069090;;0;x;abc;1;1;1\n"))
+	assert.ErrorIs(t, err, ErrInvalidTableLine)
+
+	_, err = service.ImportTable(ctx, "SP", strings.NewReader("codigo;ex;tipo;descricao;nacionalfederal;importadosfederal;estadual;municipal\n"))
+	assert.ErrorIs(t, err, ErrEmptyTable)
+}
+
+func TestGetTaxRate_PrefersLineWithoutException(t *testing.T) {
+	rate, err := service.GetTaxRate(ctx, "SP", fiscalinvoice.DefaultFoodNCM)
+	require.NoError(t, err)
+	assert.Equal(t, "", rate.Exception, "linha sem EX TIPI deve ser usada")
+	assert.True(t, rate.FederalNational.Equal(decimal.NewFromFloat(13.45)))
+
+	_, err = service.GetTaxRate(ctx, "SP", "99999999")
+	assert.ErrorIs(t, err, ErrTaxRateNotFound)
+}
+
+// ─────────────────────────────────────────────────────────────
+// Cálculo por item
+// ─────────────────────────────────────────────────────────────
+
+func TestApplyToOrder(t *testing.T) {
+	waterID := newProduct(t, "2202.10.00")
+	pizzaID := newProduct(t, "") // sem NCM: usa o NCM padrão de alimentos
+	unknownID := newProduct(t, "99999999")
+
+	pizza := newItem("Pizza", 50, 1, pizzaID)
+	pizza.AdditionalItems = []orderentity.Item{newItem("Borda", 10, 1, pizzaID)}
+	pizza.CalculateTotal()
+
+	order := &orderentity.Order{}
+	group := orderentity.GroupItem{}
+	group.Items = []orderentity.Item{pizza, newItem("Água", 5, 2, waterID), newItem("Sem tabela", 8, 1, unknownID)}
+	order.GroupItems = []orderentity.GroupItem{group}
+
+	require.NoError(t, service.ApplyToOrder(ctx, order, "sp"))
+
+	items := order.GroupItems[0].Items
+
+	// Pizza R$ 50: 13,45% federal e 18% estadual
+	require.NotNil(t, items[0].Taxes)
+	assert.Equal(t, "6.73", items[0].Taxes.Federal.StringFixed(2))
+	assert.Equal(t, "9.00", items[0].Taxes.State.StringFixed(2))
+	assert.Equal(t, "0.00", items[0].Taxes.Municipal.StringFixed(2))
+	assert.Equal(t, ibptentity.Source, items[0].Taxes.Source)
+
+	// Adicional calculado separadamente e somado no item
+	require.NotNil(t, items[0].AdditionalItems[0].Taxes)
+	assert.Equal(t, "18.88", items[0].TotalApproximateTaxes().Total().StringFixed(2), "(6,73 + 9,00) + (1,35 + 1,80): arredondado por tributo")
+
+	// Água 2 x R$ 5: 11,20% federal e 20% estadual
+	require.NotNil(t, items[1].Taxes)
+	assert.Equal(t, "3.12", items[1].Taxes.Total().StringFixed(2))
+	assert.Equal(t, "22021000", ItemNCM(&items[1]))
+
+	// NCM fora da tabela fica sem tributos
+	assert.Nil(t, items[2].Taxes)
+
+	total := order.ApproximateTaxes()
+	require.NotNil(t, total)
+	assert.Equal(t, "22.00", total.Total().StringFixed(2))
+}
+
+func TestApplyToOrder_UnknownUF(t *testing.T) {
+	order := &orderentity.Order{}
+	group := orderentity.GroupItem{}
+	group.Items = []orderentity.Item{newItem("Pizza", 50, 1, uuid.New())}
+	order.GroupItems = []orderentity.GroupItem{group}
+
+	require.NoError(t, service.ApplyToOrder(ctx, order, "AC"))
+	assert.Nil(t, order.ApproximateTaxes(), "sem tabela importada para a UF não há tributos")
+}
@@ -0,0 +1,147 @@
+package ibptusecases
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+	ibptentity "github.com/willjrcom/sales-backend-go/internal/domain/ibpt"
+)
+
+var (
+	ErrInvalidTableHeader = errors.New("invalid IBPT table: expected header codigo;ex;tipo;descricao;nacionalfederal;importadosfederal;estadual;municipal")
+	ErrInvalidTableLine   = errors.New("invalid IBPT table line")
+)
+
+// typeNCM is the "tipo" column value for goods; 1 (NBS) and 2 (LC 116) are services
+const typeNCM = "0"
+
+var requiredColumns = []string{"codigo", "ex", "tipo", "descricao", "nacionalfederal", "importadosfederal", "estadual", "municipal"}
+
+// parseTable reads the CSV distributed by IBPT (TabelaIBPTax<UF><versão>.csv).
+// The file is ";" separated and usually Latin-1 encoded.
+func parseTable(r io.Reader, uf string) (rates []*ibptentity.TaxRate, skipped int, err error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(content) {
+		content = latin1ToUTF8(content)
+	}
+
+	cr := csv.NewReader(bytes.NewReader(content))
+	cr.Comma = ';'
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, 0, ErrInvalidTableHeader
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, 0, ErrInvalidTableHeader
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	line := 1
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w %d: %v", ErrInvalidTableLine, line, err)
+		}
+
+		if field(record, "tipo") != typeNCM {
+			skipped++
+			continue
+		}
+
+		federalNational, errFN := decimal.NewFromString(field(record, "nacionalfederal"))
+		federalImported, errFI := decimal.NewFromString(field(record, "importadosfederal"))
+		state, errST := decimal.NewFromString(field(record, "estadual"))
+		municipal, errMU := decimal.NewFromString(field(record, "municipal"))
+		if err := errors.Join(errFN, errFI, errST, errMU); err != nil {
+			return nil, 0, fmt.Errorf("%w %d: invalid rate: %v", ErrInvalidTableLine, line, err)
+		}
+
+		rate, err := ibptentity.NewTaxRate(ibptentity.TaxRateCommonAttributes{
+			NCM:             onlyDigits(field(record, "codigo")),
+			Exception:       field(record, "ex"),
+			UF:              uf,
+			Description:     field(record, "descricao"),
+			FederalNational: federalNational,
+			FederalImported: federalImported,
+			State:           state,
+			Municipal:       municipal,
+			ValidFrom:       parseDate(field(record, "vigenciainicio")),
+			ValidUntil:      parseDate(field(record, "vigenciafim")),
+			Version:         field(record, "versao"),
+			Key:             field(record, "chave"),
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w %d: %v", ErrInvalidTableLine, line, err)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	return rates, skipped, nil
+}
+
+func parseDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+
+	date, err := time.Parse("02/01/2006", value)
+	if err != nil {
+		return nil
+	}
+
+	return &date
+}
+
+func onlyDigits(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func latin1ToUTF8(content []byte) []byte {
+	runes := make([]rune, len(content))
+	for i, b := range content {
+		runes[i] = rune(b)
+	}
+	return []byte(string(runes))
+}
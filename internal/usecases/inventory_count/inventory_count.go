@@ -0,0 +1,339 @@
+package inventorycountusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	inventorycountentity "github.com/willjrcom/sales-backend-go/internal/domain/inventory_count"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	inventorycountdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/inventory_count"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
+)
+
+var (
+	ErrContextUser      = errors.New("context user not found")
+	ErrNoStocksToCount  = errors.New("no active stocks found for the inventory scope")
+	ErrNoEntries        = errors.New("no count entries informed")
+	ErrEntryItemUnknown = errors.New("count entry does not match any inventory count item")
+)
+
+type Service struct {
+	db             *bun.DB
+	r              model.InventoryCountRepository
+	stockRepo      model.StockRepository
+	stockBatchRepo model.StockBatchRepository
+	stockService   *stockusecases.Service
+	employeeRepo   model.EmployeeRepository
+}
+
+func NewService(db *bun.DB, r model.InventoryCountRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) AddDependencies(stockRepo model.StockRepository, stockBatchRepo model.StockBatchRepository, stockService *stockusecases.Service, employeeRepo model.EmployeeRepository) {
+	s.stockRepo = stockRepo
+	s.stockBatchRepo = stockBatchRepo
+	s.stockService = stockService
+	s.employeeRepo = employeeRepo
+}
+
+// CreateInventoryCount abre a sessão congelando, por lote com saldo (ou por estoque sem lote),
+// a quantidade esperada e o custo naquele momento
+func (s *Service) CreateInventoryCount(ctx context.Context, dto *inventorycountdto.InventoryCountCreateDTO) (uuid.UUID, error) {
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	inventoryCount, err := inventorycountentity.NewInventoryCount(dto.Scope, dto.CategoryID, employeeID, dto.Notes)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	stockModels, err := s.stockRepo.GetActiveStocks(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("erro ao buscar estoques: %w", err)
+	}
+
+	for i := range stockModels {
+		stock := stockModels[i].ToDomain()
+		if inventoryCount.CategoryID != nil && stock.Product.CategoryID != *inventoryCount.CategoryID {
+			continue
+		}
+
+		if err := s.addStockItems(ctx, inventoryCount, stock); err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	if len(inventoryCount.Items) == 0 {
+		return uuid.Nil, ErrNoStocksToCount
+	}
+
+	inventoryCountModel := &model.InventoryCount{}
+	inventoryCountModel.FromDomain(inventoryCount)
+	if err := s.r.CreateInventoryCount(ctx, inventoryCountModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return inventoryCount.ID, nil
+}
+
+// RecordCounts aplica os lançamentos de um funcionário. A sessão é bloqueada durante a gravação,
+// então leitores diferentes somando no mesmo item não perdem contagens.
+func (s *Service) RecordCounts(ctx context.Context, dtoId *entitydto.IDRequest, dto *inventorycountdto.InventoryCountEntriesDTO) (*inventorycountdto.InventoryCountDTO, error) {
+	if len(dto.Entries) == 0 {
+		return nil, ErrNoEntries
+	}
+
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	inventoryCountModel, err := s.r.GetInventoryCountByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar inventário: %w", err)
+	}
+
+	inventoryCount := inventoryCountModel.ToDomain()
+
+	entryModels := make([]model.InventoryCountEntry, 0, len(dto.Entries))
+	changedItems := map[uuid.UUID]bool{}
+	for _, entryDTO := range dto.Entries {
+		item := entryDTO.ResolveItem(inventoryCount)
+		if item == nil {
+			return nil, ErrEntryItemUnknown
+		}
+
+		entry, err := inventoryCount.RecordCount(item.ID, employeeID, entryDTO.Quantity, entryDTO.Mode)
+		if err != nil {
+			return nil, err
+		}
+
+		entryModel := model.InventoryCountEntry{}
+		entryModel.FromDomain(entry)
+		entryModels = append(entryModels, entryModel)
+		changedItems[item.ID] = true
+	}
+
+	if err := s.r.UpdateInventoryCountItems(ctx, tx, changedItemModels(inventoryCount, changedItems)); err != nil {
+		return nil, fmt.Errorf("erro ao gravar contagem: %w", err)
+	}
+
+	if err := s.r.CreateInventoryCountEntries(ctx, tx, entryModels); err != nil {
+		return nil, fmt.Errorf("erro ao gravar lançamentos de contagem: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	inventoryCountDTO := &inventorycountdto.InventoryCountDTO{}
+	inventoryCountDTO.FromDomain(inventoryCount)
+	return inventoryCountDTO, nil
+}
+
+// ApproveInventoryCount encerra a contagem e, na mesma transação, lança cada diferença
+// como adjust_in/adjust_out ligado à sessão
+func (s *Service) ApproveInventoryCount(ctx context.Context, dtoId *entitydto.IDRequest, dto *inventorycountdto.InventoryCountApproveDTO) (*inventorycountdto.InventoryCountApprovalDTO, error) {
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	inventoryCountModel, err := s.r.GetInventoryCountByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar inventário: %w", err)
+	}
+
+	inventoryCount := inventoryCountModel.ToDomain()
+	if err := inventoryCount.Approve(employeeID, dto.TreatUncountedAsZero); err != nil {
+		return nil, err
+	}
+
+	approval := &inventorycountdto.InventoryCountApprovalDTO{MovementIDs: []uuid.UUID{}}
+	reason := fmt.Sprintf("Inventário %s", inventoryCount.ID.String()[:8])
+	for _, item := range inventoryCount.Items {
+		movement, err := s.stockService.PostCountAdjustmentWithTx(ctx, tx, item.StockID, item.BatchID, stockentity.CountAdjustment{
+			Variance:         item.Variance(),
+			CostPrice:        item.CostPrice,
+			Reason:           reason,
+			EmployeeID:       employeeID,
+			InventoryCountID: inventoryCount.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if movement != nil {
+			approval.MovementIDs = append(approval.MovementIDs, movement.ID)
+		}
+	}
+
+	inventoryCountModel.FromDomain(inventoryCount)
+	if err := s.r.UpdateInventoryCountItems(ctx, tx, inventoryCountModel.Items); err != nil {
+		return nil, fmt.Errorf("erro ao gravar contagem: %w", err)
+	}
+
+	if err := s.r.UpdateInventoryCount(ctx, tx, inventoryCountModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar inventário: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	approval.InventoryCount.FromDomain(inventoryCount)
+	return approval, nil
+}
+
+func (s *Service) CancelInventoryCount(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	inventoryCountModel, err := s.r.GetInventoryCountByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	inventoryCount := inventoryCountModel.ToDomain()
+	if err := inventoryCount.Cancel(); err != nil {
+		return err
+	}
+
+	inventoryCountModel.FromDomain(inventoryCount)
+	if err := s.r.UpdateInventoryCount(ctx, tx, inventoryCountModel); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) GetInventoryCountById(ctx context.Context, dto *entitydto.IDRequest) (*inventorycountdto.InventoryCountDTO, error) {
+	inventoryCountModel, err := s.r.GetInventoryCountById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	inventoryCountDTO := &inventorycountdto.InventoryCountDTO{}
+	inventoryCountDTO.FromDomain(inventoryCountModel.ToDomain())
+	return inventoryCountDTO, nil
+}
+
+func (s *Service) GetAllInventoryCounts(ctx context.Context, page, perPage int, status string) ([]inventorycountdto.InventoryCountDTO, int, error) {
+	inventoryCountModels, count, err := s.r.GetAllInventoryCounts(ctx, page, perPage, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	inventoryCountDTOs := []inventorycountdto.InventoryCountDTO{}
+	for i := range inventoryCountModels {
+		inventoryCountDTO := inventorycountdto.InventoryCountDTO{}
+		inventoryCountDTO.FromDomain(inventoryCountModels[i].ToDomain())
+		inventoryCountDTOs = append(inventoryCountDTOs, inventoryCountDTO)
+	}
+
+	return inventoryCountDTOs, count, nil
+}
+
+// addStockItems cria uma linha por lote com saldo; estoques sem lote viram uma única linha,
+// valorizada pelo custo do lote mais recente (ou zero, se nunca houve entrada com custo)
+func (s *Service) addStockItems(ctx context.Context, inventoryCount *inventorycountentity.InventoryCount, stock *stockentity.Stock) error {
+	batchModels, err := s.stockBatchRepo.GetBatchesByStockID(ctx, stock.ID.String())
+	if err != nil {
+		return fmt.Errorf("erro ao buscar lotes do estoque %s: %w", stock.ID, err)
+	}
+
+	base := inventorycountentity.InventoryCountItem{
+		StockID:            stock.ID,
+		ProductID:          stock.ProductID,
+		ProductVariationID: stock.ProductVariationID,
+		SKU:                stock.Product.SKU,
+		Description:        stock.Description(),
+		Unit:               stock.Unit,
+	}
+
+	lastCost := decimal.Zero
+	hasBatchWithStock := false
+	for i := range batchModels {
+		batch := batchModels[i].ToDomain()
+		lastCost = batch.CostPrice
+
+		if !batch.HasStock() {
+			continue
+		}
+
+		item := base
+		item.BatchID = &batch.ID
+		item.ExpectedQuantity = batch.CurrentQuantity
+		item.CostPrice = batch.CostPrice
+		inventoryCount.AddItem(item)
+		hasBatchWithStock = true
+	}
+
+	if hasBatchWithStock {
+		return nil
+	}
+
+	// A reserva continua na prateleira até a baixa do pedido, então entra no esperado como nos lotes
+	item := base
+	item.ExpectedQuantity = stock.PhysicalStock()
+	item.CostPrice = lastCost
+	inventoryCount.AddItem(item)
+	return nil
+}
+
+func (s *Service) getEmployeeID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return uuid.Nil, ErrContextUser
+	}
+
+	employee, err := s.employeeRepo.GetEmployeeByUserID(ctx, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("erro ao buscar funcionário: %w", err)
+	}
+
+	return employee.ID, nil
+}
+
+func changedItemModels(inventoryCount *inventorycountentity.InventoryCount, changed map[uuid.UUID]bool) []model.InventoryCountItem {
+	itemModels := make([]model.InventoryCountItem, 0, len(changed))
+	for i := range inventoryCount.Items {
+		if !changed[inventoryCount.Items[i].ID] {
+			continue
+		}
+
+		itemModel := model.InventoryCountItem{}
+		itemModel.FromDomain(&inventoryCount.Items[i])
+		itemModels = append(itemModels, itemModel)
+	}
+	return itemModels
+}
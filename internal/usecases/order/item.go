@@ -24,6 +24,8 @@ var (
 	ErrGroupNotStaging              = errors.New("group not staging")
 	ErrItemNotStagingAndPending     = errors.New("item not staging or pending")
 	ErrFractionalQuantityNotAllowed = errors.New("fractional quantity not allowed for this category")
+	ErrVariationNotAvailable        = errors.New("product variation not available: out of stock or disabled")
+	ErrComboItemDelete              = errors.New("combo item must be removed together with its combo")
 )
 
 type ItemService struct {
@@ -38,12 +40,15 @@ type ItemService struct {
 	sgi               *GroupItemService
 	stockRepo         model.StockRepository
 	stockMovementRepo model.StockMovementRepository
+	rcompany          model.CompanyRepository
+	rcombo            model.ComboRepository
+	rpricelist        model.PriceListRepository
 }
 
 func NewService(db *bun.DB, ri model.ItemRepository) *ItemService {
 	return &ItemService{db: db, ri: ri}
 }
-func (s *ItemService) AddDependencies(rgi model.GroupItemRepository, ro model.OrderRepository, rp model.ProductRepository, rc model.CategoryRepository, re model.EmployeeRepository, so *OrderService, sgi *GroupItemService, stockRepo model.StockRepository, stockMovementRepo model.StockMovementRepository) {
+func (s *ItemService) AddDependencies(rgi model.GroupItemRepository, ro model.OrderRepository, rp model.ProductRepository, rc model.CategoryRepository, re model.EmployeeRepository, so *OrderService, sgi *GroupItemService, stockRepo model.StockRepository, stockMovementRepo model.StockMovementRepository, rcompany model.CompanyRepository, rcombo model.ComboRepository, rpricelist model.PriceListRepository) {
 	s.rgi = rgi
 	s.ro = ro
 	s.rp = rp
@@ -53,6 +58,9 @@ func (s *ItemService) AddDependencies(rgi model.GroupItemRepository, ro model.Or
 	s.sgi = sgi
 	s.stockRepo = stockRepo
 	s.stockMovementRepo = stockMovementRepo
+	s.rcompany = rcompany
+	s.rcombo = rcombo
+	s.rpricelist = rpricelist
 }
 
 func (s *ItemService) AddItemOrder(ctx context.Context, dto *itemdto.OrderItemCreateDTO) (ids *itemdto.ItemIDDTO, err error) {
@@ -71,42 +79,15 @@ func (s *ItemService) AddItemOrder(ctx context.Context, dto *itemdto.OrderItemCr
 
 	product := productModel.ToDomain()
 
-	var variation *productentity.ProductVariation
-	for _, v := range product.Variations {
-		if v.ID == dto.VariationID {
-			variation = &v
-			break
-		}
-	}
-
-	if variation == nil {
-		return nil, errors.New("variation not found")
-	}
-
-	if !variation.IsAvailable {
-		return nil, errors.New("product variation not available")
-	}
-
-	if !product.IsActive {
-		return nil, errors.New("product not active")
-	}
-
-	if product.Category == nil {
-		return nil, ErrCategoryNotFound
+	variation, err := findSellableVariation(product, dto.VariationID)
+	if err != nil {
+		return nil, err
 	}
 
 	if product.Category.AllowFractional == false && dto.Quantity != float64(int64(dto.Quantity)) {
 		return nil, ErrFractionalQuantityNotAllowed
 	}
 
-	if variation.Size == nil {
-		return nil, ErrSizeNotFound
-	}
-
-	if !variation.Size.IsActive {
-		return nil, ErrSizeNotActive
-	}
-
 	// If group item id is not provided, create a new group item
 	if dto.GroupItemID == nil {
 		groupItem, err := s.newGroupItem(ctx, dto.OrderID, product, variation)
@@ -139,6 +120,10 @@ func (s *ItemService) AddItemOrder(ctx context.Context, dto *itemdto.OrderItemCr
 		return nil, err
 	}
 
+	if err := s.applyPriceList(ctx, item, dto.OrderID, dto.Channel); err != nil {
+		return nil, errors.New("price list error: " + err.Error())
+	}
+
 	// Process Removed Items
 	for _, removedDTO := range dto.RemovedItems {
 		name, err := removedDTO.ToDomain()
@@ -225,6 +210,14 @@ func (s *ItemService) DeleteItemOrder(ctx context.Context, dto *entitydto.IDRequ
 		return false, err
 	}
 
+	if itemModel.ComboSaleID != nil {
+		return false, ErrComboItemDelete
+	}
+
+	return s.deleteItemOrder(ctx, itemModel)
+}
+
+func (s *ItemService) deleteItemOrder(ctx context.Context, itemModel *model.Item) (groupItemDeleted bool, err error) {
 	item := itemModel.ToDomain()
 
 	groupItemModel, err := s.rgi.GetGroupByID(ctx, item.GroupItemID.String(), true)
@@ -257,7 +250,7 @@ func (s *ItemService) DeleteItemOrder(ctx context.Context, dto *entitydto.IDRequ
 		return false, err
 	}
 
-	if err = s.ri.DeleteItemWithTx(ctx, tx, dto.ID.String()); err != nil {
+	if err = s.ri.DeleteItemWithTx(ctx, tx, itemModel.ID.String()); err != nil {
 		return false, errors.New("delete item error: " + err.Error())
 	}
 
@@ -332,6 +325,43 @@ func (s *ItemService) DeleteItemOrder(ctx context.Context, dto *entitydto.IDRequ
 	return false, nil
 }
 
+// findSellableVariation busca a variação do produto e confere se produto, categoria e tamanho podem ser vendidos
+func findSellableVariation(product *productentity.Product, variationID uuid.UUID) (*productentity.ProductVariation, error) {
+	var variation *productentity.ProductVariation
+	for _, v := range product.Variations {
+		if v.ID == variationID {
+			variation = &v
+			break
+		}
+	}
+
+	if variation == nil {
+		return nil, errors.New("variation not found")
+	}
+
+	if !variation.IsAvailable {
+		return nil, ErrVariationNotAvailable
+	}
+
+	if !product.IsActive {
+		return nil, errors.New("product not active")
+	}
+
+	if product.Category == nil {
+		return nil, ErrCategoryNotFound
+	}
+
+	if variation.Size == nil {
+		return nil, ErrSizeNotFound
+	}
+
+	if !variation.Size.IsActive {
+		return nil, ErrSizeNotActive
+	}
+
+	return variation, nil
+}
+
 func (s *ItemService) newGroupItem(ctx context.Context, orderID uuid.UUID, product *productentity.Product, variation *productentity.ProductVariation) (groupItem *orderentity.GroupItem, err error) {
 	groupCommonAttributes := orderentity.GroupCommonAttributes{
 		OrderID: orderID,
@@ -92,6 +92,10 @@ func (s *ItemService) addAdditionalItemWithTx(ctx context.Context, tx *bun.Tx, i
 		return uuid.Nil, errors.New("additional variation not found")
 	}
 
+	if !variationAdditional.IsAvailable {
+		return uuid.Nil, ErrVariationNotAvailable
+	}
+
 	found := false
 	for _, additionalCategory := range groupItem.Category.AdditionalCategories {
 		if additionalCategory.ID == productAdditional.CategoryID {
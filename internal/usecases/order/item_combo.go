@@ -0,0 +1,187 @@
+package orderusecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	comboentity "github.com/willjrcom/sales-backend-go/internal/domain/combo"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
+	itemdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/item"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+var (
+	ErrComboNotFoundInOrder = errors.New("combo sale not found")
+)
+
+// comboSelection guarda o produto e a variação já validados de uma escolha do combo
+type comboSelection struct {
+	product     *productentity.Product
+	variation   *productentity.ProductVariation
+	flavor      *string
+	observation string
+}
+
+// AddComboOrder expande o combo em um item por componente. Cada componente entra no grupo
+// da sua categoria e tamanho, então gera os processos e reserva o estoque como um item avulso;
+// o preço do combo é rateado entre os componentes.
+func (s *ItemService) AddComboOrder(ctx context.Context, dto *itemdto.OrderComboCreateDTO) (*itemdto.ComboItemIDsDTO, error) {
+	if err := dto.Validate(); err != nil {
+		return nil, err
+	}
+
+	if exists, _ := s.ro.ExistsOrderById(ctx, dto.OrderID.String()); !exists {
+		return nil, errors.New("order not found")
+	}
+
+	comboModel, err := s.rcombo.GetComboById(ctx, dto.ComboID.String())
+	if err != nil {
+		return nil, errors.New("combo not found: " + err.Error())
+	}
+
+	combo := comboModel.ToDomain()
+
+	selections := make([]comboSelection, 0, len(dto.Choices))
+	choices := make([]comboentity.ComboChoice, 0, len(dto.Choices))
+	for _, choiceDTO := range dto.Choices {
+		productModel, err := s.rp.GetProductById(ctx, choiceDTO.ProductID.String())
+		if err != nil {
+			return nil, errors.New("product not found: " + err.Error())
+		}
+
+		product := productModel.ToDomain()
+		variation, err := findSellableVariation(product, choiceDTO.VariationID)
+		if err != nil {
+			return nil, err
+		}
+
+		flavor, err := itemdto.NormalizeFlavor(choiceDTO.Flavor, product.Flavors)
+		if err != nil {
+			return nil, err
+		}
+
+		selections = append(selections, comboSelection{
+			product:     product,
+			variation:   variation,
+			flavor:      flavor,
+			observation: joinObservations(dto.Observation, choiceDTO.Observation),
+		})
+		choices = append(choices, comboentity.ComboChoice{
+			SlotID:     choiceDTO.SlotID,
+			ProductID:  product.ID,
+			CategoryID: product.CategoryID,
+			ListPrice:  variation.Price,
+		})
+	}
+
+	// Resolve mantém a ordem das escolhas: components[i] corresponde a selections[i]
+	components, err := combo.Resolve(choices)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	attendantID := uuid.Nil
+	if ok {
+		userIDUUID := uuid.MustParse(userID)
+		employee, err := s.re.GetEmployeeByUserID(ctx, userIDUUID.String())
+		if err != nil {
+			return nil, err
+		}
+		attendantID = employee.ID
+	}
+
+	// Um grupo por categoria e tamanho: cada um segue as regras de processo da sua categoria
+	groupItems := map[string]*orderentity.GroupItem{}
+	for _, selection := range selections {
+		key := selection.product.CategoryID.String() + "|" + selection.variation.Size.Name
+		if _, ok := groupItems[key]; ok {
+			continue
+		}
+
+		groupItem, err := s.newGroupItem(ctx, dto.OrderID, selection.product, selection.variation)
+		if err != nil {
+			return nil, errors.New("new group item error: " + err.Error())
+		}
+		groupItems[key] = groupItem
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	comboSaleID := uuid.New()
+	ids := &itemdto.ComboItemIDsDTO{ComboSaleID: comboSaleID, Items: []itemdto.ItemIDDTO{}}
+
+	for i, component := range components {
+		selection := selections[i]
+		groupItem := groupItems[selection.product.CategoryID.String()+"|"+selection.variation.Size.Name]
+
+		item := orderentity.NewItem(selection.product.Name, component.Price, dto.Quantity, selection.variation.Size.Name, selection.product.ID, selection.variation.ID, selection.product.CategoryID, selection.flavor)
+		item.AddSizeToName()
+		item.GroupItemID = groupItem.ID
+		item.Observation = selection.observation
+		item.ComboID = &combo.ID
+		item.ComboSaleID = &comboSaleID
+
+		if err := s.reserveStockFromItemWithTx(ctx, tx, item, groupItem.OrderID, attendantID); err != nil {
+			return nil, err
+		}
+
+		itemModel := &model.Item{}
+		itemModel.FromDomain(item)
+		if err := s.ri.AddItemWithTx(ctx, tx, itemModel); err != nil {
+			return nil, errors.New("add item error: " + err.Error())
+		}
+
+		ids.Items = append(ids.Items, *itemdto.FromDomain(item.ID, groupItem.ID))
+	}
+
+	if err := s.so.UpdateOrderTotalWithTx(ctx, tx, dto.OrderID.String()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// DeleteComboOrder remove todos os componentes de um combo vendido, restaurando o estoque de cada um
+func (s *ItemService) DeleteComboOrder(ctx context.Context, comboSaleID uuid.UUID) error {
+	itemModels, err := s.ri.GetItemsByComboSaleID(ctx, comboSaleID.String())
+	if err != nil {
+		return err
+	}
+
+	if len(itemModels) == 0 {
+		return ErrComboNotFoundInOrder
+	}
+
+	for i := range itemModels {
+		if _, err := s.deleteItemOrder(ctx, &itemModels[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func joinObservations(observations ...string) string {
+	parts := []string{}
+	for _, observation := range observations {
+		if observation = strings.TrimSpace(observation); observation != "" {
+			parts = append(parts, observation)
+		}
+	}
+	return strings.Join(parts, " - ")
+}
@@ -0,0 +1,66 @@
+package orderusecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	pricelistentity "github.com/willjrcom/sales-backend-go/internal/domain/price_list"
+)
+
+// applyPriceList troca o preço da variação pelo da lista vigente para o canal do pedido.
+// O cardápio digital informa o próprio canal; nos demais ele vem do tipo do pedido.
+func (s *ItemService) applyPriceList(ctx context.Context, item *orderentity.Item, orderID uuid.UUID, requested pricelistentity.Channel) error {
+	priceListModels, err := s.rpricelist.GetAllPriceLists(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	if len(priceListModels) == 0 {
+		return nil
+	}
+
+	channel := requested
+	if channel == "" {
+		orderModel, err := s.ro.GetOnlyOrderById(ctx, orderID.String())
+		if err != nil {
+			return err
+		}
+		channel = orderChannel(orderModel.ToDomain())
+	}
+
+	priceLists := make([]pricelistentity.PriceList, 0, len(priceListModels))
+	for i := range priceListModels {
+		priceLists = append(priceLists, *priceListModels[i].ToDomain())
+	}
+
+	// Janelas de horário e de datas da lista são locais da empresa
+	now := time.Now()
+	if s.rcompany != nil {
+		if companyModel, err := s.rcompany.GetCompany(ctx, true); err == nil {
+			now = companyModel.ToDomain().Now()
+		}
+	}
+
+	applied, price := pricelistentity.Resolve(priceLists, item.ProductVariationID, channel, now)
+	if applied == nil {
+		return nil
+	}
+
+	item.ApplyPriceList(applied.ID, price)
+	return nil
+}
+
+// orderChannel deriva o canal de venda do tipo do pedido; pedido sem tipo não tem canal
+func orderChannel(order *orderentity.Order) pricelistentity.Channel {
+	switch {
+	case order.Delivery != nil:
+		return pricelistentity.ChannelDelivery
+	case order.Table != nil:
+		return pricelistentity.ChannelTable
+	case order.Pickup != nil:
+		return pricelistentity.ChannelPickup
+	}
+	return ""
+}
@@ -1,8 +1,10 @@
 package orderusecases
 
 import (
+	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 	"github.com/willjrcom/sales-backend-go/internal/infra/service/rabbitmq"
+	accountreceivableusecases "github.com/willjrcom/sales-backend-go/internal/usecases/account_receivable"
 	clientusecases "github.com/willjrcom/sales-backend-go/internal/usecases/client"
 	companyusecases "github.com/willjrcom/sales-backend-go/internal/usecases/company"
 	orderqueueusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order_queue"
@@ -10,6 +12,7 @@ import (
 )
 
 type OrderService struct {
+	db                      *bun.DB
 	ro                      model.OrderRepository
 	rs                      model.ShiftRepository
 	rp                      model.ProductRepository
@@ -29,10 +32,11 @@ type OrderService struct {
 	sc                      *companyusecases.Service
 	rabbitmq                *rabbitmq.RabbitMQ
 	clientService           *clientusecases.Service
+	sreceivable             *accountreceivableusecases.Service
 }
 
-func NewOrderService(ro model.OrderRepository) *OrderService {
-	return &OrderService{ro: ro}
+func NewOrderService(db *bun.DB, ro model.OrderRepository) *OrderService {
+	return &OrderService{db: db, ro: ro}
 }
 
 func (s *OrderService) AddDependencies(
@@ -55,6 +59,7 @@ func (s *OrderService) AddDependencies(
 	re model.EmployeeRepository,
 	rabbitmq *rabbitmq.RabbitMQ,
 	clientService *clientusecases.Service,
+	sreceivable *accountreceivableusecases.Service,
 ) {
 	s.ro = ro
 	s.rs = rs
@@ -75,4 +80,5 @@ func (s *OrderService) AddDependencies(
 	s.re = re
 	s.rabbitmq = rabbitmq
 	s.clientService = clientService
+	s.sreceivable = sreceivable
 }
@@ -3,13 +3,18 @@ package orderusecases
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
 )
 
 func (s *ItemService) reserveStockFromItemWithTx(ctx context.Context, tx *bun.Tx, item *orderentity.Item, orderID uuid.UUID, attendantID uuid.UUID) error {
@@ -38,6 +43,9 @@ func (s *ItemService) reserveStockFromItemWithTx(ctx context.Context, tx *bun.Tx
 		return err
 	}
 
+	expiresAt := stockentity.ReservationExpiresAt(time.Now().UTC(), s.reservationMinutes(ctx))
+	movement.ExpiresAt = &expiresAt
+
 	movementModel := &model.StockMovement{}
 	movementModel.FromDomain(movement)
 	if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
@@ -47,7 +55,7 @@ func (s *ItemService) reserveStockFromItemWithTx(ctx context.Context, tx *bun.Tx
 
 	// Atualizar estoque (CurrentStock diminuiu, ReservedStock aumentou)
 	lockedStockModel.FromDomain(stock)
-	if err := s.stockRepo.UpdateStock(ctx, tx, lockedStockModel); err != nil {
+	if err := stockusecases.UpdateStockBalance(ctx, tx, s.stockRepo, s.rp, lockedStockModel); err != nil {
 		fmt.Printf("Aviso: erro ao atualizar estoque: %v\n", err)
 		return err
 	}
@@ -87,8 +95,18 @@ func (s *ItemService) restoreStockFromItemWithTx(ctx context.Context, tx *bun.Tx
 
 	stock := lockedStockModel.ToDomain()
 
+	// Reserva já liberada pelo job de expiração não deve ser restaurada de novo
+	quantity, err := s.openReservationToRestore(ctx, stock.ID, groupItem.OrderID, decimal.NewFromFloat(item.Quantity))
+	if err != nil {
+		return err
+	}
+
+	if quantity.IsZero() {
+		return nil
+	}
+
 	movement, err := stock.RestoreStock(
-		decimal.NewFromFloat(item.Quantity),
+		quantity,
 		groupItem.OrderID,
 		attendantID,
 		item.SubTotal,
@@ -109,10 +127,45 @@ func (s *ItemService) restoreStockFromItemWithTx(ctx context.Context, tx *bun.Tx
 
 	// Atualizar estoque
 	lockedStockModel.FromDomain(stock)
-	if err := s.stockRepo.UpdateStock(ctx, tx, lockedStockModel); err != nil {
+	if err := stockusecases.UpdateStockBalance(ctx, tx, s.stockRepo, s.rp, lockedStockModel); err != nil {
 		fmt.Printf("Aviso: erro ao atualizar estoque: %v\n", err)
 		return err
 	}
 
 	return nil
 }
+
+// reservationMinutes lê a validade da reserva configurada na empresa (0 = padrão)
+func (s *ItemService) reservationMinutes(ctx context.Context) int {
+	if s.rcompany == nil {
+		return 0
+	}
+
+	company, err := s.rcompany.GetCompany(ctx, true)
+	if err != nil {
+		return 0
+	}
+
+	raw, err := company.Preferences.GetString(companyentity.StockReservationMinutes)
+	if err != nil {
+		return 0
+	}
+
+	minutes, _ := strconv.Atoi(raw)
+	return minutes
+}
+
+// openReservationToRestore limita a restauração ao que o pedido ainda tem reservado no estoque
+func (s *ItemService) openReservationToRestore(ctx context.Context, stockID uuid.UUID, orderID uuid.UUID, quantity decimal.Decimal) (decimal.Decimal, error) {
+	movementModels, err := s.stockMovementRepo.GetMovementsByOrderID(ctx, orderID.String())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("erro ao buscar reservas do pedido: %w", err)
+	}
+
+	movements := make([]stockentity.StockMovement, 0, len(movementModels))
+	for _, mm := range movementModels {
+		movements = append(movements, *mm.ToDomain())
+	}
+
+	return decimal.Min(quantity, stockentity.OpenReservation(movements, stockID)), nil
+}
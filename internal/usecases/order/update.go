@@ -8,6 +8,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountreceivableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_receivable"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
 	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
@@ -275,8 +277,7 @@ func (s *OrderService) CancelOrder(ctx context.Context, dtoOrderID *entitydto.ID
 		return err
 	}
 
-	orderModel.FromDomain(order)
-	if err := s.ro.UpdateOrder(ctx, orderModel); err != nil {
+	if err := s.cancelOrderWithCardSettlements(ctx, orderModel, order); err != nil {
 		return err
 	}
 
@@ -311,6 +312,29 @@ func (s *OrderService) CancelOrder(ctx context.Context, dtoOrderID *entitydto.ID
 	return nil
 }
 
+// cancelOrderWithCardSettlements grava o pedido cancelado junto com o cancelamento dos repasses de cartão em aberto
+func (s *OrderService) cancelOrderWithCardSettlements(ctx context.Context, orderModel *model.Order, order *orderentity.Order) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	if s.sreceivable != nil {
+		if err := s.sreceivable.CancelOrderCardSettlementsWithTx(ctx, tx, order.ID); err != nil {
+			return err
+		}
+	}
+
+	orderModel.FromDomain(order)
+	if err := s.ro.UpdateOrderWithTx(ctx, tx, orderModel); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (s *OrderService) ArchiveOrder(ctx context.Context, dto *entitydto.IDRequest) (err error) {
 	orderModel, err := s.ro.GetOnlyOrderById(ctx, dto.ID.String())
 
@@ -368,22 +392,45 @@ func (s *OrderService) AddPayment(ctx context.Context, dto *entitydto.IDRequest,
 		return err
 	}
 
+	// calcula taxa e previsão de repasse antes de gravar, para o pagamento já sair com o líquido
+	var settlement *accountreceivableentity.AccountReceivable
+	if s.sreceivable != nil {
+		settlement, err = s.sreceivable.ExpectCardSettlement(ctx, paymentOrder)
+		if err != nil {
+			fmt.Printf("failed to expect card settlement for order %s: %v\n", order.ID, err)
+		}
+	}
+
 	order.AddPayment(paymentOrder)
 
 	order.CalculateTotalPaid()
 
+	// pagamento, repasse e total pago do pedido são gravados juntos
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
 	paymentOrderModel := &model.PaymentOrder{}
 	paymentOrderModel.FromDomain(paymentOrder)
-	if err := s.ro.AddPaymentOrder(ctx, paymentOrderModel); err != nil {
+	if err := s.ro.AddPaymentOrderWithTx(ctx, tx, paymentOrderModel); err != nil {
 		return err
 	}
 
+	if settlement != nil {
+		if err := s.sreceivable.SaveCardSettlementWithTx(ctx, tx, settlement); err != nil {
+			return err
+		}
+	}
+
 	orderModel.FromDomain(order)
-	if err := s.ro.UpdateOrder(ctx, orderModel); err != nil {
+	if err := s.ro.UpdateOrderWithTx(ctx, tx, orderModel); err != nil {
 		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (s *OrderService) UpdateOrderObservation(ctx context.Context, dtoId *entitydto.IDRequest, dto *orderdto.OrderUpdateObservationDTO) error {
@@ -0,0 +1,85 @@
+package pricelistusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	pricelistdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/price_list"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type Service struct {
+	db *bun.DB
+	r  model.PriceListRepository
+}
+
+func NewService(db *bun.DB, r model.PriceListRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) CreatePriceList(ctx context.Context, dto *pricelistdto.PriceListCreateDTO) (uuid.UUID, error) {
+	priceList, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	priceListModel := &model.PriceList{}
+	priceListModel.FromDomain(priceList)
+	if err := s.r.CreatePriceList(ctx, priceListModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return priceList.ID, nil
+}
+
+func (s *Service) UpdatePriceList(ctx context.Context, dtoID *entitydto.IDRequest, dto *pricelistdto.PriceListUpdateDTO) error {
+	priceListModel, err := s.r.GetPriceListById(ctx, dtoID.ID.String())
+	if err != nil {
+		return err
+	}
+
+	priceList := priceListModel.ToDomain()
+	if err := dto.UpdateDomain(priceList); err != nil {
+		return err
+	}
+
+	priceListModel.FromDomain(priceList)
+	return s.r.UpdatePriceList(ctx, priceListModel)
+}
+
+func (s *Service) DeletePriceList(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	if _, err := s.r.GetPriceListById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeletePriceList(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetPriceListById(ctx context.Context, dtoID *entitydto.IDRequest) (*pricelistdto.PriceListDTO, error) {
+	priceListModel, err := s.r.GetPriceListById(ctx, dtoID.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	priceListDTO := &pricelistdto.PriceListDTO{}
+	priceListDTO.FromDomain(priceListModel.ToDomain())
+	return priceListDTO, nil
+}
+
+func (s *Service) GetAllPriceLists(ctx context.Context, onlyActive bool) ([]pricelistdto.PriceListDTO, error) {
+	priceListModels, err := s.r.GetAllPriceLists(ctx, onlyActive)
+	if err != nil {
+		return nil, err
+	}
+
+	priceListDTOs := []pricelistdto.PriceListDTO{}
+	for i := range priceListModels {
+		priceListDTO := pricelistdto.PriceListDTO{}
+		priceListDTO.FromDomain(priceListModels[i].ToDomain())
+		priceListDTOs = append(priceListDTOs, priceListDTO)
+	}
+
+	return priceListDTOs, nil
+}
@@ -44,6 +44,8 @@ func (s *Service) PrintOrder(ctx context.Context, req *entitydto.IDRequest) ([]b
 	}
 
 	order := model.ToDomain()
+	s.applyTaxBreakdown(ctx, order)
+
 	data, err := pos.FormatOrder(order, company)
 	if err != nil {
 		return nil, err
@@ -65,6 +67,8 @@ func (s *Service) PrintOrderHTML(ctx context.Context, req *entitydto.IDRequest)
 	}
 
 	order := model.ToDomain()
+	s.applyTaxBreakdown(ctx, order)
+
 	data, err := pos.RenderOrderHTML(order, company)
 	if err != nil {
 		return nil, err
@@ -2,10 +2,14 @@ package printmanagerusecases
 
 import (
 	"context"
+	"fmt"
+
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
 
 	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 	"github.com/willjrcom/sales-backend-go/internal/infra/service/rabbitmq"
+	ibptusecases "github.com/willjrcom/sales-backend-go/internal/usecases/ibpt"
 	orderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/order"
 	shiftusecases "github.com/willjrcom/sales-backend-go/internal/usecases/shift"
 )
@@ -19,6 +23,7 @@ type Service struct {
 	groupItemRepository model.GroupItemRepository
 	companyRepository   model.CompanyRepository
 	rabbitmq            *rabbitmq.RabbitMQ
+	ibptService         *ibptusecases.Service
 }
 
 // NewService creates a new print service using the given order and report usecase services.
@@ -26,13 +31,14 @@ func NewService() *Service {
 	return &Service{}
 }
 
-func (s *Service) AddDependencies(orderService *orderusecases.OrderService, orderRepository model.OrderRepository, shiftService *shiftusecases.Service, groupItemRepository model.GroupItemRepository, companyRepository model.CompanyRepository, rabbitmq *rabbitmq.RabbitMQ) {
+func (s *Service) AddDependencies(orderService *orderusecases.OrderService, orderRepository model.OrderRepository, shiftService *shiftusecases.Service, groupItemRepository model.GroupItemRepository, companyRepository model.CompanyRepository, rabbitmq *rabbitmq.RabbitMQ, ibptService *ibptusecases.Service) {
 	s.orderService = orderService
 	s.orderRepository = orderRepository
 	s.shiftService = shiftService
 	s.groupItemRepository = groupItemRepository
 	s.companyRepository = companyRepository
 	s.rabbitmq = rabbitmq
+	s.ibptService = ibptService
 }
 
 func (s *Service) getCompany(ctx context.Context) (*companydto.CompanyDTO, error) {
@@ -46,3 +52,15 @@ func (s *Service) getCompany(ctx context.Context) (*companydto.CompanyDTO, error
 	dto.FromDomain(company)
 	return dto, nil
 }
+
+// applyTaxBreakdown fills the approximate taxes (Lei 12.741/2012) when enabled in fiscal settings.
+// A missing IBPT table must not block printing, so failures are only logged.
+func (s *Service) applyTaxBreakdown(ctx context.Context, order *orderentity.Order) {
+	if s.ibptService == nil {
+		return
+	}
+
+	if err := s.ibptService.ApplyToReceipt(ctx, order); err != nil {
+		fmt.Printf("Warning: failed to calculate tax breakdown for order %s: %v\n", order.ID, err)
+	}
+}
@@ -38,7 +38,12 @@ func (s *Service) PrintShift(ctx context.Context, req *entitydto.IDRequest) ([]b
 		return nil, err
 	}
 
-	data, err := pos.FormatShift(shift)
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := pos.FormatShift(shift, companyentity.LoadLocation(company.TimeZone))
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +58,12 @@ func (s *Service) PrintShiftHTML(ctx context.Context, req *entitydto.IDRequest)
 		return nil, err
 	}
 
-	data, err := pos.RenderShiftHTML(shift)
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := pos.RenderShiftHTML(shift, companyentity.LoadLocation(company.TimeZone))
 	if err != nil {
 		return nil, err
 	}
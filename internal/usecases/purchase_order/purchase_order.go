@@ -0,0 +1,306 @@
+package purchaseorderusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	accountpayableentity "github.com/willjrcom/sales-backend-go/internal/domain/account_payable"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	purchaseorderentity "github.com/willjrcom/sales-backend-go/internal/domain/purchase_order"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	purchaseorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/purchase_order"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
+)
+
+var (
+	ErrSupplierInactive = errors.New("supplier is inactive")
+	ErrContextUser      = errors.New("context user not found")
+)
+
+type Service struct {
+	db           *bun.DB
+	r            model.PurchaseOrderRepository
+	supplierRepo model.SupplierRepository
+	stockRepo    model.StockRepository
+	stockService *stockusecases.Service
+	payableRepo  model.AccountPayableRepository
+	employeeRepo model.EmployeeRepository
+}
+
+func NewService(db *bun.DB, r model.PurchaseOrderRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) AddDependencies(supplierRepo model.SupplierRepository, stockRepo model.StockRepository, stockService *stockusecases.Service, payableRepo model.AccountPayableRepository, employeeRepo model.EmployeeRepository) {
+	s.supplierRepo = supplierRepo
+	s.stockRepo = stockRepo
+	s.stockService = stockService
+	s.payableRepo = payableRepo
+	s.employeeRepo = employeeRepo
+}
+
+func (s *Service) CreatePurchaseOrder(ctx context.Context, dto *purchaseorderdto.PurchaseOrderCreateDTO) (uuid.UUID, error) {
+	supplierModel, err := s.supplierRepo.GetSupplierById(ctx, dto.SupplierID.String())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("erro ao buscar fornecedor: %w", err)
+	}
+
+	if !supplierModel.IsActive {
+		return uuid.Nil, ErrSupplierInactive
+	}
+
+	expectedDeliveryAt := dto.ExpectedDeliveryAt
+	if expectedDeliveryAt == nil && supplierModel.LeadTimeDays > 0 {
+		expected := time.Now().UTC().AddDate(0, 0, supplierModel.LeadTimeDays)
+		expectedDeliveryAt = &expected
+	}
+
+	purchaseOrder, err := purchaseorderentity.NewPurchaseOrder(supplierModel.ID, expectedDeliveryAt, dto.Notes)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := s.addItems(ctx, purchaseOrder, dto.Items); err != nil {
+		return uuid.Nil, err
+	}
+
+	purchaseOrderModel := &model.PurchaseOrder{}
+	purchaseOrderModel.FromDomain(purchaseOrder)
+	if err := s.r.CreatePurchaseOrder(ctx, purchaseOrderModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return purchaseOrder.ID, nil
+}
+
+func (s *Service) UpdatePurchaseOrder(ctx context.Context, dtoId *entitydto.IDRequest, dto *purchaseorderdto.PurchaseOrderUpdateDTO) error {
+	return s.updateWithTx(ctx, dtoId, func(purchaseOrder *purchaseorderentity.PurchaseOrder) error {
+		if purchaseOrder.Status != purchaseorderentity.StatusDraft {
+			return purchaseorderentity.ErrPurchaseOrderNotDraft
+		}
+
+		if dto.ExpectedDeliveryAt != nil {
+			purchaseOrder.ExpectedDeliveryAt = dto.ExpectedDeliveryAt
+		}
+
+		if dto.Notes != nil {
+			purchaseOrder.Notes = *dto.Notes
+		}
+
+		if dto.Items == nil {
+			return nil
+		}
+
+		if err := purchaseOrder.ClearItems(); err != nil {
+			return err
+		}
+
+		return s.addItems(ctx, purchaseOrder, *dto.Items)
+	})
+}
+
+// SendPurchaseOrder marca o pedido como enviado ao fornecedor, liberando o recebimento
+func (s *Service) SendPurchaseOrder(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	return s.updateWithTx(ctx, dtoId, func(purchaseOrder *purchaseorderentity.PurchaseOrder) error {
+		return purchaseOrder.Send()
+	})
+}
+
+func (s *Service) CancelPurchaseOrder(ctx context.Context, dtoId *entitydto.IDRequest) error {
+	return s.updateWithTx(ctx, dtoId, func(purchaseOrder *purchaseorderentity.PurchaseOrder) error {
+		return purchaseOrder.Cancel()
+	})
+}
+
+// ReceivePurchaseOrder recebe itens do pedido (total ou parcialmente). Na mesma transação cria
+// um lote com custo/validade e um movimento de entrada por item, e gera a conta a pagar do recebimento.
+func (s *Service) ReceivePurchaseOrder(ctx context.Context, dtoId *entitydto.IDRequest, dto *purchaseorderdto.PurchaseOrderReceiveDTO) (*purchaseorderdto.PurchaseOrderReceiptDTO, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return nil, ErrContextUser
+	}
+
+	employee, err := s.employeeRepo.GetEmployeeByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar funcionário: %w", err)
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	purchaseOrderModel, err := s.r.GetPurchaseOrderByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar pedido de compra: %w", err)
+	}
+
+	purchaseOrder := purchaseOrderModel.ToDomain()
+
+	receivedItems, err := purchaseOrder.Receive(dto.ToLines())
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &purchaseorderdto.PurchaseOrderReceiptDTO{Total: decimal.Zero}
+	for _, received := range receivedItems {
+		movement, err := s.stockService.ReceiveBatchWithTx(ctx, tx, received.Item.StockID, stockentity.BatchEntry{
+			Quantity:        received.Quantity,
+			CostPrice:       received.UnitCost,
+			ExpiresAt:       received.ExpiresAt,
+			Reason:          fmt.Sprintf("Recebimento do pedido de compra %s", purchaseOrder.ID.String()[:8]),
+			EmployeeID:      employee.ID,
+			PurchaseOrderID: &purchaseOrder.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		receipt.MovementIDs = append(receipt.MovementIDs, movement.ID)
+		receipt.Total = receipt.Total.Add(received.Total())
+	}
+
+	// Recebimento com custo zero (bonificação) não gera conta a pagar
+	if receipt.Total.GreaterThan(decimal.Zero) {
+		payable, err := s.newAccountPayable(ctx, purchaseOrder, receipt.Total, dto)
+		if err != nil {
+			return nil, err
+		}
+
+		payableModel := &model.AccountPayable{}
+		payableModel.FromDomain(payable)
+		if err := s.payableRepo.CreateAccountPayable(ctx, tx, payableModel); err != nil {
+			return nil, fmt.Errorf("erro ao gerar conta a pagar: %w", err)
+		}
+
+		receipt.AccountPayableID = &payable.ID
+	}
+
+	purchaseOrderModel.FromDomain(purchaseOrder)
+	if err := s.r.UpdatePurchaseOrder(ctx, tx, purchaseOrderModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar pedido de compra: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	receipt.PurchaseOrder.FromDomain(purchaseOrder)
+	return receipt, nil
+}
+
+func (s *Service) GetPurchaseOrderById(ctx context.Context, dto *entitydto.IDRequest) (*purchaseorderdto.PurchaseOrderDTO, error) {
+	purchaseOrderModel, err := s.r.GetPurchaseOrderById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return toPurchaseOrderDTO(purchaseOrderModel), nil
+}
+
+func (s *Service) GetAllPurchaseOrders(ctx context.Context, page, perPage int, status string) ([]purchaseorderdto.PurchaseOrderDTO, int, error) {
+	purchaseOrderModels, count, err := s.r.GetAllPurchaseOrders(ctx, page, perPage, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	purchaseOrderDTOs := []purchaseorderdto.PurchaseOrderDTO{}
+	for i := range purchaseOrderModels {
+		purchaseOrderDTOs = append(purchaseOrderDTOs, *toPurchaseOrderDTO(&purchaseOrderModels[i]))
+	}
+
+	return purchaseOrderDTOs, count, nil
+}
+
+// addItems resolve o produto/variação de cada linha a partir do estoque informado
+func (s *Service) addItems(ctx context.Context, purchaseOrder *purchaseorderentity.PurchaseOrder, items []purchaseorderdto.PurchaseOrderItemCreateDTO) error {
+	for _, itemDTO := range items {
+		stockModel, err := s.stockRepo.GetStockByID(ctx, itemDTO.StockID.String())
+		if err != nil {
+			return fmt.Errorf("erro ao buscar estoque %s: %w", itemDTO.StockID, err)
+		}
+
+		description := itemDTO.Description
+		if description == "" {
+			description = stockModel.Product.Name
+		}
+
+		if _, err := purchaseOrder.AddItem(stockModel.ID, stockModel.ProductID, stockModel.ProductVariationID, description, itemDTO.Quantity, itemDTO.UnitCost); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) updateWithTx(ctx context.Context, dtoId *entitydto.IDRequest, update func(*purchaseorderentity.PurchaseOrder) error) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	purchaseOrderModel, err := s.r.GetPurchaseOrderByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	purchaseOrder := purchaseOrderModel.ToDomain()
+	if err := update(purchaseOrder); err != nil {
+		return err
+	}
+
+	purchaseOrderModel.FromDomain(purchaseOrder)
+	if err := s.r.UpdatePurchaseOrder(ctx, tx, purchaseOrderModel); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// newAccountPayable usa o vencimento informado ou o prazo de pagamento do fornecedor
+func (s *Service) newAccountPayable(ctx context.Context, purchaseOrder *purchaseorderentity.PurchaseOrder, amount decimal.Decimal, dto *purchaseorderdto.PurchaseOrderReceiveDTO) (*accountpayableentity.AccountPayable, error) {
+	supplierModel, err := s.supplierRepo.GetSupplierById(ctx, purchaseOrder.SupplierID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar fornecedor: %w", err)
+	}
+
+	dueDate := time.Now().UTC().AddDate(0, 0, supplierModel.PaymentTermDays)
+	if dto.DueDate != nil {
+		dueDate = *dto.DueDate
+	}
+
+	description := fmt.Sprintf("Pedido de compra %s - %s", purchaseOrder.ID.String()[:8], supplierModel.Name)
+	if dto.DocumentNumber != "" {
+		description = fmt.Sprintf("NF %s - %s", dto.DocumentNumber, supplierModel.Name)
+	}
+
+	return accountpayableentity.NewAccountPayable(accountpayableentity.AccountPayableCommonAttributes{
+		Description:     description,
+		SupplierID:      &purchaseOrder.SupplierID,
+		PurchaseOrderID: &purchaseOrder.ID,
+		DocumentNumber:  dto.DocumentNumber,
+		Amount:          amount.Round(2),
+		DueDate:         dueDate,
+	})
+}
+
+func toPurchaseOrderDTO(purchaseOrderModel *model.PurchaseOrder) *purchaseorderdto.PurchaseOrderDTO {
+	purchaseOrderDTO := &purchaseorderdto.PurchaseOrderDTO{}
+	purchaseOrderDTO.FromDomain(purchaseOrderModel.ToDomain())
+	if purchaseOrderModel.Supplier != nil {
+		purchaseOrderDTO.SupplierName = purchaseOrderModel.Supplier.Name
+	}
+	return purchaseOrderDTO
+}
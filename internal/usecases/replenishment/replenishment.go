@@ -0,0 +1,283 @@
+package replenishmentusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	replenishmententity "github.com/willjrcom/sales-backend-go/internal/domain/replenishment"
+	purchaseorderdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/purchase_order"
+	replenishmentdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/replenishment"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	purchaseorderusecases "github.com/willjrcom/sales-backend-go/internal/usecases/purchase_order"
+)
+
+var (
+	ErrNoSuggestions = errors.New("no reorder suggestions with a known supplier")
+)
+
+type Service struct {
+	db                   *bun.DB
+	stockRepo            model.StockRepository
+	movementRepo         model.StockMovementRepository
+	purchaseOrderRepo    model.PurchaseOrderRepository
+	supplierRepo         model.SupplierRepository
+	companyRepo          model.CompanyRepository
+	purchaseOrderService *purchaseorderusecases.Service
+}
+
+func NewService(db *bun.DB, stockRepo model.StockRepository) *Service {
+	return &Service{db: db, stockRepo: stockRepo}
+}
+
+func (s *Service) AddDependencies(movementRepo model.StockMovementRepository, purchaseOrderRepo model.PurchaseOrderRepository, supplierRepo model.SupplierRepository, companyRepo model.CompanyRepository, purchaseOrderService *purchaseorderusecases.Service) {
+	s.movementRepo = movementRepo
+	s.purchaseOrderRepo = purchaseOrderRepo
+	s.supplierRepo = supplierRepo
+	s.companyRepo = companyRepo
+	s.purchaseOrderService = purchaseOrderService
+}
+
+// GetSuggestions calcula a lista de compras sugerida a partir do consumo recente
+func (s *Service) GetSuggestions(ctx context.Context, dto *replenishmentdto.ReplenishmentParamsDTO) (*replenishmentdto.ReplenishmentDTO, error) {
+	params, err := s.resolveParameters(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.buildSuggestions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	replenishment := &replenishmentdto.ReplenishmentDTO{}
+	replenishment.FromDomain(params, replenishmententity.GroupBySupplier(suggestions))
+	return replenishment, nil
+}
+
+// CreateDraftPurchaseOrders gera um pedido de compra em rascunho por fornecedor,
+// para revisão antes do envio; sugestões sem fornecedor são apenas contadas.
+func (s *Service) CreateDraftPurchaseOrders(ctx context.Context, dto *replenishmentdto.DraftPurchaseOrdersCreateDTO) (*replenishmentdto.DraftPurchaseOrdersDTO, error) {
+	params, err := s.resolveParameters(ctx, &dto.ReplenishmentParamsDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.buildSuggestions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &replenishmentdto.DraftPurchaseOrdersDTO{PurchaseOrderIDs: []uuid.UUID{}}
+
+	for _, group := range replenishmententity.GroupBySupplier(suggestions) {
+		if group.SupplierID == nil {
+			result.UnassignedItems += len(group.Items)
+			continue
+		}
+
+		if !dto.IncludesSupplier(*group.SupplierID) {
+			continue
+		}
+
+		createDTO := &purchaseorderdto.PurchaseOrderCreateDTO{
+			SupplierID: *group.SupplierID,
+			Notes:      fmt.Sprintf("Sugestão de reposição: consumo de %d dias, cobertura de %d dias", params.PeriodDays, params.CoverageDays),
+		}
+
+		for _, item := range group.Items {
+			createDTO.Items = append(createDTO.Items, purchaseorderdto.PurchaseOrderItemCreateDTO{
+				StockID:     item.StockID,
+				Description: item.Description,
+				Quantity:    item.SuggestedQuantity,
+				UnitCost:    item.UnitCost,
+			})
+		}
+
+		purchaseOrderID, err := s.purchaseOrderService.CreatePurchaseOrder(ctx, createDTO)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar pedido para o fornecedor %s: %w", group.SupplierName, err)
+		}
+
+		result.PurchaseOrderIDs = append(result.PurchaseOrderIDs, purchaseOrderID)
+	}
+
+	if len(result.PurchaseOrderIDs) == 0 {
+		return nil, ErrNoSuggestions
+	}
+
+	return result, nil
+}
+
+// AdjustStockLimits grava ponto de pedido e alvo como mínimo e máximo dos estoques com consumo
+func (s *Service) AdjustStockLimits(ctx context.Context, dto *replenishmentdto.ReplenishmentParamsDTO) (*replenishmentdto.StockLimitsDTO, error) {
+	params, err := s.resolveParameters(ctx, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.buildSuggestions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	result := &replenishmentdto.StockLimitsDTO{}
+
+	for _, suggestion := range suggestions {
+		minStock, maxStock, ok := suggestion.StockLimits()
+		if !ok || (minStock.Equal(suggestion.MinStock) && maxStock.Equal(suggestion.MaxStock)) {
+			continue
+		}
+
+		stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, suggestion.StockID.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao bloquear estoque %s: %w", suggestion.StockID, err)
+		}
+
+		stock := stockModel.ToDomain()
+		stock.MinStock = minStock
+		stock.MaxStock = maxStock
+
+		stockModel.FromDomain(stock)
+		if err := s.stockRepo.UpdateStock(ctx, tx, stockModel); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar limites do estoque %s: %w", suggestion.StockID, err)
+		}
+
+		result.UpdatedStocks++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AdjustStockLimitsIfEnabled é usado pelo agendador diário: só ajusta empresas com a preferência ativa
+func (s *Service) AdjustStockLimitsIfEnabled(ctx context.Context) (bool, error) {
+	company, err := s.companyRepo.GetCompany(ctx, true)
+	if err != nil {
+		return false, err
+	}
+
+	if enabled, _ := company.Preferences.GetBool(companyentity.EnableAutoStockLimits); !enabled {
+		return false, nil
+	}
+
+	if _, err := s.AdjustStockLimits(ctx, &replenishmentdto.ReplenishmentParamsDTO{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// resolveParameters usa a cobertura configurada na empresa quando a requisição não informa uma
+func (s *Service) resolveParameters(ctx context.Context, dto *replenishmentdto.ReplenishmentParamsDTO) (replenishmententity.Parameters, error) {
+	if dto.CoverageDays == 0 && s.companyRepo != nil {
+		if company, err := s.companyRepo.GetCompany(ctx, true); err == nil {
+			if raw, err := company.Preferences.GetString(companyentity.ReplenishmentCoverageDays); err == nil {
+				if days, err := strconv.Atoi(raw); err == nil && days > 0 {
+					dto.CoverageDays = days
+				}
+			}
+		}
+	}
+
+	return dto.ToDomain()
+}
+
+func (s *Service) buildSuggestions(ctx context.Context, params replenishmententity.Parameters) ([]replenishmententity.ReorderSuggestion, error) {
+	stockModels, err := s.stockRepo.GetActiveStocks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoques: %w", err)
+	}
+
+	end := time.Now().UTC()
+	start := end.AddDate(0, 0, -params.PeriodDays)
+
+	consumptionModels, err := s.movementRepo.GetConsumptionByStock(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao calcular consumo: %w", err)
+	}
+
+	consumptionByStock := make(map[uuid.UUID]model.StockConsumption, len(consumptionModels))
+	for _, consumption := range consumptionModels {
+		consumptionByStock[consumption.StockID] = consumption
+	}
+
+	stockSuppliers, err := s.purchaseOrderRepo.GetPreferredSuppliersByStock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar fornecedores dos estoques: %w", err)
+	}
+
+	supplierByStock := make(map[uuid.UUID]model.StockSupplier, len(stockSuppliers))
+	for _, stockSupplier := range stockSuppliers {
+		supplierByStock[stockSupplier.StockID] = stockSupplier
+	}
+
+	suppliers := map[uuid.UUID]*model.Supplier{}
+	suggestions := make([]replenishmententity.ReorderSuggestion, 0, len(stockModels))
+
+	for _, stockModel := range stockModels {
+		stock := stockModel.ToDomain()
+
+		input := replenishmententity.StockInput{
+			StockID:            stock.ID,
+			ProductID:          stock.ProductID,
+			ProductVariationID: stock.ProductVariationID,
+			Description:        stock.Description(),
+			Unit:               stock.Unit,
+			CurrentStock:       stock.CurrentStock,
+			MinStock:           stock.MinStock,
+			MaxStock:           stock.MaxStock,
+			ConsumedQuantity:   consumptionByStock[stock.ID].Quantity,
+		}
+
+		if stockSupplier, ok := supplierByStock[stock.ID]; ok {
+			supplier := s.getSupplier(ctx, suppliers, stockSupplier.SupplierID)
+
+			// Fornecedor inativo não recebe pedido: o item entra como sem fornecedor
+			if supplier != nil && supplier.IsActive {
+				input.SupplierID = &supplier.ID
+				input.SupplierName = supplier.Name
+				if supplier.TradeName != "" {
+					input.SupplierName = supplier.TradeName
+				}
+				input.LeadTimeDays = supplier.LeadTimeDays
+				input.UnitCost = stockSupplier.UnitCost
+			}
+		}
+
+		suggestions = append(suggestions, replenishmententity.Suggest(input, params))
+	}
+
+	return suggestions, nil
+}
+
+// getSupplier busca cada fornecedor uma única vez; fornecedor excluído devolve nil
+func (s *Service) getSupplier(ctx context.Context, cache map[uuid.UUID]*model.Supplier, id uuid.UUID) *model.Supplier {
+	if supplier, ok := cache[id]; ok {
+		return supplier
+	}
+
+	supplier, err := s.supplierRepo.GetSupplierById(ctx, id.String())
+	if err != nil {
+		supplier = nil
+	}
+
+	cache[id] = supplier
+	return supplier
+}
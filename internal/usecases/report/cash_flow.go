@@ -0,0 +1,99 @@
+package reportusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+var ErrCashFlowPeriod = errors.New("cash flow projection end must not be before start, up to one year")
+
+// cashFlowDefaultDays é o horizonte da projeção quando o período não é informado
+const cashFlowDefaultDays = 30
+
+// CashFlowProjection projeta o saldo dia a dia com as contas em aberto e a receita média esperada dos pedidos.
+func (s *Service) CashFlowProjection(ctx context.Context, req *reportdto.CashFlowProjectionRequest) (*reportdto.CashFlowProjectionResponse, error) {
+	now := time.Now()
+	start, end := req.Start, req.End
+	if start.IsZero() {
+		start = now
+	}
+	if end.IsZero() {
+		end = start.AddDate(0, 0, cashFlowDefaultDays-1)
+	}
+
+	if end.Before(start) || end.After(start.AddDate(1, 0, 0)) {
+		return nil, ErrCashFlowPeriod
+	}
+
+	// O repositório trabalha com [start, end): o último dia pedido entra inteiro
+	data, err := s.reportSvc.CashFlowProjection(ctx, start, end.AddDate(0, 0, 1), now)
+	if err != nil {
+		return nil, err
+	}
+
+	return cashFlowProjection(data, start, end, now, req.OpeningBalance), nil
+}
+
+// cashFlowProjection monta os dias de start a end no fuso da empresa, incluindo os dias sem movimento
+func cashFlowProjection(data *report.CashFlowProjectionDTO, start, end, now time.Time, openingBalance decimal.Decimal) *reportdto.CashFlowProjectionResponse {
+	loc := companyentity.LoadLocation(data.TimeZone)
+	start, end, now = start.In(loc), end.In(loc), now.In(loc)
+	today := now.Format(time.DateOnly)
+
+	flows := map[string]report.CashFlowDayDTO{}
+	for _, day := range data.Days {
+		flows[day.Day.Format(time.DateOnly)] = day
+	}
+
+	revenueByWeekday := map[time.Weekday]decimal.Decimal{}
+	for _, weekday := range data.RevenueByWeekday {
+		revenueByWeekday[time.Weekday(weekday.Weekday)] = weekday.Revenue
+	}
+
+	resp := &reportdto.CashFlowProjectionResponse{
+		Start:              start.Format(time.DateOnly),
+		End:                end.Format(time.DateOnly),
+		OpeningBalance:     openingBalance,
+		OverduePayables:    data.Overdue.Payables,
+		OverdueReceivables: data.Overdue.Receivables,
+		Days:               []reportdto.CashFlowDay{},
+	}
+
+	balance := openingBalance
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, loc)
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		date := day.Format(time.DateOnly)
+		flow := flows[date]
+
+		cashFlowDay := reportdto.CashFlowDay{
+			Date:            date,
+			Receivables:     flow.Receivables,
+			ExpectedRevenue: decimal.Zero,
+			Payables:        flow.Payables,
+			Payroll:         flow.Payroll,
+		}
+		if date > today {
+			cashFlowDay.ExpectedRevenue = revenueByWeekday[day.Weekday()]
+		}
+
+		cashFlowDay.Inflows = cashFlowDay.Receivables.Add(cashFlowDay.ExpectedRevenue)
+		cashFlowDay.Outflows = cashFlowDay.Payables.Add(cashFlowDay.Payroll)
+		cashFlowDay.Net = cashFlowDay.Inflows.Sub(cashFlowDay.Outflows)
+		balance = balance.Add(cashFlowDay.Net)
+		cashFlowDay.Balance = balance
+
+		resp.TotalInflows = resp.TotalInflows.Add(cashFlowDay.Inflows)
+		resp.TotalOutflows = resp.TotalOutflows.Add(cashFlowDay.Outflows)
+		resp.Days = append(resp.Days, cashFlowDay)
+	}
+
+	resp.ClosingBalance = balance
+	return resp
+}
@@ -0,0 +1,48 @@
+package reportusecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+func TestCashFlowProjection(t *testing.T) {
+	d := decimal.RequireFromString
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	// Segunda-feira, 21h locais: já é terça em UTC
+	now := time.Date(2026, 10, 19, 21, 0, 0, 0, loc)
+	data := &report.CashFlowProjectionDTO{
+		TimeZone: "America/Sao_Paulo",
+		Days: []report.CashFlowDayDTO{
+			{Day: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), Payables: d("300"), Receivables: d("50")},
+			{Day: time.Date(2026, 10, 21, 0, 0, 0, 0, time.UTC), Payroll: d("200")},
+		},
+		RevenueByWeekday: []report.CashFlowWeekdayDTO{
+			{Weekday: int(time.Monday), Revenue: d("1000")},
+			{Weekday: int(time.Tuesday), Revenue: d("400")},
+		},
+		Overdue: report.CashFlowOverdueDTO{Payables: d("80")},
+	}
+
+	resp := cashFlowProjection(data, now, now.AddDate(0, 0, 2), now, d("100"))
+
+	require.Len(t, resp.Days, 3)
+	assert.Equal(t, []string{"2026-10-19", "2026-10-20", "2026-10-21"}, []string{resp.Days[0].Date, resp.Days[1].Date, resp.Days[2].Date})
+
+	assert.True(t, decimal.Zero.Equal(resp.Days[0].ExpectedRevenue), "hoje não recebe receita prevista")
+	assert.True(t, d("-150").Equal(resp.Days[0].Balance))
+	assert.True(t, d("400").Equal(resp.Days[1].ExpectedRevenue))
+	assert.True(t, d("250").Equal(resp.Days[1].Balance))
+	assert.True(t, d("50").Equal(resp.Days[2].Balance), "dia sem média não projeta receita")
+
+	assert.True(t, d("450").Equal(resp.TotalInflows))
+	assert.True(t, d("500").Equal(resp.TotalOutflows))
+	assert.True(t, d("50").Equal(resp.ClosingBalance))
+	assert.True(t, d("80").Equal(resp.OverduePayables), "vencidas ficam fora do saldo")
+}
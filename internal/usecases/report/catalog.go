@@ -0,0 +1,184 @@
+package reportusecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+var ErrReportTypeUnknown = errors.New("report type is unknown")
+
+// reportRunner decodifica o corpo da requisição do relatório e executa a consulta
+type reportRunner func(ctx context.Context, s *Service, body []byte) (any, error)
+
+// catalogEntry liga a rota ao arquivo exportado, à execução e às chaves das linhas usadas no comparativo
+type catalogEntry struct {
+	filename string
+	run      reportRunner
+	rows     rowKeys
+}
+
+// rowKey identifica as linhas de uma lista da resposta para casar os dois períodos do comparativo
+type rowKey struct {
+	date   string   // campo de data das séries: a n-ésima data casa com a n-ésima do outro período
+	fields []string // campos que identificam a linha (dentro da data, nas séries)
+	ignore []string // campos numéricos da linha que só ordenam ou descrevem (rank, tamanho) e não entram nas variações
+}
+
+// rowKeys indexa as chaves pelo caminho da lista no JSON sem as linhas: "" quando a resposta é a
+// própria lista, "combos" ou "combos.components" nas listas aninhadas
+type rowKeys map[string]rowKey
+
+func keyBy(fields ...string) rowKey {
+	return rowKey{fields: fields}
+}
+
+func seriesBy(date string, fields ...string) rowKey {
+	return rowKey{date: date, fields: fields}
+}
+
+// runWith adapta um método do Service para o catálogo, decodificando o request do próprio relatório
+func runWith[Req any, Resp any](call func(*Service, context.Context, *Req) (Resp, error)) reportRunner {
+	return func(ctx context.Context, s *Service, body []byte) (any, error) {
+		req := new(Req)
+		if err := json.Unmarshal(body, req); err != nil {
+			return nil, err
+		}
+		return call(s, ctx, req)
+	}
+}
+
+// reportCatalog indexa os relatórios pela rota em /report, com o mesmo nome de arquivo da exportação
+var reportCatalog = map[string]catalogEntry{
+	"sales-total-by-day":              {"vendas-por-dia", runWith((*Service).SalesTotalByDay), rowKeys{"": seriesBy("day")}},
+	"revenue-cumulative-by-month":     {"receita-acumulada-por-mes", runWith((*Service).RevenueCumulativeByMonth), rowKeys{"": seriesBy("month")}},
+	"sales-by-hour":                   {"vendas-por-hora", runWith((*Service).SalesByHour), rowKeys{"": keyBy("hour")}},
+	"sales-by-channel":                {"vendas-por-canal", runWith((*Service).SalesByChannel), rowKeys{"": keyBy("channel")}},
+	"avg-ticket-by-day":               {"ticket-medio-por-dia", runWith((*Service).AvgTicketByDay), rowKeys{"": seriesBy("day")}},
+	"avg-ticket-by-channel":           {"ticket-medio-por-canal", runWith((*Service).AvgTicketByChannel), rowKeys{"": keyBy("channel")}},
+	"products-sold-by-day":            {"produtos-vendidos-por-dia", runWith((*Service).ProductsSoldByDay), rowKeys{"": seriesBy("day")}},
+	"top-products":                    {"produtos-mais-vendidos", runWith((*Service).TopProducts), rowKeys{"": keyBy("name")}},
+	"sales-by-category":               {"vendas-por-categoria", runWith((*Service).SalesByCategory), rowKeys{"": keyBy("category")}},
+	"clients-registered-by-day":       {"clientes-cadastrados-por-dia", runWith((*Service).ClientsRegisteredByDay), rowKeys{"": seriesBy("day")}},
+	"new-vs-recurring-clients":        {"clientes-novos-e-recorrentes", runWith((*Service).NewVsRecurringClients), rowKeys{"": keyBy("type")}},
+	"orders-by-status":                {"pedidos-por-status", runWith((*Service).OrdersByStatus), rowKeys{"": keyBy("status")}},
+	"avg-process-step-duration":       {"duracao-media-por-etapa", runWith((*Service).AvgProcessStepDurationByRule), rowKeys{"": keyBy("process_rule_name")}},
+	"cancellation-rate":               {"taxa-de-cancelamento", runWith((*Service).CancellationRate), nil},
+	"current-queue-length":            {"fila-atual", runWith((*Service).CurrentQueueLength), nil},
+	"avg-delivery-time-by-driver":     {"tempo-medio-de-entrega-por-entregador", runWith((*Service).AvgDeliveryTimeByDriver), rowKeys{"": keyBy("driver_name")}},
+	"deliveries-per-driver":           {"entregas-por-entregador", runWith((*Service).DeliveriesPerDriver), rowKeys{"": keyBy("driver_name")}},
+	"orders-per-table":                {"pedidos-por-mesa", runWith((*Service).OrdersPerTable), rowKeys{"": keyBy("table_name")}},
+	"avg-queue-duration":              {"duracao-media-da-fila", runWith((*Service).AvgQueueDuration), nil},
+	"avg-process-duration-by-product": {"duracao-media-de-producao-por-produto", runWith((*Service).AvgProcessDurationByProduct), rowKeys{"": keyBy("product_id")}},
+	"total-queue-time-by-group-item":  {"tempo-de-fila-por-grupo", runWith((*Service).TotalQueueTimeByGroupItem), rowKeys{"": keyBy("group_item_id")}},
+	"sales-by-shift":                  {"vendas-por-turno", runWith((*Service).SalesByShift), rowKeys{"": seriesBy("opened_at")}},
+	"top-tables":                      {"mesas-mais-usadas", runWith((*Service).TopTables), rowKeys{"": keyBy("table_name")}},
+	"payments-by-method":              {"pagamentos-por-forma", runWith((*Service).PaymentsByMethod), rowKeys{"": keyBy("method")}},
+	"employee-payments-report":        {"pagamentos-de-funcionarios", runWith((*Service).EmployeePaymentsReport), rowKeys{"": keyBy("employee_name")}},
+	"sales-by-place":                  {"vendas-por-ambiente", runWith((*Service).SalesByPlace), rowKeys{"": keyBy("place")}},
+	"sales-by-size":                   {"vendas-por-tamanho", runWith((*Service).SalesBySize), rowKeys{"": keyBy("size")}},
+	"additional-items-sold":           {"adicionais-vendidos", runWith((*Service).AdditionalItemsSold), rowKeys{"": keyBy("name")}},
+	"complement-items-sold":           {"complementos-vendidos", runWith((*Service).ComplementItemsSold), rowKeys{"": keyBy("name")}},
+	"avg-pickup-time":                 {"tempo-medio-de-retirada", runWith((*Service).AvgPickupTime), nil},
+	"group-items-status":              {"grupos-por-status", runWith((*Service).GroupItemsByStatus), rowKeys{"": keyBy("status")}},
+	"deliveries-by-cep":               {"entregas-por-cep", runWith((*Service).DeliveriesByCep), rowKeys{"": keyBy("cep")}},
+	"processed-count-by-rule":         {"processados-por-etapa", runWith((*Service).ProcessedCountByRule), rowKeys{"": keyBy("process_rule_name")}},
+	"daily-sales":                     {"vendas-do-dia", runWith((*Service).DailySales), nil},
+	"stock-losses":                    {"perdas-estoque", runWith((*Service).StockLosses), rowKeys{"": keyBy("key")}},
+	"stock-valuation":                 {"valorizacao-estoque", runWith((*Service).StockValuation), rowKeys{"items": keyBy("stock_id")}},
+	"cogs":                            {"cmv", runWith((*Service).CostOfGoodsSold), rowKeys{"days": seriesBy("day")}},
+	"gross-margin":                    {"margem-bruta", runWith((*Service).GrossMargin), rowKeys{"products": keyBy("product_id")}},
+	"stock-reconciliation":            {"conciliacao-estoque", runWith((*Service).StockReconciliation), rowKeys{"items": keyBy("stock_id")}},
+	"combo-sales":                     {"vendas-combos", runWith((*Service).ComboSales), rowKeys{"combos": keyBy("combo_id"), "combos.components": keyBy("product_id")}},
+	"price-list-sales":                {"vendas-listas-preco", runWith((*Service).PriceListSales), rowKeys{"price_lists": keyBy("price_list_id")}},
+	"profit-and-loss":                 {"demonstrativo-de-resultado", runWith((*Service).ProfitAndLoss), rowKeys{"lines": keyBy("key")}},
+	"cash-flow-projection":            {"projecao-fluxo-de-caixa", runWith((*Service).CashFlowProjection), rowKeys{"days": seriesBy("date")}},
+	"menu-engineering":                {"engenharia-de-cardapio", runWith((*Service).MenuEngineering), rowKeys{"items": {fields: []string{"product_id", "variation_id"}, ignore: []string{"size"}}}},
+	"abc-curve":                       {"curva-abc", runWith((*Service).AbcCurve), rowKeys{"classes": keyBy("class"), "products": {fields: []string{"product_id"}, ignore: []string{"rank"}}}},
+	"sales-forecast":                  {"previsao-de-vendas", runWith((*Service).SalesForecast), salesForecastRows},
+	"consolidated":                    {"consolidado-lojas", runWith((*Service).ConsolidatedReport), consolidatedRows},
+}
+
+// salesForecastRows casa os dias da previsão pela posição, as horas pelo número e produtos e etapas pelo id
+var salesForecastRows = rowKeys{
+	"days":               seriesBy("date"),
+	"days.hours":         keyBy("hour"),
+	"products":           keyBy("product_id"),
+	"products.days":      seriesBy("date"),
+	"prep_list":          {date: "date", fields: []string{"process_rule_id"}, ignore: []string{"order"}},
+	"prep_list.products": keyBy("product_id"),
+}
+
+// consolidatedRows casa as lojas pelo id da empresa; produtos são agrupados pelo nome em todas as lojas
+var consolidatedRows = rowKeys{
+	"payments":            keyBy("method"),
+	"top_products":        keyBy("name"),
+	"stores":              keyBy("company_id"),
+	"stores.payments":     keyBy("method"),
+	"stores.top_products": keyBy("name"),
+}
+
+// ReportTypes lista as rotas aceitas em RunReport, em ordem alfabética
+func ReportTypes() []string {
+	types := make([]string, 0, len(reportCatalog))
+	for reportType := range reportCatalog {
+		types = append(types, reportType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func IsReportType(reportType string) bool {
+	_, ok := reportCatalog[reportType]
+	return ok
+}
+
+// ReportFilename devolve o nome do arquivo exportado, sem extensão
+func ReportFilename(reportType string) string {
+	return reportCatalog[reportType].filename
+}
+
+// ReportTitle transforma o nome do arquivo no título impresso no PDF e na aba da planilha
+func ReportTitle(filename string) string {
+	title := strings.ReplaceAll(filename, "-", " ")
+	if title == "" {
+		return title
+	}
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// RunReport executa o relatório fora de uma requisição HTTP (assinaturas por email).
+// O período [start, end) preenche start/end, day (último dia do período) e at (fim do período);
+// params completa os demais filtros do relatório, como group_by ou only_drift, e pode trazer "compare".
+func (s *Service) RunReport(ctx context.Context, reportType string, params map[string]any, start, end time.Time) (any, error) {
+	entry, ok := reportCatalog[reportType]
+	if !ok {
+		return nil, ErrReportTypeUnknown
+	}
+
+	// As consultas usam BETWEEN: o fim fica no último instante do período
+	last := end.Add(-time.Microsecond)
+
+	request := map[string]any{}
+	for key, value := range params {
+		request[key] = value
+	}
+	request["start"] = start
+	request["end"] = last
+	request["day"] = end.AddDate(0, 0, -1)
+	request["at"] = last
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if HasComparison(body) {
+		return s.CompareReport(ctx, reportType, body)
+	}
+
+	return entry.run(ctx, s, body)
+}
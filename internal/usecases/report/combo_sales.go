@@ -0,0 +1,49 @@
+package reportusecases
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+// ComboSales returns the combos sold in the period, each one broken down by component product.
+func (s *Service) ComboSales(ctx context.Context, req *reportdto.ComboSalesRequest) (*reportdto.ComboSalesResponse, error) {
+	combos, err := s.reportSvc.ComboSales(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := s.reportSvc.ComboComponentSales(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	componentsByCombo := map[string][]reportdto.ComboComponentSales{}
+	for _, c := range components {
+		componentsByCombo[c.ComboID] = append(componentsByCombo[c.ComboID], reportdto.ComboComponentSales{
+			ProductID: c.ProductID,
+			Name:      c.Name,
+			Quantity:  c.Quantity,
+			Revenue:   c.Revenue,
+		})
+	}
+
+	resp := &reportdto.ComboSalesResponse{
+		Quantity: decimal.Zero,
+		Revenue:  decimal.Zero,
+		Combos:   make([]reportdto.ComboSales, len(combos)),
+	}
+	for i, c := range combos {
+		resp.Combos[i] = reportdto.ComboSales{
+			ComboID:    c.ComboID,
+			Name:       c.Name,
+			Quantity:   c.Quantity,
+			Revenue:    c.Revenue,
+			Components: componentsByCombo[c.ComboID],
+		}
+		resp.Quantity = resp.Quantity.Add(c.Quantity)
+		resp.Revenue = resp.Revenue.Add(c.Revenue)
+	}
+	return resp, nil
+}
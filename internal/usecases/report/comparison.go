@@ -0,0 +1,347 @@
+package reportusecases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+var (
+	ErrComparisonMode   = errors.New("compare mode must be previous_period, previous_year or custom")
+	ErrComparisonPeriod = errors.New("report has no period to compare")
+	ErrComparisonCustom = errors.New("custom comparison requires start and end")
+	ErrComparisonRowKey = errors.New("report rows repeat the comparison key")
+)
+
+// comparisonTimeFields são os campos de período dos requests; month (YYYY-MM) é tratado à parte
+var comparisonTimeFields = []string{"start", "end", "day", "at"}
+
+var decimalPattern = regexp.MustCompile(`^-?\d+(\.\d+)?$`)
+
+// HasComparison indica se o corpo do relatório pede o modo comparativo (campo "compare")
+func HasComparison(body []byte) bool {
+	var request struct {
+		Compare json.RawMessage `json:"compare"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return false
+	}
+	return len(request.Compare) > 0 && !bytes.Equal(request.Compare, []byte("null"))
+}
+
+// CompareReport executa o relatório no período pedido e no período de comparação e calcula as variações
+func (s *Service) CompareReport(ctx context.Context, reportType string, body []byte) (*reportdto.ReportComparisonResponse, error) {
+	entry, ok := reportCatalog[reportType]
+	if !ok {
+		return nil, ErrReportTypeUnknown
+	}
+
+	var request map[string]any
+	if err := json.Unmarshal(body, &request); err != nil {
+		return nil, err
+	}
+
+	compare := reportdto.ReportComparisonRequest{}
+	compareBody, err := json.Marshal(request["compare"])
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(compareBody, &compare); err != nil {
+		return nil, err
+	}
+	delete(request, "compare")
+
+	comparisonRequest, err := comparisonPeriod(request, compare)
+	if err != nil {
+		return nil, err
+	}
+
+	currentBody, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	comparisonBody, err := json.Marshal(comparisonRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := entry.run(ctx, s, currentBody)
+	if err != nil {
+		return nil, err
+	}
+	comparison, err := entry.run(ctx, s, comparisonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas, err := reportDeltas(current, comparison, entry.rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reportdto.ReportComparisonResponse{
+		Mode:             compare.Mode,
+		CurrentPeriod:    periodFields(request),
+		ComparisonPeriod: periodFields(comparisonRequest),
+		Current:          current,
+		Comparison:       comparison,
+		Deltas:           deltas,
+	}, nil
+}
+
+// comparisonPeriod copia o request trocando os campos de período. O período anterior recua a duração de
+// start/end arredondada para dias inteiros (um dia para day/at e um mês para month).
+func comparisonPeriod(request map[string]any, compare reportdto.ReportComparisonRequest) (map[string]any, error) {
+	times := map[string]time.Time{}
+	for _, field := range comparisonTimeFields {
+		if value, ok := request[field].(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				times[field] = t
+			}
+		}
+	}
+
+	var month time.Time
+	hasMonth := false
+	if value, ok := request["month"].(string); ok && value != "" {
+		parsed, err := time.Parse("2006-01", value)
+		if err != nil {
+			return nil, ErrInvalidMonth
+		}
+		month, hasMonth = parsed, true
+	}
+
+	if len(times) == 0 && !hasMonth {
+		return nil, ErrComparisonPeriod
+	}
+
+	result := map[string]any{}
+	for key, value := range request {
+		result[key] = value
+	}
+
+	switch compare.Mode {
+	case reportdto.ComparisonPreviousYear:
+		for field, t := range times {
+			result[field] = t.AddDate(-1, 0, 0)
+		}
+		month = month.AddDate(-1, 0, 0)
+
+	case reportdto.ComparisonPreviousPeriod:
+		days := 1
+		start, hasStart := times["start"]
+		end, hasEnd := times["end"]
+		if hasStart && hasEnd {
+			days = max(1, int(math.Ceil(end.Sub(start).Hours()/24)))
+		}
+		for field, t := range times {
+			result[field] = t.AddDate(0, 0, -days)
+		}
+		month = month.AddDate(0, -1, 0)
+
+	case reportdto.ComparisonCustom:
+		if compare.Start == nil || compare.End == nil || compare.End.Before(*compare.Start) {
+			return nil, ErrComparisonCustom
+		}
+		custom := map[string]time.Time{"start": *compare.Start, "end": *compare.End, "day": *compare.Start, "at": *compare.End}
+		for field := range times {
+			result[field] = custom[field]
+		}
+		month = *compare.Start
+
+	default:
+		return nil, ErrComparisonMode
+	}
+
+	if hasMonth {
+		result["month"] = month.Format("2006-01")
+	}
+	return result, nil
+}
+
+// periodFields devolve os campos de período enviados ao relatório
+func periodFields(request map[string]any) map[string]string {
+	fields := map[string]string{}
+	for _, field := range append(comparisonTimeFields, "month") {
+		switch value := request[field].(type) {
+		case string:
+			fields[field] = value
+		case time.Time:
+			fields[field] = value.Format(time.RFC3339)
+		}
+	}
+	return fields
+}
+
+// reportDeltas compara os números dos dois resultados pelo caminho no JSON; caminhos que só existem
+// em um dos lados contam como zero no outro. As linhas das listas casam pela chave declarada no catálogo.
+func reportDeltas(current, comparison any, rows rowKeys) ([]reportdto.ReportDelta, error) {
+	currentValues, currentPaths, err := comparisonValues(current, rows)
+	if err != nil {
+		return nil, err
+	}
+	comparisonValuesByPath, comparisonPaths, err := comparisonValues(comparison, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := currentPaths
+	for _, path := range comparisonPaths {
+		if _, ok := currentValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	deltas := make([]reportdto.ReportDelta, len(paths))
+	for i, path := range paths {
+		value, base := currentValues[path], comparisonValuesByPath[path]
+		deltas[i] = reportdto.ReportDelta{
+			Path:       path,
+			Current:    value,
+			Comparison: base,
+			Delta:      value.Sub(base),
+		}
+		if !base.IsZero() {
+			percent := value.Sub(base).Div(base.Abs()).Mul(hundred).Round(2)
+			deltas[i].DeltaPercent = &percent
+		}
+	}
+	return deltas, nil
+}
+
+// comparisonValues achata o resultado em caminho → número, na ordem em que aparecem.
+// Listas sem chave no catálogo ficam de fora: sem identificador não há como casar as linhas.
+func comparisonValues(result any, rows rowKeys) (map[string]decimal.Decimal, []string, error) {
+	body, err := json.Marshal(result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var tree any
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, nil, err
+	}
+
+	values := map[string]decimal.Decimal{}
+	paths := []string{}
+	// path é o caminho do número; list é o caminho da lista sem as linhas, como está no catálogo
+	var walk func(node any, path, list string, skip map[string]bool) error
+	walk = func(node any, path, list string, skip map[string]bool) error {
+		switch n := node.(type) {
+		case map[string]any:
+			keys := make([]string, 0, len(n))
+			for key := range n {
+				if !skip[key] {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				child, childList := key, key
+				if path != "" {
+					child = path + "." + key
+				}
+				if list != "" {
+					childList = list + "." + key
+				}
+				if err := walk(n[key], child, childList, nil); err != nil {
+					return err
+				}
+			}
+		case []any:
+			key, ok := rows[list]
+			if !ok {
+				return nil
+			}
+			names, err := key.names(n)
+			if err != nil {
+				return fmt.Errorf("%w: %s", err, list)
+			}
+			for i, name := range names {
+				if err := walk(n[i], path+"["+name+"]", list, key.skipped()); err != nil {
+					return err
+				}
+			}
+		case json.Number:
+			if value, err := decimal.NewFromString(n.String()); err == nil {
+				values[path] = value
+				paths = append(paths, path)
+			}
+		case string:
+			// decimal.Decimal vira string no JSON
+			if !decimalPattern.MatchString(n) {
+				return nil
+			}
+			if value, err := decimal.NewFromString(n); err == nil {
+				values[path] = value
+				paths = append(paths, path)
+			}
+		}
+		return nil
+	}
+	if err := walk(tree, "", "", nil); err != nil {
+		return nil, nil, err
+	}
+
+	return values, paths, nil
+}
+
+// names nomeia as linhas da lista: "#n" para a n-ésima data de uma série, seguido dos campos da chave
+// separados por "/". Duas linhas com o mesmo nome são erro, para não misturar as variações.
+func (k rowKey) names(items []any) ([]string, error) {
+	names := make([]string, len(items))
+	dates := map[string]int{}
+	used := map[string]bool{}
+	for i, item := range items {
+		row, _ := item.(map[string]any)
+
+		parts := []string{}
+		if k.date != "" {
+			date := fmt.Sprint(row[k.date])
+			if _, seen := dates[date]; !seen {
+				dates[date] = len(dates) + 1
+			}
+			parts = append(parts, "#"+strconv.Itoa(dates[date]))
+		}
+		for _, field := range k.fields {
+			value := ""
+			if row[field] != nil {
+				value = fmt.Sprint(row[field])
+			}
+			parts = append(parts, value)
+		}
+
+		name := strings.Join(parts, "/")
+		if used[name] {
+			return nil, ErrComparisonRowKey
+		}
+		used[name] = true
+		names[i] = name
+	}
+	return names, nil
+}
+
+// skipped são os campos da linha que não entram nas variações: a chave e os números que só ordenam a linha
+func (k rowKey) skipped() map[string]bool {
+	skip := map[string]bool{}
+	if k.date != "" {
+		skip[k.date] = true
+	}
+	for _, field := range append(append([]string{}, k.fields...), k.ignore...) {
+		skip[field] = true
+	}
+	return skip
+}
@@ -0,0 +1,127 @@
+package reportusecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+func TestComparisonPeriod(t *testing.T) {
+	request := map[string]any{"start": "2026-10-12T00:00:00Z", "end": "2026-10-18T23:59:59Z", "group_by": "day"}
+
+	previous, err := comparisonPeriod(request, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonPreviousPeriod})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), previous["start"], "semana anterior inteira")
+	assert.Equal(t, time.Date(2026, 10, 11, 23, 59, 59, 0, time.UTC), previous["end"])
+	assert.Equal(t, "day", previous["group_by"], "os demais filtros são mantidos")
+
+	lastYear, err := comparisonPeriod(request, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonPreviousYear})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 10, 12, 0, 0, 0, 0, time.UTC), lastYear["start"])
+
+	customStart := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	customEnd := time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+	custom, err := comparisonPeriod(map[string]any{"day": "2026-10-19T00:00:00Z"}, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonCustom, Start: &customStart, End: &customEnd})
+	require.NoError(t, err)
+	assert.Equal(t, customStart, custom["day"])
+
+	month, err := comparisonPeriod(map[string]any{"month": "2026-01"}, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonPreviousPeriod})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-12", month["month"])
+
+	_, err = comparisonPeriod(map[string]any{}, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonPreviousPeriod})
+	assert.ErrorIs(t, err, ErrComparisonPeriod)
+	_, err = comparisonPeriod(request, reportdto.ReportComparisonRequest{Mode: "yesterday"})
+	assert.ErrorIs(t, err, ErrComparisonMode)
+	_, err = comparisonPeriod(request, reportdto.ReportComparisonRequest{Mode: reportdto.ComparisonCustom})
+	assert.ErrorIs(t, err, ErrComparisonCustom)
+}
+
+func TestReportDeltas(t *testing.T) {
+	d := decimal.RequireFromString
+	current := &reportdto.ComboSalesResponse{
+		Quantity: d("15"),
+		Revenue:  d("300"),
+		Combos: []reportdto.ComboSales{
+			{ComboID: "c1", Name: "Combo 1", Quantity: d("10"), Revenue: d("200")},
+			{ComboID: "c2", Name: "Combo 2", Quantity: d("5"), Revenue: d("100")},
+		},
+	}
+	comparison := &reportdto.ComboSalesResponse{
+		Quantity: d("8"),
+		Revenue:  d("160"),
+		Combos: []reportdto.ComboSales{
+			{ComboID: "c2", Name: "Combo 2", Quantity: d("8"), Revenue: d("160")},
+		},
+	}
+
+	deltas, err := reportDeltas(current, comparison, reportCatalog["combo-sales"].rows)
+	require.NoError(t, err)
+
+	byPath := map[string]reportdto.ReportDelta{}
+	for _, delta := range deltas {
+		byPath[delta.Path] = delta
+	}
+
+	revenue := byPath["revenue"]
+	assert.True(t, d("140").Equal(revenue.Delta))
+	require.NotNil(t, revenue.DeltaPercent)
+	assert.True(t, d("87.5").Equal(*revenue.DeltaPercent))
+
+	c2 := byPath["combos[c2].quantity"]
+	assert.True(t, d("-3").Equal(c2.Delta), "linhas casam pelo identificador, não pela posição")
+	require.NotNil(t, c2.DeltaPercent)
+	assert.True(t, d("-37.5").Equal(*c2.DeltaPercent))
+
+	c1 := byPath["combos[c1].revenue"]
+	assert.True(t, c1.Comparison.IsZero())
+	assert.Nil(t, c1.DeltaPercent, "sem base não há percentual")
+}
+
+func TestReportDeltas_SameNameRows(t *testing.T) {
+	d := decimal.RequireFromString
+	current := &reportdto.AbcCurveResponse{
+		Products: []reportdto.AbcCurveProduct{
+			{Rank: 1, ProductID: "p1", Name: "Suco", Category: "Bebidas", Revenue: d("100")},
+			{Rank: 2, ProductID: "p2", Name: "Suco", Category: "Sobremesas", Revenue: d("40")},
+		},
+	}
+	comparison := &reportdto.AbcCurveResponse{
+		Products: []reportdto.AbcCurveProduct{
+			{Rank: 1, ProductID: "p2", Name: "Suco", Category: "Sobremesas", Revenue: d("50")},
+		},
+	}
+
+	deltas, err := reportDeltas(current, comparison, reportCatalog["abc-curve"].rows)
+	require.NoError(t, err)
+
+	byPath := map[string]reportdto.ReportDelta{}
+	for _, delta := range deltas {
+		byPath[delta.Path] = delta
+	}
+
+	assert.True(t, d("-10").Equal(byPath["products[p2].revenue"].Delta), "mesmo nome em outra categoria não mistura as linhas")
+	assert.True(t, byPath["products[p1].revenue"].Comparison.IsZero())
+	assert.NotContains(t, byPath, "products[p1].rank", "rank só ordena a linha")
+}
+
+func TestRowKeyNames(t *testing.T) {
+	prep := seriesBy("date", "process_rule_id")
+	names, err := prep.names([]any{
+		map[string]any{"date": "2026-10-12", "process_rule_id": "r1"},
+		map[string]any{"date": "2026-10-12", "process_rule_id": "r2"},
+		map[string]any{"date": "2026-10-13", "process_rule_id": "r1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"#1/r1", "#1/r2", "#2/r1"}, names)
+
+	_, err = keyBy("name").names([]any{
+		map[string]any{"name": "Suco", "category": "Bebidas"},
+		map[string]any{"name": "Suco", "category": "Sobremesas"},
+	})
+	assert.ErrorIs(t, err, ErrComparisonRowKey, "chave repetida é erro, não soma silenciosa")
+}
@@ -0,0 +1,190 @@
+package reportusecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+var (
+	ErrConsolidatedNoCompanies = errors.New("user has no company with statistics permission")
+	ErrConsolidatedForbidden   = errors.New("user has no statistics permission in the requested company")
+)
+
+const consolidatedTopProducts = 10
+
+// consolidatedStore reúne os números brutos de uma loja
+type consolidatedStore struct {
+	company  report.StatisticsCompanyDTO
+	summary  report.StoreSalesSummaryDTO
+	payments []report.PaymentsByMethodDTO
+	products []report.ProductSalesDTO
+}
+
+// ConsolidatedReport soma vendas, ticket, pagamentos e produtos das empresas em que o usuário tem a permissão
+// statistics, consultando o schema de cada loja.
+func (s *Service) ConsolidatedReport(ctx context.Context, req *reportdto.ConsolidatedReportRequest) (*reportdto.ConsolidatedReportResponse, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return nil, errors.New("context user not found")
+	}
+
+	companies, err := s.reportSvc.StatisticsCompanies(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.CompanyIDs) > 0 {
+		allowed := map[string]report.StatisticsCompanyDTO{}
+		for _, company := range companies {
+			allowed[company.CompanyID] = company
+		}
+
+		companies = []report.StatisticsCompanyDTO{}
+		for _, id := range req.CompanyIDs {
+			company, ok := allowed[id.String()]
+			if !ok {
+				return nil, ErrConsolidatedForbidden
+			}
+			companies = append(companies, company)
+		}
+	}
+
+	if len(companies) == 0 {
+		return nil, ErrConsolidatedNoCompanies
+	}
+
+	stores := make([]consolidatedStore, len(companies))
+	for i, company := range companies {
+		storeCtx := context.WithValue(ctx, model.Schema("schema"), company.SchemaName)
+		stores[i].company = company
+
+		summary, err := s.reportSvc.StoreSalesSummary(storeCtx, req.Start, req.End)
+		if err != nil {
+			return nil, err
+		}
+		stores[i].summary = *summary
+
+		if stores[i].payments, err = s.reportSvc.PaymentsByMethod(storeCtx, req.Start, req.End); err != nil {
+			return nil, err
+		}
+		if stores[i].products, err = s.reportSvc.ProductSales(storeCtx, req.Start, req.End); err != nil {
+			return nil, err
+		}
+	}
+
+	top := req.TopProducts
+	if top <= 0 {
+		top = consolidatedTopProducts
+	}
+
+	return consolidate(stores, top), nil
+}
+
+// consolidate soma as lojas; produtos de lojas diferentes se juntam pelo nome
+func consolidate(stores []consolidatedStore, top int) *reportdto.ConsolidatedReportResponse {
+	resp := &reportdto.ConsolidatedReportResponse{
+		Revenue:   decimal.Zero,
+		AvgTicket: decimal.Zero,
+		Stores:    make([]reportdto.ConsolidatedStore, len(stores)),
+	}
+
+	payments := map[string]decimal.Decimal{}
+	products := map[string]*reportdto.ConsolidatedProduct{}
+	for i, store := range stores {
+		storePayments := map[string]decimal.Decimal{}
+		for _, payment := range store.payments {
+			storePayments[payment.Method] = storePayments[payment.Method].Add(payment.Total)
+			payments[payment.Method] = payments[payment.Method].Add(payment.Total)
+		}
+
+		storeProducts := map[string]*reportdto.ConsolidatedProduct{}
+		for _, p := range store.products {
+			storeProducts[p.Name] = &reportdto.ConsolidatedProduct{Name: p.Name, Quantity: p.Quantity, Revenue: p.Revenue, Stores: 1}
+
+			product, ok := products[p.Name]
+			if !ok {
+				product = &reportdto.ConsolidatedProduct{Name: p.Name, Quantity: decimal.Zero, Revenue: decimal.Zero}
+				products[p.Name] = product
+			}
+			product.Quantity = product.Quantity.Add(p.Quantity)
+			product.Revenue = product.Revenue.Add(p.Revenue)
+			product.Stores++
+		}
+
+		resp.Stores[i] = reportdto.ConsolidatedStore{
+			CompanyID:   store.company.CompanyID,
+			TradeName:   store.company.TradeName,
+			Orders:      store.summary.Orders,
+			Revenue:     store.summary.Revenue,
+			AvgTicket:   averageTicket(store.summary.Revenue, store.summary.Orders),
+			Payments:    consolidatedPayments(storePayments),
+			TopProducts: topConsolidatedProducts(storeProducts, top),
+		}
+		resp.Orders += store.summary.Orders
+		resp.Revenue = resp.Revenue.Add(store.summary.Revenue)
+	}
+
+	resp.AvgTicket = averageTicket(resp.Revenue, resp.Orders)
+	resp.Payments = consolidatedPayments(payments)
+	resp.TopProducts = topConsolidatedProducts(products, top)
+	for i := range resp.Stores {
+		resp.Stores[i].RevenueSharePercent = reportdto.MarginPercent(resp.Stores[i].Revenue, resp.Revenue)
+	}
+
+	return resp
+}
+
+func averageTicket(revenue decimal.Decimal, orders int) decimal.Decimal {
+	if orders == 0 {
+		return decimal.Zero
+	}
+	return revenue.Div(decimal.NewFromInt(int64(orders))).Round(2)
+}
+
+// consolidatedPayments ordena as formas de pagamento pelo total, com a participação de cada uma
+func consolidatedPayments(totals map[string]decimal.Decimal) []reportdto.ConsolidatedPayment {
+	sum := decimal.Zero
+	for _, total := range totals {
+		sum = sum.Add(total)
+	}
+
+	payments := []reportdto.ConsolidatedPayment{}
+	for method, total := range totals {
+		payments = append(payments, reportdto.ConsolidatedPayment{Method: method, Total: total, SharePercent: reportdto.MarginPercent(total, sum)})
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		if !payments[i].Total.Equal(payments[j].Total) {
+			return payments[i].Total.GreaterThan(payments[j].Total)
+		}
+		return payments[i].Method < payments[j].Method
+	})
+	return payments
+}
+
+// topConsolidatedProducts devolve os top produtos por unidades vendidas, desempatando pela receita
+func topConsolidatedProducts(products map[string]*reportdto.ConsolidatedProduct, top int) []reportdto.ConsolidatedProduct {
+	ranking := make([]reportdto.ConsolidatedProduct, 0, len(products))
+	for _, product := range products {
+		ranking = append(ranking, *product)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if !ranking[i].Quantity.Equal(ranking[j].Quantity) {
+			return ranking[i].Quantity.GreaterThan(ranking[j].Quantity)
+		}
+		if !ranking[i].Revenue.Equal(ranking[j].Revenue) {
+			return ranking[i].Revenue.GreaterThan(ranking[j].Revenue)
+		}
+		return ranking[i].Name < ranking[j].Name
+	})
+	if len(ranking) > top {
+		ranking = ranking[:top]
+	}
+	return ranking
+}
@@ -0,0 +1,74 @@
+package reportusecases
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+func TestConsolidate(t *testing.T) {
+	d := decimal.RequireFromString
+	stores := []consolidatedStore{
+		{
+			company: report.StatisticsCompanyDTO{CompanyID: "a", TradeName: "Centro"},
+			summary: report.StoreSalesSummaryDTO{Orders: 30, Revenue: d("1500")},
+			payments: []report.PaymentsByMethodDTO{
+				{Method: "Pix", Total: d("1000")},
+				{Method: "Dinheiro", Total: d("500")},
+			},
+			products: []report.ProductSalesDTO{
+				{Name: "X-Burger", Quantity: d("40"), Revenue: d("1200")},
+				{Name: "Suco", Quantity: d("20"), Revenue: d("300")},
+			},
+		},
+		{
+			company:  report.StatisticsCompanyDTO{CompanyID: "b", TradeName: "Shopping"},
+			summary:  report.StoreSalesSummaryDTO{Orders: 10, Revenue: d("500")},
+			payments: []report.PaymentsByMethodDTO{{Method: "Pix", Total: d("500")}},
+			products: []report.ProductSalesDTO{
+				{Name: "Suco", Quantity: d("25"), Revenue: d("375")},
+				{Name: "Batata", Quantity: d("5"), Revenue: d("125")},
+			},
+		},
+	}
+
+	resp := consolidate(stores, 2)
+
+	assert.Equal(t, 40, resp.Orders)
+	assert.True(t, d("2000").Equal(resp.Revenue))
+	assert.True(t, d("50").Equal(resp.AvgTicket))
+
+	require.Len(t, resp.Payments, 2)
+	assert.Equal(t, "Pix", resp.Payments[0].Method)
+	assert.True(t, d("1500").Equal(resp.Payments[0].Total))
+	assert.True(t, d("75").Equal(resp.Payments[0].SharePercent))
+
+	// suco soma as duas lojas e passa o x-burger; batata fica fora do top 2
+	require.Len(t, resp.TopProducts, 2)
+	assert.Equal(t, "Suco", resp.TopProducts[0].Name)
+	assert.True(t, d("45").Equal(resp.TopProducts[0].Quantity))
+	assert.Equal(t, 2, resp.TopProducts[0].Stores)
+	assert.Equal(t, "X-Burger", resp.TopProducts[1].Name)
+
+	require.Len(t, resp.Stores, 2)
+	assert.True(t, d("75").Equal(resp.Stores[0].RevenueSharePercent))
+	assert.True(t, d("50").Equal(resp.Stores[1].AvgTicket))
+	require.Len(t, resp.Stores[1].TopProducts, 2)
+	assert.Equal(t, "Suco", resp.Stores[1].TopProducts[0].Name)
+	assert.Equal(t, 1, resp.Stores[1].TopProducts[0].Stores)
+}
+
+func TestConsolidateWithoutOrders(t *testing.T) {
+	resp := consolidate([]consolidatedStore{{
+		company: report.StatisticsCompanyDTO{CompanyID: "a"},
+		summary: report.StoreSalesSummaryDTO{Revenue: decimal.Zero},
+	}}, 10)
+
+	assert.True(t, resp.AvgTicket.IsZero())
+	assert.True(t, resp.Stores[0].RevenueSharePercent.IsZero())
+	assert.Empty(t, resp.Payments)
+	assert.Empty(t, resp.TopProducts)
+}
@@ -0,0 +1,272 @@
+package reportusecases
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
+)
+
+var ErrReportNotExportable = errors.New("report result cannot be exported")
+
+// ExportFormat é o formato de saída de um relatório
+type ExportFormat string
+
+const (
+	ExportJSON ExportFormat = "json"
+	ExportCSV  ExportFormat = "csv"
+	ExportXLSX ExportFormat = "xlsx"
+	ExportPDF  ExportFormat = "pdf"
+)
+
+var exportContentTypes = map[ExportFormat]string{
+	ExportJSON: "application/json",
+	ExportCSV:  "text/csv; charset=utf-8",
+	ExportXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	ExportPDF:  "application/pdf",
+}
+
+// ParseExportFormat escolhe o formato pelo parâmetro ?format= e, sem ele, pelo header Accept.
+// Sem nenhum dos dois (ou com valores desconhecidos) o relatório continua em JSON.
+func ParseExportFormat(format string, accept string) ExportFormat {
+	if f := ExportFormat(strings.ToLower(strings.TrimSpace(format))); f != "" {
+		if _, ok := exportContentTypes[f]; ok {
+			return f
+		}
+		return ExportJSON
+	}
+
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		for f, contentType := range exportContentTypes {
+			if strings.HasPrefix(contentType, mediaType) {
+				return f
+			}
+		}
+	}
+
+	return ExportJSON
+}
+
+func (f ExportFormat) ContentType() string {
+	return exportContentTypes[f]
+}
+
+// Filename devolve o nome do anexo com a extensão do formato
+func (f ExportFormat) Filename(name string) string {
+	return name + "." + string(f)
+}
+
+// csvRecorder é implementado pelos relatórios que definem o próprio layout de exportação
+type csvRecorder interface {
+	CSVRecords() [][]string
+}
+
+// ReportTable é o relatório achatado em cabeçalho e linhas, comum aos três formatos
+type ReportTable struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// NewReportTable achata o resultado de um relatório. Usa CSVRecords quando o relatório define;
+// senão lê os campos simples (texto, número, data, decimal) de uma struct ou lista de structs,
+// com o nome da tag json como cabeçalho.
+func NewReportTable(report any) (*ReportTable, error) {
+	if recorder, ok := report.(csvRecorder); ok {
+		records := recorder.CSVRecords()
+		if len(records) == 0 {
+			return nil, ErrReportNotExportable
+		}
+		return &ReportTable{Headers: records[0], Rows: records[1:]}, nil
+	}
+
+	value := reflect.ValueOf(report)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil, ErrReportNotExportable
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		table := &ReportTable{Headers: structHeaders(value.Type())}
+		table.Rows = append(table.Rows, structRow(value))
+		return table, nil
+	case reflect.Slice:
+		elemType := value.Type().Elem()
+		for elemType.Kind() == reflect.Pointer {
+			elemType = elemType.Elem()
+		}
+
+		if elemType.Kind() != reflect.Struct {
+			return nil, ErrReportNotExportable
+		}
+
+		table := &ReportTable{Headers: structHeaders(elemType), Rows: make([][]string, 0, value.Len())}
+		for i := 0; i < value.Len(); i++ {
+			elem := value.Index(i)
+			for elem.Kind() == reflect.Pointer {
+				elem = elem.Elem()
+			}
+			table.Rows = append(table.Rows, structRow(elem))
+		}
+		return table, nil
+	}
+
+	return nil, ErrReportNotExportable
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+func exportableField(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.Interface, reflect.Func, reflect.Chan:
+		return "", false
+	case reflect.Struct:
+		if fieldType != timeType && fieldType != decimalType {
+			return "", false
+		}
+	}
+
+	return name, true
+}
+
+func structHeaders(structType reflect.Type) []string {
+	headers := []string{}
+	for i := 0; i < structType.NumField(); i++ {
+		if name, ok := exportableField(structType.Field(i)); ok {
+			headers = append(headers, name)
+		}
+	}
+	return headers
+}
+
+func structRow(value reflect.Value) []string {
+	row := []string{}
+	for i := 0; i < value.NumField(); i++ {
+		if _, ok := exportableField(value.Type().Field(i)); ok {
+			row = append(row, formatCell(value.Field(i)))
+		}
+	}
+	return row
+}
+
+func formatCell(value reflect.Value) string {
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	switch v := value.Interface().(type) {
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format("2006-01-02 15:04:05")
+	case decimal.Decimal:
+		return v.String()
+	case float32, float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+	}
+
+	return fmt.Sprint(value.Interface())
+}
+
+// ReportExport é um relatório pronto para ser escrito; montar a tabela e a marca antes de escrever
+// permite devolver erro ao cliente antes de enviar o cabeçalho HTTP.
+type ReportExport struct {
+	Format  ExportFormat
+	Title   string
+	Table   *ReportTable
+	Company *companydto.CompanyDTO
+	Logo    []byte // JPEG já convertido, só no PDF
+}
+
+// Export prepara o relatório no formato pedido. No PDF carrega nome e logo da empresa;
+// logo inacessível não impede a exportação.
+func (s *Service) Export(ctx context.Context, format ExportFormat, title string, report any) (*ReportExport, error) {
+	table, err := NewReportTable(report)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &ReportExport{Format: format, Title: title, Table: table}
+	if format != ExportPDF || s.rcompany == nil {
+		return export, nil
+	}
+
+	companyModel, err := s.rcompany.GetCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	export.Company = &companydto.CompanyDTO{}
+	export.Company.FromDomain(companyModel.ToDomain())
+	export.Logo, _ = fetchLogoJPEG(ctx, export.Company.ImagePath)
+	return export, nil
+}
+
+// Write escreve o relatório no writer. Os relatórios são agregados (por dia, hora, produto...),
+// então a tabela já vem inteira do Export e cada formato é escrito de uma vez.
+func (e *ReportExport) Write(w io.Writer) error {
+	switch e.Format {
+	case ExportCSV:
+		return writeCSV(w, e.Table)
+	case ExportXLSX:
+		return writeXLSX(w, e.Title, e.Table)
+	case ExportPDF:
+		return writePDF(w, e)
+	}
+	return ErrReportNotExportable
+}
+
+func writeCSV(w io.Writer, table *ReportTable) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+
+	if err := cw.Write(table.Headers); err != nil {
+		return err
+	}
+
+	if err := cw.WriteAll(table.Rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
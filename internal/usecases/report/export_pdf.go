@@ -0,0 +1,361 @@
+package reportusecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	s3service "github.com/willjrcom/sales-backend-go/internal/infra/service/s3"
+)
+
+const (
+	pdfMargin       = 36.0
+	pdfHeaderHeight = 64.0
+	pdfRowHeight    = 14.0
+	pdfFontSize     = 8.0
+	pdfLogoMaxSize  = 48.0
+	// largura média de um caractere da Helvetica em relação ao tamanho da fonte
+	pdfCharWidth = 0.52
+	// relatórios com muitas colunas saem em paisagem
+	pdfLandscapeColumns = 6
+	pdfMaxColumnChars   = 40
+	logoMaxBytes        = 2 << 20
+	// maior lado aceito: a decodificação aloca largura × altura × 4 bytes
+	logoMaxDimension = 2048
+)
+
+var (
+	errLogoOutsideBucket = errors.New("logo must be stored in the application bucket")
+	errLogoTooLarge      = errors.New("logo dimensions too large")
+)
+
+// logoClient não segue redirecionamentos: o host já foi validado e não pode mudar no meio do caminho
+var logoClient = &http.Client{
+	Timeout: 5 * time.Second,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// objetos fixos do PDF; páginas e conteúdos começam em pdfFirstPageObject
+const (
+	pdfCatalogObject = iota + 1
+	pdfPagesObject
+	pdfFontObject
+	pdfBoldFontObject
+	pdfLogoObject
+	pdfFirstPageObject
+)
+
+// pdfWriter escreve objetos direto no destino, guardando o offset de cada um para o xref
+type pdfWriter struct {
+	w       io.Writer
+	offset  int
+	offsets map[int]int
+	err     error
+}
+
+func (p *pdfWriter) write(data []byte) {
+	if p.err != nil {
+		return
+	}
+	n, err := p.w.Write(data)
+	p.offset += n
+	p.err = err
+}
+
+func (p *pdfWriter) printf(format string, args ...any) {
+	p.write([]byte(fmt.Sprintf(format, args...)))
+}
+
+func (p *pdfWriter) object(number int, body string) {
+	p.offsets[number] = p.offset
+	p.printf("%d 0 obj\n%s\nendobj\n", number, body)
+}
+
+func (p *pdfWriter) stream(number int, dict string, data []byte) {
+	p.offsets[number] = p.offset
+	p.printf("%d 0 obj\n<< %s /Length %d >>\nstream\n", number, dict, len(data))
+	p.write(data)
+	p.printf("\nendstream\nendobj\n")
+}
+
+// pdfLayout guarda as medidas da página e das colunas, iguais em todas as páginas
+type pdfLayout struct {
+	width, height float64
+	columns       []float64
+	logoWidth     float64
+	logoHeight    float64
+	hasLogo       bool
+	company       string
+	title         string
+	generatedAt   string
+}
+
+// writePDF gera um PDF com cabeçalho da empresa (nome e logo) em cada página e a tabela do relatório.
+// Cada página é enviada assim que fica pronta; o objeto Pages e o xref vão no fim do arquivo.
+func writePDF(w io.Writer, export *ReportExport) error {
+	p := &pdfWriter{w: w, offsets: map[int]int{}}
+	layout := newPDFLayout(export)
+
+	p.write([]byte("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"))
+	p.object(pdfCatalogObject, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfPagesObject))
+	p.object(pdfFontObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	p.object(pdfBoldFontObject, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+
+	resources := fmt.Sprintf("/Font << /F1 %d 0 R /F2 %d 0 R >>", pdfFontObject, pdfBoldFontObject)
+	if layout.hasLogo {
+		config, _, _ := image.DecodeConfig(bytes.NewReader(export.Logo))
+		p.stream(pdfLogoObject, fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8 /Filter /DCTDecode", config.Width, config.Height), export.Logo)
+		resources += fmt.Sprintf(" /XObject << /Im1 %d 0 R >>", pdfLogoObject)
+	}
+
+	rowsPerPage := int((layout.height - 2*pdfMargin - pdfHeaderHeight - pdfRowHeight - pdfRowHeight) / pdfRowHeight)
+	if rowsPerPage < 1 {
+		rowsPerPage = 1
+	}
+
+	pageObjects := []int{}
+	rows := export.Table.Rows
+	for pageNumber := 1; pageNumber == 1 || len(rows) > 0; pageNumber++ {
+		pageRows := rows
+		if len(pageRows) > rowsPerPage {
+			pageRows = pageRows[:rowsPerPage]
+		}
+		rows = rows[len(pageRows):]
+
+		contentObject := pdfFirstPageObject + 2*(pageNumber-1)
+		pageObject := contentObject + 1
+
+		p.stream(contentObject, "", layout.page(pageNumber, export.Table.Headers, pageRows))
+		p.object(pageObject, fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.0f %.0f] /Resources << %s >> /Contents %d 0 R >>",
+			pdfPagesObject, layout.width, layout.height, resources, contentObject))
+		pageObjects = append(pageObjects, pageObject)
+	}
+
+	kids := make([]string, len(pageObjects))
+	for i, number := range pageObjects {
+		kids[i] = fmt.Sprintf("%d 0 R", number)
+	}
+	p.object(pdfPagesObject, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pageObjects)))
+
+	lastObject := pdfFirstPageObject + 2*len(pageObjects) - 1
+	xrefOffset := p.offset
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", lastObject+1)
+	for number := 1; number <= lastObject; number++ {
+		if offset, ok := p.offsets[number]; ok {
+			p.printf("%010d 00000 n \n", offset)
+		} else {
+			p.printf("0000000000 65535 f \n")
+		}
+	}
+	p.printf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", lastObject+1, pdfCatalogObject, xrefOffset)
+
+	return p.err
+}
+
+func newPDFLayout(export *ReportExport) *pdfLayout {
+	layout := &pdfLayout{width: 595, height: 842, title: export.Title}
+	if len(export.Table.Headers) > pdfLandscapeColumns {
+		layout.width, layout.height = layout.height, layout.width
+	}
+
+	timeZone := ""
+	if export.Company != nil {
+		timeZone = export.Company.TimeZone
+		layout.company = export.Company.TradeName
+		if layout.company == "" {
+			layout.company = export.Company.BusinessName
+		}
+	}
+
+	// "Gerado em" no fuso da empresa, como as datas dos relatórios
+	layout.generatedAt = time.Now().In(companyentity.LoadLocation(timeZone)).Format("02/01/2006 15:04")
+
+	if config, _, err := image.DecodeConfig(bytes.NewReader(export.Logo)); err == nil && config.Width > 0 && config.Height > 0 {
+		scale := pdfLogoMaxSize / float64(max(config.Width, config.Height))
+		layout.hasLogo = true
+		layout.logoWidth = float64(config.Width) * scale
+		layout.logoHeight = float64(config.Height) * scale
+	}
+
+	// largura de cada coluna proporcional ao maior texto dela, limitado a pdfMaxColumnChars
+	chars := make([]float64, len(export.Table.Headers))
+	total := 0.0
+	for i, header := range export.Table.Headers {
+		longest := len([]rune(header))
+		for _, row := range export.Table.Rows {
+			if i < len(row) {
+				longest = max(longest, len([]rune(row[i])))
+			}
+		}
+		chars[i] = float64(min(max(longest, 4), pdfMaxColumnChars))
+		total += chars[i]
+	}
+
+	available := layout.width - 2*pdfMargin
+	layout.columns = make([]float64, len(chars))
+	for i := range chars {
+		layout.columns[i] = available * chars[i] / total
+	}
+
+	return layout
+}
+
+func (l *pdfLayout) page(pageNumber int, headers []string, rows [][]string) []byte {
+	var content bytes.Buffer
+	top := l.height - pdfMargin
+
+	textX := pdfMargin
+	if l.hasLogo {
+		fmt.Fprintf(&content, "q %.2f 0 0 %.2f %.2f %.2f cm /Im1 Do Q\n", l.logoWidth, l.logoHeight, pdfMargin, top-l.logoHeight)
+		textX += l.logoWidth + 10
+	}
+
+	pdfText(&content, "F2", 14, textX, top-14, l.company)
+	pdfText(&content, "F2", 11, textX, top-32, l.title)
+	pdfText(&content, "F1", pdfFontSize, textX, top-46, "Gerado em "+l.generatedAt)
+
+	y := top - pdfHeaderHeight
+	fmt.Fprintf(&content, "0.9 g %.2f %.2f %.2f %.2f re f 0 g\n", pdfMargin, y-pdfRowHeight+4, l.width-2*pdfMargin, pdfRowHeight)
+	l.row(&content, "F2", y, headers)
+
+	for i, row := range rows {
+		y -= pdfRowHeight
+		if i%2 == 1 {
+			fmt.Fprintf(&content, "0.96 g %.2f %.2f %.2f %.2f re f 0 g\n", pdfMargin, y-pdfRowHeight+4, l.width-2*pdfMargin, pdfRowHeight)
+		}
+		l.row(&content, "F1", y, row)
+	}
+
+	pdfText(&content, "F1", pdfFontSize, l.width-pdfMargin-40, pdfMargin/2, fmt.Sprintf("Página %d", pageNumber))
+	return content.Bytes()
+}
+
+func (l *pdfLayout) row(content *bytes.Buffer, font string, y float64, cells []string) {
+	x := pdfMargin
+	for i, width := range l.columns {
+		if i < len(cells) {
+			pdfText(content, font, pdfFontSize, x+2, y-pdfRowHeight+8, fitText(cells[i], width-4))
+		}
+		x += width
+	}
+}
+
+// fitText corta o texto que não cabe na coluna
+func fitText(text string, width float64) string {
+	maxChars := int(width / (pdfFontSize * pdfCharWidth))
+	runes := []rune(text)
+	if len(runes) <= maxChars {
+		return text
+	}
+	if maxChars <= 1 {
+		return ""
+	}
+	return string(runes[:maxChars-1]) + "…"
+}
+
+func pdfText(content *bytes.Buffer, font string, size, x, y float64, text string) {
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(content, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfString(text))
+}
+
+// pdfString converte para WinAnsi (cobre os acentos do português) e escapa os delimitadores
+func pdfString(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 32 && r < 127:
+			sb.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		case r == '…':
+			sb.WriteString("\\205")
+		case r == '–' || r == '—':
+			sb.WriteByte('-')
+		case r == '€':
+			sb.WriteString("\\200")
+		default:
+			sb.WriteByte('?')
+		}
+	}
+	return sb.String()
+}
+
+// fetchLogoJPEG baixa o logo da empresa, só do bucket da aplicação, e converte para JPEG
+func fetchLogoJPEG(ctx context.Context, url string) ([]byte, error) {
+	if url == "" {
+		return nil, nil
+	}
+
+	if !s3service.IsBucketURL(url) {
+		return nil, errLogoOutsideBucket
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := logoClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("logo not found")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, logoMaxBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return logoJPEG(data)
+}
+
+// logoJPEG converte a imagem para JPEG (o único formato que o PDF embute sem decodificar);
+// transparência vira fundo branco. As dimensões são checadas antes de decodificar.
+func logoJPEG(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Width > logoMaxDimension || config.Height > logoMaxDimension {
+		return nil, errLogoTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := image.NewRGBA(img.Bounds())
+	draw.Draw(canvas, canvas.Bounds(), &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+	draw.Draw(canvas, canvas.Bounds(), img, img.Bounds().Min, draw.Over)
+
+	var out bytes.Buffer
+	if err := jpeg.Encode(&out, canvas, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
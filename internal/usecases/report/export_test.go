@@ -0,0 +1,158 @@
+package reportusecases
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	companydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/company"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	assert.Equal(t, ExportXLSX, ParseExportFormat("XLSX", "application/pdf"), "query vence o Accept")
+	assert.Equal(t, ExportPDF, ParseExportFormat("", "application/pdf"))
+	assert.Equal(t, ExportCSV, ParseExportFormat("", "text/html;q=0.9, text/csv"))
+	assert.Equal(t, ExportJSON, ParseExportFormat("", "*/*"))
+	assert.Equal(t, ExportJSON, ParseExportFormat("doc", ""))
+}
+
+func TestNewReportTable(t *testing.T) {
+	day := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	table, err := NewReportTable([]reportdto.AvgTicketByDayResponse{{Day: day, Avg: decimal.RequireFromString("42.5")}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"day", "avg"}, table.Headers)
+	assert.Equal(t, [][]string{{"2026-10-19 00:00:00", "42.5"}}, table.Rows)
+
+	table, err = NewReportTable(&reportdto.CancellationRateResponse{Rate: 0.25})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"0.25"}}, table.Rows, "struct única vira uma linha")
+
+	table, err = NewReportTable(&reportdto.PriceListSalesResponse{})
+	require.NoError(t, err)
+	assert.Equal(t, "lista", table.Headers[0], "CSVRecords define o layout")
+
+	_, err = NewReportTable([]string{"a"})
+	assert.ErrorIs(t, err, ErrReportNotExportable)
+}
+
+func TestWriteXLSX(t *testing.T) {
+	export := &ReportExport{Format: ExportXLSX, Title: "Vendas por CEP", Table: &ReportTable{
+		Headers: []string{"cep", "total"},
+		Rows:    [][]string{{"01310100", "10.5"}},
+	}}
+
+	var out bytes.Buffer
+	require.NoError(t, export.Write(&out))
+
+	zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, err := f.Open()
+			require.NoError(t, err)
+			data, _ := io.ReadAll(rc)
+			sheet = string(data)
+		}
+	}
+
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">01310100</t></is></c>`, "zero à esquerda continua texto")
+	assert.Contains(t, sheet, `<c r="B2"><v>10.5</v></c>`)
+	assert.Equal(t, "AA", xlsxColumn(26))
+}
+
+func TestWritePDF(t *testing.T) {
+	rows := make([][]string, 120)
+	for i := range rows {
+		rows[i] = []string{"Pão de queijo", strconv.Itoa(i)}
+	}
+
+	export := &ReportExport{
+		Format:  ExportPDF,
+		Title:   "Produtos mais vendidos",
+		Table:   &ReportTable{Headers: []string{"name", "quantity"}, Rows: rows},
+		Company: &companydto.CompanyDTO{TradeName: "Padaria (Centro)"},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, export.Write(&out))
+	pdf := out.String()
+
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, `(Padaria \(Centro\)) Tj`)
+	assert.Contains(t, pdf, `(P\343o de queijo) Tj`, "acentos em WinAnsi")
+	assert.Contains(t, pdf, "/Count 3", "120 linhas em três páginas")
+
+	// startxref aponta para a tabela xref
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	require.Len(t, match, 2)
+	offset, _ := strconv.Atoi(match[1])
+	require.True(t, strings.HasPrefix(pdf[offset:], "xref\n"))
+
+	// cada entrada em uso do xref aponta para o início do próprio objeto
+	entries := strings.Split(pdf[offset:strings.Index(pdf, "trailer")], "\n")[2:]
+	for number, entry := range entries {
+		if strings.HasSuffix(entry, " n ") {
+			objectOffset, _ := strconv.Atoi(entry[:10])
+			assert.True(t, strings.HasPrefix(pdf[objectOffset:], strconv.Itoa(number)+" 0 obj"), "objeto %d", number)
+		}
+	}
+}
+
+func TestFetchLogoJPEG_OnlyFromBucket(t *testing.T) {
+	for _, url := range []string{
+		"http://169.254.169.254/latest/meta-data/",
+		"http://sales-backend-golang.s3.sa-east-1.amazonaws.com/logo.png",
+		"https://sales-backend-golang.s3.sa-east-1.amazonaws.com.evil.com/logo.png",
+		"https://sales-backend-golang.s3.sa-east-1.amazonaws.com:8443/logo.png",
+		"file:///etc/passwd",
+	} {
+		_, err := fetchLogoJPEG(context.Background(), url)
+		assert.ErrorIs(t, err, errLogoOutsideBucket, url)
+	}
+}
+
+func TestLogoJPEG(t *testing.T) {
+	encode := func(width, height int) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+		return buf.Bytes()
+	}
+
+	logo, err := logoJPEG(encode(64, 32))
+	require.NoError(t, err)
+	config, format, err := image.DecodeConfig(bytes.NewReader(logo))
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, 64, config.Width)
+
+	// poucos bytes comprimidos, mas a decodificação alocaria a imagem inteira
+	_, err = logoJPEG(encode(logoMaxDimension+1, 1))
+	assert.ErrorIs(t, err, errLogoTooLarge)
+}
+
+func TestReportCatalog(t *testing.T) {
+	for _, reportType := range ReportTypes() {
+		assert.NotEmpty(t, ReportFilename(reportType), reportType)
+	}
+
+	assert.True(t, IsReportType("daily-sales"))
+	assert.Equal(t, "Produtos mais vendidos", ReportTitle(ReportFilename("top-products")))
+
+	_, err := (&Service{}).RunReport(context.Background(), "unknown", nil, time.Now(), time.Now())
+	assert.ErrorIs(t, err, ErrReportTypeUnknown)
+}
@@ -0,0 +1,167 @@
+package reportusecases
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+const (
+	MenuClassStar      = "star"
+	MenuClassPlowhorse = "plowhorse"
+	MenuClassPuzzle    = "puzzle"
+	MenuClassDog       = "dog"
+)
+
+var (
+	// popularityFactor segue Kasavana & Smith: item popular vende ao menos 70% da participação média (1/N)
+	popularityFactor = decimal.RequireFromString("0.7")
+	// limites do acumulado da receita para as classes A e B; o restante é C
+	abcClassALimit = decimal.NewFromInt(80)
+	abcClassBLimit = decimal.NewFromInt(95)
+	hundred        = decimal.NewFromInt(100)
+)
+
+// MenuEngineering classifies each variation sold in the period as star, plowhorse, puzzle or dog.
+func (s *Service) MenuEngineering(ctx context.Context, req *reportdto.MenuEngineeringRequest) (*reportdto.MenuEngineeringResponse, error) {
+	data, err := s.reportSvc.MenuEngineeringItems(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return menuEngineering(data), nil
+}
+
+// AbcCurve ranks the products by revenue in the period and splits them into the A, B and C classes.
+func (s *Service) AbcCurve(ctx context.Context, req *reportdto.AbcCurveRequest) (*reportdto.AbcCurveResponse, error) {
+	data, err := s.reportSvc.RevenueByProduct(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+	return abcCurve(data), nil
+}
+
+// menuEngineering calcula a margem unitária (preço da variação - custo) e compara cada item com os dois cortes:
+// popularidade acima de 70% do mix médio e margem acima da média ponderada pelas unidades vendidas.
+func menuEngineering(data []report.MenuEngineeringItemDTO) *reportdto.MenuEngineeringResponse {
+	resp := &reportdto.MenuEngineeringResponse{
+		Quantity:            decimal.Zero,
+		Revenue:             decimal.Zero,
+		Contribution:        decimal.Zero,
+		PopularityThreshold: decimal.Zero,
+		MarginThreshold:     decimal.Zero,
+		Classes:             map[string]int{MenuClassStar: 0, MenuClassPlowhorse: 0, MenuClassPuzzle: 0, MenuClassDog: 0},
+		Items:               make([]reportdto.MenuEngineeringItem, len(data)),
+	}
+
+	for i, d := range data {
+		margin := d.Price.Sub(d.UnitCost)
+		contribution := margin.Mul(d.Quantity).Round(2)
+		resp.Items[i] = reportdto.MenuEngineeringItem{
+			ProductID:          d.ProductID,
+			VariationID:        d.VariationID,
+			Name:               d.Name,
+			Size:               d.Size,
+			Category:           d.Category,
+			Price:              d.Price,
+			UnitCost:           d.UnitCost,
+			CostSource:         d.CostSource,
+			ContributionMargin: margin,
+			Quantity:           d.Quantity,
+			Revenue:            d.Revenue,
+			Contribution:       contribution,
+		}
+		resp.Quantity = resp.Quantity.Add(d.Quantity)
+		resp.Revenue = resp.Revenue.Add(d.Revenue)
+		resp.Contribution = resp.Contribution.Add(contribution)
+	}
+
+	if len(data) == 0 || resp.Quantity.IsZero() {
+		return resp
+	}
+
+	resp.PopularityThreshold = resp.Quantity.Div(decimal.NewFromInt(int64(len(data)))).Mul(popularityFactor).Round(2)
+	resp.MarginThreshold = resp.Contribution.Div(resp.Quantity).Round(2)
+
+	for i := range resp.Items {
+		item := &resp.Items[i]
+		item.MenuMixPercent = item.Quantity.Div(resp.Quantity).Mul(hundred).Round(2)
+
+		popular := item.Quantity.GreaterThanOrEqual(resp.PopularityThreshold)
+		profitable := item.ContributionMargin.GreaterThanOrEqual(resp.MarginThreshold)
+		switch {
+		case popular && profitable:
+			item.Class = MenuClassStar
+		case popular:
+			item.Class = MenuClassPlowhorse
+		case profitable:
+			item.Class = MenuClassPuzzle
+		default:
+			item.Class = MenuClassDog
+		}
+		resp.Classes[item.Class]++
+	}
+
+	return resp
+}
+
+// abcCurve espera os produtos em ordem decrescente de receita. O produto que cruza 80% do acumulado
+// ainda é A (e o que cruza 95% ainda é B), para que a classe A nunca fique vazia.
+func abcCurve(data []report.ProductRevenueDTO) *reportdto.AbcCurveResponse {
+	resp := &reportdto.AbcCurveResponse{
+		Revenue:  decimal.Zero,
+		Products: make([]reportdto.AbcCurveProduct, len(data)),
+	}
+	for _, d := range data {
+		resp.Revenue = resp.Revenue.Add(d.Revenue)
+	}
+
+	resp.Classes = make([]reportdto.AbcCurveClass, 3)
+	classes := map[string]*reportdto.AbcCurveClass{}
+	for i, class := range []string{"A", "B", "C"} {
+		resp.Classes[i] = reportdto.AbcCurveClass{Class: class, Revenue: decimal.Zero, SharePercent: decimal.Zero}
+		classes[class] = &resp.Classes[i]
+	}
+
+	cumulative := decimal.Zero
+	for i, d := range data {
+		previous := decimal.Zero
+		share := decimal.Zero
+		if resp.Revenue.IsPositive() {
+			previous = cumulative.Div(resp.Revenue).Mul(hundred)
+			share = d.Revenue.Div(resp.Revenue).Mul(hundred)
+		}
+		cumulative = cumulative.Add(d.Revenue)
+
+		class := "C"
+		switch {
+		case previous.LessThan(abcClassALimit):
+			class = "A"
+		case previous.LessThan(abcClassBLimit):
+			class = "B"
+		}
+
+		resp.Products[i] = reportdto.AbcCurveProduct{
+			Rank:              i + 1,
+			ProductID:         d.ProductID,
+			Name:              d.Name,
+			Category:          d.Category,
+			Quantity:          d.Quantity,
+			Revenue:           d.Revenue,
+			SharePercent:      share.Round(2),
+			CumulativePercent: previous.Add(share).Round(2),
+			Class:             class,
+		}
+		classes[class].Products++
+		classes[class].Revenue = classes[class].Revenue.Add(d.Revenue)
+	}
+
+	if resp.Revenue.IsPositive() {
+		for i := range resp.Classes {
+			resp.Classes[i].SharePercent = resp.Classes[i].Revenue.Div(resp.Revenue).Mul(hundred).Round(2)
+		}
+	}
+
+	return resp
+}
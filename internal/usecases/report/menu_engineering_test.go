@@ -0,0 +1,67 @@
+package reportusecases
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+func TestMenuEngineering(t *testing.T) {
+	d := decimal.RequireFromString
+	data := []report.MenuEngineeringItemDTO{
+		{VariationID: "burger", Price: d("30"), UnitCost: d("10"), Quantity: d("100"), Revenue: d("3000"), CostSource: "debited"},
+		{VariationID: "fries", Price: d("12"), UnitCost: d("4"), Quantity: d("80"), Revenue: d("960"), CostSource: "on_hand"},
+		{VariationID: "steak", Price: d("90"), UnitCost: d("40"), Quantity: d("10"), Revenue: d("900"), CostSource: "debited"},
+		{VariationID: "salad", Price: d("15"), UnitCost: d("10"), Quantity: d("10"), Revenue: d("150"), CostSource: "none"},
+	}
+
+	resp := menuEngineering(data)
+
+	// 200 unidades em 4 itens: corte de popularidade em 70% de 50
+	assert.True(t, d("35").Equal(resp.PopularityThreshold))
+	// contribuição 2000 + 640 + 500 + 50 = 3190 sobre 200 unidades
+	assert.True(t, d("3190").Equal(resp.Contribution))
+	assert.True(t, d("15.95").Equal(resp.MarginThreshold))
+
+	require.Len(t, resp.Items, 4)
+	assert.Equal(t, MenuClassStar, resp.Items[0].Class)
+	assert.Equal(t, MenuClassPlowhorse, resp.Items[1].Class)
+	assert.Equal(t, MenuClassPuzzle, resp.Items[2].Class)
+	assert.Equal(t, MenuClassDog, resp.Items[3].Class)
+	assert.True(t, d("50").Equal(resp.Items[0].MenuMixPercent))
+	assert.Equal(t, map[string]int{MenuClassStar: 1, MenuClassPlowhorse: 1, MenuClassPuzzle: 1, MenuClassDog: 1}, resp.Classes)
+}
+
+func TestMenuEngineering_Empty(t *testing.T) {
+	resp := menuEngineering(nil)
+	assert.Empty(t, resp.Items)
+	assert.True(t, resp.MarginThreshold.IsZero())
+}
+
+func TestAbcCurve(t *testing.T) {
+	d := decimal.RequireFromString
+	data := []report.ProductRevenueDTO{
+		{ProductID: "p1", Revenue: d("700")},
+		{ProductID: "p2", Revenue: d("150")},
+		{ProductID: "p3", Revenue: d("100")},
+		{ProductID: "p4", Revenue: d("50")},
+	}
+
+	resp := abcCurve(data)
+
+	require.Len(t, resp.Products, 4)
+	assert.Equal(t, []string{"A", "A", "B", "C"}, []string{resp.Products[0].Class, resp.Products[1].Class, resp.Products[2].Class, resp.Products[3].Class},
+		"o produto que cruza 80% ainda é A")
+	assert.True(t, d("85").Equal(resp.Products[1].CumulativePercent))
+	assert.True(t, d("100").Equal(resp.Products[3].CumulativePercent))
+	assert.Equal(t, 4, resp.Products[3].Rank)
+
+	require.Len(t, resp.Classes, 3)
+	assert.Equal(t, 2, resp.Classes[0].Products)
+	assert.True(t, d("850").Equal(resp.Classes[0].Revenue))
+	assert.True(t, d("85").Equal(resp.Classes[0].SharePercent))
+	assert.True(t, d("5").Equal(resp.Classes[2].SharePercent))
+}
@@ -0,0 +1,42 @@
+package reportusecases
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+// PriceListSales returns the revenue per applied price list and the discount given by each one.
+func (s *Service) PriceListSales(ctx context.Context, req *reportdto.PriceListSalesRequest) (*reportdto.PriceListSalesResponse, error) {
+	data, err := s.reportSvc.PriceListSales(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reportdto.PriceListSalesResponse{
+		Revenue:    decimal.Zero,
+		Discount:   decimal.Zero,
+		PriceLists: make([]reportdto.PriceListSales, len(data)),
+	}
+	for i, d := range data {
+		name := d.Name
+		if d.PriceListID == "" {
+			name = "Preço padrão"
+		}
+
+		discount := d.ListRevenue.Sub(d.Revenue)
+		resp.PriceLists[i] = reportdto.PriceListSales{
+			PriceListID: d.PriceListID,
+			Name:        name,
+			Items:       d.Items,
+			Quantity:    d.Quantity,
+			Revenue:     d.Revenue,
+			ListRevenue: d.ListRevenue,
+			Discount:    discount,
+		}
+		resp.Revenue = resp.Revenue.Add(d.Revenue)
+		resp.Discount = resp.Discount.Add(discount)
+	}
+	return resp, nil
+}
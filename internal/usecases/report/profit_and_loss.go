@@ -0,0 +1,169 @@
+package reportusecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+var ErrInvalidMonth = errors.New("month must be in the format YYYY-MM")
+
+// profitAndLossChannels fixa a ordem e o nome dos canais na DRE
+var profitAndLossChannels = []struct{ key, label string }{
+	{"delivery", "Receita bruta - Delivery"},
+	{"table", "Receita bruta - Mesa"},
+	{"pickup", "Receita bruta - Retirada"},
+	{"unknown", "Receita bruta - Sem canal"},
+}
+
+// ProfitAndLoss monta a DRE do mês comparada com o mês anterior e com o mesmo mês do ano anterior.
+func (s *Service) ProfitAndLoss(ctx context.Context, req *reportdto.ProfitAndLossRequest) (*reportdto.ProfitAndLossResponse, error) {
+	month, err := profitAndLossMonth(req)
+	if err != nil {
+		return nil, err
+	}
+
+	months := [3]time.Time{month, month.AddDate(0, -1, 0), month.AddDate(-1, 0, 0)}
+	var periods [3]*report.ProfitAndLossDTO
+	for i, m := range months {
+		if periods[i], err = s.reportSvc.ProfitAndLoss(ctx, m.Year(), m.Month()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &reportdto.ProfitAndLossResponse{
+		Month:         months[0].Format("2006-01"),
+		PreviousMonth: months[1].Format("2006-01"),
+		LastYear:      months[2].Format("2006-01"),
+		Lines:         profitAndLossLines(periods),
+	}, nil
+}
+
+// profitAndLossMonth devolve o primeiro dia do mês pedido; sem month usa o mês de start (assinaturas) ou o atual
+func profitAndLossMonth(req *reportdto.ProfitAndLossRequest) (time.Time, error) {
+	if req.Month != "" {
+		month, err := time.Parse("2006-01", req.Month)
+		if err != nil {
+			return time.Time{}, ErrInvalidMonth
+		}
+		return month, nil
+	}
+
+	ref := req.Start
+	if ref.IsZero() {
+		ref = time.Now()
+	}
+	return time.Date(ref.Year(), ref.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// profitAndLossLines calcula as linhas da DRE nos três meses; deduções e despesas entram negativas
+func profitAndLossLines(periods [3]*report.ProfitAndLossDTO) []reportdto.ProfitAndLossLine {
+	type values [3]decimal.Decimal
+
+	var (
+		gross, discounts, taxes, netRevenue, cogs, grossProfit values
+		grossMargin, payroll, driverFees, platformFees         values
+		operatingExpenses, netProfit, netMargin                values
+	)
+	channels := map[string]*values{}
+	categories := map[string]*values{}
+	categoryLabels := map[string]string{}
+	categoryKeys := []string{}
+
+	for i, p := range periods {
+		for _, channel := range p.Channels {
+			if channels[channel.Channel] == nil {
+				channels[channel.Channel] = &values{}
+			}
+			channels[channel.Channel][i] = channel.Gross
+			gross[i] = gross[i].Add(channel.Gross)
+			discounts[i] = discounts[i].Sub(channel.Discount)
+		}
+
+		for _, category := range p.ExpenseCategories {
+			key := category.CategoryID
+			if key == "" {
+				key = "uncategorized"
+			}
+			if categories[key] == nil {
+				categories[key] = &values{}
+				categoryKeys = append(categoryKeys, key)
+				categoryLabels[key] = category.Category
+			}
+			categories[key][i] = category.Amount.Neg()
+		}
+
+		taxes[i] = p.Taxes.Neg()
+		netRevenue[i] = gross[i].Add(discounts[i]).Add(taxes[i])
+		cogs[i] = p.Cogs.Neg()
+		grossProfit[i] = netRevenue[i].Add(cogs[i])
+		grossMargin[i] = reportdto.MarginPercent(grossProfit[i], netRevenue[i])
+		payroll[i] = p.Payroll.Neg()
+		driverFees[i] = p.DriverFees.Neg()
+		platformFees[i] = p.PlatformFees.Neg()
+		operatingExpenses[i] = p.OperatingExpenses.Neg()
+		netProfit[i] = grossProfit[i].Add(payroll[i]).Add(driverFees[i]).Add(platformFees[i]).Add(operatingExpenses[i])
+		netMargin[i] = reportdto.MarginPercent(netProfit[i], netRevenue[i])
+	}
+
+	lines := []reportdto.ProfitAndLossLine{}
+	add := func(key, label string, v values, isMargin bool) {
+		line := reportdto.ProfitAndLossLine{Key: key, Label: label, Current: v[0], PreviousMonth: v[1], LastYear: v[2]}
+		line.ChangeVsPreviousMonth = profitAndLossChange(v[0], v[1], isMargin)
+		line.ChangeVsLastYear = profitAndLossChange(v[0], v[2], isMargin)
+		lines = append(lines, line)
+	}
+
+	add("gross_revenue", "Receita bruta", gross, false)
+	for _, channel := range profitAndLossChannels {
+		if v, ok := channels[channel.key]; ok {
+			add("revenue_"+channel.key, channel.label, *v, false)
+		}
+	}
+	add("discounts", "(-) Descontos", discounts, false)
+	add("taxes", "(-) Impostos das notas fiscais", taxes, false)
+	add("net_revenue", "Receita líquida", netRevenue, false)
+	add("cogs", "(-) CMV", cogs, false)
+	add("gross_profit", "Lucro bruto", grossProfit, false)
+	add("gross_margin", "Margem bruta (%)", grossMargin, true)
+	add("payroll", "(-) Folha de pagamento", payroll, false)
+	add("driver_fees", "(-) Taxas de entregadores", driverFees, false)
+	add("platform_fees", "(-) Custos da plataforma", platformFees, false)
+	add("operating_expenses", "(-) Despesas operacionais", operatingExpenses, false)
+	sort.SliceStable(categoryKeys, func(a, b int) bool {
+		// Sem categoria fica por último, as demais em ordem alfabética
+		if (categoryKeys[a] == "uncategorized") != (categoryKeys[b] == "uncategorized") {
+			return categoryKeys[b] == "uncategorized"
+		}
+		return categoryLabels[categoryKeys[a]] < categoryLabels[categoryKeys[b]]
+	})
+	for _, key := range categoryKeys {
+		label := categoryLabels[key]
+		if key == "uncategorized" {
+			label = "Sem categoria"
+		}
+		add("operating_expenses_"+key, "(-) Despesas operacionais - "+label, *categories[key], false)
+	}
+	add("net_profit", "Resultado líquido", netProfit, false)
+	add("net_margin", "Margem líquida (%)", netMargin, true)
+	return lines
+}
+
+// profitAndLossChange é a variação percentual sobre base (em pontos percentuais nas margens); nil quando base é zero
+func profitAndLossChange(current, base decimal.Decimal, isMargin bool) *decimal.Decimal {
+	if base.IsZero() {
+		return nil
+	}
+
+	change := current.Sub(base)
+	if !isMargin {
+		change = change.Div(base.Abs()).Mul(decimal.NewFromInt(100))
+	}
+	change = change.Round(2)
+	return &change
+}
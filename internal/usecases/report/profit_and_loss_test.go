@@ -0,0 +1,73 @@
+package reportusecases
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+func TestProfitAndLossLines(t *testing.T) {
+	d := decimal.RequireFromString
+	current := &report.ProfitAndLossDTO{
+		Channels: []report.ProfitAndLossChannelDTO{
+			{Channel: "table", Orders: 10, Gross: d("700"), Discount: d("50")},
+			{Channel: "delivery", Orders: 5, Gross: d("300"), Discount: d("0")},
+		},
+		ProfitAndLossExpensesDTO: report.ProfitAndLossExpensesDTO{
+			Taxes: d("50"), Cogs: d("300"), Payroll: d("200"), DriverFees: d("30"), PlatformFees: d("20"), OperatingExpenses: d("100"),
+		},
+		ExpenseCategories: []report.ProfitAndLossExpenseCategoryDTO{
+			{Amount: d("10")},
+			{CategoryID: "b", Category: "Energia", Amount: d("30")},
+			{CategoryID: "a", Category: "Aluguel", Amount: d("60")},
+		},
+	}
+	previous := &report.ProfitAndLossDTO{
+		Channels: []report.ProfitAndLossChannelDTO{{Channel: "table", Orders: 8, Gross: d("500"), Discount: d("0")}},
+		ProfitAndLossExpensesDTO: report.ProfitAndLossExpensesDTO{
+			Cogs: d("200"), Payroll: d("400"),
+		},
+	}
+	lastYear := &report.ProfitAndLossDTO{}
+
+	lines := map[string]reportdto.ProfitAndLossLine{}
+	keys := []string{}
+	for _, line := range profitAndLossLines([3]*report.ProfitAndLossDTO{current, previous, lastYear}) {
+		lines[line.Key] = line
+		keys = append(keys, line.Key)
+	}
+
+	assert.Equal(t, []string{"gross_revenue", "revenue_delivery", "revenue_table", "discounts", "taxes", "net_revenue"}, keys[:6], "canais na ordem fixa, só os que venderam")
+	assert.True(t, d("1000").Equal(lines["gross_revenue"].Current))
+	assert.True(t, d("-50").Equal(lines["discounts"].Current))
+	assert.True(t, d("900").Equal(lines["net_revenue"].Current))
+	assert.True(t, d("600").Equal(lines["gross_profit"].Current))
+	assert.True(t, d("66.67").Equal(lines["gross_margin"].Current))
+	assert.True(t, d("250").Equal(lines["net_profit"].Current))
+	assert.True(t, d("27.78").Equal(lines["net_margin"].Current))
+
+	require.NotNil(t, lines["gross_revenue"].ChangeVsPreviousMonth)
+	assert.True(t, d("100").Equal(*lines["gross_revenue"].ChangeVsPreviousMonth))
+	require.NotNil(t, lines["gross_margin"].ChangeVsPreviousMonth)
+	assert.True(t, d("6.67").Equal(*lines["gross_margin"].ChangeVsPreviousMonth), "margem varia em pontos percentuais")
+	require.NotNil(t, lines["net_profit"].ChangeVsPreviousMonth)
+	assert.True(t, d("350").Equal(*lines["net_profit"].ChangeVsPreviousMonth), "prejuízo anterior usa o valor absoluto como base")
+	assert.Nil(t, lines["gross_revenue"].ChangeVsLastYear, "sem base não há variação")
+
+	assert.Equal(t, []string{"operating_expenses", "operating_expenses_a", "operating_expenses_b", "operating_expenses_uncategorized", "net_profit"}, keys[len(keys)-6:len(keys)-1], "categorias em ordem alfabética, sem categoria por último")
+	assert.True(t, d("-60").Equal(lines["operating_expenses_a"].Current))
+	assert.Equal(t, "(-) Despesas operacionais - Sem categoria", lines["operating_expenses_uncategorized"].Label)
+}
+
+func TestProfitAndLossMonth(t *testing.T) {
+	month, err := profitAndLossMonth(&reportdto.ProfitAndLossRequest{Month: "2026-09"})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-09-01", month.Format("2006-01-02"))
+
+	_, err = profitAndLossMonth(&reportdto.ProfitAndLossRequest{Month: "09/2026"})
+	assert.ErrorIs(t, err, ErrInvalidMonth)
+}
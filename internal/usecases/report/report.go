@@ -4,13 +4,16 @@ import (
 	"context"
 	"time"
 
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
 	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
 )
 
 // Service wraps internal report generation logic.
 type Service struct {
 	reportSvc *report.ReportService
+	rcompany  model.CompanyRepository
 }
 
 // TopTables returns the top 10 most used tables in the given period.
@@ -31,6 +34,11 @@ func NewService(reportSvc *report.ReportService) *Service {
 	return &Service{reportSvc: reportSvc}
 }
 
+// AddDependencies sets the company repository used to brand exported reports.
+func (s *Service) AddDependencies(rcompany model.CompanyRepository) {
+	s.rcompany = rcompany
+}
+
 // SalesTotalByDay returns total sales per day in the period.
 func (s *Service) SalesTotalByDay(ctx context.Context, req *reportdto.SalesTotalByDayRequest) ([]reportdto.SalesByDayResponse, error) {
 	data, err := s.reportSvc.SalesTotalByDay(ctx, req.Start, req.End)
@@ -449,3 +457,26 @@ func (s *Service) TotalQueueTimeByGroupItem(ctx context.Context, req *reportdto.
 	}
 	return resp, nil
 }
+
+// StockLosses returns losses valued at batch cost grouped by reason, product, employee or day.
+func (s *Service) StockLosses(ctx context.Context, req *reportdto.StockLossesRequest) ([]reportdto.StockLossesResponse, error) {
+	data, err := s.reportSvc.StockLosses(ctx, req.Start, req.End, req.GroupBy, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]reportdto.StockLossesResponse, len(data))
+	for i, d := range data {
+		label := d.Label
+		if req.GroupBy == "" || req.GroupBy == "reason" {
+			label = stocklossentity.LossReason(d.Key).Label()
+		}
+		resp[i] = reportdto.StockLossesResponse{
+			Key:       d.Key,
+			Label:     label,
+			Count:     d.Count,
+			Quantity:  d.Quantity,
+			TotalCost: d.TotalCost,
+		}
+	}
+	return resp, nil
+}
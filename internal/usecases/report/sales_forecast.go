@@ -0,0 +1,350 @@
+package reportusecases
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+var ErrSalesForecastDays = errors.New("sales forecast must cover 1 to 31 days")
+
+const (
+	// forecastHistoryWeeks semanas completas antes do início formam a base sazonal e a tendência
+	forecastHistoryWeeks = 8
+	forecastDefaultDays  = 7
+	forecastMaxDays      = 31
+	// forecastMaxWeeklyTrend limita a tendência semanal para um pico isolado não distorcer a previsão
+	forecastMaxWeeklyTrend = 0.25
+)
+
+var forecastOrdersPerEmployeeHour = decimal.NewFromInt(12)
+
+// SalesForecast prevê pedidos e unidades por produto para os próximos dias, com os realizados dos dias já fechados.
+func (s *Service) SalesForecast(ctx context.Context, req *reportdto.SalesForecastRequest) (*reportdto.SalesForecastResponse, error) {
+	now := time.Now()
+	days := req.Days
+	if days == 0 {
+		days = forecastDefaultDays
+	}
+	if days < 0 || days > forecastMaxDays {
+		return nil, ErrSalesForecastDays
+	}
+
+	ordersPerEmployeeHour := req.OrdersPerEmployeeHour
+	if !ordersPerEmployeeHour.IsPositive() {
+		ordersPerEmployeeHour = forecastOrdersPerEmployeeHour
+	}
+
+	// um dia de folga em cada ponta cobre a diferença entre a data do servidor e a data local da empresa
+	from := req.Start
+	if from.IsZero() {
+		from = now
+	}
+
+	data, err := s.reportSvc.SalesForecastHistory(ctx, from.AddDate(0, 0, -7*forecastHistoryWeeks-1), from.AddDate(0, 0, days+1))
+	if err != nil {
+		return nil, err
+	}
+
+	return salesForecast(data, req.Start, days, now, ordersPerEmployeeHour), nil
+}
+
+// forecastBaseline acumula o histórico de um dia da semana
+type forecastBaseline struct {
+	orders  int
+	revenue decimal.Decimal
+	hours   map[int]int
+	units   map[string]decimal.Decimal
+}
+
+type forecastProduct struct {
+	name       string
+	categoryID string
+}
+
+// salesForecast multiplica a média de cada dia da semana nas últimas 8 semanas pela tendência linear dos
+// totais semanais. As horas seguem a distribuição histórica do mesmo dia da semana. Os dias vêm do campo
+// Day das consultas, já no fuso da empresa. Sem start, a previsão começa hoje.
+func salesForecast(data *report.SalesForecastHistoryDTO, start time.Time, days int, now time.Time, ordersPerEmployeeHour decimal.Decimal) *reportdto.SalesForecastResponse {
+	loc := companyentity.LoadLocation(data.TimeZone)
+	now = now.In(loc)
+	if start.IsZero() {
+		start = now
+	}
+	first := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	historyFirst := first.AddDate(0, 0, -7*forecastHistoryWeeks)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	ordersByDay := map[string]int{}
+	revenueByDay := map[string]decimal.Decimal{}
+	ordersByHour := map[string]map[int]int{}
+	for _, h := range data.Hours {
+		date := h.Day.Format(time.DateOnly)
+		ordersByDay[date] += h.Orders
+		revenueByDay[date] = revenueByDay[date].Add(h.Revenue)
+		if ordersByHour[date] == nil {
+			ordersByHour[date] = map[int]int{}
+		}
+		ordersByHour[date][h.Hour] += h.Orders
+	}
+
+	products := map[string]forecastProduct{}
+	unitsByDay := map[string]map[string]decimal.Decimal{}
+	for _, p := range data.Products {
+		date := p.Day.Format(time.DateOnly)
+		products[p.ProductID] = forecastProduct{name: p.Name, categoryID: p.CategoryID}
+		if unitsByDay[date] == nil {
+			unitsByDay[date] = map[string]decimal.Decimal{}
+		}
+		unitsByDay[date][p.ProductID] = unitsByDay[date][p.ProductID].Add(p.Quantity)
+	}
+
+	var baselines [7]forecastBaseline
+	for i := range baselines {
+		baselines[i] = forecastBaseline{revenue: decimal.Zero, hours: map[int]int{}, units: map[string]decimal.Decimal{}}
+	}
+
+	weekly := make([]float64, forecastHistoryWeeks)
+	for i := 0; i < 7*forecastHistoryWeeks; i++ {
+		day := historyFirst.AddDate(0, 0, i)
+		date := day.Format(time.DateOnly)
+		baseline := &baselines[day.Weekday()]
+
+		baseline.orders += ordersByDay[date]
+		baseline.revenue = baseline.revenue.Add(revenueByDay[date])
+		for hour, orders := range ordersByHour[date] {
+			baseline.hours[hour] += orders
+		}
+		for productID, units := range unitsByDay[date] {
+			baseline.units[productID] = baseline.units[productID].Add(units)
+		}
+		weekly[i/7] += float64(ordersByDay[date])
+	}
+
+	trend := weeklyTrend(weekly)
+	weeks := decimal.NewFromInt(forecastHistoryWeeks)
+
+	rulesByCategory := map[string][]report.ForecastProcessRuleDTO{}
+	for _, rule := range data.ProcessRules {
+		rulesByCategory[rule.CategoryID] = append(rulesByCategory[rule.CategoryID], rule)
+	}
+
+	resp := &reportdto.SalesForecastResponse{
+		Start:              first.Format(time.DateOnly),
+		End:                first.AddDate(0, 0, days-1).Format(time.DateOnly),
+		HistoryWeeks:       forecastHistoryWeeks,
+		WeeklyTrendPercent: decimal.NewFromFloat(trend * 100).Round(2),
+		Orders:             decimal.Zero,
+		Revenue:            decimal.Zero,
+		Accuracy:           reportdto.SalesForecastAccuracy{ForecastOrders: decimal.Zero, OrdersErrorPercent: decimal.Zero, UnitsErrorPercent: decimal.Zero},
+		Days:               []reportdto.SalesForecastDay{},
+		Products:           []reportdto.SalesForecastProduct{},
+		PrepList:           []reportdto.SalesForecastPrep{},
+	}
+
+	productDays := map[string][]reportdto.SalesForecastProductDay{}
+	ordersError := decimal.Zero
+	ordersErrorDays := 0
+	unitsError, unitsActual := decimal.Zero, decimal.Zero
+
+	for i := 0; i < days; i++ {
+		day := first.AddDate(0, 0, i)
+		date := day.Format(time.DateOnly)
+		baseline := baselines[day.Weekday()]
+		closed := day.Before(today)
+
+		// fator da tendência no meio do dia, medido em semanas a partir do centro do histórico
+		weeksFromCenter := (float64(7*forecastHistoryWeeks+i)+0.5)/7 - float64(forecastHistoryWeeks)/2
+		factor := decimal.NewFromFloat(math.Max(0, 1+trend*weeksFromCenter))
+
+		forecastDay := reportdto.SalesForecastDay{
+			Date:    date,
+			Orders:  decimal.NewFromInt(int64(baseline.orders)).Div(weeks).Mul(factor).Round(1),
+			Revenue: baseline.revenue.Div(weeks).Mul(factor).Round(2),
+			Hours:   []reportdto.SalesForecastHour{},
+		}
+
+		unitsByCategory := map[string]decimal.Decimal{}
+		dayUnits := map[string]decimal.Decimal{}
+		for productID, units := range baseline.units {
+			forecastUnits := units.Div(weeks).Mul(factor).Round(1)
+			if forecastUnits.IsZero() {
+				continue
+			}
+			dayUnits[productID] = forecastUnits
+			category := products[productID].categoryID
+			unitsByCategory[category] = unitsByCategory[category].Add(forecastUnits)
+		}
+
+		if closed {
+			actualOrders := ordersByDay[date]
+			actualRevenue := revenueByDay[date]
+			forecastDay.ActualOrders = &actualOrders
+			forecastDay.ActualRevenue = &actualRevenue
+
+			resp.Accuracy.ClosedDays++
+			resp.Accuracy.ForecastOrders = resp.Accuracy.ForecastOrders.Add(forecastDay.Orders)
+			resp.Accuracy.ActualOrders += actualOrders
+			if actualOrders > 0 {
+				actual := decimal.NewFromInt(int64(actualOrders))
+				ordersError = ordersError.Add(forecastDay.Orders.Sub(actual).Abs().Div(actual))
+				ordersErrorDays++
+			}
+		}
+
+		for hour := 0; hour < 24; hour++ {
+			if baseline.hours[hour] == 0 {
+				continue
+			}
+
+			share := decimal.NewFromInt(int64(baseline.hours[hour])).Div(decimal.NewFromInt(int64(baseline.orders)))
+			orders := forecastDay.Orders.Mul(share).Round(1)
+
+			// segundos de produção previstos na hora: unidades da categoria × tempo ideal de cada etapa
+			workload := decimal.Zero
+			for category, units := range unitsByCategory {
+				for _, rule := range rulesByCategory[category] {
+					workload = workload.Add(units.Mul(share).Mul(decimal.NewFromInt(rule.IdealTime)).Div(decimal.NewFromInt(int64(time.Second))))
+				}
+			}
+
+			forecastHour := reportdto.SalesForecastHour{
+				Hour:            hour,
+				Orders:          orders,
+				ProductionStaff: int(workload.Div(decimal.NewFromInt(3600)).Ceil().IntPart()),
+				ServiceStaff:    int(orders.Div(ordersPerEmployeeHour).Ceil().IntPart()),
+			}
+			forecastHour.SuggestedStaff = forecastHour.ProductionStaff + forecastHour.ServiceStaff
+			if closed {
+				actualOrders := ordersByHour[date][hour]
+				forecastHour.ActualOrders = &actualOrders
+			}
+			forecastDay.Hours = append(forecastDay.Hours, forecastHour)
+		}
+
+		resp.Days = append(resp.Days, forecastDay)
+		resp.Orders = resp.Orders.Add(forecastDay.Orders)
+		resp.Revenue = resp.Revenue.Add(forecastDay.Revenue)
+
+		productIDs := map[string]bool{}
+		for productID := range dayUnits {
+			productIDs[productID] = true
+		}
+		if closed {
+			for productID := range unitsByDay[date] {
+				productIDs[productID] = true
+			}
+		}
+
+		for productID := range productIDs {
+			productDay := reportdto.SalesForecastProductDay{Date: date, Units: dayUnits[productID]}
+			if closed {
+				actual := unitsByDay[date][productID]
+				productDay.ActualUnits = &actual
+				unitsError = unitsError.Add(productDay.Units.Sub(actual).Abs())
+				unitsActual = unitsActual.Add(actual)
+			}
+			productDays[productID] = append(productDays[productID], productDay)
+		}
+
+		resp.PrepList = append(resp.PrepList, forecastPrepList(date, data.ProcessRules, unitsByCategory, dayUnits, products)...)
+	}
+
+	if ordersErrorDays > 0 {
+		resp.Accuracy.OrdersErrorPercent = ordersError.Div(decimal.NewFromInt(int64(ordersErrorDays))).Mul(hundred).Round(2)
+	}
+	if unitsActual.IsPositive() {
+		resp.Accuracy.UnitsErrorPercent = unitsError.Div(unitsActual).Mul(hundred).Round(2)
+	}
+
+	for productID, productDay := range productDays {
+		product := reportdto.SalesForecastProduct{ProductID: productID, Name: products[productID].name, Units: decimal.Zero, Days: productDay}
+		for _, d := range productDay {
+			product.Units = product.Units.Add(d.Units)
+			if d.ActualUnits != nil {
+				actual := *d.ActualUnits
+				if product.ActualUnits != nil {
+					actual = actual.Add(*product.ActualUnits)
+				}
+				product.ActualUnits = &actual
+			}
+		}
+		resp.Products = append(resp.Products, product)
+	}
+
+	sort.Slice(resp.Products, func(i, j int) bool {
+		if !resp.Products[i].Units.Equal(resp.Products[j].Units) {
+			return resp.Products[i].Units.GreaterThan(resp.Products[j].Units)
+		}
+		return resp.Products[i].Name < resp.Products[j].Name
+	})
+
+	return resp
+}
+
+// forecastPrepList monta a lista de preparo do dia: cada etapa ativa recebe as unidades previstas da sua categoria
+func forecastPrepList(date string, rules []report.ForecastProcessRuleDTO, unitsByCategory, dayUnits map[string]decimal.Decimal, products map[string]forecastProduct) []reportdto.SalesForecastPrep {
+	prepList := []reportdto.SalesForecastPrep{}
+	for _, rule := range rules {
+		units := unitsByCategory[rule.CategoryID]
+		if units.IsZero() {
+			continue
+		}
+
+		prep := reportdto.SalesForecastPrep{
+			Date:            date,
+			ProcessRuleID:   rule.ProcessRuleID,
+			Name:            rule.Name,
+			Order:           rule.Order,
+			Units:           units,
+			WorkloadMinutes: units.Mul(decimal.NewFromInt(rule.IdealTime)).Div(decimal.NewFromInt(int64(time.Minute))).Round(1),
+			Products:        []reportdto.SalesForecastPrepItem{},
+		}
+		for productID, productUnits := range dayUnits {
+			if products[productID].categoryID == rule.CategoryID {
+				prep.Products = append(prep.Products, reportdto.SalesForecastPrepItem{ProductID: productID, Name: products[productID].name, Units: productUnits})
+			}
+		}
+		sort.Slice(prep.Products, func(i, j int) bool {
+			if !prep.Products[i].Units.Equal(prep.Products[j].Units) {
+				return prep.Products[i].Units.GreaterThan(prep.Products[j].Units)
+			}
+			return prep.Products[i].Name < prep.Products[j].Name
+		})
+		prepList = append(prepList, prep)
+	}
+	return prepList
+}
+
+// weeklyTrend ajusta uma reta aos totais semanais e devolve a inclinação relativa à média (0,05 = +5% por semana)
+func weeklyTrend(weekly []float64) float64 {
+	n := float64(len(weekly))
+	if n < 2 {
+		return 0
+	}
+
+	meanX, meanY := n/2, 0.0
+	for _, y := range weekly {
+		meanY += y
+	}
+	meanY /= n
+	if meanY == 0 {
+		return 0
+	}
+
+	var covariance, variance float64
+	for k, y := range weekly {
+		x := float64(k) + 0.5
+		covariance += (x - meanX) * (y - meanY)
+		variance += (x - meanX) * (x - meanX)
+	}
+
+	return math.Max(-forecastMaxWeeklyTrend, math.Min(forecastMaxWeeklyTrend, covariance/variance/meanY))
+}
@@ -0,0 +1,85 @@
+package reportusecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/postgres/report"
+)
+
+func TestSalesForecast(t *testing.T) {
+	d := decimal.RequireFromString
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	// Previsão a partir de segunda 19/10; agora é terça 20/10, então só segunda está fechada
+	start := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 20, 15, 0, 0, 0, loc)
+	data := &report.SalesForecastHistoryDTO{
+		TimeZone: "America/Sao_Paulo",
+		ProcessRules: []report.ForecastProcessRuleDTO{
+			{ProcessRuleID: "grill", Name: "Chapa", Order: 1, CategoryID: "burgers", IdealTime: int64(6 * time.Minute)},
+		},
+	}
+
+	// 8 segundas com 10 pedidos (8 às 12h, 2 às 19h) e 20 hambúrgueres cada
+	for week := 1; week <= forecastHistoryWeeks; week++ {
+		monday := start.AddDate(0, 0, -7*week)
+		data.Hours = append(data.Hours,
+			report.ForecastHourDTO{Day: monday, Hour: 12, Orders: 8, Revenue: d("400")},
+			report.ForecastHourDTO{Day: monday, Hour: 19, Orders: 2, Revenue: d("100")},
+		)
+		data.Products = append(data.Products, report.ForecastProductDayDTO{Day: monday, ProductID: "x-burger", Name: "X-Burger", CategoryID: "burgers", Quantity: d("20")})
+	}
+
+	// realizado da segunda prevista
+	data.Hours = append(data.Hours, report.ForecastHourDTO{Day: start, Hour: 12, Orders: 12, Revenue: d("600")})
+	data.Products = append(data.Products, report.ForecastProductDayDTO{Day: start, ProductID: "x-burger", Name: "X-Burger", CategoryID: "burgers", Quantity: d("25")})
+
+	resp := salesForecast(data, start, 2, now, d("4"))
+
+	assert.True(t, resp.WeeklyTrendPercent.IsZero(), "histórico estável não tem tendência")
+	require.Len(t, resp.Days, 2)
+
+	monday := resp.Days[0]
+	assert.Equal(t, "2026-10-19", monday.Date)
+	assert.True(t, d("10").Equal(monday.Orders))
+	assert.True(t, d("500").Equal(monday.Revenue))
+	require.NotNil(t, monday.ActualOrders)
+	assert.Equal(t, 12, *monday.ActualOrders)
+
+	require.Len(t, monday.Hours, 2)
+	lunch := monday.Hours[0]
+	assert.Equal(t, 12, lunch.Hour)
+	assert.True(t, d("8").Equal(lunch.Orders))
+	// 16 hambúrgueres × 6 min = 96 min de chapa; 8 pedidos / 4 por atendente
+	assert.Equal(t, 2, lunch.ProductionStaff)
+	assert.Equal(t, 2, lunch.ServiceStaff)
+	assert.Equal(t, 4, lunch.SuggestedStaff)
+
+	tuesday := resp.Days[1]
+	assert.True(t, tuesday.Orders.IsZero(), "terça sem histórico")
+	assert.Nil(t, tuesday.ActualOrders, "dia ainda aberto não tem realizado")
+
+	require.Len(t, resp.PrepList, 1)
+	assert.Equal(t, "grill", resp.PrepList[0].ProcessRuleID)
+	assert.True(t, d("20").Equal(resp.PrepList[0].Units))
+	assert.True(t, d("120").Equal(resp.PrepList[0].WorkloadMinutes))
+
+	require.Len(t, resp.Products, 1)
+	require.NotNil(t, resp.Products[0].ActualUnits)
+	assert.True(t, d("25").Equal(*resp.Products[0].ActualUnits))
+
+	assert.Equal(t, 1, resp.Accuracy.ClosedDays)
+	assert.True(t, d("16.67").Equal(resp.Accuracy.OrdersErrorPercent))
+	assert.True(t, d("20").Equal(resp.Accuracy.UnitsErrorPercent))
+}
+
+func TestWeeklyTrend(t *testing.T) {
+	assert.InDelta(t, 0.0, weeklyTrend([]float64{10, 10, 10, 10}), 1e-9)
+	assert.InDelta(t, 0.25, weeklyTrend([]float64{10, 20, 30, 40}), 1e-9, "+40% por semana fica limitado a 25%")
+	assert.InDelta(t, 1.0/104.5, weeklyTrend([]float64{100, 101, 102, 103, 104, 105, 106, 107, 108, 109}), 1e-9)
+}
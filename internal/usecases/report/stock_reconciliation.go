@@ -0,0 +1,44 @@
+package reportusecases
+
+import (
+	"context"
+
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+// StockReconciliation compares stored stock balances with the ones rebuilt from the movement ledger.
+func (s *Service) StockReconciliation(ctx context.Context, req *reportdto.StockReconciliationRequest) (*reportdto.StockReconciliationResponse, error) {
+	data, err := s.reportSvc.StockReconciliation(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reportdto.StockReconciliationResponse{Items: []reportdto.StockReconciliationItem{}}
+	for _, d := range data {
+		description := d.ProductName
+		if d.SizeName != "" {
+			description += " - " + d.SizeName
+		}
+
+		item := reportdto.StockReconciliationItem{
+			StockID:          d.StockID,
+			Description:      description,
+			Unit:             d.Unit,
+			CurrentStock:     d.CurrentStock,
+			ExpectedCurrent:  d.ExpectedCurrent,
+			CurrentDrift:     d.CurrentStock.Sub(d.ExpectedCurrent),
+			ReservedStock:    d.ReservedStock,
+			ExpectedReserved: d.ExpectedReserved,
+			ReservedDrift:    d.ReservedStock.Sub(d.ExpectedReserved),
+		}
+		item.HasDrift = !item.CurrentDrift.IsZero() || !item.ReservedDrift.IsZero()
+
+		if item.HasDrift {
+			resp.DriftCount++
+		} else if req.OnlyDrift {
+			continue
+		}
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
@@ -0,0 +1,94 @@
+package reportusecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	reportdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report"
+)
+
+// StockValuation returns the inventory value at batch cost at the requested date.
+func (s *Service) StockValuation(ctx context.Context, req *reportdto.StockValuationRequest) (*reportdto.StockValuationResponse, error) {
+	at := req.At
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+
+	data, err := s.reportSvc.StockValuation(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reportdto.StockValuationResponse{At: at, TotalValue: decimal.Zero, Items: make([]reportdto.StockValuationItem, len(data))}
+	for i, d := range data {
+		description := d.ProductName
+		if d.SizeName != "" {
+			description += " - " + d.SizeName
+		}
+
+		avgCost := decimal.Zero
+		if d.Quantity.IsPositive() {
+			avgCost = d.TotalValue.Div(d.Quantity).Round(2)
+		}
+
+		resp.Items[i] = reportdto.StockValuationItem{
+			StockID:     d.StockID,
+			Description: description,
+			Unit:        d.Unit,
+			Quantity:    d.Quantity,
+			AvgCost:     avgCost,
+			TotalValue:  d.TotalValue,
+		}
+		resp.TotalValue = resp.TotalValue.Add(d.TotalValue)
+	}
+	return resp, nil
+}
+
+// CostOfGoodsSold returns the batch cost consumed by orders per day.
+func (s *Service) CostOfGoodsSold(ctx context.Context, req *reportdto.CostOfGoodsSoldRequest) (*reportdto.CostOfGoodsSoldResponse, error) {
+	data, err := s.reportSvc.CostOfGoodsSold(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reportdto.CostOfGoodsSoldResponse{Total: decimal.Zero, Days: make([]reportdto.CostOfGoodsSoldByDay, len(data))}
+	for i, d := range data {
+		resp.Days[i] = reportdto.CostOfGoodsSoldByDay{Day: d.Day, Quantity: d.Quantity, Cost: d.Cost}
+		resp.Total = resp.Total.Add(d.Cost)
+	}
+	return resp, nil
+}
+
+// GrossMargin returns revenue, consumed batch cost and margin per product.
+func (s *Service) GrossMargin(ctx context.Context, req *reportdto.GrossMarginRequest) (*reportdto.GrossMarginResponse, error) {
+	data, err := s.reportSvc.GrossMarginByProduct(ctx, req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &reportdto.GrossMarginResponse{
+		Revenue:  decimal.Zero,
+		Cost:     decimal.Zero,
+		Products: make([]reportdto.GrossMarginByProduct, len(data)),
+	}
+	for i, d := range data {
+		profit := d.Revenue.Sub(d.Cost)
+		resp.Products[i] = reportdto.GrossMarginByProduct{
+			ProductID:         d.ProductID,
+			Name:              d.Name,
+			Quantity:          d.Quantity,
+			Revenue:           d.Revenue,
+			Cost:              d.Cost,
+			GrossProfit:       profit,
+			MarginPercent:     reportdto.MarginPercent(profit, d.Revenue),
+			UntrackedQuantity: d.UntrackedQuantity,
+		}
+		resp.Revenue = resp.Revenue.Add(d.Revenue)
+		resp.Cost = resp.Cost.Add(d.Cost)
+	}
+
+	resp.GrossProfit = resp.Revenue.Sub(resp.Cost)
+	resp.MarginPercent = reportdto.MarginPercent(resp.GrossProfit, resp.Revenue)
+	return resp, nil
+}
@@ -0,0 +1,158 @@
+package reportsubscriptionusecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	reportsubscriptionentity "github.com/willjrcom/sales-backend-go/internal/domain/report_subscription"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	reportsubscriptiondto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report_subscription"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	emailservice "github.com/willjrcom/sales-backend-go/internal/infra/service/email"
+	reportusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report"
+)
+
+// SendDueReportSubscriptions envia as assinaturas vencidas do schema do contexto e devolve quantas foram enviadas.
+// Cada uma cobre o período do horário agendado; depois de um atraso (servidor parado) só o último envio sai,
+// e o próximo é agendado a partir de now. Falhas ficam no log de envios e não são repetidas até a próxima cadência.
+func (s *Service) SendDueReportSubscriptions(ctx context.Context, now time.Time) (int, error) {
+	subscriptionModels, err := s.r.GetDueReportSubscriptions(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	now = s.companyNow(ctx, now)
+	sent := 0
+	for i := range subscriptionModels {
+		subscription := subscriptionModels[i].ToDomain()
+
+		delivery := s.deliver(ctx, subscription, subscription.NextRunAt.In(now.Location()), false)
+		if delivery.Status == reportsubscriptionentity.DeliveryStatusSent {
+			sent++
+		}
+
+		subscription.MarkRun(now)
+		subscriptionModels[i].FromDomain(subscription)
+		if err := s.r.UpdateReportSubscription(ctx, &subscriptionModels[i]); err != nil {
+			fmt.Printf("error rescheduling report subscription %s: %v\n", subscription.ID, err)
+		}
+	}
+
+	return sent, nil
+}
+
+// SendReportSubscriptionNow envia agora o último período fechado da assinatura, sem mudar o agendamento
+func (s *Service) SendReportSubscriptionNow(ctx context.Context, dtoID *entitydto.IDRequest) (*reportsubscriptiondto.DeliveryDTO, error) {
+	subscriptionModel, err := s.getOwnedSubscription(ctx, dtoID.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := s.deliver(ctx, subscriptionModel.ToDomain(), s.companyNow(ctx, time.Now()), true)
+
+	deliveryDTO := &reportsubscriptiondto.DeliveryDTO{}
+	deliveryDTO.FromDomain(delivery)
+	return deliveryDTO, nil
+}
+
+// deliver gera o relatório do período de runAt, publica um email por destinatário e grava o envio no log
+func (s *Service) deliver(ctx context.Context, subscription *reportsubscriptionentity.ReportSubscription, runAt time.Time, manual bool) *reportsubscriptionentity.Delivery {
+	start, end := subscription.Cadence.Period(runAt)
+	delivery := reportsubscriptionentity.NewDelivery(subscription, start, end, manual)
+
+	if filename, err := s.send(ctx, subscription, start, end); err != nil {
+		delivery.Failed(err)
+	} else {
+		delivery.Sent(filename)
+	}
+
+	deliveryModel := &model.ReportSubscriptionDelivery{}
+	deliveryModel.FromDomain(delivery)
+	if err := s.r.CreateDelivery(ctx, deliveryModel); err != nil {
+		fmt.Printf("error saving delivery of report subscription %s: %v\n", subscription.ID, err)
+	}
+
+	return delivery
+}
+
+func (s *Service) send(ctx context.Context, subscription *reportsubscriptionentity.ReportSubscription, start, end time.Time) (string, error) {
+	if s.reportService == nil || s.emailService == nil {
+		return "", errors.New("report subscription dependencies not configured")
+	}
+
+	// o consolidado de lojas depende das empresas do dono da inscrição
+	ctx = context.WithValue(ctx, companyentity.UserValue("user_id"), subscription.UserID.String())
+
+	report, err := s.reportService.RunReport(ctx, subscription.ReportType, subscription.Params, start, end)
+	if err != nil {
+		return "", fmt.Errorf("failed to run report: %w", err)
+	}
+
+	name := reportusecases.ReportFilename(subscription.ReportType)
+	title := reportusecases.ReportTitle(name)
+	format := reportusecases.ExportFormat(subscription.Format)
+
+	export, err := s.reportService.Export(ctx, format, title, report)
+	if err != nil {
+		return "", fmt.Errorf("failed to export report: %w", err)
+	}
+
+	content := &bytes.Buffer{}
+	if err := export.Write(content); err != nil {
+		return "", fmt.Errorf("failed to export report: %w", err)
+	}
+
+	filename := format.Filename(name + "-" + start.Format("2006-01-02"))
+	period := periodLabel(start, end)
+
+	tradeName := ""
+	if s.rcompany != nil {
+		if companyModel, err := s.rcompany.GetCompany(ctx); err == nil {
+			tradeName = companyModel.TradeName
+		}
+	}
+
+	subject := fmt.Sprintf("%s - %s", title, period)
+	if tradeName != "" {
+		subject += " - " + tradeName
+	}
+
+	for _, recipient := range subscription.Recipients {
+		bodyEmail := &emailservice.BodyEmail{
+			Email:   recipient,
+			Subject: subject,
+			Body: fmt.Sprintf(`<div style="font-family: Arial, sans-serif; max-width: 480px; margin: 0 auto; padding: 32px;">
+			<h2 style="color: #eab308; margin-bottom: 16px;">%s</h2>
+			<p style="color: #333; font-size: 16px;">
+				Empresa: %s<br>
+				Período: %s
+			</p>
+			<p style="color: #333; font-size: 16px;">O relatório segue em anexo (%s).</p>
+			</div>`, title, tradeName, period, filename),
+			Attachments: []emailservice.Attachment{{
+				Filename:    filename,
+				ContentType: format.ContentType(),
+				Content:     content.Bytes(),
+			}},
+		}
+
+		if err := s.emailService.SendEmail(bodyEmail); err != nil {
+			return "", fmt.Errorf("failed to send email to %s: %w", recipient, err)
+		}
+	}
+
+	return filename, nil
+}
+
+// periodLabel escreve o período [start, end) em dd/mm/aaaa, com o último dia inclusivo
+func periodLabel(start, end time.Time) string {
+	last := end.AddDate(0, 0, -1)
+	if !last.After(start) {
+		return start.Format("02/01/2006")
+	}
+	return start.Format("02/01/2006") + " a " + last.Format("02/01/2006")
+}
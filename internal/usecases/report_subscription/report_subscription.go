@@ -0,0 +1,190 @@
+package reportsubscriptionusecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	reportsubscriptiondto "github.com/willjrcom/sales-backend-go/internal/infra/dto/report_subscription"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	emailservice "github.com/willjrcom/sales-backend-go/internal/infra/service/email"
+	reportusecases "github.com/willjrcom/sales-backend-go/internal/usecases/report"
+)
+
+var (
+	ErrReportSubscriptionNotFound = errors.New("report subscription not found")
+	ErrContextUserNotFound        = errors.New("context user not found")
+)
+
+// deliveriesLimit é quantos envios o histórico da assinatura devolve
+const deliveriesLimit = 50
+
+type Service struct {
+	db            *bun.DB
+	r             model.ReportSubscriptionRepository
+	reportService *reportusecases.Service
+	emailService  *emailservice.Service
+	rcompany      model.CompanyRepository
+}
+
+func NewService(db *bun.DB, r model.ReportSubscriptionRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) AddDependencies(reportService *reportusecases.Service, emailService *emailservice.Service, rcompany model.CompanyRepository) {
+	s.reportService = reportService
+	s.emailService = emailService
+	s.rcompany = rcompany
+}
+
+// companyNow converte t para o fuso da empresa: a hora, o dia da semana e o dia do mês da cadência são locais
+func (s *Service) companyNow(ctx context.Context, t time.Time) time.Time {
+	if s.rcompany == nil {
+		return t.In(companyentity.LoadLocation(""))
+	}
+
+	companyModel, err := s.rcompany.GetCompany(ctx, true)
+	if err != nil {
+		return t.In(companyentity.LoadLocation(""))
+	}
+
+	return t.In(companyModel.ToDomain().Location())
+}
+
+func (s *Service) CreateReportSubscription(ctx context.Context, dto *reportsubscriptiondto.ReportSubscriptionCreateDTO) (uuid.UUID, error) {
+	userID, err := contextUserID(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	subscription, err := dto.ToDomain(userID, s.companyNow(ctx, time.Now()))
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if !reportusecases.IsReportType(subscription.ReportType) {
+		return uuid.Nil, reportusecases.ErrReportTypeUnknown
+	}
+
+	subscriptionModel := &model.ReportSubscription{}
+	subscriptionModel.FromDomain(subscription)
+	if err := s.r.CreateReportSubscription(ctx, subscriptionModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return subscription.ID, nil
+}
+
+func (s *Service) UpdateReportSubscription(ctx context.Context, dtoID *entitydto.IDRequest, dto *reportsubscriptiondto.ReportSubscriptionUpdateDTO) error {
+	subscriptionModel, err := s.getOwnedSubscription(ctx, dtoID.ID)
+	if err != nil {
+		return err
+	}
+
+	subscription := subscriptionModel.ToDomain()
+	if err := dto.UpdateDomain(subscription, s.companyNow(ctx, time.Now())); err != nil {
+		return err
+	}
+
+	if !reportusecases.IsReportType(subscription.ReportType) {
+		return reportusecases.ErrReportTypeUnknown
+	}
+
+	subscription.Touch()
+	subscriptionModel.FromDomain(subscription)
+	return s.r.UpdateReportSubscription(ctx, subscriptionModel)
+}
+
+func (s *Service) DeleteReportSubscription(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	if _, err := s.getOwnedSubscription(ctx, dtoID.ID); err != nil {
+		return err
+	}
+
+	return s.r.DeleteReportSubscription(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetReportSubscriptionById(ctx context.Context, dtoID *entitydto.IDRequest) (*reportsubscriptiondto.ReportSubscriptionDTO, error) {
+	subscriptionModel, err := s.getOwnedSubscription(ctx, dtoID.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionDTO := &reportsubscriptiondto.ReportSubscriptionDTO{}
+	subscriptionDTO.FromDomain(subscriptionModel.ToDomain())
+	return subscriptionDTO, nil
+}
+
+// GetAllReportSubscriptions lista as assinaturas do usuário logado
+func (s *Service) GetAllReportSubscriptions(ctx context.Context) ([]reportsubscriptiondto.ReportSubscriptionDTO, error) {
+	userID, err := contextUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionModels, err := s.r.GetReportSubscriptionsByUserID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionDTOs := []reportsubscriptiondto.ReportSubscriptionDTO{}
+	for i := range subscriptionModels {
+		subscriptionDTO := reportsubscriptiondto.ReportSubscriptionDTO{}
+		subscriptionDTO.FromDomain(subscriptionModels[i].ToDomain())
+		subscriptionDTOs = append(subscriptionDTOs, subscriptionDTO)
+	}
+
+	return subscriptionDTOs, nil
+}
+
+// GetDeliveries devolve os últimos envios da assinatura, do mais recente para o mais antigo
+func (s *Service) GetDeliveries(ctx context.Context, dtoID *entitydto.IDRequest) ([]reportsubscriptiondto.DeliveryDTO, error) {
+	if _, err := s.getOwnedSubscription(ctx, dtoID.ID); err != nil {
+		return nil, err
+	}
+
+	deliveryModels, err := s.r.GetDeliveriesBySubscriptionID(ctx, dtoID.ID.String(), deliveriesLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveryDTOs := []reportsubscriptiondto.DeliveryDTO{}
+	for i := range deliveryModels {
+		deliveryDTO := reportsubscriptiondto.DeliveryDTO{}
+		deliveryDTO.FromDomain(deliveryModels[i].ToDomain())
+		deliveryDTOs = append(deliveryDTOs, deliveryDTO)
+	}
+
+	return deliveryDTOs, nil
+}
+
+// getOwnedSubscription carrega a assinatura e esconde as de outros usuários
+func (s *Service) getOwnedSubscription(ctx context.Context, id uuid.UUID) (*model.ReportSubscription, error) {
+	userID, err := contextUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionModel, err := s.r.GetReportSubscriptionById(ctx, id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if subscriptionModel.UserID != userID {
+		return nil, ErrReportSubscriptionNotFound
+	}
+
+	return subscriptionModel, nil
+}
+
+func contextUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return uuid.Nil, ErrContextUserNotFound
+	}
+
+	return uuid.Parse(userID)
+}
@@ -0,0 +1,55 @@
+package stockusecases
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// SyncVariationAvailability aplica a disponibilidade automática às variações controladas pelo
+// estoque (a variação dele ou, sem variação, todas do produto) na transação que gravou o saldo.
+func SyncVariationAvailability(ctx context.Context, db bun.IDB, productRepo model.ProductRepository, stock *stockentity.Stock) error {
+	if productRepo == nil {
+		return nil
+	}
+
+	variationModels, err := productRepo.GetVariationsForUpdate(ctx, db, stock.ProductID, stock.ProductVariationID)
+	if err != nil {
+		return err
+	}
+
+	for i := range variationModels {
+		variation := variationModels[i].ToDomain()
+		if !stock.ApplyVariationAvailability(&variation) {
+			continue
+		}
+
+		variationModels[i].FromDomain(variation)
+		if err := productRepo.UpdateVariationAvailability(ctx, db, &variationModels[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateStockBalance grava o saldo após uma movimentação. Com a disponibilidade automática
+// desligada o saldo não mexe nas variações: desligar a opção passa por UpdateStock, que religa as marcadas.
+func UpdateStockBalance(ctx context.Context, db bun.IDB, stockRepo model.StockRepository, productRepo model.ProductRepository, stockModel *model.Stock) error {
+	if err := stockRepo.UpdateStock(ctx, db, stockModel); err != nil {
+		return err
+	}
+
+	stock := stockModel.ToDomain()
+	if _, managed := stock.VariationAvailability(); !managed {
+		return nil
+	}
+
+	return SyncVariationAvailability(ctx, db, productRepo, stock)
+}
+
+func (s *Service) updateStockBalance(ctx context.Context, db bun.IDB, stockModel *model.Stock) error {
+	return UpdateStockBalance(ctx, db, s.stockRepo, s.productRepo, stockModel)
+}
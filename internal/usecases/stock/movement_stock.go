@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	"github.com/willjrcom/sales-backend-go/internal/domain/entity"
@@ -96,7 +97,7 @@ func (s *Service) AddMovementStock(ctx context.Context, dtoID *entitydto.IDReque
 	// 4. Atualizar o estoque principal
 	stock.CurrentStock = stock.CurrentStock.Add(dto.Quantity)
 	stockModel.FromDomain(stock)
-	if err := s.stockRepo.UpdateStock(ctx, tx, stockModel); err != nil {
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
 		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
 	}
 
@@ -111,6 +112,149 @@ func (s *Service) AddMovementStock(ctx context.Context, dtoID *entitydto.IDReque
 	return movementDTO, nil
 }
 
+// ReceiveBatchWithTx registra a entrada de um lote dentro de uma transação aberta pelo chamador,
+// para que o recebimento de compras grave lotes, movimentos e contas a pagar de forma atômica.
+func (s *Service) ReceiveBatchWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, entry stockentity.BatchEntry) (*stockentity.StockMovement, error) {
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s para atualização: %w", stockID, err)
+	}
+
+	stock := stockModel.ToDomain()
+
+	batch, movement, err := stock.ReceiveBatch(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	batchModel := &model.StockBatch{}
+	batchModel.FromDomain(batch)
+	if err := s.stockBatchRepo.CreateBatch(ctx, tx, batchModel); err != nil {
+		return nil, fmt.Errorf("erro ao criar lote de estoque: %w", err)
+	}
+
+	movementModel := &model.StockMovement{}
+	movementModel.FromDomain(movement)
+	if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+		return nil, fmt.Errorf("erro ao salvar movimento de entrada: %w", err)
+	}
+
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
+	}
+
+	return movement, nil
+}
+
+// PostCountAdjustmentWithTx lança a diferença de inventário de um estoque (e do lote contado, se houver)
+// dentro da transação de aprovação da contagem. Devolve nil quando não há diferença.
+func (s *Service) PostCountAdjustmentWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, batchID *uuid.UUID, adjustment stockentity.CountAdjustment) (*stockentity.StockMovement, error) {
+	if adjustment.Variance.IsZero() {
+		return nil, nil
+	}
+
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s para atualização: %w", stockID, err)
+	}
+
+	stock := stockModel.ToDomain()
+
+	var batchModel *model.StockBatch
+	var batch *stockentity.StockBatch
+	if batchID != nil {
+		if batchModel, err = s.stockBatchRepo.GetBatchByID(ctx, tx, batchID.String()); err != nil {
+			return nil, fmt.Errorf("erro ao buscar lote %s: %w", batchID, err)
+		}
+		batch = batchModel.ToDomain()
+	}
+
+	movement, err := stock.PostCountAdjustment(batch, adjustment)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch != nil {
+		batchModel.FromDomain(batch)
+		if err := s.stockBatchRepo.UpdateBatch(ctx, tx, batchModel); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar lote %s: %w", batch.ID, err)
+		}
+	}
+
+	movementModel := &model.StockMovement{}
+	movementModel.FromDomain(movement)
+	if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+		return nil, fmt.Errorf("erro ao salvar movimento de ajuste: %w", err)
+	}
+
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
+	}
+
+	return movement, nil
+}
+
+// RegisterLossWithTx baixa uma perda dentro da transação do registro de perda, no lote informado
+// ou via FIFO, e devolve os movimentos de saída (com o custo de cada lote) para valorizar a perda.
+func (s *Service) RegisterLossWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, entry stockentity.LossEntry) ([]*stockentity.StockMovement, error) {
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s para atualização: %w", stockID, err)
+	}
+
+	stock := stockModel.ToDomain()
+
+	var batchModels []model.StockBatch
+	if entry.BatchID != nil {
+		batchModel, err := s.stockBatchRepo.GetBatchByID(ctx, tx, entry.BatchID.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar lote %s: %w", entry.BatchID, err)
+		}
+
+		if batchModel.StockID != stockID {
+			return nil, fmt.Errorf("lote %s não pertence ao estoque %s", entry.BatchID, stockID)
+		}
+
+		batchModels = []model.StockBatch{*batchModel}
+	} else if batchModels, err = s.stockBatchRepo.GetActiveBatchesByStockIDForUpdate(ctx, tx, stockID.String()); err != nil {
+		return nil, fmt.Errorf("erro ao buscar lotes para baixa: %w", err)
+	}
+
+	batches := make([]*stockentity.StockBatch, 0, len(batchModels))
+	for i := range batchModels {
+		batches = append(batches, batchModels[i].ToDomain())
+	}
+
+	movements, err := stock.RegisterLoss(batches, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, batch := range batches {
+		batchModels[i].FromDomain(batch)
+		if err := s.stockBatchRepo.UpdateBatch(ctx, tx, &batchModels[i]); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar lote %s: %w", batch.ID, err)
+		}
+	}
+
+	for _, movement := range movements {
+		movementModel := &model.StockMovement{}
+		movementModel.FromDomain(movement)
+		if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+			return nil, fmt.Errorf("erro ao salvar movimento de perda: %w", err)
+		}
+	}
+
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
+	}
+
+	return movements, nil
+}
+
 // RemoveMovementStock remove estoque manualmente via FIFO
 func (s *Service) RemoveMovementStock(ctx context.Context, dtoID *entitydto.IDRequest, dto *stockdto.StockMovementRemoveDTO) (*stockdto.StockMovementDTO, error) {
 	// 1. Buscar estoque
@@ -0,0 +1,80 @@
+package stockusecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// ReleaseExpiredReservations devolve ao disponível as reservas vencidas de pedidos em rascunho.
+// Cada par pedido/estoque é liberado na sua própria transação; retorna quantos foram liberados.
+func (s *Service) ReleaseExpiredReservations(ctx context.Context) (int, error) {
+	reservations, err := s.stockMovementRepo.GetExpiredReservations(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("erro ao buscar reservas vencidas: %w", err)
+	}
+
+	released := 0
+	for _, reservation := range reservations {
+		ok, err := s.releaseReservation(ctx, reservation)
+		if err != nil {
+			fmt.Printf("Aviso: erro ao liberar reserva do pedido %s: %v\n", reservation.OrderID.String(), err)
+			continue
+		}
+		if ok {
+			released++
+		}
+	}
+
+	return released, nil
+}
+
+// releaseReservation libera a reserva relida com o estoque bloqueado: se o pedido foi finalizado
+// ou teve a reserva liberada depois da busca das vencidas, não há nada a devolver.
+func (s *Service) releaseReservation(ctx context.Context, reservation model.ExpiredReservation) (bool, error) {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return false, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, reservation.StockID.String())
+	if err != nil {
+		return false, fmt.Errorf("erro ao bloquear estoque: %w", err)
+	}
+
+	quantity, err := s.stockMovementRepo.GetStagingReservationWithTx(ctx, tx, reservation.OrderID.String(), reservation.StockID.String())
+	if err != nil {
+		return false, fmt.Errorf("erro ao buscar reserva do pedido: %w", err)
+	}
+	if !quantity.IsPositive() {
+		return false, nil
+	}
+
+	stock := stockModel.ToDomain()
+	movement, err := stock.ReleaseReservation(quantity, reservation.OrderID, reservation.EmployeeID)
+	if err != nil {
+		return false, err
+	}
+
+	movementModel := &model.StockMovement{}
+	movementModel.FromDomain(movement)
+	if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+		return false, fmt.Errorf("erro ao salvar movimento de liberação: %w", err)
+	}
+
+	// UpdateStock também sincroniza a disponibilidade automática da variação
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return false, fmt.Errorf("erro ao atualizar estoque: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
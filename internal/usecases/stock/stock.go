@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
 	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
 	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
@@ -25,6 +26,8 @@ type Service struct {
 	itemRepo          model.ItemRepository
 	employeeRepo      model.EmployeeRepository
 	orderRepo         model.OrderRepository
+	stockLocationRepo model.StockLocationRepository
+	processRuleRepo   model.ProcessRuleRepository
 }
 
 func NewStockService(
@@ -43,11 +46,13 @@ func NewStockService(
 	}
 }
 
-func (s *Service) AddDependencies(productRepo model.ProductRepository, itemRepo model.ItemRepository, employeeRepo model.EmployeeRepository, orderRepo model.OrderRepository) {
+func (s *Service) AddDependencies(productRepo model.ProductRepository, itemRepo model.ItemRepository, employeeRepo model.EmployeeRepository, orderRepo model.OrderRepository, stockLocationRepo model.StockLocationRepository, processRuleRepo model.ProcessRuleRepository) {
 	s.itemRepo = itemRepo
 	s.productRepo = productRepo
 	s.employeeRepo = employeeRepo
 	s.orderRepo = orderRepo
+	s.stockLocationRepo = stockLocationRepo
+	s.processRuleRepo = processRuleRepo
 }
 
 // createAlertsIfNotDuplicate persiste cada alerta somente se não houver outro alerta
@@ -106,6 +111,12 @@ func (s *Service) CreateStock(ctx context.Context, dto *stockdto.StockCreateDTO)
 		return nil, err
 	}
 
+	if _, managed := stock.VariationAvailability(); managed {
+		if err := s.syncCreatedStockAvailability(ctx, stock); err != nil {
+			return nil, err
+		}
+	}
+
 	// Verificar alertas (com deduplicação)
 	s.createAlertsIfNotDuplicate(ctx, stock.CheckAlerts())
 
@@ -126,8 +137,42 @@ func (s *Service) UpdateStock(ctx context.Context, dtoID *entitydto.IDRequest, d
 	stock := stockModel.ToDomain()
 	dto.UpdateDomain(stock)
 
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
 	stockModel.FromDomain(stock)
-	return s.stockRepo.UpdateStock(ctx, s.db, stockModel)
+	if err := s.stockRepo.UpdateStock(ctx, tx, stockModel); err != nil {
+		return err
+	}
+
+	// Ligar ou desligar a disponibilidade automática (ou desativar o estoque) também acerta as variações
+	if err := SyncVariationAvailability(ctx, tx, s.productRepo, stock); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// syncCreatedStockAvailability desliga as variações de um estoque criado sem saldo com a disponibilidade automática
+func (s *Service) syncCreatedStockAvailability(ctx context.Context, stock *stockentity.Stock) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	defer cancel()
+	defer tx.Rollback()
+
+	if err := SyncVariationAvailability(ctx, tx, s.productRepo, stock); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // GetStockByID busca estoque por ID
@@ -455,7 +500,7 @@ func (s *Service) GetStockReport(ctx context.Context, page, perPage int) (*stock
 	return report, count, nil
 }
 
-func (s *Service) debitStockFromItem(ctx context.Context, item *model.Item, orderID uuid.UUID, employeeID uuid.UUID) error {
+func (s *Service) debitStockFromItem(ctx context.Context, item *model.Item, orderID uuid.UUID, employeeID uuid.UUID, resolver *locationResolver) error {
 	reason := fmt.Sprintf("Venda Pedido %s", orderID)
 	quantity := decimal.NewFromFloat(item.Quantity)
 
@@ -481,8 +526,9 @@ func (s *Service) debitStockFromItem(ctx context.Context, item *model.Item, orde
 		return nil
 	}
 
-	// Debitar FIFO
-	if err := s.DebitStockFIFO(ctx, stockModel.ID, quantity, orderID, employeeID, reason); err != nil {
+	// Debitar FIFO do local que prepara o item (regra de processo ou categoria)
+	location := s.resolveItemLocation(ctx, resolver, item)
+	if err := s.DebitStockFIFOFromLocation(ctx, stockModel.ID, quantity, orderID, employeeID, reason, location); err != nil {
 		fmt.Printf("Aviso: erro ao debitar estoque para item %s: %v\n", item.ProductID, err)
 		return nil
 	}
@@ -502,16 +548,18 @@ func (s *Service) DebitStockFromOrder(ctx context.Context, orderID uuid.UUID, em
 		return fmt.Errorf("erro ao buscar pedido: %w", err)
 	}
 
+	resolver := s.newLocationResolver(ctx)
+
 	for _, groupItem := range orderModel.GroupItems {
 		// 1. Itens principais e seus adicionais
 		for _, item := range groupItem.Items {
-			if err := s.debitStockFromItem(ctx, &item, orderID, employeeID); err != nil {
+			if err := s.debitStockFromItem(ctx, &item, orderID, employeeID, resolver); err != nil {
 				return err
 			}
 
 			// Processar adicionais do item
 			for _, addItem := range item.AdditionalItems {
-				if err := s.debitStockFromItem(ctx, &addItem, orderID, employeeID); err != nil {
+				if err := s.debitStockFromItem(ctx, &addItem, orderID, employeeID, resolver); err != nil {
 					return err
 				}
 			}
@@ -519,7 +567,7 @@ func (s *Service) DebitStockFromOrder(ctx context.Context, orderID uuid.UUID, em
 
 		// 2. Complemento do grupo
 		if groupItem.ComplementItem != nil {
-			if err := s.debitStockFromItem(ctx, groupItem.ComplementItem, orderID, employeeID); err != nil {
+			if err := s.debitStockFromItem(ctx, groupItem.ComplementItem, orderID, employeeID, resolver); err != nil {
 				return err
 			}
 		}
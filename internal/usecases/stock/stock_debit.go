@@ -6,8 +6,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
 	"github.com/willjrcom/sales-backend-go/bootstrap/database"
 	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
 	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 )
 
@@ -47,21 +49,22 @@ func getPendingOutMovementsToRestore(movements []model.StockMovement) []model.St
 	return pending
 }
 
-func reconcileStockAfterDebit(stock *stockentity.Stock, quantity decimal.Decimal, orderID uuid.UUID) {
+// reconcileStockAfterDebit ajusta os saldos após a saída. reserved é quanto o próprio pedido
+// ainda tinha reservado: essa parte já saiu de CurrentStock na reserva.
+func reconcileStockAfterDebit(stock *stockentity.Stock, quantity decimal.Decimal, orderID uuid.UUID, reserved decimal.Decimal) {
 	if orderID != uuid.Nil {
-		// Pedido com possível reserva prévia.
-		// Usa o saldo real de ReservedStock para saber quanto já foi pré-debitado
-		// de CurrentStock na etapa de reserva.
-		if stock.ReservedStock.GreaterThanOrEqual(quantity) {
+		reserved = decimal.Min(reserved, stock.ReservedStock)
+		if reserved.GreaterThanOrEqual(quantity) {
 			// Reserva cobriu tudo: CurrentStock já foi decrementado. Só limpar reserva.
 			stock.ReservedStock = stock.ReservedStock.Sub(quantity)
 			return
 		}
 
-		// Reserva parcial ou falhou silenciosamente: decrementar a parte não reservada.
-		notReserved := quantity.Sub(stock.ReservedStock)
-		stock.ReservedStock = decimal.Zero
-		stock.CurrentStock = stock.CurrentStock.Sub(notReserved)
+		// Reserva parcial, expirada ou que falhou silenciosamente: decrementar a parte não reservada.
+		if reserved.IsPositive() {
+			stock.ReservedStock = stock.ReservedStock.Sub(reserved)
+		}
+		stock.CurrentStock = stock.CurrentStock.Sub(quantity.Sub(reserved))
 		return
 	}
 
@@ -69,8 +72,34 @@ func reconcileStockAfterDebit(stock *stockentity.Stock, quantity decimal.Decimal
 	stock.CurrentStock = stock.CurrentStock.Sub(quantity)
 }
 
+// orderReservationWithTx busca quanto o pedido ainda mantém reservado no estoque. Chamar com o
+// estoque já bloqueado: outro débito ou liberação do mesmo pedido espera e lê a reserva atualizada.
+func (s *Service) orderReservationWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, orderID uuid.UUID) (decimal.Decimal, error) {
+	if orderID == uuid.Nil {
+		return decimal.Zero, nil
+	}
+
+	movementModels, err := s.stockMovementRepo.GetMovementsByOrderIDWithTx(ctx, tx, orderID.String())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("erro ao buscar reservas do pedido %s: %w", orderID.String(), err)
+	}
+
+	movements := make([]stockentity.StockMovement, 0, len(movementModels))
+	for _, mm := range movementModels {
+		movements = append(movements, *mm.ToDomain())
+	}
+
+	return stockentity.OpenReservation(movements, stockID), nil
+}
+
 // DebitStockFIFO debita o estoque seguindo a estratégia FIFO (First-In, First-Out)
 func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantity decimal.Decimal, orderID uuid.UUID, employeeID uuid.UUID, reason string) error {
+	return s.DebitStockFIFOFromLocation(ctx, stockID, quantity, orderID, employeeID, reason, nil)
+}
+
+// DebitStockFIFOFromLocation debita via FIFO somente os lotes do local informado;
+// sem local (empresa sem locais cadastrados) consome todos os lotes.
+func (s *Service) DebitStockFIFOFromLocation(ctx context.Context, stockID uuid.UUID, quantity decimal.Decimal, orderID uuid.UUID, employeeID uuid.UUID, reason string, location *stocklocationentity.StockLocation) error {
 	// 1. Iniciar transação de tenant
 	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
 	if err != nil {
@@ -79,6 +108,18 @@ func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantit
 	defer cancel()
 	defer tx.Rollback()
 
+	// Bloquear o estoque principal antes dos lotes, na mesma ordem dos demais lançamentos
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return fmt.Errorf("erro ao bloquear estoque principal: %w", err)
+	}
+
+	// Reserva ainda aberta do pedido, lida com o estoque bloqueado e antes das saídas deste débito
+	reserved, err := s.orderReservationWithTx(ctx, tx, stockID, orderID)
+	if err != nil {
+		return err
+	}
+
 	// 2. Bloquear Lotes com SELECT FOR UPDATE (Pessimistic Locking)
 	batchesModel, err := s.stockBatchRepo.GetActiveBatchesByStockIDForUpdate(ctx, tx, stockID.String())
 	if err != nil {
@@ -92,12 +133,21 @@ func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantit
 		movementOrderID = &orderID
 	}
 
+	var movementLocationID *uuid.UUID
+	if location != nil {
+		movementLocationID = &location.ID
+	}
+
 	// 3. Processar lotes (FIFO)
 	for _, bm := range batchesModel {
 		if remainingQuantity.LessThanOrEqual(decimal.Zero) {
 			break
 		}
 
+		if location != nil && !location.Holds(bm.LocationID) {
+			continue
+		}
+
 		batch := bm.ToDomain()
 		consumeQuantity := decimal.Min(remainingQuantity, batch.CurrentQuantity)
 
@@ -110,6 +160,7 @@ func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantit
 				Quantity:   consumeQuantity,
 				Reason:     reason,
 				OrderID:    movementOrderID,
+				LocationID: movementLocationID,
 				EmployeeID: employeeID,
 				Price:      batch.CostPrice, // Valor de custo na saída (COGS)
 			},
@@ -139,6 +190,7 @@ func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantit
 				Quantity:   remainingQuantity,
 				Reason:     reason + " (Estoque Negativo)",
 				OrderID:    movementOrderID,
+				LocationID: movementLocationID,
 				EmployeeID: employeeID,
 				Price:      decimal.Zero, // Custo zero para estoque não rastreado
 			},
@@ -158,17 +210,11 @@ func (s *Service) DebitStockFIFO(ctx context.Context, stockID uuid.UUID, quantit
 	}
 
 	// 6. Atualizar o estoque principal (total)
-	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
-	if err != nil {
-		fmt.Printf("Aviso: erro ao buscar estoque principal para atualização: %v\n", err)
-		return nil
-	}
-
 	stock := stockModel.ToDomain()
-	reconcileStockAfterDebit(stock, quantity, orderID)
+	reconcileStockAfterDebit(stock, quantity, orderID, reserved)
 
 	stockModel.FromDomain(stock)
-	if err := s.stockRepo.UpdateStock(ctx, tx, stockModel); err != nil {
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
 		fmt.Printf("Aviso: erro ao atualizar estoque principal: %v\n", err)
 		return nil
 	}
@@ -259,7 +305,7 @@ func (s *Service) RestoreStockFromOrder(ctx context.Context, orderID uuid.UUID,
 		stock.CurrentStock = stock.CurrentStock.Add(movement.Quantity)
 
 		stockModel.FromDomain(stock)
-		if err := s.stockRepo.UpdateStock(ctx, tx, stockModel); err != nil {
+		if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
 			fmt.Printf("Aviso: erro ao atualizar estoque principal %s na restauração: %v\n", movement.StockID.String(), err)
 			continue
 		}
@@ -13,7 +13,7 @@ func TestReconcileStockAfterDebit_OrderWithFullReserve(t *testing.T) {
 	stock := newStock(10, 0, 100)
 	stock.ReservedStock = decimal.NewFromInt(5)
 
-	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New())
+	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New(), decimal.NewFromInt(5))
 
 	if stock.CurrentStock.String() != "10" {
 		t.Fatalf("expected current_stock unchanged when fully reserved, got %s", stock.CurrentStock.String())
@@ -27,7 +27,7 @@ func TestReconcileStockAfterDebit_OrderWithoutReserveFallbackDebitsCurrentStock(
 	stock := newStock(10, 0, 100)
 	stock.ReservedStock = decimal.Zero
 
-	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New())
+	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New(), decimal.Zero)
 
 	if stock.CurrentStock.String() != "5" {
 		t.Fatalf("expected current_stock to be debited when reserve is zero, got %s", stock.CurrentStock.String())
@@ -41,7 +41,7 @@ func TestReconcileStockAfterDebit_OrderWithPartialReserveDebitsOnlyUnreserved(t
 	stock := newStock(10, 0, 100)
 	stock.ReservedStock = decimal.NewFromInt(2)
 
-	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New())
+	reconcileStockAfterDebit(stock, decimal.NewFromInt(5), uuid.New(), decimal.NewFromInt(2))
 
 	if stock.CurrentStock.String() != "7" {
 		t.Fatalf("expected current_stock to debit only unreserved quantity, got %s", stock.CurrentStock.String())
@@ -51,6 +51,21 @@ func TestReconcileStockAfterDebit_OrderWithPartialReserveDebitsOnlyUnreserved(t
 	}
 }
 
+func TestReconcileStockAfterDebit_ExpiredReserveKeepsOtherOrdersReserved(t *testing.T) {
+	stock := newStock(10, 0, 100)
+	stock.ReservedStock = decimal.NewFromInt(5)
+
+	// A reserva deste pedido já foi liberada; os 5 reservados pertencem a outro pedido
+	reconcileStockAfterDebit(stock, decimal.NewFromInt(3), uuid.New(), decimal.Zero)
+
+	if stock.CurrentStock.String() != "7" {
+		t.Fatalf("expected current_stock to be debited when order reserve expired, got %s", stock.CurrentStock.String())
+	}
+	if stock.ReservedStock.String() != "5" {
+		t.Fatalf("expected other orders reserved_stock untouched, got %s", stock.ReservedStock.String())
+	}
+}
+
 func TestGetPendingOutMovementsToRestore_IgnoresAlreadyRestoredOuts(t *testing.T) {
 	stockID := uuid.New()
 	batchID := uuid.New()
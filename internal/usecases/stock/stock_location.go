@@ -0,0 +1,160 @@
+package stockusecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// locationResolver escolhe o local de débito de cada item de um pedido,
+// guardando as regras de processo por categoria para não repetir a busca.
+type locationResolver struct {
+	locations            []stocklocationentity.StockLocation
+	processRulesCategory map[uuid.UUID][]uuid.UUID
+}
+
+func (s *Service) newLocationResolver(ctx context.Context) *locationResolver {
+	resolver := &locationResolver{processRulesCategory: map[uuid.UUID][]uuid.UUID{}}
+	if s.stockLocationRepo == nil {
+		return resolver
+	}
+
+	locationModels, err := s.stockLocationRepo.GetAllStockLocations(ctx)
+	if err != nil {
+		fmt.Printf("Aviso: erro ao buscar locais de estoque: %v\n", err)
+		return resolver
+	}
+
+	for i := range locationModels {
+		resolver.locations = append(resolver.locations, *locationModels[i].ToDomain())
+	}
+
+	return resolver
+}
+
+func (s *Service) resolveItemLocation(ctx context.Context, resolver *locationResolver, item *model.Item) *stocklocationentity.StockLocation {
+	if resolver == nil || len(resolver.locations) == 0 {
+		return nil
+	}
+
+	processRuleIDs, ok := resolver.processRulesCategory[item.CategoryID]
+	if !ok && s.processRuleRepo != nil {
+		if processRules, err := s.processRuleRepo.GetProcessRulesByCategoryId(ctx, item.CategoryID.String()); err == nil {
+			for _, processRule := range processRules {
+				processRuleIDs = append(processRuleIDs, processRule.ID)
+			}
+		}
+		resolver.processRulesCategory[item.CategoryID] = processRuleIDs
+	}
+
+	return stocklocationentity.ResolveLocation(resolver.locations, item.CategoryID, processRuleIDs)
+}
+
+// DispatchTransferWithTx retira a quantidade dos lotes do local de origem dentro da transação
+// da transferência e devolve os movimentos de saída (um por lote, com o custo do lote).
+func (s *Service) DispatchTransferWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, from *stocklocationentity.StockLocation, entry stockentity.TransferEntry) ([]*stockentity.StockMovement, error) {
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s para atualização: %w", stockID, err)
+	}
+
+	stock := stockModel.ToDomain()
+
+	activeBatchModels, err := s.stockBatchRepo.GetActiveBatchesByStockIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar lotes para transferência: %w", err)
+	}
+
+	batchModels := []model.StockBatch{}
+	for _, batchModel := range activeBatchModels {
+		if from.Holds(batchModel.LocationID) {
+			batchModels = append(batchModels, batchModel)
+		}
+	}
+
+	batches := make([]*stockentity.StockBatch, 0, len(batchModels))
+	for i := range batchModels {
+		batches = append(batches, batchModels[i].ToDomain())
+	}
+
+	movements, err := stock.DispatchTransfer(batches, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, batch := range batches {
+		batchModels[i].FromDomain(batch)
+		if err := s.stockBatchRepo.UpdateBatch(ctx, tx, &batchModels[i]); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar lote %s: %w", batch.ID, err)
+		}
+	}
+
+	for _, movement := range movements {
+		movementModel := &model.StockMovement{}
+		movementModel.FromDomain(movement)
+		if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+			return nil, fmt.Errorf("erro ao salvar movimento de transferência: %w", err)
+		}
+	}
+
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
+	}
+
+	return movements, nil
+}
+
+// ReceiveTransferWithTx dá entrada de uma linha da transferência: em um novo lote no local
+// de destino ou, no cancelamento, de volta ao lote de origem (returnToBatchID).
+func (s *Service) ReceiveTransferWithTx(ctx context.Context, tx bun.IDB, stockID uuid.UUID, receipt stockentity.TransferReceipt, returnToBatchID *uuid.UUID) (*stockentity.StockBatch, error) {
+	stockModel, err := s.stockRepo.GetStockByIDForUpdate(ctx, tx, stockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s para atualização: %w", stockID, err)
+	}
+
+	stock := stockModel.ToDomain()
+
+	var returnTo *stockentity.StockBatch
+	if returnToBatchID != nil {
+		batchModel, err := s.stockBatchRepo.GetBatchByID(ctx, tx, returnToBatchID.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar lote %s: %w", returnToBatchID, err)
+		}
+		returnTo = batchModel.ToDomain()
+	}
+
+	batch, movement, err := stock.ReceiveTransfer(receipt, returnTo)
+	if err != nil {
+		return nil, err
+	}
+
+	batchModel := &model.StockBatch{}
+	batchModel.FromDomain(batch)
+	if returnTo != nil {
+		err = s.stockBatchRepo.UpdateBatch(ctx, tx, batchModel)
+	} else {
+		err = s.stockBatchRepo.CreateBatch(ctx, tx, batchModel)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao salvar lote da transferência: %w", err)
+	}
+
+	movementModel := &model.StockMovement{}
+	movementModel.FromDomain(movement)
+	if err := s.stockMovementRepo.CreateMovement(ctx, tx, movementModel); err != nil {
+		return nil, fmt.Errorf("erro ao salvar movimento de transferência: %w", err)
+	}
+
+	stockModel.FromDomain(stock)
+	if err := s.updateStockBalance(ctx, tx, stockModel); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar estoque principal: %w", err)
+	}
+
+	return batch, nil
+}
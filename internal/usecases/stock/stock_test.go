@@ -11,8 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	productentity "github.com/willjrcom/sales-backend-go/internal/domain/product"
 	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	productrepositorylocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/product"
 	stocklocal "github.com/willjrcom/sales-backend-go/internal/infra/repository/local/stock"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
 )
 
 var (
@@ -155,6 +158,203 @@ func TestAddMovement_InactiveStock_Error(t *testing.T) {
 	assert.Error(t, err)
 }
 
+// ─────────────────────────────────────────────────────────────
+// ReceiveBatch / ReceiveBatchWithTx — entrada de compras
+// ─────────────────────────────────────────────────────────────
+
+func TestReceiveBatch_CreatesBatchLinkedToMovement(t *testing.T) {
+	stock := newStock(5, 0, 100)
+	purchaseOrderID := uuid.New()
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+
+	batch, movement, err := stock.ReceiveBatch(stockentity.BatchEntry{
+		Quantity:        decimal.NewFromInt(12),
+		CostPrice:       decimal.NewFromFloat(3.25),
+		ExpiresAt:       &expiresAt,
+		Reason:          "pedido de compra",
+		EmployeeID:      uuid.New(),
+		PurchaseOrderID: &purchaseOrderID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "17", stock.CurrentStock.String(), "CurrentStock deve somar a quantidade recebida")
+	assert.Equal(t, "12", batch.CurrentQuantity.String())
+	assert.Equal(t, "3.25", batch.CostPrice.String(), "lote deve guardar o custo da compra")
+	assert.Equal(t, batch.ID, *movement.BatchID, "movimento deve apontar para o lote criado")
+	assert.Equal(t, purchaseOrderID, *movement.PurchaseOrderID, "movimento deve apontar para o pedido de compra")
+	assert.Equal(t, stockentity.MovementTypeIn, movement.Type)
+}
+
+func TestReceiveBatchWithTx_PersistsBatchMovementAndStock(t *testing.T) {
+	stock := newStock(0, 0, 100)
+	stockModel := &model.Stock{}
+	stockModel.FromDomain(stock)
+	require.NoError(t, stockRepo.CreateStock(ctx, stockModel))
+
+	movement, err := svc.ReceiveBatchWithTx(ctx, nil, stock.ID, stockentity.BatchEntry{
+		Quantity:   decimal.NewFromInt(4),
+		CostPrice:  decimal.NewFromFloat(10),
+		Reason:     "pedido de compra",
+		EmployeeID: uuid.New(),
+	})
+	require.NoError(t, err)
+
+	saved, err := stockRepo.GetStockByID(ctx, stock.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "4", saved.GetCurrentStock().String(), "estoque persistido deve refletir a entrada")
+
+	batches, err := batchRepo.GetBatchesByStockID(ctx, stock.ID.String())
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	assert.Equal(t, *movement.BatchID, batches[0].ID)
+
+	movements, err := movementRepo.GetMovementsByStockID(ctx, stock.ID.String(), nil)
+	require.NoError(t, err)
+	assert.Len(t, movements, 1)
+}
+
+// ─────────────────────────────────────────────────────────────
+// PostCountAdjustment — diferenças de inventário
+// ─────────────────────────────────────────────────────────────
+
+func TestPostCountAdjustment_NoVariance_NoMovement(t *testing.T) {
+	stock := newStock(10, 0, 100)
+	movement, err := stock.PostCountAdjustment(nil, stockentity.CountAdjustment{Variance: decimal.Zero})
+	require.NoError(t, err)
+	assert.Nil(t, movement)
+	assert.Equal(t, "10", stock.CurrentStock.String())
+}
+
+func TestPostCountAdjustmentWithTx_ShortageDebitsBatch(t *testing.T) {
+	stock := newStock(10, 0, 100)
+	stockModel := &model.Stock{}
+	stockModel.FromDomain(stock)
+	require.NoError(t, stockRepo.CreateStock(ctx, stockModel))
+
+	batch := newBatch(stock.ID, 10, nil)
+	batchModel := &model.StockBatch{}
+	batchModel.FromDomain(batch)
+	require.NoError(t, batchRepo.CreateBatch(ctx, nil, batchModel))
+
+	countID := uuid.New()
+	movement, err := svc.PostCountAdjustmentWithTx(ctx, nil, stock.ID, &batch.ID, stockentity.CountAdjustment{
+		Variance:         decimal.NewFromInt(-3),
+		CostPrice:        decimal.NewFromFloat(5),
+		Reason:           "inventário",
+		EmployeeID:       uuid.New(),
+		InventoryCountID: countID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, stockentity.MovementTypeAdjustOut, movement.Type)
+	assert.Equal(t, "3", movement.Quantity.String())
+	assert.Equal(t, countID, *movement.InventoryCountID, "movimento deve apontar para a sessão de inventário")
+
+	saved, err := stockRepo.GetStockByID(ctx, stock.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "7", saved.GetCurrentStock().String())
+
+	savedBatch, err := batchRepo.GetBatchByID(ctx, nil, batch.ID.String())
+	require.NoError(t, err)
+	assert.Equal(t, "7", savedBatch.ToDomain().CurrentQuantity.String(), "lote contado deve ser debitado")
+}
+
+// ─────────────────────────────────────────────────────────────
+// RegisterLoss — perdas valorizadas pelo custo do lote
+// ─────────────────────────────────────────────────────────────
+
+func TestRegisterLoss_ConsumesBatchesInOrder(t *testing.T) {
+	stock := newStock(8, 0, 100)
+	first := newBatch(stock.ID, 3, nil)
+	second := newBatch(stock.ID, 5, nil)
+	second.CostPrice = decimal.NewFromInt(8)
+
+	movements, err := stock.RegisterLoss([]*stockentity.StockBatch{first, second}, stockentity.LossEntry{
+		Quantity:    decimal.NewFromInt(5),
+		Reason:      "Perda - Estragado",
+		EmployeeID:  uuid.New(),
+		StockLossID: uuid.New(),
+	})
+	require.NoError(t, err)
+	require.Len(t, movements, 2)
+
+	assert.Equal(t, "3", stock.CurrentStock.String())
+	assert.Equal(t, "0", first.CurrentQuantity.String())
+	assert.Equal(t, "3", second.CurrentQuantity.String())
+	assert.Equal(t, "8", movements[1].Price.String(), "saída deve levar o custo do lote consumido")
+}
+
+func TestRegisterLoss_SpecificBatchInsufficient_Error(t *testing.T) {
+	stock := newStock(10, 0, 100)
+	batch := newBatch(stock.ID, 2, nil)
+
+	_, err := stock.RegisterLoss([]*stockentity.StockBatch{batch}, stockentity.LossEntry{
+		Quantity: decimal.NewFromInt(3),
+		BatchID:  &batch.ID,
+	})
+	assert.ErrorIs(t, err, stockentity.ErrInsufficientStock)
+	assert.Equal(t, "10", stock.CurrentStock.String(), "estoque não deve mudar quando o lote não cobre a perda")
+}
+
+// ─────────────────────────────────────────────────────────────
+// Transferências entre locais
+// ─────────────────────────────────────────────────────────────
+
+func TestDispatchAndReceiveTransfer_MovesBatchToDestination(t *testing.T) {
+	stock := newStock(6, 0, 100)
+	batch := newBatch(stock.ID, 6, nil)
+	from, to, transferID := uuid.New(), uuid.New(), uuid.New()
+
+	movements, err := stock.DispatchTransfer([]*stockentity.StockBatch{batch}, stockentity.TransferEntry{
+		TransferID:     transferID,
+		FromLocationID: from,
+		Quantity:       decimal.NewFromInt(4),
+	})
+	require.NoError(t, err)
+	require.Len(t, movements, 1)
+	assert.Equal(t, stockentity.MovementTypeTransferOut, movements[0].Type)
+	assert.Equal(t, "2", stock.CurrentStock.String(), "em trânsito não fica disponível")
+
+	received, movement, err := stock.ReceiveTransfer(stockentity.TransferReceipt{
+		TransferID: transferID,
+		LocationID: to,
+		Quantity:   decimal.NewFromInt(4),
+		CostPrice:  batch.CostPrice,
+	}, nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, batch.ID, received.ID, "recebimento cria lote no destino")
+	assert.Equal(t, to, *received.LocationID)
+	assert.Equal(t, "5", received.CostPrice.String(), "lote de destino mantém o custo de origem")
+	assert.Equal(t, stockentity.MovementTypeTransferIn, movement.Type)
+	assert.Equal(t, "6", stock.CurrentStock.String())
+}
+
+func TestDispatchTransfer_InsufficientBatches_Error(t *testing.T) {
+	stock := newStock(10, 0, 100)
+	batch := newBatch(stock.ID, 2, nil)
+
+	_, err := stock.DispatchTransfer([]*stockentity.StockBatch{batch}, stockentity.TransferEntry{
+		FromLocationID: uuid.New(),
+		Quantity:       decimal.NewFromInt(3),
+	})
+	assert.ErrorIs(t, err, stockentity.ErrInsufficientStock)
+	assert.Equal(t, "2", batch.CurrentQuantity.String(), "lote não deve mudar quando não cobre a transferência")
+}
+
+func TestCancelTransfer_ReturnsToSourceBatch(t *testing.T) {
+	stock := newStock(5, 0, 100)
+	batch := newBatch(stock.ID, 5, nil)
+
+	_, err := stock.DispatchTransfer([]*stockentity.StockBatch{batch}, stockentity.TransferEntry{FromLocationID: uuid.New(), Quantity: decimal.NewFromInt(5)})
+	require.NoError(t, err)
+
+	returned, _, err := stock.ReceiveTransfer(stockentity.TransferReceipt{Quantity: decimal.NewFromInt(5)}, batch)
+	require.NoError(t, err)
+	assert.Equal(t, batch.ID, returned.ID)
+	assert.Equal(t, "5", batch.CurrentQuantity.String())
+	assert.Equal(t, "5", stock.CurrentStock.String())
+}
+
 func TestRemoveMovement_DecreasesStock(t *testing.T) {
 	stock := newStock(10, 0, 100)
 	movement, err := stock.RemoveMovementStock(decimal.NewFromInt(3), "saída", uuid.New(), decimal.NewFromFloat(5.50))
@@ -198,6 +398,157 @@ func TestAdjustMovement_NegativeTarget_Error(t *testing.T) {
 	assert.ErrorIs(t, err, stockentity.ErrInvalidQuantity)
 }
 
+// ─────────────────────────────────────────────────────────────
+// Disponibilidade automática da variação
+// ─────────────────────────────────────────────────────────────
+
+func TestVariationAvailability_FollowsAvailableStock(t *testing.T) {
+	stock := newStock(2, 0, 20)
+
+	_, managed := stock.VariationAvailability()
+	assert.False(t, managed, "sem opt-in a disponibilidade continua manual")
+
+	stock.AutoAvailability = true
+	_, err := stock.ReserveStock(decimal.NewFromInt(2), uuid.New(), uuid.New(), decimal.Zero)
+	require.NoError(t, err)
+
+	available, managed := stock.VariationAvailability()
+	assert.True(t, managed)
+	assert.False(t, available, "saldo livre zerado (tudo reservado) deixa a variação indisponível")
+
+	_, err = stock.AddMovementStock(decimal.NewFromInt(5), "reposição", uuid.New(), decimal.Zero)
+	require.NoError(t, err)
+	available, _ = stock.VariationAvailability()
+	assert.True(t, available, "reposição volta a disponibilizar a variação")
+}
+
+func TestVariationAvailability_InactiveStock_NotManaged(t *testing.T) {
+	stock := newStock(0, 0, 20)
+	stock.AutoAvailability = true
+	stock.IsActive = false
+
+	_, managed := stock.VariationAvailability()
+	assert.False(t, managed)
+}
+
+func TestApplyVariationAvailability_OnlyRestoresAutoDisabled(t *testing.T) {
+	stock := newStock(0, 0, 20)
+	stock.AutoAvailability = true
+
+	auto := productentity.NewProductVariation(stock.ProductID, uuid.New(), decimal.NewFromInt(10))
+	manual := productentity.NewProductVariation(stock.ProductID, uuid.New(), decimal.NewFromInt(10))
+	manual.IsAvailable = false
+
+	assert.True(t, stock.ApplyVariationAvailability(auto))
+	assert.False(t, auto.IsAvailable)
+	assert.True(t, auto.AutoUnavailable)
+	assert.False(t, stock.ApplyVariationAvailability(manual), "variação já desligada à mão não é marcada")
+
+	_, err := stock.AddMovementStock(decimal.NewFromInt(5), "reposição", uuid.New(), decimal.Zero)
+	require.NoError(t, err)
+
+	assert.True(t, stock.ApplyVariationAvailability(auto))
+	assert.True(t, auto.IsAvailable)
+	assert.False(t, auto.AutoUnavailable)
+	assert.False(t, stock.ApplyVariationAvailability(manual))
+	assert.False(t, manual.IsAvailable, "reposição não religa o que foi desligado à mão")
+}
+
+func TestUpdateStockBalance_TurningOptionOffRestoresAutoDisabled(t *testing.T) {
+	productRepo := productrepositorylocal.NewProductRepositoryLocal()
+	stock := newStock(0, 0, 20)
+	stock.AutoAvailability = true
+
+	variation := &model.ProductVariation{}
+	variation.FromDomain(*productentity.NewProductVariation(stock.ProductID, uuid.New(), decimal.NewFromInt(10)))
+	product := &model.Product{}
+	product.ID = stock.ProductID
+	product.Variations = []*model.ProductVariation{variation}
+	require.NoError(t, productRepo.CreateProduct(ctx, product))
+
+	stockModel := &model.Stock{}
+	stockModel.FromDomain(stock)
+	require.NoError(t, UpdateStockBalance(ctx, nil, stockRepo, productRepo, stockModel))
+	assert.False(t, variation.IsAvailable)
+	assert.True(t, variation.AutoUnavailable)
+
+	// desligar a opção não é movimentação: só UpdateStock (sync completo) religa
+	stock.AutoAvailability = false
+	stockModel.FromDomain(stock)
+	require.NoError(t, UpdateStockBalance(ctx, nil, stockRepo, productRepo, stockModel))
+	assert.False(t, variation.IsAvailable)
+
+	require.NoError(t, SyncVariationAvailability(ctx, nil, productRepo, stock))
+	assert.True(t, variation.IsAvailable)
+	assert.False(t, variation.AutoUnavailable)
+}
+
+// ─────────────────────────────────────────────────────────────
+// Validade da reserva
+// ─────────────────────────────────────────────────────────────
+
+func TestReservationExpiresAt_DefaultWhenNotConfigured(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, now.Add(2*time.Hour), stockentity.ReservationExpiresAt(now, 0))
+	assert.Equal(t, now.Add(30*time.Minute), stockentity.ReservationExpiresAt(now, 30))
+}
+
+func TestOpenReservation_DiscountsRestoresAndOuts(t *testing.T) {
+	stock := newStock(20, 0, 50)
+	otherStockID := uuid.New()
+	orderID := uuid.New()
+
+	reserve, err := stock.ReserveStock(decimal.NewFromInt(5), orderID, uuid.New(), decimal.Zero)
+	require.NoError(t, err)
+	restore, err := stock.RestoreStock(decimal.NewFromInt(2), orderID, uuid.New(), decimal.Zero, nil)
+	require.NoError(t, err)
+
+	other := stockentity.StockMovement{}
+	other.StockID = otherStockID
+	other.Type = stockentity.MovementTypeReserve
+	other.Quantity = decimal.NewFromInt(7)
+
+	movements := []stockentity.StockMovement{*reserve, *restore, other}
+	assert.Equal(t, "3", stockentity.OpenReservation(movements, stock.ID).String())
+
+	out := stockentity.StockMovement{}
+	out.StockID = stock.ID
+	out.Type = stockentity.MovementTypeOut
+	out.Quantity = decimal.NewFromInt(4)
+
+	movements = append(movements, out)
+	assert.Equal(t, "0", stockentity.OpenReservation(movements, stock.ID).String(), "nunca abaixo de zero")
+}
+
+func TestReleaseReservation_ReturnsQuantityToCurrentStock(t *testing.T) {
+	stock := newStock(10, 0, 50)
+	orderID := uuid.New()
+
+	_, err := stock.ReserveStock(decimal.NewFromInt(4), orderID, uuid.New(), decimal.Zero)
+	require.NoError(t, err)
+
+	movement, err := stock.ReleaseReservation(decimal.NewFromInt(4), orderID, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, stockentity.MovementTypeRestore, movement.Type)
+	assert.Equal(t, orderID, *movement.OrderID)
+	assert.Equal(t, "10", stock.CurrentStock.String())
+	assert.Equal(t, "0", stock.ReservedStock.String())
+}
+
+func TestReleaseReservation_CappedAtReservedStock(t *testing.T) {
+	stock := newStock(10, 0, 50)
+	stock.ReservedStock = decimal.NewFromInt(1)
+
+	movement, err := stock.ReleaseReservation(decimal.NewFromInt(3), uuid.New(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "1", movement.Quantity.String())
+	assert.Equal(t, "11", stock.CurrentStock.String())
+
+	_, err = stock.ReleaseReservation(decimal.NewFromInt(1), uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, stockentity.ErrNoReservationToRelease)
+}
+
 // ─────────────────────────────────────────────────────────────
 // CheckAlerts — Domínio
 // ─────────────────────────────────────────────────────────────
@@ -0,0 +1,202 @@
+package stocklocationusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	stocklocationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/stock_location"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
+)
+
+var (
+	ErrContextUser = errors.New("context user not found")
+)
+
+type Service struct {
+	db             *bun.DB
+	r              model.StockLocationRepository
+	transferRepo   model.StockTransferRepository
+	stockRepo      model.StockRepository
+	stockBatchRepo model.StockBatchRepository
+	stockService   *stockusecases.Service
+	employeeRepo   model.EmployeeRepository
+}
+
+func NewService(db *bun.DB, r model.StockLocationRepository, transferRepo model.StockTransferRepository) *Service {
+	return &Service{db: db, r: r, transferRepo: transferRepo}
+}
+
+func (s *Service) AddDependencies(stockRepo model.StockRepository, stockBatchRepo model.StockBatchRepository, stockService *stockusecases.Service, employeeRepo model.EmployeeRepository) {
+	s.stockRepo = stockRepo
+	s.stockBatchRepo = stockBatchRepo
+	s.stockService = stockService
+	s.employeeRepo = employeeRepo
+}
+
+func (s *Service) CreateStockLocation(ctx context.Context, dto *stocklocationdto.StockLocationCreateDTO) (uuid.UUID, error) {
+	location, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	locationModel := &model.StockLocation{}
+	locationModel.FromDomain(location)
+	if err := s.r.CreateStockLocation(ctx, locationModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return location.ID, nil
+}
+
+func (s *Service) UpdateStockLocation(ctx context.Context, dtoID *entitydto.IDRequest, dto *stocklocationdto.StockLocationUpdateDTO) error {
+	locationModel, err := s.r.GetStockLocationById(ctx, dtoID.ID.String())
+	if err != nil {
+		return err
+	}
+
+	location := locationModel.ToDomain()
+	if err := dto.UpdateDomain(location); err != nil {
+		return err
+	}
+
+	locationModel.FromDomain(location)
+	return s.r.UpdateStockLocation(ctx, locationModel)
+}
+
+func (s *Service) DeleteStockLocation(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	if _, err := s.r.GetStockLocationById(ctx, dtoID.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeleteStockLocation(ctx, dtoID.ID.String())
+}
+
+func (s *Service) GetStockLocationById(ctx context.Context, dtoID *entitydto.IDRequest) (*stocklocationdto.StockLocationDTO, error) {
+	locationModel, err := s.r.GetStockLocationById(ctx, dtoID.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	locationDTO := &stocklocationdto.StockLocationDTO{}
+	locationDTO.FromDomain(locationModel.ToDomain())
+	return locationDTO, nil
+}
+
+func (s *Service) GetAllStockLocations(ctx context.Context) ([]stocklocationdto.StockLocationDTO, error) {
+	locationModels, err := s.r.GetAllStockLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locationDTOs := []stocklocationdto.StockLocationDTO{}
+	for i := range locationModels {
+		locationDTO := stocklocationdto.StockLocationDTO{}
+		locationDTO.FromDomain(locationModels[i].ToDomain())
+		locationDTOs = append(locationDTOs, locationDTO)
+	}
+
+	return locationDTOs, nil
+}
+
+// GetStockBalances separa o saldo dos lotes ativos de um estoque por local
+// e soma em incoming o que está em trânsito para cada destino.
+func (s *Service) GetStockBalances(ctx context.Context, stockID string) ([]stocklocationdto.StockLocationBalanceDTO, error) {
+	locationModels, err := s.r.GetAllStockLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batchModels, err := s.stockBatchRepo.GetActiveBatchesByStockID(ctx, stockID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar lotes do estoque: %w", err)
+	}
+
+	transferModels, err := s.transferRepo.GetInTransitTransfersByStockID(ctx, stockID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar transferências em trânsito: %w", err)
+	}
+
+	balances := []stocklocationdto.StockLocationBalanceDTO{}
+	indexByLocation := map[uuid.UUID]int{}
+	defaultIndex := -1
+	for i := range locationModels {
+		location := locationModels[i].ToDomain()
+		indexByLocation[location.ID] = len(balances)
+		if location.IsDefault {
+			defaultIndex = len(balances)
+		}
+
+		balances = append(balances, stocklocationdto.StockLocationBalanceDTO{
+			LocationID:   &location.ID,
+			LocationName: location.Name,
+			Quantity:     decimal.Zero,
+			Incoming:     decimal.Zero,
+		})
+	}
+
+	for _, batchModel := range batchModels {
+		index := defaultIndex
+		if batchModel.LocationID != nil {
+			if i, ok := indexByLocation[*batchModel.LocationID]; ok {
+				index = i
+			}
+		}
+
+		if index == -1 {
+			index = len(balances)
+			defaultIndex = index
+			balances = append(balances, stocklocationdto.StockLocationBalanceDTO{
+				LocationName: "Sem local",
+				Quantity:     decimal.Zero,
+				Incoming:     decimal.Zero,
+			})
+		}
+
+		balances[index].Quantity = balances[index].Quantity.Add(batchModel.GetCurrentQuantity())
+		balances[index].Batches++
+	}
+
+	for _, transferModel := range transferModels {
+		if index, ok := indexByLocation[transferModel.ToLocationID]; ok {
+			balances[index].Incoming = balances[index].Incoming.Add(transferModel.GetQuantity())
+		}
+	}
+
+	return balances, nil
+}
+
+func (s *Service) getActiveLocation(ctx context.Context, id uuid.UUID) (*stocklocationentity.StockLocation, error) {
+	locationModel, err := s.r.GetStockLocationById(ctx, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar local %s: %w", id, err)
+	}
+
+	location := locationModel.ToDomain()
+	if !location.IsActive {
+		return nil, stocklocationentity.ErrLocationInactive
+	}
+
+	return location, nil
+}
+
+func (s *Service) getEmployeeID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return uuid.Nil, ErrContextUser
+	}
+
+	employee, err := s.employeeRepo.GetEmployeeByUserID(ctx, userID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("erro ao buscar funcionário: %w", err)
+	}
+
+	return employee.ID, nil
+}
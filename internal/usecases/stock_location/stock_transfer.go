@@ -0,0 +1,193 @@
+package stocklocationusecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklocationentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_location"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	stocklocationdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/stock_location"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+// CreateStockTransfer despacha a transferência: retira dos lotes do local de origem (FIFO)
+// e grava uma linha por lote consumido, com custo e validade para recriar o lote no destino.
+func (s *Service) CreateStockTransfer(ctx context.Context, dto *stocklocationdto.StockTransferCreateDTO) (*stocklocationdto.StockTransferDTO, error) {
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := dto.ToDomain(employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.getActiveLocation(ctx, transfer.FromLocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getActiveLocation(ctx, transfer.ToLocationID); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	movements, err := s.stockService.DispatchTransferWithTx(ctx, tx, transfer.StockID, from, stockentity.TransferEntry{
+		TransferID:     transfer.ID,
+		FromLocationID: transfer.FromLocationID,
+		Quantity:       transfer.Quantity,
+		EmployeeID:     employeeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range movements {
+		batchModel, err := s.stockBatchRepo.GetBatchByID(ctx, tx, movement.BatchID.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar lote %s: %w", movement.BatchID, err)
+		}
+
+		transfer.AddLine(movement.BatchID, movement.Quantity, movement.Price, batchModel.ExpiresAt)
+	}
+
+	transferModel := &model.StockTransfer{}
+	transferModel.FromDomain(transfer)
+	if err := s.transferRepo.CreateStockTransfer(ctx, tx, transferModel); err != nil {
+		return nil, fmt.Errorf("erro ao registrar transferência: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	transferDTO := &stocklocationdto.StockTransferDTO{}
+	transferDTO.FromDomain(transfer)
+	return transferDTO, nil
+}
+
+// ReceiveStockTransfer confirma a chegada no destino: cada linha vira um lote no local de destino
+func (s *Service) ReceiveStockTransfer(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.closeTransfer(ctx, dtoID, func(tx bun.IDB, transfer *stocklocationentity.StockTransfer) error {
+		if err := transfer.Receive(employeeID); err != nil {
+			return err
+		}
+
+		for i := range transfer.Lines {
+			line := &transfer.Lines[i]
+			batch, err := s.stockService.ReceiveTransferWithTx(ctx, tx, transfer.StockID, stockentity.TransferReceipt{
+				TransferID: transfer.ID,
+				LocationID: transfer.ToLocationID,
+				Quantity:   line.Quantity,
+				CostPrice:  line.CostPrice,
+				ExpiresAt:  line.ExpiresAt,
+				EmployeeID: employeeID,
+			}, nil)
+			if err != nil {
+				return err
+			}
+
+			line.DestinationBatchID = &batch.ID
+		}
+
+		return nil
+	})
+}
+
+// CancelStockTransfer devolve a quantidade em trânsito aos lotes de origem
+func (s *Service) CancelStockTransfer(ctx context.Context, dtoID *entitydto.IDRequest) error {
+	employeeID, err := s.getEmployeeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.closeTransfer(ctx, dtoID, func(tx bun.IDB, transfer *stocklocationentity.StockTransfer) error {
+		if err := transfer.Cancel(); err != nil {
+			return err
+		}
+
+		for _, line := range transfer.Lines {
+			if _, err := s.stockService.ReceiveTransferWithTx(ctx, tx, transfer.StockID, stockentity.TransferReceipt{
+				TransferID: transfer.ID,
+				LocationID: transfer.FromLocationID,
+				Quantity:   line.Quantity,
+				CostPrice:  line.CostPrice,
+				ExpiresAt:  line.ExpiresAt,
+				EmployeeID: employeeID,
+			}, line.SourceBatchID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// closeTransfer bloqueia a transferência, aplica o recebimento/cancelamento e grava tudo na mesma transação
+func (s *Service) closeTransfer(ctx context.Context, dtoID *entitydto.IDRequest, apply func(tx bun.IDB, transfer *stocklocationentity.StockTransfer) error) error {
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	transferModel, err := s.transferRepo.GetStockTransferByIDForUpdate(ctx, tx, dtoID.ID.String())
+	if err != nil {
+		return err
+	}
+
+	transfer := transferModel.ToDomain()
+	if err := apply(tx, transfer); err != nil {
+		return err
+	}
+
+	transferModel.FromDomain(transfer)
+	if err := s.transferRepo.UpdateStockTransfer(ctx, tx, transferModel); err != nil {
+		return fmt.Errorf("erro ao atualizar transferência: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (s *Service) GetStockTransferById(ctx context.Context, dtoID *entitydto.IDRequest) (*stocklocationdto.StockTransferDTO, error) {
+	transferModel, err := s.transferRepo.GetStockTransferById(ctx, dtoID.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	transferDTO := &stocklocationdto.StockTransferDTO{}
+	transferDTO.FromDomain(transferModel.ToDomain())
+	return transferDTO, nil
+}
+
+func (s *Service) GetAllStockTransfers(ctx context.Context, page, perPage int, status, stockID string) ([]stocklocationdto.StockTransferDTO, int, error) {
+	transferModels, count, err := s.transferRepo.GetAllStockTransfers(ctx, page, perPage, status, stockID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	transferDTOs := []stocklocationdto.StockTransferDTO{}
+	for i := range transferModels {
+		transferDTO := stocklocationdto.StockTransferDTO{}
+		transferDTO.FromDomain(transferModels[i].ToDomain())
+		transferDTOs = append(transferDTOs, transferDTO)
+	}
+
+	return transferDTOs, count, nil
+}
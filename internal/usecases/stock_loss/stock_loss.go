@@ -0,0 +1,185 @@
+package stocklossusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	orderentity "github.com/willjrcom/sales-backend-go/internal/domain/order"
+	orderprocessentity "github.com/willjrcom/sales-backend-go/internal/domain/order_process"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	stocklossentity "github.com/willjrcom/sales-backend-go/internal/domain/stock_loss"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	stocklossdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/stock_loss"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
+)
+
+var (
+	ErrContextUser              = errors.New("context user not found")
+	ErrOrderProcessNotCancelled = errors.New("order process is not cancelled")
+	ErrGroupItemNotCancelled    = errors.New("group item is not cancelled")
+)
+
+type Service struct {
+	db               *bun.DB
+	r                model.StockLossRepository
+	stockRepo        model.StockRepository
+	stockService     *stockusecases.Service
+	orderProcessRepo model.OrderProcessRepository
+	groupItemRepo    model.GroupItemRepository
+	employeeRepo     model.EmployeeRepository
+}
+
+func NewService(db *bun.DB, r model.StockLossRepository) *Service {
+	return &Service{db: db, r: r}
+}
+
+func (s *Service) AddDependencies(stockRepo model.StockRepository, stockService *stockusecases.Service, orderProcessRepo model.OrderProcessRepository, groupItemRepo model.GroupItemRepository, employeeRepo model.EmployeeRepository) {
+	s.stockRepo = stockRepo
+	s.stockService = stockService
+	s.orderProcessRepo = orderProcessRepo
+	s.groupItemRepo = groupItemRepo
+	s.employeeRepo = employeeRepo
+}
+
+// RegisterStockLoss baixa a perda do estoque (lote informado ou FIFO) e grava o registro
+// valorizado pelo custo dos lotes consumidos, tudo na mesma transação
+func (s *Service) RegisterStockLoss(ctx context.Context, dto *stocklossdto.StockLossCreateDTO) (*stocklossdto.StockLossDTO, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return nil, ErrContextUser
+	}
+
+	employee, err := s.employeeRepo.GetEmployeeByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar funcionário: %w", err)
+	}
+
+	loss, err := dto.ToDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	stockModel, err := s.stockRepo.GetStockByID(ctx, loss.StockID.String())
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar estoque %s: %w", loss.StockID, err)
+	}
+
+	loss.ProductID = stockModel.ProductID
+	loss.ProductVariationID = stockModel.ProductVariationID
+	loss.EmployeeID = employee.ID
+
+	if err := s.resolveSourceReason(ctx, loss); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	movements, err := s.stockService.RegisterLossWithTx(ctx, tx, loss.StockID, stockentity.LossEntry{
+		Quantity:    loss.Quantity,
+		Reason:      fmt.Sprintf("Perda - %s", loss.Reason.Label()),
+		EmployeeID:  employee.ID,
+		StockLossID: loss.ID,
+		BatchID:     loss.BatchID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCost := decimal.Zero
+	for _, movement := range movements {
+		totalCost = totalCost.Add(movement.Quantity.Mul(movement.Price))
+	}
+	loss.TotalCost = totalCost.Round(2)
+
+	lossModel := &model.StockLoss{}
+	lossModel.FromDomain(loss)
+	if err := s.r.CreateStockLoss(ctx, tx, lossModel); err != nil {
+		return nil, fmt.Errorf("erro ao registrar perda: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	lossDTO := &stocklossdto.StockLossDTO{}
+	lossDTO.FromDomain(loss)
+	lossDTO.ProductName = stockModel.Product.Name
+	return lossDTO, nil
+}
+
+func (s *Service) GetStockLossById(ctx context.Context, dto *entitydto.IDRequest) (*stocklossdto.StockLossDTO, error) {
+	lossModel, err := s.r.GetStockLossById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockLossDTO(lossModel), nil
+}
+
+func (s *Service) GetAllStockLosses(ctx context.Context, page, perPage int, reason string, start, end *time.Time) ([]stocklossdto.StockLossDTO, int, error) {
+	lossModels, count, err := s.r.GetAllStockLosses(ctx, page, perPage, reason, start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	lossDTOs := []stocklossdto.StockLossDTO{}
+	for i := range lossModels {
+		lossDTOs = append(lossDTOs, *toStockLossDTO(&lossModels[i]))
+	}
+
+	return lossDTOs, count, nil
+}
+
+// resolveSourceReason exige que o processo/item de origem esteja cancelado e copia o motivo informado no cancelamento
+func (s *Service) resolveSourceReason(ctx context.Context, loss *stocklossentity.StockLoss) error {
+	if loss.OrderProcessID != nil {
+		processModel, err := s.orderProcessRepo.GetProcessById(ctx, loss.OrderProcessID.String(), false)
+		if err != nil {
+			return fmt.Errorf("erro ao buscar processo: %w", err)
+		}
+
+		if processModel.Status != string(orderprocessentity.ProcessStatusCancelled) {
+			return ErrOrderProcessNotCancelled
+		}
+
+		if processModel.CancelledReason != nil {
+			loss.SourceReason = *processModel.CancelledReason
+		}
+	}
+
+	if loss.GroupItemID != nil {
+		groupItemModel, err := s.groupItemRepo.GetGroupByID(ctx, loss.GroupItemID.String(), false)
+		if err != nil {
+			return fmt.Errorf("erro ao buscar grupo de itens: %w", err)
+		}
+
+		if groupItemModel.Status != string(orderentity.StatusGroupCancelled) {
+			return ErrGroupItemNotCancelled
+		}
+
+		loss.SourceReason = groupItemModel.CancelledReason
+	}
+
+	return nil
+}
+
+func toStockLossDTO(lossModel *model.StockLoss) *stocklossdto.StockLossDTO {
+	lossDTO := &stocklossdto.StockLossDTO{}
+	lossDTO.FromDomain(lossModel.ToDomain())
+	if lossModel.Product != nil {
+		lossDTO.ProductName = lossModel.Product.Name
+	}
+	return lossDTO
+}
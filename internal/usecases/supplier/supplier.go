@@ -0,0 +1,83 @@
+package supplierusecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	supplierdto "github.com/willjrcom/sales-backend-go/internal/infra/dto/supplier"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+)
+
+type Service struct {
+	r model.SupplierRepository
+}
+
+func NewService(r model.SupplierRepository) *Service {
+	return &Service{r: r}
+}
+
+func (s *Service) CreateSupplier(ctx context.Context, dto *supplierdto.SupplierCreateDTO) (uuid.UUID, error) {
+	supplier, err := dto.ToDomain()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	supplierModel := &model.Supplier{}
+	supplierModel.FromDomain(supplier)
+	if err := s.r.CreateSupplier(ctx, supplierModel); err != nil {
+		return uuid.Nil, err
+	}
+
+	return supplier.ID, nil
+}
+
+func (s *Service) UpdateSupplier(ctx context.Context, dtoId *entitydto.IDRequest, dto *supplierdto.SupplierUpdateDTO) error {
+	supplierModel, err := s.r.GetSupplierById(ctx, dtoId.ID.String())
+	if err != nil {
+		return err
+	}
+
+	supplier := supplierModel.ToDomain()
+	if err := dto.UpdateDomain(supplier); err != nil {
+		return err
+	}
+
+	supplierModel.FromDomain(supplier)
+	return s.r.UpdateSupplier(ctx, supplierModel)
+}
+
+func (s *Service) DeleteSupplier(ctx context.Context, dto *entitydto.IDRequest) error {
+	if _, err := s.r.GetSupplierById(ctx, dto.ID.String()); err != nil {
+		return err
+	}
+
+	return s.r.DeleteSupplier(ctx, dto.ID.String())
+}
+
+func (s *Service) GetSupplierById(ctx context.Context, dto *entitydto.IDRequest) (*supplierdto.SupplierDTO, error) {
+	supplierModel, err := s.r.GetSupplierById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	supplierDTO := &supplierdto.SupplierDTO{}
+	supplierDTO.FromDomain(supplierModel.ToDomain())
+	return supplierDTO, nil
+}
+
+func (s *Service) GetAllSuppliers(ctx context.Context, page, perPage int, isActive bool) ([]supplierdto.SupplierDTO, int, error) {
+	supplierModels, count, err := s.r.GetAllSuppliers(ctx, page, perPage, isActive)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	supplierDTOs := []supplierdto.SupplierDTO{}
+	for _, supplierModel := range supplierModels {
+		supplierDTO := supplierdto.SupplierDTO{}
+		supplierDTO.FromDomain(supplierModel.ToDomain())
+		supplierDTOs = append(supplierDTOs, supplierDTO)
+	}
+
+	return supplierDTOs, count, nil
+}
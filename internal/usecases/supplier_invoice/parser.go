@@ -0,0 +1,216 @@
+package supplierinvoiceusecases
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	supplierinvoiceentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier_invoice"
+)
+
+var (
+	ErrInvalidNFeXML = errors.New("invalid nf-e xml")
+)
+
+// nfeDocument aceita tanto o XML distribuído (nfeProc) quanto a NFe sem protocolo.
+// encoding/xml casa pelo nome local, então o namespace do portal fiscal não precisa ser declarado.
+type nfeDocument struct {
+	XMLName xml.Name
+	NFe     *nfeNFe     `xml:"NFe"`
+	InfNFe  *nfeInfNFe  `xml:"infNFe"`
+	ProtNFe *nfeProtNFe `xml:"protNFe"`
+}
+
+type nfeNFe struct {
+	InfNFe nfeInfNFe `xml:"infNFe"`
+}
+
+type nfeProtNFe struct {
+	InfProt struct {
+		ChNFe string `xml:"chNFe"`
+	} `xml:"infProt"`
+}
+
+type nfeInfNFe struct {
+	ID  string `xml:"Id,attr"`
+	Ide struct {
+		NNF   string `xml:"nNF"`
+		Serie string `xml:"serie"`
+		DhEmi string `xml:"dhEmi"`
+		DEmi  string `xml:"dEmi"`
+	} `xml:"ide"`
+	Emit struct {
+		CNPJ  string `xml:"CNPJ"`
+		XNome string `xml:"xNome"`
+		XFant string `xml:"xFant"`
+		IE    string `xml:"IE"`
+	} `xml:"emit"`
+	Dest struct {
+		CNPJ string `xml:"CNPJ"`
+	} `xml:"dest"`
+	Det []struct {
+		NItem string `xml:"nItem,attr"`
+		Prod  struct {
+			CProd  string `xml:"cProd"`
+			CEAN   string `xml:"cEAN"`
+			XProd  string `xml:"xProd"`
+			NCM    string `xml:"NCM"`
+			UCom   string `xml:"uCom"`
+			QCom   string `xml:"qCom"`
+			VUnCom string `xml:"vUnCom"`
+			VProd  string `xml:"vProd"`
+			Rastro []struct {
+				NLote string `xml:"nLote"`
+				QLote string `xml:"qLote"`
+				DFab  string `xml:"dFab"`
+				DVal  string `xml:"dVal"`
+			} `xml:"rastro"`
+		} `xml:"prod"`
+	} `xml:"det"`
+	Total struct {
+		ICMSTot struct {
+			VNF string `xml:"vNF"`
+		} `xml:"ICMSTot"`
+	} `xml:"total"`
+}
+
+// parsedNFe é o resultado da leitura do XML, antes de resolver fornecedor e mapeamentos
+type parsedNFe struct {
+	AccessKey        string
+	Number           string
+	Series           string
+	IssuedAt         *time.Time
+	TotalAmount      decimal.Decimal
+	EmitterCnpj      string
+	EmitterName      string
+	EmitterTradeName string
+	EmitterIE        string
+	RecipientCnpj    string
+	Items            []supplierinvoiceentity.SupplierInvoiceItem
+}
+
+func parseNFe(r io.Reader) (*parsedNFe, error) {
+	doc := &nfeDocument{}
+	if err := xml.NewDecoder(r).Decode(doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidNFeXML, err)
+	}
+
+	var inf *nfeInfNFe
+	switch {
+	case doc.NFe != nil:
+		inf = &doc.NFe.InfNFe
+	case doc.InfNFe != nil:
+		inf = doc.InfNFe
+	default:
+		return nil, fmt.Errorf("%w: infNFe not found", ErrInvalidNFeXML)
+	}
+
+	accessKey := strings.TrimPrefix(strings.TrimSpace(inf.ID), "NFe")
+	if doc.ProtNFe != nil && doc.ProtNFe.InfProt.ChNFe != "" {
+		accessKey = strings.TrimSpace(doc.ProtNFe.InfProt.ChNFe)
+	}
+
+	parsed := &parsedNFe{
+		AccessKey:        accessKey,
+		Number:           strings.TrimSpace(inf.Ide.NNF),
+		Series:           strings.TrimSpace(inf.Ide.Serie),
+		IssuedAt:         parseNFeDate(inf.Ide.DhEmi, inf.Ide.DEmi),
+		EmitterCnpj:      strings.TrimSpace(inf.Emit.CNPJ),
+		EmitterName:      strings.TrimSpace(inf.Emit.XNome),
+		EmitterTradeName: strings.TrimSpace(inf.Emit.XFant),
+		EmitterIE:        strings.TrimSpace(inf.Emit.IE),
+		RecipientCnpj:    strings.TrimSpace(inf.Dest.CNPJ),
+	}
+
+	var err error
+	if parsed.TotalAmount, err = parseNFeDecimal("vNF", inf.Total.ICMSTot.VNF); err != nil {
+		return nil, err
+	}
+
+	for i, det := range inf.Det {
+		itemNumber, convErr := strconv.Atoi(det.NItem)
+		if convErr != nil {
+			itemNumber = i + 1
+		}
+
+		item := supplierinvoiceentity.SupplierInvoiceItem{
+			ItemNumber:   itemNumber,
+			SupplierCode: strings.TrimSpace(det.Prod.CProd),
+			EAN:          cleanEAN(det.Prod.CEAN),
+			Description:  strings.TrimSpace(det.Prod.XProd),
+			NCM:          strings.TrimSpace(det.Prod.NCM),
+			Unit:         strings.TrimSpace(det.Prod.UCom),
+		}
+
+		if item.Quantity, err = parseNFeDecimal("qCom", det.Prod.QCom); err != nil {
+			return nil, err
+		}
+		if item.UnitCost, err = parseNFeDecimal("vUnCom", det.Prod.VUnCom); err != nil {
+			return nil, err
+		}
+		if item.Total, err = parseNFeDecimal("vProd", det.Prod.VProd); err != nil {
+			return nil, err
+		}
+
+		for _, rastro := range det.Prod.Rastro {
+			lot := supplierinvoiceentity.InvoiceLot{
+				Code:           strings.TrimSpace(rastro.NLote),
+				ManufacturedAt: parseNFeDate(rastro.DFab),
+				ExpiresAt:      parseNFeDate(rastro.DVal),
+			}
+			if lot.Quantity, err = parseNFeDecimal("qLote", rastro.QLote); err != nil {
+				return nil, err
+			}
+			item.Lots = append(item.Lots, lot)
+		}
+
+		parsed.Items = append(parsed.Items, item)
+	}
+
+	return parsed, nil
+}
+
+func parseNFeDecimal(field, value string) (decimal.Decimal, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return decimal.Zero, nil
+	}
+
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: invalid %s %q", ErrInvalidNFeXML, field, value)
+	}
+	return d, nil
+}
+
+// parseNFeDate lê o primeiro valor preenchido entre data/hora com fuso (dhEmi) e data simples (dEmi, dVal)
+func parseNFeDate(values ...string) *time.Time {
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		for _, layout := range []string{time.RFC3339, time.DateOnly} {
+			if t, err := time.Parse(layout, value); err == nil {
+				t = t.UTC()
+				return &t
+			}
+		}
+	}
+	return nil
+}
+
+// cleanEAN descarta o "SEM GTIN" usado quando o produto não tem código de barras
+func cleanEAN(value string) string {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "SEM GTIN") {
+		return ""
+	}
+	return value
+}
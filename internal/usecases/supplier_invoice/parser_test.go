@@ -0,0 +1,95 @@
+package supplierinvoiceusecases
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sampleNFe = `<?xml version="1.0" encoding="UTF-8"?>
+<nfeProc xmlns="http://www.portalfiscal.inf.br/nfe" versao="4.00">
+  <NFe>
+    <infNFe Id="NFe35261012345678000199550010000012341000012345" versao="4.00">
+      <ide><serie>1</serie><nNF>1234</nNF><dhEmi>2026-10-15T09:30:00-03:00</dhEmi></ide>
+      <emit><CNPJ>12345678000199</CNPJ><xNome>Distribuidora Exemplo LTDA</xNome><xFant>Dist Exemplo</xFant><IE>123456789</IE></emit>
+      <dest><CNPJ>98765432000188</CNPJ></dest>
+      <det nItem="1">
+        <prod>
+          <cProd>QJ-MUS-1KG</cProd><cEAN>7891234567895</cEAN><xProd>QUEIJO MUSSARELA 1KG</xProd><NCM>04061010</NCM>
+          <uCom>CX</uCom><qCom>2.0000</qCom><vUnCom>120.0000000000</vUnCom><vProd>240.00</vProd>
+          <rastro><nLote>L01</nLote><qLote>1.000</qLote><dFab>2026-10-01</dFab><dVal>2026-12-01</dVal></rastro>
+          <rastro><nLote>L02</nLote><qLote>1.000</qLote><dFab>2026-10-05</dFab><dVal>2026-12-10</dVal></rastro>
+        </prod>
+      </det>
+      <det nItem="2">
+        <prod>
+          <cProd>FRT</cProd><cEAN>SEM GTIN</cEAN><xProd>FRETE</xProd><NCM>00000000</NCM>
+          <uCom>UN</uCom><qCom>1.0000</qCom><vUnCom>15.00</vUnCom><vProd>15.00</vProd>
+        </prod>
+      </det>
+      <total><ICMSTot><vNF>255.00</vNF></ICMSTot></total>
+    </infNFe>
+  </NFe>
+  <protNFe versao="4.00"><infProt><chNFe>35261012345678000199550010000012341000012345</chNFe></infProt></protNFe>
+</nfeProc>`
+
+// ─────────────────────────────────────────────────────────────
+// parseNFe
+// ─────────────────────────────────────────────────────────────
+
+func TestParseNFe_ReadsHeaderItemsAndLots(t *testing.T) {
+	parsed, err := parseNFe(strings.NewReader(sampleNFe))
+	require.NoError(t, err)
+
+	assert.Equal(t, "35261012345678000199550010000012341000012345", parsed.AccessKey)
+	assert.Equal(t, "1234", parsed.Number)
+	assert.Equal(t, "1", parsed.Series)
+	assert.Equal(t, "12345678000199", parsed.EmitterCnpj)
+	assert.Equal(t, "Distribuidora Exemplo LTDA", parsed.EmitterName)
+	assert.Equal(t, "98765432000188", parsed.RecipientCnpj)
+	assert.Equal(t, "255", parsed.TotalAmount.String())
+	require.NotNil(t, parsed.IssuedAt)
+	assert.Equal(t, 12, parsed.IssuedAt.Hour(), "dhEmi deve ser convertido para UTC")
+
+	require.Len(t, parsed.Items, 2)
+	cheese := parsed.Items[0]
+	assert.Equal(t, 1, cheese.ItemNumber)
+	assert.Equal(t, "QJ-MUS-1KG", cheese.SupplierCode)
+	assert.Equal(t, "04061010", cheese.NCM)
+	assert.Equal(t, "2", cheese.Quantity.String())
+	assert.Equal(t, "120", cheese.UnitCost.String())
+	require.Len(t, cheese.Lots, 2)
+	assert.Equal(t, "L02", cheese.Lots[1].Code)
+	require.NotNil(t, cheese.Lots[1].ExpiresAt)
+	assert.Equal(t, "2026-12-10", cheese.Lots[1].ExpiresAt.Format("2006-01-02"))
+
+	assert.Empty(t, parsed.Items[1].EAN, "SEM GTIN deve ser descartado")
+	assert.Empty(t, parsed.Items[1].Lots)
+}
+
+func TestParseNFe_WithoutProtocolUsesInfNFeID(t *testing.T) {
+	start := strings.Index(sampleNFe, "<NFe>")
+	end := strings.Index(sampleNFe, "</NFe>") + len("</NFe>")
+
+	parsed, err := parseNFe(strings.NewReader(sampleNFe[start:end]))
+	require.NoError(t, err)
+	assert.Equal(t, "35261012345678000199550010000012341000012345", parsed.AccessKey)
+	assert.Len(t, parsed.Items, 2)
+}
+
+func TestParseNFe_InvalidXML(t *testing.T) {
+	_, err := parseNFe(strings.NewReader("not xml"))
+	assert.ErrorIs(t, err, ErrInvalidNFeXML)
+
+	_, err = parseNFe(strings.NewReader("<root><foo/></root>"))
+	assert.ErrorIs(t, err, ErrInvalidNFeXML)
+}
+
+func TestParseNFe_InvalidQuantity(t *testing.T) {
+	xmlContent := strings.Replace(sampleNFe, "<qCom>2.0000</qCom>", "<qCom>dois</qCom>", 1)
+
+	_, err := parseNFe(strings.NewReader(xmlContent))
+	assert.ErrorIs(t, err, ErrInvalidNFeXML)
+}
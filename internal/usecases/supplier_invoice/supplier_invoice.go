@@ -0,0 +1,409 @@
+package supplierinvoiceusecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+	"github.com/willjrcom/sales-backend-go/bootstrap/database"
+	companyentity "github.com/willjrcom/sales-backend-go/internal/domain/company"
+	stockentity "github.com/willjrcom/sales-backend-go/internal/domain/stock"
+	supplierentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier"
+	supplierinvoiceentity "github.com/willjrcom/sales-backend-go/internal/domain/supplier_invoice"
+	entitydto "github.com/willjrcom/sales-backend-go/internal/infra/dto/entity"
+	supplierinvoicedto "github.com/willjrcom/sales-backend-go/internal/infra/dto/supplier_invoice"
+	"github.com/willjrcom/sales-backend-go/internal/infra/repository/model"
+	stockusecases "github.com/willjrcom/sales-backend-go/internal/usecases/stock"
+)
+
+var (
+	ErrInvoiceNotAddressedToCompany = errors.New("nf-e recipient cnpj does not match the company")
+	ErrEmitterCnpjRequired          = errors.New("nf-e emitter cnpj is required")
+	ErrContextUser                  = errors.New("context user not found")
+)
+
+type Service struct {
+	db           *bun.DB
+	r            model.SupplierInvoiceRepository
+	mappingRepo  model.SupplierProductMappingRepository
+	supplierRepo model.SupplierRepository
+	stockRepo    model.StockRepository
+	stockService *stockusecases.Service
+	employeeRepo model.EmployeeRepository
+	companyRepo  model.CompanyRepository
+}
+
+func NewService(db *bun.DB, r model.SupplierInvoiceRepository, mappingRepo model.SupplierProductMappingRepository) *Service {
+	return &Service{db: db, r: r, mappingRepo: mappingRepo}
+}
+
+func (s *Service) AddDependencies(supplierRepo model.SupplierRepository, stockRepo model.StockRepository, stockService *stockusecases.Service, employeeRepo model.EmployeeRepository, companyRepo model.CompanyRepository) {
+	s.supplierRepo = supplierRepo
+	s.stockRepo = stockRepo
+	s.stockService = stockService
+	s.employeeRepo = employeeRepo
+	s.companyRepo = companyRepo
+}
+
+// UploadSupplierInvoice lê o XML da NF-e de compra e devolve a prévia com os estoques sugeridos a
+// partir dos mapeamentos já conhecidos do fornecedor. Reenviar uma nota pendente devolve a mesma prévia;
+// reenviar uma nota já importada é rejeitado pela chave de acesso.
+func (s *Service) UploadSupplierInvoice(ctx context.Context, xmlReader io.Reader) (*supplierinvoicedto.SupplierInvoiceDTO, error) {
+	parsed, err := parseNFe(xmlReader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateRecipient(ctx, parsed.RecipientCnpj); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.r.GetSupplierInvoiceByAccessKey(ctx, parsed.AccessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		if existing.Status == string(supplierinvoiceentity.StatusImported) {
+			return nil, supplierinvoiceentity.ErrInvoiceAlreadyImported
+		}
+		return s.GetSupplierInvoiceById(ctx, &entitydto.IDRequest{ID: existing.ID})
+	}
+
+	supplierModel, err := s.findOrCreateSupplier(ctx, parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := supplierinvoiceentity.NewSupplierInvoice(supplierinvoiceentity.SupplierInvoiceCommonAttributes{
+		AccessKey:   parsed.AccessKey,
+		SupplierID:  supplierModel.ID,
+		Number:      parsed.Number,
+		Series:      parsed.Series,
+		IssuedAt:    parsed.IssuedAt,
+		TotalAmount: parsed.TotalAmount,
+		Items:       parsed.Items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.applyKnownMappings(ctx, invoice); err != nil {
+		return nil, err
+	}
+
+	invoiceModel := &model.SupplierInvoice{}
+	invoiceModel.FromDomain(invoice)
+	if err := s.r.CreateSupplierInvoice(ctx, invoiceModel); err != nil {
+		return nil, err
+	}
+
+	invoiceDTO := &supplierinvoicedto.SupplierInvoiceDTO{}
+	invoiceDTO.FromDomain(invoice)
+	invoiceDTO.SupplierName = supplierModel.Name
+	return invoiceDTO, nil
+}
+
+// UpdateSupplierInvoiceMapping confirma o estoque de cada linha e memoriza o vínculo
+// código do fornecedor → estoque para as próximas notas
+func (s *Service) UpdateSupplierInvoiceMapping(ctx context.Context, dtoId *entitydto.IDRequest, dto *supplierinvoicedto.SupplierInvoiceMappingDTO) (*supplierinvoicedto.SupplierInvoiceDTO, error) {
+	if err := s.validateMappingStocks(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	invoiceModel, err := s.r.GetSupplierInvoiceByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := invoiceModel.ToDomain()
+	if err := applyMapping(invoice, dto); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveInvoiceAndMappings(ctx, tx, invoiceModel, invoice); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	invoiceDTO := &supplierinvoicedto.SupplierInvoiceDTO{}
+	invoiceDTO.FromDomain(invoice)
+	return invoiceDTO, nil
+}
+
+// ImportSupplierInvoice dá entrada da nota no estoque: um StockBatch e um movimento IN por lote
+// (rastro) de cada item mapeado, tudo na mesma transação que marca a nota como importada.
+func (s *Service) ImportSupplierInvoice(ctx context.Context, dtoId *entitydto.IDRequest, dto *supplierinvoicedto.SupplierInvoiceMappingDTO) (*supplierinvoicedto.SupplierInvoiceImportDTO, error) {
+	userID, ok := ctx.Value(companyentity.UserValue("user_id")).(string)
+	if !ok {
+		return nil, ErrContextUser
+	}
+
+	employee, err := s.employeeRepo.GetEmployeeByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar funcionário: %w", err)
+	}
+
+	if err := s.validateMappingStocks(ctx, dto); err != nil {
+		return nil, err
+	}
+
+	ctx, tx, cancel, err := database.GetTenantTransaction(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+	defer tx.Rollback()
+
+	invoiceModel, err := s.r.GetSupplierInvoiceByIDForUpdate(ctx, tx, dtoId.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := invoiceModel.ToDomain()
+	if err := applyMapping(invoice, dto); err != nil {
+		return nil, err
+	}
+
+	entries, err := invoice.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &supplierinvoicedto.SupplierInvoiceImportDTO{}
+	for _, entry := range entries {
+		reason := fmt.Sprintf("NF-e %s/%s", invoice.Number, invoice.Series)
+		if entry.LotCode != "" {
+			reason += " lote " + entry.LotCode
+		}
+
+		movement, err := s.stockService.ReceiveBatchWithTx(ctx, tx, entry.StockID, stockentity.BatchEntry{
+			Quantity:          entry.Quantity,
+			CostPrice:         entry.UnitCost,
+			ExpiresAt:         entry.ExpiresAt,
+			Reason:            reason,
+			EmployeeID:        employee.ID,
+			SupplierInvoiceID: &invoice.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.MovementIDs = append(result.MovementIDs, movement.ID)
+	}
+
+	if err := invoice.MarkImported(); err != nil {
+		return nil, err
+	}
+
+	if err := s.saveInvoiceAndMappings(ctx, tx, invoiceModel, invoice); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result.Invoice.FromDomain(invoice)
+	return result, nil
+}
+
+func (s *Service) GetSupplierInvoiceById(ctx context.Context, dto *entitydto.IDRequest) (*supplierinvoicedto.SupplierInvoiceDTO, error) {
+	invoiceModel, err := s.r.GetSupplierInvoiceById(ctx, dto.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return toSupplierInvoiceDTO(invoiceModel), nil
+}
+
+func (s *Service) GetAllSupplierInvoices(ctx context.Context, page, perPage int, status string) ([]supplierinvoicedto.SupplierInvoiceDTO, int, error) {
+	invoiceModels, count, err := s.r.GetAllSupplierInvoices(ctx, page, perPage, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	invoiceDTOs := []supplierinvoicedto.SupplierInvoiceDTO{}
+	for i := range invoiceModels {
+		invoiceDTOs = append(invoiceDTOs, *toSupplierInvoiceDTO(&invoiceModels[i]))
+	}
+
+	return invoiceDTOs, count, nil
+}
+
+// validateRecipient impede dar entrada em nota emitida para outro CNPJ
+func (s *Service) validateRecipient(ctx context.Context, recipientCnpj string) error {
+	if recipientCnpj == "" {
+		return nil
+	}
+
+	companyModel, err := s.companyRepo.GetCompany(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	if companyCnpj := onlyDigits(companyModel.Cnpj); companyCnpj != "" && companyCnpj != onlyDigits(recipientCnpj) {
+		return ErrInvoiceNotAddressedToCompany
+	}
+
+	return nil
+}
+
+// findOrCreateSupplier localiza o fornecedor pelo CNPJ do emitente ou cadastra com os dados da nota
+func (s *Service) findOrCreateSupplier(ctx context.Context, parsed *parsedNFe) (*model.Supplier, error) {
+	cnpj := onlyDigits(parsed.EmitterCnpj)
+	if cnpj == "" {
+		return nil, ErrEmitterCnpjRequired
+	}
+
+	supplierModel, err := s.supplierRepo.GetSupplierByCnpj(ctx, cnpj)
+	if err != nil {
+		return nil, err
+	}
+
+	if supplierModel != nil {
+		return supplierModel, nil
+	}
+
+	supplier, err := supplierentity.NewSupplier(supplierentity.SupplierCommonAttributes{
+		Name:              parsed.EmitterName,
+		TradeName:         parsed.EmitterTradeName,
+		Cnpj:              cnpj,
+		StateRegistration: parsed.EmitterIE,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	supplierModel = &model.Supplier{}
+	supplierModel.FromDomain(supplier)
+	if err := s.supplierRepo.CreateSupplier(ctx, supplierModel); err != nil {
+		return nil, err
+	}
+
+	return supplierModel, nil
+}
+
+func (s *Service) applyKnownMappings(ctx context.Context, invoice *supplierinvoiceentity.SupplierInvoice) error {
+	mappingModels, err := s.mappingRepo.GetMappingsBySupplierID(ctx, invoice.SupplierID.String())
+	if err != nil {
+		return err
+	}
+
+	known := map[string]*supplierentity.SupplierProductMapping{}
+	for i := range mappingModels {
+		known[mappingModels[i].SupplierCode] = mappingModels[i].ToDomain()
+	}
+
+	for _, item := range invoice.Items {
+		mapping, ok := known[item.SupplierCode]
+		if !ok {
+			continue
+		}
+
+		stockID := mapping.StockID
+		if err := invoice.MapItem(item.ItemNumber, &stockID, mapping.Factor, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) validateMappingStocks(ctx context.Context, dto *supplierinvoicedto.SupplierInvoiceMappingDTO) error {
+	if dto == nil {
+		return nil
+	}
+
+	for _, item := range dto.Items {
+		if item.StockID == nil || item.Ignored {
+			continue
+		}
+
+		if _, err := s.stockRepo.GetStockByID(ctx, item.StockID.String()); err != nil {
+			return fmt.Errorf("erro ao buscar estoque %s do item %d: %w", item.StockID, item.ItemNumber, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) saveInvoiceAndMappings(ctx context.Context, tx bun.IDB, invoiceModel *model.SupplierInvoice, invoice *supplierinvoiceentity.SupplierInvoice) error {
+	mappings := []model.SupplierProductMapping{}
+	for _, item := range invoice.Items {
+		if item.StockID == nil || item.SupplierCode == "" {
+			continue
+		}
+
+		mapping, err := supplierentity.NewSupplierProductMapping(invoice.SupplierID, item.SupplierCode, *item.StockID, item.Factor)
+		if err != nil {
+			return err
+		}
+
+		mappingModel := model.SupplierProductMapping{}
+		mappingModel.FromDomain(mapping)
+		mappings = append(mappings, mappingModel)
+	}
+
+	if err := s.mappingRepo.UpsertMappings(ctx, tx, mappings); err != nil {
+		return fmt.Errorf("erro ao salvar mapeamento de produtos do fornecedor: %w", err)
+	}
+
+	invoiceModel.FromDomain(invoice)
+	return s.r.UpdateSupplierInvoice(ctx, tx, invoiceModel)
+}
+
+func applyMapping(invoice *supplierinvoiceentity.SupplierInvoice, dto *supplierinvoicedto.SupplierInvoiceMappingDTO) error {
+	if invoice.IsImported() {
+		return supplierinvoiceentity.ErrInvoiceAlreadyImported
+	}
+
+	if dto == nil {
+		return nil
+	}
+
+	for _, item := range dto.Items {
+		var stockID *uuid.UUID
+		if item.StockID != nil && *item.StockID != uuid.Nil {
+			stockID = item.StockID
+		}
+
+		if err := invoice.MapItem(item.ItemNumber, stockID, item.Factor, item.Ignored); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toSupplierInvoiceDTO(invoiceModel *model.SupplierInvoice) *supplierinvoicedto.SupplierInvoiceDTO {
+	invoiceDTO := &supplierinvoicedto.SupplierInvoiceDTO{}
+	invoiceDTO.FromDomain(invoiceModel.ToDomain())
+	if invoiceModel.Supplier != nil {
+		invoiceDTO.SupplierName = invoiceModel.Supplier.Name
+	}
+	return invoiceDTO
+}
+
+func onlyDigits(value string) string {
+	digits := make([]rune, 0, len(value))
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, r)
+		}
+	}
+	return string(digits)
+}
@@ -37,9 +37,24 @@ func main() {
 	rootCmd.AddCommand(cmd.MigrateAllCmd)
 	rootCmd.AddCommand(cmd.PublicMigrateAllCmd)
 
+	// Tabela IBPT compartilhada: importada só no deploy
+	cmd.IbptImportCmd.Flags().String("uf", "", "UF of the table (e.g. SP)")
+	cmd.IbptImportCmd.Flags().StringP("file", "f", "", "TabelaIBPTax CSV file")
+	if err := cmd.IbptImportCmd.MarkFlagRequired("uf"); err != nil {
+		panic(err)
+	}
+	if err := cmd.IbptImportCmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(cmd.IbptImportCmd)
+
 	// Email Worker
 	rootCmd.AddCommand(cmd.EmailworkerCmd)
 
+	// Focus NFe local stand-in
+	cmd.FocusNFeFakeCmd.Flags().StringP("port", "p", ":8090", "the port to listen on")
+	rootCmd.AddCommand(cmd.FocusNFeFakeCmd)
+
 	ctx := context.Background()
 	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		panic(err)